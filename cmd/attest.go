@@ -0,0 +1,150 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/anchore/stereoscope"
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/attest"
+	"github.com/anchore/syft/internal/bus"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/internal/output"
+	"github.com/anchore/syft/syft/event"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/anchore/syft/syft/source"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/wagoodman/go-partybus"
+)
+
+const attestExample = `  {{.appName}} {{.command}} --key cosign.key alpine:latest               create a signed SBOM attestation for an image
+  {{.appName}} {{.command}} --key cosign.key docker-archive:image.tar     attest an image from a "docker save" tarball (no registry or daemon required)
+  {{.appName}} {{.command}} --key awskms:///alias/sbom alpine:latest       sign the attestation with a key held in AWS KMS
+
+  The attestation is a DSSE envelope containing an in-toto statement with the syft-json SBOM as the predicate
+  (predicate type "https://syft.dev/bom"). The key is given in the same way as for cosign: a cosign key pair (from
+  "cosign generate-key-pair", with the password given by SYFT_ATTEST_PASSWORD or COSIGN_PASSWORD), an unencrypted
+  PKCS8/EC private key, or a KMS key reference (awskms://, gcpkms://, azurekms:// or hashivault://). Signing with a
+  local key is performed entirely offline. Nothing is uploaded to a transparency log and no certificate is requested.
+`
+
+var attestCmd = &cobra.Command{
+	Use:   "attest --key [KEY] [IMAGE]",
+	Short: "Generate a signed SBOM attestation for a container image",
+	Example: internal.Tprintf(attestExample, map[string]interface{}{
+		"appName": internal.ApplicationName,
+		"command": "attest",
+	}),
+	Args:          validateInputArgs,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return attestExec(cmd, args)
+	},
+	ValidArgsFunction: dockerImageValidArgsFunction,
+}
+
+func init() {
+	flags := attestCmd.Flags()
+	flags.StringP(
+		"key", "", "cosign.key",
+		"the key used to sign the attestation: a path to a private key or a KMS key reference (e.g. awskms://...)",
+	)
+
+	if err := viper.BindPFlag("attest.key", flags.Lookup("key")); err != nil {
+		panic(fmt.Sprintf("unable to bind flag 'key': %+v", err))
+	}
+
+	rootCmd.AddCommand(attestCmd)
+}
+
+func attestExec(_ *cobra.Command, args []string) error {
+	// could be an image or a directory, with or without a scheme
+	userInput := args[0]
+
+	defer startTracing("attest", userInput)()
+
+	if err := rejectPublishConfig("attest"); err != nil {
+		return err
+	}
+
+	// the key is loaded before cataloging so that a bad key or password fails fast
+	signer, err := attest.LoadSigner(context.Background(), appConfig.Attest.Key, []byte(appConfig.Attest.Password))
+	if err != nil {
+		return err
+	}
+
+	writer, err := output.MakeWriter(output.WriterOption{
+		Format: attest.Format(signer),
+		Path:   appConfig.Output.File,
+	})
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err := writer.Close(); err != nil {
+			log.Warnf("unable to write to report destination: %+v", err)
+		}
+	}()
+
+	return eventLoop(
+		scratchSpace.Guard(attestExecWorker(userInput, writer)),
+		setupSignals(),
+		eventSubscription,
+		stereoscope.Cleanup,
+		selectUI()...,
+	)
+}
+
+func attestExecWorker(userInput string, writer sbom.Writer) <-chan error {
+	errs := make(chan error)
+	go func() {
+		defer close(errs)
+
+		tasks, err := tasks()
+		if err != nil {
+			errs <- err
+			return
+		}
+
+		verification, err := checkInput(userInput)
+		if err != nil {
+			errs <- err
+			return
+		}
+
+		src, cleanup, err := newSource(userInput, verification)
+		if cleanup != nil {
+			defer cleanup()
+		}
+		if err != nil {
+			errs <- err
+			return
+		}
+
+		if src.Metadata.Scheme != source.ImageScheme {
+			errs <- fmt.Errorf("attestations can only be created for container images (got %q)", userInput)
+			return
+		}
+
+		s, err := newSBOM(src)
+		if err != nil {
+			errs <- err
+			return
+		}
+		if err := catalogWithCache(&s, src, tasks); err != nil {
+			errs <- err
+			return
+		}
+
+		bus.Publish(partybus.Event{
+			Type: event.Exit,
+			Value: func() error {
+				return writeResults(s, writer)
+			},
+		})
+	}()
+	return errs
+}
@@ -0,0 +1,299 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/anchore/stereoscope"
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/batch"
+	"github.com/anchore/syft/internal/bus"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/internal/output"
+	"github.com/anchore/syft/syft/event"
+	"github.com/anchore/syft/syft/format"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/wagoodman/go-partybus"
+)
+
+const batchExample = `  {{.appName}} {{.command}} -f targets.yaml                                 catalog all targets, writing a syft-json SBOM for each
+  {{.appName}} {{.command}} -f targets.yaml -o spdx-json -o cyclonedx-json  write multiple formats for each target
+  {{.appName}} {{.command}} -f targets.yaml --output-template "sboms/{{"{{"}}.Name{{"}}"}}/{{"{{"}}.Format{{"}}"}}"
+  {{.appName}} {{.command}} --registry registry.example.com                 catalog every repository and tag in a registry
+  {{.appName}} {{.command}} --registry registry.example.com --registry-include "team-a/**" --registry-exclude "**/*:*-rc*"
+
+  The targets file lists the sources to catalog (any input accepted by the packages command):
+
+    targets:
+      - input: alpine:3.15
+      - input: dir:./my-project
+        name: my-project
+
+  Alternatively, all images within a registry can be cataloged (via the registry catalog API). Include and exclude
+  patterns are globs matched against "<repository>:<tag>". Registry credentials and TLS options are the same as
+  those used when pulling images.
+
+  The output template may reference {{"{{"}}.Index{{"}}"}}, {{"{{"}}.Name{{"}}"}}, {{"{{"}}.Input{{"}}"}}, and {{"{{"}}.Format{{"}}"}}. A JSON summary of the
+  successes and failures of all targets is written to STDOUT (or --summary).
+`
+
+var batchCmd = &cobra.Command{
+	Use:   "batch [-f TARGETS-FILE | --registry HOST]",
+	Short: "Generate package SBOMs for many sources at once",
+	Example: internal.Tprintf(batchExample, map[string]interface{}{
+		"appName": internal.ApplicationName,
+		"command": "batch",
+	}),
+	Args:          cobra.NoArgs,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return batchExec(cmd, args)
+	},
+}
+
+func init() {
+	flags := batchCmd.Flags()
+	flags.StringP(
+		"file", "f", "",
+		"the YAML file listing the targets to catalog",
+	)
+
+	flags.StringP(
+		"registry", "", "",
+		"catalog every repository and tag within the given registry (host[:port]) instead of a targets file",
+	)
+
+	flags.StringArrayP(
+		"registry-include", "", nil,
+		"only catalog registry images matching the given glob of \"<repository>:<tag>\" (can be given multiple times)",
+	)
+	if err := viper.BindPFlag("batch.registry.include", flags.Lookup("registry-include")); err != nil {
+		panic(fmt.Sprintf("unable to bind flag 'registry-include': %+v", err))
+	}
+
+	flags.StringArrayP(
+		"registry-exclude", "", nil,
+		"skip registry images matching the given glob of \"<repository>:<tag>\" (can be given multiple times)",
+	)
+	if err := viper.BindPFlag("batch.registry.exclude", flags.Lookup("registry-exclude")); err != nil {
+		panic(fmt.Sprintf("unable to bind flag 'registry-exclude': %+v", err))
+	}
+
+	flags.StringArrayP(
+		"output", "o", []string{string(format.JSONOption)},
+		fmt.Sprintf("report output format(s) to write for every target, options=%v", format.AllOptions),
+	)
+	if err := viper.BindPFlag("batch.output", flags.Lookup("output")); err != nil {
+		panic(fmt.Sprintf("unable to bind flag 'output': %+v", err))
+	}
+
+	flags.IntP(
+		"parallelism", "", 4,
+		"the max number of targets to catalog at once",
+	)
+	if err := viper.BindPFlag("batch.parallelism", flags.Lookup("parallelism")); err != nil {
+		panic(fmt.Sprintf("unable to bind flag 'parallelism': %+v", err))
+	}
+
+	flags.StringP(
+		"output-template", "", batch.DefaultOutputTemplate,
+		"go template for the path of each output file",
+	)
+	if err := viper.BindPFlag("batch.output-template", flags.Lookup("output-template")); err != nil {
+		panic(fmt.Sprintf("unable to bind flag 'output-template': %+v", err))
+	}
+
+	flags.StringP(
+		"summary", "", "",
+		"file to write the JSON batch summary to (default is STDOUT)",
+	)
+	if err := viper.BindPFlag("batch.summary", flags.Lookup("summary")); err != nil {
+		panic(fmt.Sprintf("unable to bind flag 'summary': %+v", err))
+	}
+
+	rootCmd.AddCommand(batchCmd)
+}
+
+func batchExec(cmd *cobra.Command, _ []string) error {
+	targetsFile, err := cmd.Flags().GetString("file")
+	if err != nil {
+		return err
+	}
+
+	registry, err := cmd.Flags().GetString("registry")
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case targetsFile == "" && registry == "":
+		return fmt.Errorf("either a targets file (-f) or a registry (--registry) must be given")
+	case targetsFile != "" && registry != "":
+		return fmt.Errorf("a targets file (-f) and a registry (--registry) cannot be given together")
+	}
+
+	userInput := targetsFile
+	if registry != "" {
+		userInput = registry
+	}
+	defer startTracing("batch", userInput)()
+
+	if err := rejectPublishConfig("batch"); err != nil {
+		return err
+	}
+
+	var targets []batch.Target
+	if registry != "" {
+		if appConfig.Offline {
+			return fmt.Errorf("unable to list the images in registry %q: %w", registry, errOffline)
+		}
+		targets, err = batch.RegistryTargets(context.Background(), registry, appConfig.Batch.RegistryFilter(), appConfig.Registry.ToOptions())
+		if err != nil {
+			return err
+		}
+		if len(targets) == 0 {
+			return fmt.Errorf("no images found in registry %q (after applying include/exclude patterns)", registry)
+		}
+		log.Infof("found %d images in registry %q", len(targets), registry)
+	} else {
+		targets, err = readTargetsFile(targetsFile)
+		if err != nil {
+			return err
+		}
+	}
+
+	// all output paths are determined (and checked for collisions) before any cataloging starts
+	jobs, err := batch.Plan(targets, appConfig.Batch.OutputOpts, appConfig.Batch.OutputTemplate)
+	if err != nil {
+		return err
+	}
+
+	var summary batch.Summary
+	err = eventLoop(
+		scratchSpace.Guard(batchExecWorker(jobs, &summary)),
+		setupSignals(),
+		eventSubscription,
+		stereoscope.Cleanup,
+		selectUI()...,
+	)
+	if err != nil {
+		return err
+	}
+
+	// the UI only logs errors from the exit event, so failed targets are raised here to get a non-zero exit code
+	if summary.Failed > 0 {
+		return newExitCodeError(batchExitCode(summary), fmt.Errorf("%d of %d targets failed", summary.Failed, len(summary.Results)))
+	}
+	return nil
+}
+
+// batchExitCode returns the exit code for a batch with failed targets: the first configured exit code carried by a
+// target failure (e.g. when a target has no packages), otherwise 1.
+func batchExitCode(summary batch.Summary) int {
+	for _, r := range summary.Results {
+		var codeErr *exitCodeError
+		if errors.As(r.Err, &codeErr) {
+			return codeErr.code
+		}
+	}
+	return 1
+}
+
+func readTargetsFile(path string) ([]batch.Target, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open targets file: %w", err)
+	}
+	defer f.Close()
+
+	return batch.ReadTargets(f)
+}
+
+func batchExecWorker(jobs []batch.Job, summary *batch.Summary) <-chan error {
+	errs := make(chan error)
+	go func() {
+		defer close(errs)
+
+		*summary = batch.Run(jobs, appConfig.Batch.Parallelism, catalogBatchJob)
+
+		bus.Publish(partybus.Event{
+			Type: event.Exit,
+			Value: func() error {
+				return writeBatchSummary(*summary)
+			},
+		})
+	}()
+	return errs
+}
+
+// catalogBatchJob catalogs a single target and writes all of its reports. Unlike the packages command, any failure
+// is returned to be recorded in the batch summary instead of ending the run.
+func catalogBatchJob(job batch.Job) error {
+	log.Infof("cataloging batch target %d: %q", job.Index, job.Target.Input)
+
+	// each target gets its own set of tasks so that no cataloger state is shared between concurrent jobs
+	tasks, err := tasks()
+	if err != nil {
+		return err
+	}
+
+	src, cleanup, err := newIndexedSource(job.Target.Input)
+	if cleanup != nil {
+		defer cleanup()
+	}
+	if err != nil {
+		return err
+	}
+
+	s, err := newSBOM(src)
+	if err != nil {
+		return err
+	}
+	if err := catalog(&s, src, tasks); err != nil {
+		return err
+	}
+
+	var options []output.WriterOption
+	for _, o := range job.Outputs {
+		encoder := formatByOption(o.Format)
+		if encoder == nil {
+			return fmt.Errorf("unknown format: %s", o.Format)
+		}
+		options = append(options, output.WriterOption{
+			Format: *encoder,
+			Path:   o.Path,
+		})
+	}
+
+	writer, err := output.MakeWriter(options...)
+	if err != nil {
+		return err
+	}
+	if err := writeResults(s, writer); err != nil {
+		_ = writer.Close()
+		return err
+	}
+	return writer.Close()
+}
+
+func writeBatchSummary(summary batch.Summary) error {
+	var out io.Writer = os.Stdout
+	if appConfig.Batch.Summary != "" {
+		f, err := os.Create(appConfig.Batch.Summary)
+		if err != nil {
+			return fmt.Errorf("unable to create batch summary file: %w", err)
+		}
+		defer f.Close()
+		out = f
+	}
+
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", " ")
+	return enc.Encode(summary)
+}
@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/internal/resultcache"
+	"github.com/anchore/syft/internal/version"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/anchore/syft/syft/source"
+)
+
+// catalogWithCache catalogs the given source like catalog, but reuses the results of a previous scan of the same image
+// when caching results (--cache), keeping the results of new scans for subsequent scans.
+func catalogWithCache(s *sbom.SBOM, src *source.Source, tasks []task) error {
+	if !appConfig.Cache.Enabled {
+		return catalog(s, src, tasks)
+	}
+	key := resultCacheKey(src.Metadata)
+	if key == "" {
+		return catalog(s, src, tasks)
+	}
+
+	cache := resultcache.New(appConfig.Cache.Dir, appConfig.Cache.TTL)
+	if cached, ok := cache.Get(key); ok {
+		log.Infof("using the cached results for %q", src.Metadata.ImageMetadata.UserInput)
+		s.Artifacts = cached.Artifacts
+		s.Relationships = cached.Relationships
+		s.Nested = cached.Nested
+		if appConfig.Document.Deterministic {
+			return makeDeterministic(s, src.Metadata)
+		}
+		return nil
+	}
+
+	if err := catalog(s, src, tasks); err != nil {
+		return err
+	}
+	if err := cache.Set(key, *s); err != nil {
+		log.Warnf("unable to cache the results: %+v", err)
+	}
+	return nil
+}
+
+// resultCacheKey returns the key of the results of cataloging the given source (empty when the results should not be
+// cached). Only images are immutable, so other sources are always cataloged.
+func resultCacheKey(srcMetadata source.Metadata) string {
+	if srcMetadata.Scheme != source.ImageScheme {
+		return ""
+	}
+	digest := srcMetadata.ImageMetadata.ManifestDigest
+	if digest == "" {
+		// e.g. an image from a docker daemon, which is identified by the digest of its config instead
+		digest = srcMetadata.ImageMetadata.ID
+	}
+
+	key, err := resultcache.Key(digest, version.FromBuild().Version, resultCacheConfig())
+	if err != nil {
+		log.Warnf("unable to cache the results: %+v", err)
+		return ""
+	}
+	return key
+}
+
+// resultCacheConfig returns the options that affect the cataloging results, such that changing other options (e.g.
+// the output format or the document name) still reuses the cached results.
+func resultCacheConfig() interface{} {
+	return struct {
+		Package            interface{}
+		OwnershipRules     interface{} // the rules of the ownership file are not part of the package options
+		FileMetadata       interface{}
+		FileClassification interface{}
+		FileContents       interface{}
+		BinaryLinks        interface{}
+		Executables        interface{}
+		Secrets            interface{}
+		Exclusions         []string
+		Enrichment         interface{}
+		EOL                interface{}
+		Offline            bool
+	}{
+		Package:            appConfig.Package,
+		OwnershipRules:     appConfig.Package.Ownership.ToConfig(),
+		FileMetadata:       appConfig.FileMetadata,
+		FileClassification: appConfig.FileClassification,
+		FileContents:       appConfig.FileContents,
+		BinaryLinks:        appConfig.BinaryLinks,
+		Executables:        appConfig.Executables,
+		Secrets:            appConfig.Secrets,
+		Exclusions:         appConfig.Exclusions,
+		Enrichment:         appConfig.Enrichment,
+		EOL:                appConfig.EOL,
+		Offline:            appConfig.Offline,
+	}
+}
@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/internal/config"
+	"github.com/anchore/syft/syft/source"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResultCacheKey(t *testing.T) {
+	original := appConfig
+	defer func() { appConfig = original }()
+
+	image := source.Metadata{
+		Scheme: source.ImageScheme,
+		ImageMetadata: source.ImageMetadata{
+			ManifestDigest: "sha256:abc",
+			ID:             "sha256:def",
+		},
+	}
+
+	appConfig = &config.Application{}
+	key := resultCacheKey(image)
+	assert.NotEmpty(t, key)
+
+	// options that do not affect the results keep the key
+	appConfig.Quiet = true
+	appConfig.Document.Deterministic = true
+	assert.Equal(t, key, resultCacheKey(image))
+
+	// options that affect the results change the key
+	appConfig.Package.Cataloger.Scope = "all-layers"
+	assert.NotEqual(t, key, resultCacheKey(image))
+	appConfig = &config.Application{}
+
+	// images from a daemon are keyed by their ID
+	daemonImage := image
+	daemonImage.ImageMetadata.ManifestDigest = ""
+	daemonKey := resultCacheKey(daemonImage)
+	assert.NotEmpty(t, daemonKey)
+	assert.NotEqual(t, key, daemonKey)
+
+	// directories may change between scans
+	assert.Empty(t, resultCacheKey(source.Metadata{Scheme: source.DirectoryScheme, Path: "."}))
+}
@@ -10,9 +10,11 @@ import (
 	"github.com/anchore/syft/internal/config"
 	"github.com/anchore/syft/internal/log"
 	"github.com/anchore/syft/internal/logger"
+	"github.com/anchore/syft/internal/telemetry"
 	"github.com/anchore/syft/internal/version"
 	"github.com/anchore/syft/syft"
 	"github.com/gookit/color"
+	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/wagoodman/go-partybus"
@@ -29,6 +31,7 @@ func init() {
 		initCmdAliasBindings,
 		initAppConfig,
 		initLogging,
+		initScratchSpace,
 		logAppConfig,
 		checkForApplicationUpdate,
 		logAppVersion,
@@ -37,9 +40,12 @@ func init() {
 }
 
 func Execute() {
-	if err := rootCmd.Execute(); err != nil {
+	err := rootCmd.Execute()
+	// note: this runs after any interrupted scan has returned, removing whatever it left behind
+	scratchSpace.Cleanup()
+	if err != nil {
 		fmt.Fprintln(os.Stderr, color.Red.Sprint(err.Error()))
-		os.Exit(1)
+		os.Exit(exitCode(err))
 	}
 }
 
@@ -68,6 +74,14 @@ func initCmdAliasBindings() {
 		if err = bindPackagesConfigOptions(activeCmd.Flags()); err != nil {
 			panic(err)
 		}
+	case convertCmd:
+		// the convert command shares the output options of the packages command, but with flags of its own
+		if err = bindPackagesConfigOptions(packagesCmd.Flags()); err != nil {
+			panic(err)
+		}
+		if err = bindConvertConfigOptions(activeCmd.Flags()); err != nil {
+			panic(err)
+		}
 	default:
 		// even though the root command or packages command is NOT being run, we still need default bindings
 		// such that application config parsing passes.
@@ -94,9 +108,19 @@ func initLogging() {
 		Level:         appConfig.Log.LevelOpt,
 		Structured:    appConfig.Log.Structured,
 		FileLocation:  appConfig.Log.FileLocation,
+		Syslog: logger.SyslogConfig{
+			Enabled: appConfig.Log.Syslog.Enabled,
+			Network: appConfig.Log.Syslog.Network,
+			Address: appConfig.Log.Syslog.Address,
+			Tag:     appConfig.Log.Syslog.Tag,
+		},
 	}
 
 	logWrapper := logger.NewLogrusLogger(cfg)
+	if appConfig.Tracing.Enabled {
+		// attach warnings and errors to the trace of the command invocation
+		logWrapper.Logger.AddHook(telemetry.NewLogHook(logrus.WarnLevel))
+	}
 	syft.SetLogger(logWrapper)
 	stereoscope.SetLogger(&logger.LogrusNestedLogger{
 		Logger: logWrapper.Logger.WithField("from-lib", "stereoscope"),
@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/config"
+	"github.com/spf13/cobra"
+)
+
+const configEnvExample = `  {{.appName}} {{.command}}                         list all environment variables with the current value of each option
+
+  Every config option can be set with an environment variable instead of a config file (useful when a config file
+  cannot be mounted, e.g. in container-based CI). Lists are comma-separated (e.g. {{.exampleList}}) and maps are
+  comma-separated key=value pairs (e.g. {{.exampleMap}}).
+`
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Show how the application is configured",
+	Args:  cobra.NoArgs,
+}
+
+var configEnvCmd = &cobra.Command{
+	Use:   "env",
+	Short: "List the environment variables that configure the application",
+	Example: internal.Tprintf(configEnvExample, map[string]interface{}{
+		"appName":     internal.ApplicationName,
+		"command":     "config env",
+		"exampleList": config.EnvVarName("exclude") + "='./out/**,./vendor/**'",
+		"exampleMap":  config.EnvVarName("package.cataloger.search-depth") + "='java-cataloger=2'",
+	}),
+	Args:          cobra.NoArgs,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return writeEnvVars(os.Stdout, appConfig.EnvVars())
+	},
+}
+
+func init() {
+	configCmd.AddCommand(configEnvCmd)
+	rootCmd.AddCommand(configCmd)
+}
+
+// writeEnvVars shows each environment variable in a form that can be used directly within a shell.
+func writeEnvVars(writer io.Writer, envVars []config.EnvVar) error {
+	for _, envVar := range envVars {
+		if _, err := fmt.Fprintf(writer, "%s=%q\n", envVar.Name, envVar.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
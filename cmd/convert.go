@@ -0,0 +1,140 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft"
+	"github.com/anchore/syft/syft/format"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+const convertExample = `  {{.appName}} {{.command}} ./sbom.spdx.json -o cyclonedx-json       convert an SPDX JSON document to CycloneDX JSON
+  {{.appName}} {{.command}} ./sbom.json -o spdx-tag-value -o table   write several formats at once
+  cat ./bom.xml | {{.appName}} {{.command}} - -o json                   read the document from STDIN
+
+  The format of the input document is detected automatically (syft JSON, CycloneDX JSON/XML, and SPDX JSON/tag-value
+  are supported). The conversion is lossy: only what the input format can express is carried over (e.g. package
+  metadata and file information are not part of CycloneDX or SPDX documents). The input document is recorded as the
+  origin of the converted document.
+`
+
+var convertCmd = &cobra.Command{
+	Use:   "convert SBOM-FILE",
+	Short: "Convert an SBOM document to other formats",
+	Example: internal.Tprintf(convertExample, map[string]interface{}{
+		"appName": internal.ApplicationName,
+		"command": "convert",
+	}),
+	Args:          cobra.ExactArgs(1),
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE:          convertExec,
+}
+
+func init() {
+	flags := convertCmd.Flags()
+	flags.StringArrayP(
+		"output", "o", []string{string(format.TableOption)},
+		fmt.Sprintf("report output format, options=%v", format.AllOptions),
+	)
+
+	flags.StringP(
+		"file", "", "",
+		"file to write the default report output to (default is STDOUT)",
+	)
+
+	flags.StringP(
+		"template", "t", "",
+		"the Go text/template file to render the \"template\" output format with (e.g. -o template -t csv.tmpl)",
+	)
+
+	rootCmd.AddCommand(convertCmd)
+}
+
+// bindConvertConfigOptions binds the output options to the flags of the convert command (rather than those of the
+// packages command, which are bound by default).
+func bindConvertConfigOptions(flags *pflag.FlagSet) error {
+	if err := viper.BindPFlag("output.format", flags.Lookup("output")); err != nil {
+		return err
+	}
+
+	if err := viper.BindPFlag("output.file", flags.Lookup("file")); err != nil {
+		return err
+	}
+
+	if err := viper.BindPFlag("output.template-path", flags.Lookup("template")); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func convertExec(_ *cobra.Command, args []string) error {
+	s, err := readConvertInput(args[0])
+	if err != nil {
+		return err
+	}
+
+	writer, err := makeWriter(appConfig.Output.Format, appConfig.Output.File)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err := writer.Close(); err != nil {
+			log.Warnf("unable to write to report destination: %w", err)
+		}
+	}()
+
+	return writer.Write(*s)
+}
+
+// readConvertInput decodes the given document ("-" for STDIN), describing the result as a new document created by
+// this application from the decoded document.
+func readConvertInput(path string) (*sbom.SBOM, error) {
+	var reader io.Reader = os.Stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("unable to open SBOM: %w", err)
+		}
+		defer f.Close()
+		reader = f
+	}
+
+	s, origin, err := syft.DecodeWithOrigin(reader)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode SBOM: %w", err)
+	}
+
+	descriptor, err := newDescriptor(s.Source)
+	if err != nil {
+		return nil, err
+	}
+	// the people and organizations responsible for the input are kept unless they have been configured
+	if descriptor.Author == "" {
+		descriptor.Author = s.Descriptor.Author
+	}
+	if descriptor.Organization == "" {
+		descriptor.Organization = s.Descriptor.Organization
+	}
+	if descriptor.Supplier == "" {
+		descriptor.Supplier = s.Descriptor.Supplier
+	}
+	descriptor.Origins = []sbom.DocumentOrigin{*origin}
+	s.Descriptor = descriptor
+
+	if appConfig.Document.Deterministic {
+		if err := makeDeterministic(s, s.Source); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/anchore/syft/syft/sbom"
+)
+
+// exitCodeError is an error that should result in the process exiting with a specific (configured) exit code.
+type exitCodeError struct {
+	code int
+	err  error
+}
+
+func newExitCodeError(code int, err error) error {
+	return &exitCodeError{
+		code: code,
+		err:  err,
+	}
+}
+
+func (e *exitCodeError) Error() string {
+	return e.err.Error()
+}
+
+func (e *exitCodeError) Unwrap() error {
+	return e.err
+}
+
+// exitCode returns the process exit code for the given command error (0 when there is no error).
+func exitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+
+	var codeErr *exitCodeError
+	if errors.As(err, &codeErr) {
+		return codeErr.code
+	}
+	return 1
+}
+
+// checkPackagesFound returns an error carrying the configured exit code when the SBOM describes no packages.
+func checkPackagesFound(s sbom.SBOM) error {
+	if appConfig.ExitCode.NoPackages == 0 {
+		return nil
+	}
+
+	if s.Artifacts.PackageCatalog != nil && s.Artifacts.PackageCatalog.PackageCount() > 0 {
+		return nil
+	}
+
+	return newExitCodeError(appConfig.ExitCode.NoPackages, errors.New("no packages were discovered"))
+}
@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/anchore/syft/internal/batch"
+	"github.com/hashicorp/go-multierror"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{
+			name:     "no error",
+			expected: 0,
+		},
+		{
+			name:     "plain error",
+			err:      errors.New("failed"),
+			expected: 1,
+		},
+		{
+			name:     "exit code error",
+			err:      newExitCodeError(3, errors.New("no packages were discovered")),
+			expected: 3,
+		},
+		{
+			name:     "exit code error within a multierror",
+			err:      multierror.Append(nil, newExitCodeError(4, errors.New("cataloger failed"))),
+			expected: 4,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, exitCode(test.err))
+		})
+	}
+}
+
+func TestBatchExitCode(t *testing.T) {
+	summary := batch.Summary{
+		Failed: 2,
+		Results: []batch.Result{
+			{Status: batch.FailureStatus, Err: errors.New("unable to pull image")},
+			{Status: batch.SuccessStatus},
+			{Status: batch.FailureStatus, Err: newExitCodeError(3, errors.New("no packages were discovered"))},
+		},
+	}
+	assert.Equal(t, 3, batchExitCode(summary))
+
+	summary.Results = summary.Results[:2]
+	assert.Equal(t, 1, batchExitCode(summary))
+}
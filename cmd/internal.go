@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/fixtures"
+	"github.com/anchore/syft/syft/pkg/cataloger"
+	"github.com/spf13/cobra"
+)
+
+const internalFixturesExample = `  {{.appName}} {{.command}} --cataloger python-index-cataloger ./poetry.lock
+  {{.appName}} {{.command}} --cataloger javascript-lock-cataloger --name npm-v2 --dir syft/pkg/cataloger/javascript/test-fixtures/snapshot ./package-lock.json
+
+  The given files (or directories) are copied into a new fixture directory (<dir>/<name>), and the packages the cataloger
+  finds within the fixture are written to its golden file (<dir>/<name>.golden). Assert the cataloger against the
+  golden file from the cataloger tests with fixtures.AssertCatalogerAgainstGolden.
+`
+
+var internalCmd = &cobra.Command{
+	Use:    "internal",
+	Short:  "Tools for the development of " + internal.ApplicationName,
+	Hidden: true,
+}
+
+var internalFixturesCmd = &cobra.Command{
+	Use:   "fixtures --cataloger NAME [--name NAME] [--dir DIR] PATH...",
+	Short: "Snapshot real-world files into cataloger test fixtures with golden results",
+	Example: internal.Tprintf(internalFixturesExample, map[string]interface{}{
+		"appName": internal.ApplicationName,
+		"command": "internal fixtures",
+	}),
+	Args:          cobra.MinimumNArgs(1),
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE:          internalFixturesExec,
+}
+
+func init() {
+	flags := internalFixturesCmd.Flags()
+	flags.StringP(
+		"cataloger", "c", "",
+		"the name of the cataloger to snapshot the results of (see 'syft version -o json' for all names)",
+	)
+	flags.StringP(
+		"name", "n", "",
+		"the name of the fixture (default is the name of the first path)",
+	)
+	flags.StringP(
+		"dir", "d", filepath.Join("test-fixtures", "snapshot"),
+		"the directory to create the fixture within",
+	)
+
+	internalCmd.AddCommand(internalFixturesCmd)
+	rootCmd.AddCommand(internalCmd)
+}
+
+func internalFixturesExec(cmd *cobra.Command, args []string) error {
+	catalogerName, err := cmd.Flags().GetString("cataloger")
+	if err != nil {
+		return err
+	}
+	name, err := cmd.Flags().GetString("name")
+	if err != nil {
+		return err
+	}
+	dir, err := cmd.Flags().GetString("dir")
+	if err != nil {
+		return err
+	}
+	if name == "" {
+		name = filepath.Base(args[0])
+	}
+
+	// note: not every image or directory cataloger is part of the full set of catalogers
+	cfg := appConfig.Package.ToConfig()
+	candidates := append(cataloger.AllCatalogers(cfg), cataloger.ImageCatalogers(cfg)...)
+	candidates = append(candidates, cataloger.DirectoryCatalogers(cfg)...)
+
+	var selected cataloger.Cataloger
+	for _, c := range candidates {
+		if c.Name() == catalogerName {
+			selected = c
+			break
+		}
+	}
+	if selected == nil {
+		return fmt.Errorf("unknown cataloger: %q", catalogerName)
+	}
+
+	count, err := fixtures.Snapshot(selected, dir, name, args...)
+	if err != nil {
+		return err
+	}
+
+	fixtureDir := filepath.Join(dir, name)
+	fmt.Printf("created fixture %s with %d package(s) found by %s (golden file: %s)\n", fixtureDir, count, catalogerName, fixtures.GoldenPath(fixtureDir))
+	return nil
+}
@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/anchore/stereoscope/pkg/image"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/source"
+	"github.com/docker/docker/client"
+)
+
+var errOffline = errors.New("network access is disabled (--offline)")
+
+// daemonHasImage indicates if the local docker daemon (or the podman service at the given address, when given) already
+// has the given image (in which case it does not need to be pulled).
+var daemonHasImage = func(host, ref string) (bool, error) {
+	opts := []client.Opt{client.FromEnv, client.WithAPIVersionNegotiation()}
+	if host != "" {
+		opts = append(opts, client.WithHost(host))
+	}
+	cli, err := client.NewClientWithOpts(opts...)
+	if err != nil {
+		return false, err
+	}
+	defer cli.Close()
+
+	_, _, err = cli.ImageInspectWithRaw(context.Background(), ref)
+	if client.IsErrNotFound(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// checkOfflineInput fails fast when running in offline mode and cataloging the given user input would require
+// network access (pulling an image from a registry, either directly or through the docker daemon, or reading a
+// directory on a remote host). Nested images are always read from the files of the scanned source.
+func checkOfflineInput(userInput string) error {
+	if !appConfig.Offline {
+		return nil
+	}
+
+	scheme, imageSource, location, err := source.DetectScheme(userInput)
+	if err != nil {
+		// leave reporting bad input to the source construction
+		return nil
+	}
+
+	var podman string
+	if scheme == source.ImageScheme {
+		if podman, err = source.PodmanHost(userInput, imageSource); err != nil {
+			// leave reporting an unavailable podman service to the source construction
+			return nil
+		}
+	}
+
+	switch {
+	case scheme == source.ImageScheme && podman != "":
+		exists, err := daemonHasImage(podman, location)
+		if err != nil {
+			log.Debugf("unable to check for image %q in the podman service: %+v", location, err)
+			return nil
+		}
+		if !exists {
+			return fmt.Errorf("image %q is not present in the podman service and cannot be pulled: %w", location, errOffline)
+		}
+	case scheme == source.ImageScheme && imageSource == image.OciRegistrySource:
+		return fmt.Errorf("unable to pull image %q from a registry: %w (use a local docker daemon or an image archive instead)", location, errOffline)
+	case scheme == source.ImageScheme && imageSource == image.DockerDaemonSource:
+		exists, err := daemonHasImage("", location)
+		if err != nil {
+			// leave reporting an unavailable daemon to the source construction
+			log.Debugf("unable to check for image %q in the docker daemon: %+v", location, err)
+			return nil
+		}
+		if !exists {
+			return fmt.Errorf("image %q is not present in the docker daemon and cannot be pulled: %w", location, errOffline)
+		}
+	case scheme == source.DirectoryScheme && strings.HasPrefix(location, "ssh://"):
+		return fmt.Errorf("unable to read remote directory %q: %w", location, errOffline)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckOfflineInput(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		offline     bool
+		daemonImage bool
+		wantErr     bool
+	}{
+		{
+			name:    "registry image",
+			input:   "registry:alpine:latest",
+			offline: true,
+			wantErr: true,
+		},
+		{
+			name:    "registry image while online",
+			input:   "registry:alpine:latest",
+			offline: false,
+		},
+		{
+			name:        "daemon image",
+			input:       "docker:alpine:latest",
+			offline:     true,
+			daemonImage: true,
+		},
+		{
+			name:    "daemon image that must be pulled",
+			input:   "docker:alpine:latest",
+			offline: true,
+			wantErr: true,
+		},
+		{
+			name:    "remote directory",
+			input:   "ssh://user@host/path",
+			offline: true,
+			wantErr: true,
+		},
+		{
+			name:    "local directory",
+			input:   "dir:.",
+			offline: true,
+		},
+	}
+
+	original, originalDaemonHasImage := appConfig, daemonHasImage
+	defer func() { appConfig, daemonHasImage = original, originalDaemonHasImage }()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			appConfig = &config.Application{Offline: test.offline}
+			daemonHasImage = func(string, string) (bool, error) { return test.daemonImage, nil }
+
+			err := checkOfflineInput(test.input)
+			if test.wantErr {
+				assert.ErrorIs(t, err, errOffline)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -5,7 +5,11 @@ import (
 	"strings"
 
 	"github.com/anchore/syft/internal/formats"
+	"github.com/anchore/syft/internal/formats/csv"
+	"github.com/anchore/syft/internal/formats/table"
+	"github.com/anchore/syft/internal/formats/template"
 	"github.com/anchore/syft/internal/output"
+	"github.com/anchore/syft/internal/publish"
 	"github.com/anchore/syft/syft/format"
 	"github.com/anchore/syft/syft/sbom"
 	"github.com/hashicorp/go-multierror"
@@ -27,6 +31,95 @@ func makeWriter(outputs []string, defaultFile string) (sbom.Writer, error) {
 	return writer, nil
 }
 
+// makePublishWriter creates a sbom.Writer that publishes to all configured message brokers, or returns nil if
+// publishing has not been configured.
+func makePublishWriter() (sbom.Writer, error) {
+	cfg := appConfig.Publish
+	if !cfg.Enabled() {
+		return nil, nil
+	}
+
+	f := formatByOption(cfg.FormatOpt)
+	if f == nil {
+		return nil, fmt.Errorf("unknown publish format: %s", cfg.FormatOpt)
+	}
+
+	var writers []sbom.Writer
+	if len(cfg.Kafka.Brokers) > 0 {
+		p, err := publish.NewKafkaPublisher(publish.KafkaConfig{
+			Brokers:         cfg.Kafka.Brokers,
+			Topic:           cfg.Kafka.Topic,
+			MaxMessageBytes: cfg.Kafka.MaxMessageBytes,
+		})
+		if err != nil {
+			return nil, err
+		}
+		writers = append(writers, publish.NewWriter(p, *f, cfg.ModeOpt))
+	}
+
+	if cfg.NATS.URL != "" {
+		p, err := publish.NewNATSPublisher(publish.NATSConfig{
+			URL:     cfg.NATS.URL,
+			Subject: cfg.NATS.Subject,
+		})
+		if err != nil {
+			// release any previously created publishers
+			_ = output.NewMultiWriter(writers...).Close()
+			return nil, err
+		}
+		writers = append(writers, publish.NewWriter(p, *f, cfg.ModeOpt))
+	}
+
+	return output.NewMultiWriter(writers...), nil
+}
+
+// withPublishWriter adds publishing to all configured message brokers to the given writer. The given writer is closed
+// when an error is returned.
+func withPublishWriter(writer sbom.Writer) (sbom.Writer, error) {
+	publishWriter, err := makePublishWriter()
+	if err != nil {
+		_ = writer.Close()
+		return nil, err
+	}
+	if publishWriter == nil {
+		return writer, nil
+	}
+	return output.NewMultiWriter(writer, publishWriter), nil
+}
+
+// rejectPublishConfig returns an error when publishing has been configured for a command that does not publish SBOMs,
+// so that the configuration is never silently ignored.
+func rejectPublishConfig(command string) error {
+	if appConfig.Publish.Enabled() {
+		return fmt.Errorf("publishing to message brokers (publish.*) is not supported by the %s command", command)
+	}
+	return nil
+}
+
+// formatByOption returns the format for the given option, where the template format renders with the template given by
+// --template (or output.template-path), the csv and table formats write the configured columns (csv.columns,
+// table.columns and table.sort), and JSON and XML formats are written compactly when output.pretty is disabled.
+func formatByOption(option format.Option) *format.Format {
+	switch option {
+	case format.TemplateOption:
+		f := template.Format(appConfig.Output.TemplatePath)
+		return &f
+	case format.CSVOption:
+		f := csv.Format(appConfig.CSV.Columns...)
+		return &f
+	case format.TableOption:
+		f := table.FormatWith(appConfig.Table.Columns, appConfig.Table.Sort)
+		return &f
+	}
+
+	f := formats.ByOption(option)
+	if f != nil && !appConfig.Output.Pretty {
+		compacted := formats.Compact(*f)
+		return &compacted
+	}
+	return f
+}
+
 // parseOptions utility to parse command-line option strings and retain the existing behavior of default format and file
 func parseOptions(outputs []string, defaultFile string) (out []output.WriterOption, errs error) {
 	// always should have one option -- we generally get the default of "table", but just make sure
@@ -34,6 +127,9 @@ func parseOptions(outputs []string, defaultFile string) (out []output.WriterOpti
 		outputs = append(outputs, string(format.TableOption))
 	}
 
+	// each output needs its own destination, otherwise the reports would be interleaved or overwrite each other
+	destinations := make(map[string]string)
+
 	for _, name := range outputs {
 		name = strings.TrimSpace(name)
 
@@ -57,12 +153,22 @@ func parseOptions(outputs []string, defaultFile string) (out []output.WriterOpti
 			continue
 		}
 
-		encoder := formats.ByOption(option)
+		encoder := formatByOption(option)
 		if encoder == nil {
 			errs = multierror.Append(errs, fmt.Errorf("unknown format: %s", outputFormat))
 			continue
 		}
 
+		if other, ok := destinations[file]; ok {
+			destination := "stdout"
+			if file != "" {
+				destination = fmt.Sprintf("file %q", file)
+			}
+			errs = multierror.Append(errs, fmt.Errorf("both the %s and %s outputs would be written to %s (use <format>=<file> to write each output to a separate file)", other, name, destination))
+			continue
+		}
+		destinations[file] = name
+
 		out = append(out, output.WriterOption{
 			Format: *encoder,
 			Path:   file,
@@ -5,11 +5,15 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/anchore/syft/internal/config"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestOutputWriterConfig(t *testing.T) {
 	tmp := t.TempDir() + "/"
+	original := appConfig
+	defer func() { appConfig = original }()
+	appConfig = &config.Application{}
 
 	tests := []struct {
 		outputs  []string
@@ -41,6 +45,24 @@ func TestOutputWriterConfig(t *testing.T) {
 			outputs:  []string{"text", "json=test-4.json"},
 			expected: []string{"", "test-4.json"},
 		},
+		{
+			outputs: []string{"json", "table"},
+			err:     true,
+		},
+		{
+			outputs: []string{"json=test-5.json", "spdx-json=test-5.json"},
+			err:     true,
+		},
+		{
+			outputs: []string{"json", "spdx-json"},
+			file:    "test-6.json",
+			err:     true,
+		},
+		{
+			outputs:  []string{"json", "spdx-json=test-7.json"},
+			file:     "test-7-1.json",
+			expected: []string{"test-7-1.json", "test-7.json"},
+		},
 	}
 
 	for _, test := range tests {
@@ -55,7 +77,7 @@ func TestOutputWriterConfig(t *testing.T) {
 				file = tmp + file
 			}
 
-			_, err := makeWriter(test.outputs, file)
+			writer, err := makeWriter(test.outputs, file)
 
 			if test.err {
 				assert.Error(t, err)
@@ -64,6 +86,9 @@ func TestOutputWriterConfig(t *testing.T) {
 				assert.NoError(t, err)
 			}
 
+			// files are written when the writer is closed
+			assert.NoError(t, writer.Close())
+
 			for _, expected := range test.expected {
 				if expected != "" {
 					assert.FileExists(t, tmp+expected)
@@ -10,10 +10,9 @@ import (
 	"github.com/anchore/syft/internal"
 	"github.com/anchore/syft/internal/anchore"
 	"github.com/anchore/syft/internal/bus"
+	"github.com/anchore/syft/internal/compliance"
 	"github.com/anchore/syft/internal/log"
 	"github.com/anchore/syft/internal/ui"
-	"github.com/anchore/syft/internal/version"
-	"github.com/anchore/syft/syft/artifact"
 	"github.com/anchore/syft/syft/event"
 	"github.com/anchore/syft/syft/format"
 	"github.com/anchore/syft/syft/pkg/cataloger"
@@ -40,12 +39,15 @@ const (
 
   You can also explicitly specify the scheme to use:
     {{.appName}} {{.command}} docker:yourrepo/yourimage:tag          explicitly use the Docker daemon
+    {{.appName}} {{.command}} podman:yourrepo/yourimage:tag          explicitly use the Podman service (rootless or rootful socket)
     {{.appName}} {{.command}} docker-archive:path/to/yourimage.tar   use a tarball from disk for archives created from "docker save"
     {{.appName}} {{.command}} oci-archive:path/to/yourimage.tar      use a tarball from disk for OCI archives (from Skopeo or otherwise)
     {{.appName}} {{.command}} oci-dir:path/to/yourimage              read directly from a path on disk for OCI layout directories (from Skopeo or otherwise)
     {{.appName}} {{.command}} dir:path/to/yourproject                read directly from a path on disk (any directory)
     {{.appName}} {{.command}} file:path/to/yourproject/file          read directly from a path on disk (any single file)
     {{.appName}} {{.command}} registry:yourrepo/yourimage:tag        pull image directly from a registry (no container runtime required)
+    {{.appName}} {{.command}} ssh://user@host/path/to/dir            read a directory on a remote host over SFTP (no agent install required)
+    {{.appName}} {{.command}} host:                                  read the root filesystem of the local host (skips /proc, /sys, /dev, and container storage)
 `
 )
 
@@ -103,6 +105,11 @@ func setPackageFlags(flags *pflag.FlagSet) {
 		"file to write the default report output to (default is STDOUT)",
 	)
 
+	flags.StringP(
+		"template", "t", "",
+		"the Go text/template file to render the \"template\" output format with (e.g. -o template -t csv.tmpl)",
+	)
+
 	// Upload options //////////////////////////////////////////////////////////
 	flags.StringP(
 		"host", "H", "",
@@ -129,6 +136,56 @@ func setPackageFlags(flags *pflag.FlagSet) {
 		"exclude paths from being scanned using a glob expression",
 	)
 
+	flags.StringP(
+		"base-path", "", "",
+		"treat this directory as the filesystem root of a directory scan, reporting absolute paths and resolving links within it (e.g. a mounted root filesystem)",
+	)
+
+	flags.BoolP(
+		"ignore-files", "", true,
+		"exclude the paths listed in a .syftignore file at the root of a directory scan (the applied patterns are reported as warnings)",
+	)
+
+	flags.StringP(
+		"document-timestamp", "", "",
+		"pin the document creation time (RFC3339 or seconds since the unix epoch, defaults to SOURCE_DATE_EPOCH if set)",
+	)
+
+	flags.StringP(
+		"document-uuid", "", "",
+		"pin the unique identifier used for the document serial number and namespace (default is a random UUID)",
+	)
+
+	flags.Bool(
+		"deterministic", false,
+		"produce byte-identical output for identical inputs (epoch timestamp and content-derived UUID unless pinned, no scan duration)",
+	)
+
+	flags.StringP(
+		"compliance", "", "",
+		fmt.Sprintf("score the SBOM against a set of minimum elements and report missing fields to STDERR, options=%v", compliance.AllStandards),
+	)
+
+	flags.StringArrayP(
+		"verify-key", "", nil,
+		"only catalog images with a valid cosign signature from the given public key (can be given multiple times)",
+	)
+
+	flags.Bool(
+		"enrich", false,
+		"backfill missing package licenses and descriptions from package registries (npm, PyPI, Maven Central); requires network access",
+	)
+
+	flags.Bool(
+		"eol", false,
+		"annotate language runtimes and the distro with their end-of-life status, warning about unsupported releases",
+	)
+
+	flags.Bool(
+		"cache", false,
+		"reuse the SBOM of a previous scan of the same image digest (with the same version and configuration), keeping the SBOM of new scans",
+	)
+
 	flags.Bool(
 		"overwrite-existing-image", false,
 		"overwrite an existing image during the upload to Anchore Enterprise",
@@ -138,6 +195,11 @@ func setPackageFlags(flags *pflag.FlagSet) {
 		"import-timeout", 30,
 		"set a timeout duration (in seconds) for the upload to Anchore Enterprise",
 	)
+
+	flags.Bool(
+		"import-dry-run", false,
+		"validate the payloads for the upload to Anchore Enterprise without sending them",
+	)
 }
 
 func bindPackagesConfigOptions(flags *pflag.FlagSet) error {
@@ -147,11 +209,15 @@ func bindPackagesConfigOptions(flags *pflag.FlagSet) error {
 		return err
 	}
 
-	if err := viper.BindPFlag("output", flags.Lookup("output")); err != nil {
+	if err := viper.BindPFlag("output.format", flags.Lookup("output")); err != nil {
 		return err
 	}
 
-	if err := viper.BindPFlag("file", flags.Lookup("file")); err != nil {
+	if err := viper.BindPFlag("output.file", flags.Lookup("file")); err != nil {
+		return err
+	}
+
+	if err := viper.BindPFlag("output.template-path", flags.Lookup("template")); err != nil {
 		return err
 	}
 
@@ -159,6 +225,46 @@ func bindPackagesConfigOptions(flags *pflag.FlagSet) error {
 		return err
 	}
 
+	if err := viper.BindPFlag("directory.base-path", flags.Lookup("base-path")); err != nil {
+		return err
+	}
+
+	if err := viper.BindPFlag("directory.ignore-files", flags.Lookup("ignore-files")); err != nil {
+		return err
+	}
+
+	if err := viper.BindPFlag("document.timestamp", flags.Lookup("document-timestamp")); err != nil {
+		return err
+	}
+
+	if err := viper.BindPFlag("document.uuid", flags.Lookup("document-uuid")); err != nil {
+		return err
+	}
+
+	if err := viper.BindPFlag("document.deterministic", flags.Lookup("deterministic")); err != nil {
+		return err
+	}
+
+	if err := viper.BindPFlag("compliance", flags.Lookup("compliance")); err != nil {
+		return err
+	}
+
+	if err := viper.BindPFlag("verify.keys", flags.Lookup("verify-key")); err != nil {
+		return err
+	}
+
+	if err := viper.BindPFlag("enrichment.enabled", flags.Lookup("enrich")); err != nil {
+		return err
+	}
+
+	if err := viper.BindPFlag("eol.enabled", flags.Lookup("eol")); err != nil {
+		return err
+	}
+
+	if err := viper.BindPFlag("cache.enabled", flags.Lookup("cache")); err != nil {
+		return err
+	}
+
 	// Upload options //////////////////////////////////////////////////////////
 
 	if err := viper.BindPFlag("anchore.host", flags.Lookup("host")); err != nil {
@@ -185,6 +291,10 @@ func bindPackagesConfigOptions(flags *pflag.FlagSet) error {
 		return err
 	}
 
+	if err := viper.BindPFlag("anchore.import-dry-run", flags.Lookup("import-dry-run")); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -201,7 +311,17 @@ func validateInputArgs(cmd *cobra.Command, args []string) error {
 }
 
 func packagesExec(_ *cobra.Command, args []string) error {
-	writer, err := makeWriter(appConfig.Output, appConfig.File)
+	// could be an image or a directory, with or without a scheme
+	userInput := args[0]
+
+	defer startTracing("packages", userInput)()
+
+	writer, err := makeWriter(appConfig.Output.Format, appConfig.Output.File)
+	if err != nil {
+		return err
+	}
+
+	writer, err = withPublishWriter(writer)
 	if err != nil {
 		return err
 	}
@@ -212,18 +332,24 @@ func packagesExec(_ *cobra.Command, args []string) error {
 		}
 	}()
 
-	// could be an image or a directory, with or without a scheme
-	userInput := args[0]
-
 	return eventLoop(
-		packagesExecWorker(userInput, writer),
+		scratchSpace.Guard(packagesExecWorker(userInput, writer)),
 		setupSignals(),
 		eventSubscription,
 		stereoscope.Cleanup,
-		ui.Select(isVerbose(), appConfig.Quiet)...,
+		selectUI()...,
 	)
 }
 
+// selectUI returns the UIs to handle the events of the bus with, where streaming the events (--events) replaces the
+// progress display.
+func selectUI() []ui.UI {
+	if appConfig.Events != "" {
+		return []ui.UI{ui.NewNDJSONUI(appConfig.Events), ui.NewLoggerUI()}
+	}
+	return ui.Select(isVerbose(), appConfig.Quiet, appConfig.NoProgress)
+}
+
 func isVerbose() (result bool) {
 	isPipedInput, err := internal.IsPipedInput()
 	if err != nil {
@@ -246,34 +372,32 @@ func packagesExecWorker(userInput string, writer sbom.Writer) <-chan error {
 			return
 		}
 
-		src, cleanup, err := source.New(userInput, appConfig.Registry.ToOptions(), appConfig.Exclusions)
+		verification, err := checkInput(userInput)
 		if err != nil {
-			errs <- fmt.Errorf("failed to construct source from user input %q: %w", userInput, err)
+			errs <- err
 			return
 		}
+
+		src, cleanup, err := newSource(userInput, verification)
 		if cleanup != nil {
 			defer cleanup()
 		}
-
-		s := sbom.SBOM{
-			Source: src.Metadata,
-			Descriptor: sbom.Descriptor{
-				Name:          internal.ApplicationName,
-				Version:       version.FromBuild().Version,
-				Configuration: appConfig,
-			},
+		if err != nil {
+			errs <- err
+			return
 		}
 
-		var relationships []<-chan artifact.Relationship
-		for _, task := range tasks {
-			c := make(chan artifact.Relationship)
-			relationships = append(relationships, c)
-
-			go runTask(task, &s.Artifacts, src, c, errs)
+		s, err := newSBOM(src)
+		if err != nil {
+			errs <- err
+			return
+		}
+		if err := catalogWithCache(&s, src, tasks); err != nil {
+			errs <- err
+			return
 		}
-		s.Relationships = append(s.Relationships, mergeRelationships(relationships...)...)
 
-		if appConfig.Anchore.Host != "" {
+		if appConfig.Anchore.Host != "" || appConfig.Anchore.ImportDryRun {
 			if err := runPackageSbomUpload(src, s); err != nil {
 				errs <- err
 				return
@@ -281,26 +405,39 @@ func packagesExecWorker(userInput string, writer sbom.Writer) <-chan error {
 		}
 
 		bus.Publish(partybus.Event{
-			Type:  event.Exit,
-			Value: func() error { return writer.Write(s) },
+			Type: event.Exit,
+			Value: func() error {
+				return writeResults(s, writer)
+			},
 		})
 	}()
 	return errs
 }
 
-func mergeRelationships(cs ...<-chan artifact.Relationship) (relationships []artifact.Relationship) {
-	for _, c := range cs {
-		for n := range c {
-			relationships = append(relationships, n)
-		}
+// writeComplianceReport scores the SBOM against the configured compliance standard (if any) and shows the results
+// on stderr, keeping stdout reserved for the SBOM itself. A failed check results in the configured policy-failure
+// exit code (if any).
+func writeComplianceReport(s sbom.SBOM) error {
+	if appConfig.ComplianceOpt == "" {
+		return nil
 	}
 
-	return relationships
+	report, err := compliance.Check(appConfig.ComplianceOpt, s)
+	if err != nil {
+		return err
+	}
+
+	if err := report.Write(os.Stderr); err != nil {
+		return err
+	}
+
+	if !report.Passed() && appConfig.ExitCode.PolicyFailure != 0 {
+		return newExitCodeError(appConfig.ExitCode.PolicyFailure, fmt.Errorf("SBOM does not meet the %s minimum elements", appConfig.ComplianceOpt))
+	}
+	return nil
 }
 
 func runPackageSbomUpload(src *source.Source, s sbom.SBOM) error {
-	log.Infof("uploading results to %s", appConfig.Anchore.Host)
-
 	if src.Metadata.Scheme != source.ImageScheme {
 		return fmt.Errorf("unable to upload results: only images are supported")
 	}
@@ -322,23 +459,42 @@ func runPackageSbomUpload(src *source.Source, s sbom.SBOM) error {
 		}
 	}
 
+	importCfg := anchore.ImportConfig{
+		ImageMetadata:           src.Image.Metadata,
+		SBOM:                    s,
+		Dockerfile:              dockerfileContents,
+		OverwriteExistingUpload: appConfig.Anchore.OverwriteExistingImage,
+		Timeout:                 appConfig.Anchore.ImportTimeout,
+	}
+
+	if appConfig.Anchore.ImportDryRun {
+		log.Info("validating upload payloads (dry-run, nothing will be uploaded)")
+		if err := anchore.ValidateImport(importCfg); err != nil {
+			return fmt.Errorf("upload dry-run failed: %w", err)
+		}
+		return nil
+	}
+
+	log.Infof("uploading results to %s", appConfig.Anchore.Host)
+
 	c, err := anchore.NewClient(anchore.Configuration{
 		BaseURL:  appConfig.Anchore.Host,
 		Username: appConfig.Anchore.Username,
 		Password: appConfig.Anchore.Password,
+		TLS: anchore.TLSConfiguration{
+			CACertFile:     appConfig.Anchore.TLS.CACert,
+			ClientCertFile: appConfig.Anchore.TLS.ClientCert,
+			ClientKeyFile:  appConfig.Anchore.TLS.ClientKey,
+		},
+		Retry: anchore.RetryConfiguration{
+			MaxAttempts: appConfig.Anchore.ImportRetries,
+		},
+		ChunkedUpload: appConfig.Anchore.ImportChunked,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to create anchore client: %w", err)
 	}
 
-	importCfg := anchore.ImportConfig{
-		ImageMetadata:           src.Image.Metadata,
-		SBOM:                    s,
-		Dockerfile:              dockerfileContents,
-		OverwriteExistingUpload: appConfig.Anchore.OverwriteExistingImage,
-		Timeout:                 appConfig.Anchore.ImportTimeout,
-	}
-
 	if err := c.Import(context.Background(), importCfg); err != nil {
 		return fmt.Errorf("failed to upload results to host=%s: %+v", appConfig.Anchore.Host, err)
 	}
@@ -7,15 +7,11 @@ import (
 	"github.com/anchore/stereoscope"
 	"github.com/anchore/syft/internal"
 	"github.com/anchore/syft/internal/bus"
-	"github.com/anchore/syft/internal/formats/syftjson"
 	"github.com/anchore/syft/internal/log"
 	"github.com/anchore/syft/internal/output"
-	"github.com/anchore/syft/internal/ui"
-	"github.com/anchore/syft/internal/version"
-	"github.com/anchore/syft/syft/artifact"
 	"github.com/anchore/syft/syft/event"
+	"github.com/anchore/syft/syft/format"
 	"github.com/anchore/syft/syft/sbom"
-	"github.com/anchore/syft/syft/source"
 	"github.com/gookit/color"
 	"github.com/pkg/profile"
 	"github.com/spf13/cobra"
@@ -74,14 +70,21 @@ func powerUserExec(_ *cobra.Command, args []string) error {
 	// could be an image or a directory, with or without a scheme
 	userInput := args[0]
 
+	defer startTracing("power-user", userInput)()
+
 	writer, err := output.MakeWriter(output.WriterOption{
-		Format: syftjson.Format(),
-		Path:   appConfig.File,
+		Format: *formatByOption(format.JSONOption),
+		Path:   appConfig.Output.File,
 	})
 	if err != nil {
 		return err
 	}
 
+	writer, err = withPublishWriter(writer)
+	if err != nil {
+		return err
+	}
+
 	defer func() {
 		if err := writer.Close(); err != nil {
 			log.Warnf("unable to write to report destination: %+v", err)
@@ -93,11 +96,11 @@ func powerUserExec(_ *cobra.Command, args []string) error {
 	}()
 
 	return eventLoop(
-		powerUserExecWorker(userInput, writer),
+		scratchSpace.Guard(powerUserExecWorker(userInput, writer)),
 		setupSignals(),
 		eventSubscription,
 		stereoscope.Cleanup,
-		ui.Select(isVerbose(), appConfig.Quiet)...,
+		selectUI()...,
 	)
 }
 func powerUserExecWorker(userInput string, writer sbom.Writer) <-chan error {
@@ -115,37 +118,36 @@ func powerUserExecWorker(userInput string, writer sbom.Writer) <-chan error {
 			return
 		}
 
-		src, cleanup, err := source.New(userInput, appConfig.Registry.ToOptions(), appConfig.Exclusions)
+		verification, err := checkInput(userInput)
 		if err != nil {
 			errs <- err
 			return
 		}
+
+		src, cleanup, err := newSource(userInput, verification)
 		if cleanup != nil {
 			defer cleanup()
 		}
-
-		s := sbom.SBOM{
-			Source: src.Metadata,
-			Descriptor: sbom.Descriptor{
-				Name:          internal.ApplicationName,
-				Version:       version.FromBuild().Version,
-				Configuration: appConfig,
-			},
+		if err != nil {
+			errs <- err
+			return
 		}
 
-		var relationships []<-chan artifact.Relationship
-		for _, task := range tasks {
-			c := make(chan artifact.Relationship)
-			relationships = append(relationships, c)
-
-			go runTask(task, &s.Artifacts, src, c, errs)
+		s, err := newSBOM(src)
+		if err != nil {
+			errs <- err
+			return
+		}
+		if err := catalog(&s, src, tasks); err != nil {
+			errs <- err
+			return
 		}
-
-		s.Relationships = append(s.Relationships, mergeRelationships(relationships...)...)
 
 		bus.Publish(partybus.Event{
-			Type:  event.Exit,
-			Value: func() error { return writer.Write(s) },
+			Type: event.Exit,
+			Value: func() error {
+				return writeResults(s, writer)
+			},
 		})
 	}()
 
@@ -43,6 +43,39 @@ func init() {
 		os.Exit(1)
 	}
 
+	flag = "no-progress"
+	rootCmd.PersistentFlags().Bool(
+		flag, false,
+		"show plain log output instead of the interactive progress display (the default when not attached to a terminal)",
+	)
+
+	if err := viper.BindPFlag(flag, rootCmd.PersistentFlags().Lookup(flag)); err != nil {
+		fmt.Printf("unable to bind flag '%s': %+v", flag, err)
+		os.Exit(1)
+	}
+
+	flag = "events"
+	rootCmd.PersistentFlags().String(
+		flag, "",
+		"stream cataloging events (packages discovered, files classified, catalogers finished) as newline-delimited JSON to a file (\"-\" for stderr), instead of the progress display",
+	)
+
+	if err := viper.BindPFlag(flag, rootCmd.PersistentFlags().Lookup(flag)); err != nil {
+		fmt.Printf("unable to bind flag '%s': %+v", flag, err)
+		os.Exit(1)
+	}
+
+	flag = "offline"
+	rootCmd.PersistentFlags().Bool(
+		flag, false,
+		"disable all network access (update checks, registry pulls, uploads) and fail if an operation requires it",
+	)
+
+	if err := viper.BindPFlag(flag, rootCmd.PersistentFlags().Lookup(flag)); err != nil {
+		fmt.Printf("unable to bind flag '%s': %+v", flag, err)
+		os.Exit(1)
+	}
+
 	rootCmd.PersistentFlags().CountVarP(&persistentOpts.Verbosity, "verbose", "v", "increase verbosity (-v = info, -vv = debug)")
 
 	// set common options that are not universal (package subcommand-alias specific)
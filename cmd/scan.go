@@ -0,0 +1,234 @@
+package cmd
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/version"
+	"github.com/anchore/syft/syft"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/pkg/history"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/anchore/syft/syft/source"
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-multierror"
+)
+
+// indexLock serializes building file trees. Stereoscope file references are allocated from a global counter, so this
+// is not safe to do concurrently (which only happens when cataloging several targets at once with the batch command).
+var indexLock sync.Mutex
+
+// warningsLock serializes recording warnings, since tasks are run concurrently against the same results.
+var warningsLock sync.Mutex
+
+// checkInput ensures that the given user input may be cataloged (without network access when offline, and from a
+// trusted image when verification is configured), returning the image verification result to record in the SBOM.
+func checkInput(userInput string) (*source.ImageVerification, error) {
+	if err := checkOfflineInput(userInput); err != nil {
+		return nil, err
+	}
+
+	// the image signatures are checked before anything is pulled or cataloged
+	return verifyInput(userInput)
+}
+
+// newSource constructs the source for the given (checked) user input, configured from the application config. The
+// returned cleanup function should be called even when an error is returned (if not nil).
+func newSource(userInput string, verification *source.ImageVerification) (*source.Source, func(), error) {
+	indexLock.Lock()
+	src, cleanup, err := source.New(userInput, appConfig.Registry.ToOptions(), appConfig.Exclusions)
+	indexLock.Unlock()
+	if err != nil {
+		return nil, cleanup, fmt.Errorf("failed to construct source from user input %q: %w", userInput, err)
+	}
+	src.Directory = appConfig.Directory.ToConfig()
+	src.SSH = appConfig.SSH.ToOptions()
+
+	if err := recordVerification(src, verification); err != nil {
+		return nil, cleanup, err
+	}
+	return src, cleanup, nil
+}
+
+// newIndexedSource checks and constructs the source for the given input along with the resolvers for all configured
+// scopes. Building file trees is done for one source at a time (see indexLock); cataloging from the completed trees can
+// then run in parallel (as done by the batch and serve commands).
+func newIndexedSource(input string) (*source.Source, func(), error) {
+	verification, err := checkInput(input)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	src, cleanup, err := newSource(input, verification)
+	if err != nil {
+		return nil, cleanup, err
+	}
+
+	indexLock.Lock()
+	defer indexLock.Unlock()
+
+	scopes := []source.Scope{
+		appConfig.Package.Cataloger.ScopeOpt,
+		appConfig.FileMetadata.Cataloger.ScopeOpt,
+		appConfig.FileClassification.Cataloger.ScopeOpt,
+		appConfig.FileContents.Cataloger.ScopeOpt,
+		appConfig.Secrets.Cataloger.ScopeOpt,
+		appConfig.BinaryLinks.Cataloger.ScopeOpt,
+		appConfig.Executables.Cataloger.ScopeOpt,
+	}
+	for _, scope := range scopes {
+		// resolvers are cached by the source, so this only indexes each scope once
+		if _, err := src.FileResolver(scope); err != nil {
+			return nil, cleanup, fmt.Errorf("unable to index %q: %w", input, err)
+		}
+	}
+
+	return src, cleanup, nil
+}
+
+// newSBOM returns an (empty) SBOM for the given source, described by the application config. The creation time is
+// fixed when not pinned by the config, so that every output of a scan has the same timestamp. For deterministic output
+// the timestamp and UUID are left for catalog to fill in once the content is known.
+func newSBOM(src *source.Source) (sbom.SBOM, error) {
+	descriptor, err := newDescriptor(src.Metadata)
+	if err != nil {
+		return sbom.SBOM{}, err
+	}
+
+	return sbom.SBOM{
+		Source:     src.Metadata,
+		Descriptor: descriptor,
+	}, nil
+}
+
+// newDescriptor describes a document created by this invocation of the application for the given source.
+func newDescriptor(srcMetadata source.Metadata) (sbom.Descriptor, error) {
+	timestamp := appConfig.Document.TimestampOpt
+	if timestamp.IsZero() && !appConfig.Document.Deterministic {
+		timestamp = time.Now().UTC()
+	}
+
+	id := appConfig.Document.UUIDOpt
+	var name, namespace string
+	if appConfig.Document.HasTemplates() && !appConfig.Document.Deterministic {
+		if id == uuid.Nil {
+			// the templates may refer to the UUID, so every output must use the same one
+			id = uuid.New()
+		}
+		var err error
+		if name, namespace, err = appConfig.Document.RenderNames(srcMetadata, id); err != nil {
+			return sbom.Descriptor{}, err
+		}
+	}
+
+	return sbom.Descriptor{
+		Name:          internal.ApplicationName,
+		Version:       version.FromBuild().Version,
+		Configuration: appConfig,
+		Author:        appConfig.Document.Author,
+		Organization:  appConfig.Document.Organization,
+		Supplier:      appConfig.Document.Supplier,
+		Timestamp:     timestamp,
+		UUID:          id,
+		DocumentName:  name,
+		Namespace:     namespace,
+	}, nil
+}
+
+// catalog runs all tasks concurrently against the source, followed by cataloging nested images (when enabled), and
+// adds all results (and how long cataloging took, unless the output is deterministic) to the given SBOM.
+func catalog(s *sbom.SBOM, src *source.Source, tasks []task) error {
+	start := time.Now()
+	defer func() {
+		if !appConfig.Document.Deterministic {
+			s.Descriptor.Duration = time.Since(start)
+		}
+	}()
+
+	errs := make(chan error, len(tasks))
+	var relationships []<-chan artifact.Relationship
+	for _, t := range tasks {
+		c := make(chan artifact.Relationship)
+		relationships = append(relationships, c)
+
+		go runTask(t, &s.Artifacts, src, c, errs)
+	}
+	s.Relationships = append(s.Relationships, mergeRelationships(relationships...)...)
+	close(errs)
+
+	var catalogErr error
+	for err := range errs {
+		catalogErr = multierror.Append(catalogErr, err)
+	}
+	if catalogErr != nil {
+		return catalogErr
+	}
+
+	// binaries linking libraries (as found by the binary links cataloger) make the packages that own them dependencies
+	s.Relationships = append(s.Relationships, sbom.LinkedPackageRelationships(*s)...)
+
+	addWarnings(&s.Artifacts, src.Warnings()...)
+	if appConfig.Package.HistoryHints {
+		addWarnings(&s.Artifacts, history.Hints(src.Metadata.ImageMetadata.RawConfig, s.Artifacts.PackageCatalog)...)
+	}
+	source.SortWarnings(s.Artifacts.Warnings)
+
+	if appConfig.Package.NestedImages {
+		// nested images build new file trees as well (see indexLock)
+		indexLock.Lock()
+		nested, err := syft.CatalogNestedImages(src, appConfig.Package.ToConfig())
+		indexLock.Unlock()
+		if err != nil {
+			return err
+		}
+		s.Nested = nested
+	}
+
+	if appConfig.Document.Deterministic {
+		return makeDeterministic(s, src.Metadata)
+	}
+	return nil
+}
+
+// makeDeterministic removes the values of the SBOM that differ between scans of the same input, then renders the
+// document name and namespace templates (which may refer to the content-derived UUID).
+func makeDeterministic(s *sbom.SBOM, srcMetadata source.Metadata) error {
+	sbom.MakeDeterministic(s)
+	if !appConfig.Document.HasTemplates() {
+		return nil
+	}
+	var err error
+	s.Descriptor.DocumentName, s.Descriptor.Namespace, err = appConfig.Document.RenderNames(srcMetadata, s.Descriptor.UUID)
+	return err
+}
+
+// addWarnings records the given non-fatal problems found while cataloging in the results.
+func addWarnings(results *sbom.Artifacts, warnings ...source.Warning) {
+	warningsLock.Lock()
+	defer warningsLock.Unlock()
+	results.Warnings = append(results.Warnings, warnings...)
+}
+
+// writeResults writes the SBOM with the given writer, followed by the compliance report (if any), and returns an error
+// carrying the configured exit code for any unwanted outcome of the scan.
+func writeResults(s sbom.SBOM, writer sbom.Writer) error {
+	if err := writer.Write(s); err != nil {
+		return err
+	}
+	if err := writeComplianceReport(s); err != nil {
+		return err
+	}
+	return checkPackagesFound(s)
+}
+
+func mergeRelationships(cs ...<-chan artifact.Relationship) (relationships []artifact.Relationship) {
+	for _, c := range cs {
+		for n := range c {
+			relationships = append(relationships, n)
+		}
+	}
+
+	return relationships
+}
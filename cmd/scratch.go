@@ -0,0 +1,36 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/internal/scratch"
+)
+
+// scratchSpace is the directory that all temporary files of this invocation are written within (removed on exit).
+var scratchSpace *scratch.Space
+
+// tempDirEnvVars are the variables that decide the platform temp dir (TMP and TEMP are consulted on windows).
+var tempDirEnvVars = []string{"TMPDIR", "TMP", "TEMP"}
+
+func initScratchSpace() {
+	s, err := scratch.New(appConfig.Scratch.Dir, appConfig.Scratch.MaxSize)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to create scratch space: %+v\n", err)
+		os.Exit(1)
+	}
+
+	// point the platform temp dir within the scratch space, so that everything extracted by syft and stereoscope
+	// (image layers, nested archives...) is subject to the scratch configuration and is removed together on exit
+	for _, name := range tempDirEnvVars {
+		if err := os.Setenv(name, s.Dir()); err != nil {
+			s.Cleanup()
+			fmt.Fprintf(os.Stderr, "failed to set %s: %+v\n", name, err)
+			os.Exit(1)
+		}
+	}
+
+	log.Debugf("scratch directory: %s", s.Dir())
+	scratchSpace = s
+}
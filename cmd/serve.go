@@ -0,0 +1,253 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anchore/stereoscope"
+	"github.com/anchore/stereoscope/pkg/image"
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/internal/server"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/anchore/syft/syft/source"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// serveShutdownTimeout bounds how long in-flight requests are given to complete once the server is stopped.
+const serveShutdownTimeout = 30 * time.Second
+
+const serveExample = `  SYFT_SERVE_TOKEN=... {{.appName}} {{.command}}                   serve the HTTP API on localhost:8080
+  SYFT_SERVE_TOKEN=... {{.appName}} {{.command}} --listen :9000      serve on all interfaces
+
+  Request an SBOM for an input of the kinds allowed by serve.allowed-schemes (only images pulled from a registry by
+  default), authenticating with the token (the output format defaults to syft-json):
+
+    curl -X POST localhost:8080/scan -H "Authorization: Bearer $SYFT_SERVE_TOKEN" \
+      -d '{"input": "registry:alpine:3.15", "output": "spdx-json"}'
+
+  Prometheus metrics about the scans (counts, durations, queue depth, and per-cataloger timings) are served at /metrics.
+
+  Targets configured in serve.schedule are rescanned periodically, publishing the SBOM (see publish.*) only when it
+  changed since the previous rescan.
+`
+
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Serve an HTTP API that generates package SBOMs on request",
+	Example: internal.Tprintf(serveExample, map[string]interface{}{
+		"appName": internal.ApplicationName,
+		"command": "serve",
+	}),
+	Args:          cobra.NoArgs,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return serveExec(cmd, args)
+	},
+}
+
+func init() {
+	flags := serveCmd.Flags()
+	flags.StringP(
+		"listen", "", "localhost:8080",
+		"the address to serve the HTTP API on",
+	)
+	if err := viper.BindPFlag("serve.listen", flags.Lookup("listen")); err != nil {
+		panic(fmt.Sprintf("unable to bind flag 'listen': %+v", err))
+	}
+
+	flags.IntP(
+		"workers", "", 2,
+		"the max number of scans to run at once (further requests are queued)",
+	)
+	if err := viper.BindPFlag("serve.workers", flags.Lookup("workers")); err != nil {
+		panic(fmt.Sprintf("unable to bind flag 'workers': %+v", err))
+	}
+
+	rootCmd.AddCommand(serveCmd)
+}
+
+func serveExec(_ *cobra.Command, _ []string) error {
+	if appConfig.Serve.Token == "" {
+		// scans read local files and use the configured credentials, so they may not be requested by anyone
+		return fmt.Errorf("a bearer token for scan requests is required (serve.token or SYFT_SERVE_TOKEN)")
+	}
+
+	targets := appConfig.Serve.ScheduleOpt
+	switch {
+	case len(targets) > 0 && !appConfig.Publish.Enabled():
+		return fmt.Errorf("scheduled rescans (serve.schedule) require publishing to be configured (publish.*)")
+	case len(targets) == 0 && appConfig.Publish.Enabled():
+		// only the SBOMs of scheduled rescans are published (scan requests are answered directly)
+		return fmt.Errorf("publishing to message brokers (publish.*) requires scheduled rescans (serve.schedule) to be configured")
+	}
+
+	publishWriter, err := makePublishWriter()
+	if err != nil {
+		return err
+	}
+	if publishWriter != nil {
+		defer func() {
+			if err := publishWriter.Close(); err != nil {
+				log.Warnf("unable to close publisher: %+v", err)
+			}
+		}()
+	}
+	defer stereoscope.Cleanup()
+
+	// there is no UI to consume events in server mode, so they should not accumulate on the bus
+	if err := eventSubscription.Unsubscribe(); err != nil {
+		log.Warnf("unable to unsubscribe from events: %+v", err)
+	}
+
+	metrics := server.NewMetrics()
+
+	// the spans started for each cataloger are recorded as metrics instead of being exported
+	if appConfig.Tracing.Enabled {
+		log.Warn("trace export is not supported by the serve command (tracing.enabled is ignored)")
+	}
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(metrics)))
+
+	s := server.New(serveScan, server.Config{
+		Workers:    appConfig.Serve.Workers,
+		Token:      appConfig.Serve.Token,
+		AllowInput: allowServeInput,
+	}, metrics)
+	srv := &http.Server{
+		Addr:    appConfig.Serve.Listen,
+		Handler: s.Handler(),
+	}
+
+	// scheduled rescans are stopped (and waited on) before the publishers are closed
+	scheduleCtx, stopSchedule := context.WithCancel(context.Background())
+	var scheduled sync.WaitGroup
+	defer func() {
+		stopSchedule()
+		scheduled.Wait()
+	}()
+	if len(targets) > 0 {
+		scheduled.Add(1)
+		go func() {
+			defer scheduled.Done()
+			log.Infof("rescanning %d scheduled target(s)", len(targets))
+			s.RunScheduled(scheduleCtx, targets, func(_ server.ScheduledTarget, result sbom.SBOM) error {
+				return publishWriter.Write(result)
+			})
+		}()
+	}
+
+	errs := make(chan error, 1)
+	go func() {
+		log.Infof("serving on %s", appConfig.Serve.Listen)
+		errs <- srv.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errs:
+		return err
+	case <-setupSignals():
+		log.Info("shutting down")
+		ctx, cancel := context.WithTimeout(context.Background(), serveShutdownTimeout)
+		defer cancel()
+		if err := srv.Shutdown(ctx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+		return nil
+	}
+}
+
+// serveScan catalogs a single input requested through the HTTP API or a scheduled rescan. Like batch targets,
+// concurrent scans only share the indexing of file trees (see indexLock). Neither reading a source nor running the
+// catalogers can be interrupted, so a scan whose context is done gives up at the next step instead.
+func serveScan(ctx context.Context, userInput string) (*sbom.SBOM, error) {
+	tasks, err := tasks()
+	if err != nil {
+		return nil, err
+	}
+
+	src, cleanup, err := newIndexedSource(userInput)
+	if cleanup != nil {
+		defer cleanup()
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	s, err := newSBOM(src)
+	if err != nil {
+		return nil, err
+	}
+	if err := catalog(&s, src, tasks); err != nil {
+		return nil, err
+	}
+	return &s, ctx.Err()
+}
+
+// allowServeInput rejects scan requests for inputs of a kind not listed in serve.allowed-schemes, such that clients
+// of the HTTP API cannot read the files of the server (or reach hosts with its credentials) unless allowed to.
+func allowServeInput(userInput string) error {
+	scheme, err := serveInputScheme(userInput)
+	if err != nil {
+		return err
+	}
+	for _, allowed := range appConfig.Serve.AllowedSchemes {
+		if scheme == allowed {
+			return nil
+		}
+	}
+	return fmt.Errorf("scanning %s inputs is not allowed (allowed: %s)", scheme, strings.Join(appConfig.Serve.AllowedSchemes, ", "))
+}
+
+// serveInputScheme names the kind of source the given input refers to, as listed in serve.allowed-schemes (e.g.
+// "registry" for an image that would be pulled from a registry, or "dir" for a path to a directory).
+func serveInputScheme(userInput string) (string, error) {
+	scheme, imageSource, location, err := source.DetectScheme(userInput)
+	if err != nil {
+		return "", fmt.Errorf("unable to parse input=%q: %w", userInput, err)
+	}
+
+	switch scheme {
+	case source.ImageScheme:
+		switch imageSource {
+		case image.OciRegistrySource, image.DockerDaemonSource:
+			podman, err := source.PodmanHost(userInput, imageSource)
+			switch {
+			case err != nil || podman != "":
+				// image references without a scheme are read from the podman service when no docker daemon is available
+				return "podman", nil
+			case imageSource == image.OciRegistrySource:
+				return "registry", nil
+			}
+			return "docker", nil
+		case image.DockerTarballSource:
+			return "docker-archive", nil
+		case image.OciTarballSource:
+			return "oci-archive", nil
+		case image.OciDirectorySource:
+			return "oci-dir", nil
+		}
+	case source.DirectoryScheme:
+		switch {
+		case strings.HasPrefix(userInput, "host:"):
+			return "host", nil
+		case strings.HasPrefix(location, "ssh://"):
+			return "ssh", nil
+		}
+		return "dir", nil
+	case source.FileScheme:
+		return "file", nil
+	}
+	return "", fmt.Errorf("unable to determine the kind of input=%q", userInput)
+}
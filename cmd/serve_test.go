@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllowServeInput(t *testing.T) {
+	original := appConfig
+	defer func() { appConfig = original }()
+
+	tests := []struct {
+		input    string
+		allowed  []string
+		expected string
+		wantErr  bool
+	}{
+		{input: "registry:alpine:3.15", allowed: []string{"registry"}, expected: "registry"},
+		{input: "docker:alpine:3.15", allowed: []string{"registry"}, expected: "docker", wantErr: true},
+		{input: "dir:/", allowed: []string{"registry"}, expected: "dir", wantErr: true},
+		{input: "file:/etc/shadow", allowed: []string{"registry"}, expected: "file", wantErr: true},
+		{input: "host:", allowed: []string{"registry", "dir"}, expected: "host", wantErr: true},
+		{input: "ssh://user@example.com/var/lib", allowed: []string{"registry"}, expected: "ssh", wantErr: true},
+		{input: "dir:/", allowed: []string{"registry", "dir"}, expected: "dir"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			appConfig = &config.Application{}
+			appConfig.Serve.AllowedSchemes = test.allowed
+
+			scheme, err := serveInputScheme(test.input)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, scheme)
+
+			err = allowServeInput(test.input)
+			if test.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -3,12 +3,21 @@ package cmd
 import (
 	"crypto"
 	"fmt"
+	"runtime/debug"
+	"time"
 
+	"github.com/anchore/syft/internal/classifiers"
+	"github.com/anchore/syft/internal/enrichment"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/internal/telemetry"
 	"github.com/anchore/syft/syft"
 	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/eol"
 	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg/cataloger"
 	"github.com/anchore/syft/syft/sbom"
 	"github.com/anchore/syft/syft/source"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type task func(*sbom.Artifacts, *source.Source) ([]artifact.Relationship, error)
@@ -16,39 +25,88 @@ type task func(*sbom.Artifacts, *source.Source) ([]artifact.Relationship, error)
 func tasks() ([]task, error) {
 	var tasks []task
 
-	generators := []func() (task, error){
-		generateCatalogPackagesTask,
-		generateCatalogFileMetadataTask,
-		generateCatalogFileDigestsTask,
-		generateCatalogSecretsTask,
-		generateCatalogFileClassificationsTask,
-		generateCatalogContentsTask,
+	generators := []struct {
+		name     string
+		generate func() (task, error)
+	}{
+		{"catalog-packages", generateCatalogPackagesTask},
+		{"catalog-file-metadata", generateCatalogFileMetadataTask},
+		{"catalog-file-digests", generateCatalogFileDigestsTask},
+		{"catalog-secrets", generateCatalogSecretsTask},
+		{"catalog-file-classifications", generateCatalogFileClassificationsTask},
+		{"catalog-contents", generateCatalogContentsTask},
+		{"catalog-binary-links", generateCatalogBinaryLinksTask},
+		{"catalog-executables", generateCatalogExecutablesTask},
 	}
 
 	for _, generator := range generators {
-		task, err := generator()
+		task, err := generator.generate()
 		if err != nil {
 			return nil, err
 		}
 
 		if task != nil {
-			tasks = append(tasks, task)
+			tasks = append(tasks, tracedTask(generator.name, task))
 		}
 	}
 
 	return tasks, nil
 }
 
+// tracedTask wraps the given task such that each run is recorded as a span of the command trace.
+func tracedTask(name string, t task) task {
+	return func(results *sbom.Artifacts, src *source.Source) ([]artifact.Relationship, error) {
+		span := telemetry.StartSpan(name)
+		relationships, err := t(results, src)
+		span.SetAttributes(attribute.Int("syft.relationships", len(relationships)))
+		telemetry.EndSpan(span, err)
+		return relationships, err
+	}
+}
+
 func generateCatalogPackagesTask() (task, error) {
 	if !appConfig.Package.Cataloger.Enabled {
 		return nil, nil
 	}
 
+	var enricher *enrichment.Enricher
+	if appConfig.Enrichment.Enabled {
+		var err error
+		enricher, err = enrichment.NewEnricher(appConfig.Enrichment.ToConfig())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var eolDataset *eol.Dataset
+	if appConfig.EOL.Enabled {
+		var err error
+		eolDataset, err = appConfig.EOL.ToDataset()
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	task := func(results *sbom.Artifacts, src *source.Source) ([]artifact.Relationship, error) {
-		packageCatalog, relationships, theDistro, err := syft.CatalogPackages(src, appConfig.Package.ToConfig())
+		packageCatalog, relationships, theDistro, warnings, err := syft.CatalogPackages(src, appConfig.Package.ToConfig())
 		if err != nil {
 			return nil, err
 		}
+		addWarnings(results, warnings...)
+
+		if enricher != nil {
+			packageCatalog, warnings = enricher.Enrich(packageCatalog)
+			addWarnings(results, warnings...)
+		}
+
+		if eolDataset != nil {
+			at := appConfig.Document.TimestampOpt
+			if at.IsZero() {
+				at = time.Now()
+			}
+			packageCatalog, warnings = cataloger.AnnotateEOL(packageCatalog, theDistro, eolDataset, at)
+			addWarnings(results, warnings...)
+		}
 
 		results.PackageCatalog = packageCatalog
 		results.Distro = theDistro
@@ -150,11 +208,12 @@ func generateCatalogSecretsTask() (task, error) {
 			return nil, err
 		}
 
-		result, err := secretsCataloger.Catalog(resolver)
+		result, warnings, err := secretsCataloger.Catalog(resolver)
 		if err != nil {
 			return nil, err
 		}
 		results.Secrets = result
+		addWarnings(results, warnings...)
 		return nil, nil
 	}
 
@@ -166,8 +225,7 @@ func generateCatalogFileClassificationsTask() (task, error) {
 		return nil, nil
 	}
 
-	// TODO: in the future we could expose out the classifiers via configuration
-	classifierCataloger, err := file.NewClassificationCataloger(file.DefaultClassifiers)
+	classifierCataloger, err := file.NewClassificationCataloger(classifiers.Load(appConfig.FileClassification.Database))
 	if err != nil {
 		return nil, err
 	}
@@ -205,23 +263,86 @@ func generateCatalogContentsTask() (task, error) {
 			return nil, err
 		}
 
-		result, err := contentsCataloger.Catalog(resolver)
+		result, warnings, err := contentsCataloger.Catalog(resolver)
 		if err != nil {
 			return nil, err
 		}
 		results.FileContents = result
+		addWarnings(results, warnings...)
+		return nil, nil
+	}
+
+	return task, nil
+}
+
+func generateCatalogBinaryLinksTask() (task, error) {
+	if !appConfig.BinaryLinks.Cataloger.Enabled {
+		return nil, nil
+	}
+
+	binaryLinksCataloger, err := file.NewBinaryLinksCataloger()
+	if err != nil {
+		return nil, err
+	}
+
+	task := func(results *sbom.Artifacts, src *source.Source) ([]artifact.Relationship, error) {
+		resolver, err := src.FileResolver(appConfig.BinaryLinks.Cataloger.ScopeOpt)
+		if err != nil {
+			return nil, err
+		}
+
+		result, relationships, err := binaryLinksCataloger.Catalog(resolver)
+		if err != nil {
+			return nil, err
+		}
+		results.FileImports = result
+		return relationships, nil
+	}
+
+	return task, nil
+}
+
+func generateCatalogExecutablesTask() (task, error) {
+	if !appConfig.Executables.Cataloger.Enabled {
 		return nil, nil
 	}
 
+	executableCataloger, err := file.NewExecutableCataloger()
+	if err != nil {
+		return nil, err
+	}
+
+	task := func(results *sbom.Artifacts, src *source.Source) ([]artifact.Relationship, error) {
+		resolver, err := src.FileResolver(appConfig.Executables.Cataloger.ScopeOpt)
+		if err != nil {
+			return nil, err
+		}
+
+		result, relationships, err := executableCataloger.Catalog(resolver)
+		if err != nil {
+			return nil, err
+		}
+		results.FileExecutables = result
+		return relationships, nil
+	}
+
 	return task, nil
 }
 
 func runTask(t task, a *sbom.Artifacts, src *source.Source, c chan<- artifact.Relationship, errs chan<- error) {
 	defer close(c)
+	defer func() {
+		// tasks run on their own goroutine, where a panic (e.g. within a cataloger) would otherwise crash the process,
+		// including the other targets of a batch and the server
+		if r := recover(); r != nil {
+			log.Debugf("panic while cataloging: %+v\n%s", r, debug.Stack())
+			errs <- newExitCodeError(appConfig.ExitCode.CatalogerError, fmt.Errorf("panic while cataloging: %+v", r))
+		}
+	}()
 
 	relationships, err := t(a, src)
 	if err != nil {
-		errs <- err
+		errs <- newExitCodeError(appConfig.ExitCode.CatalogerError, err)
 		return
 	}
 
@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/internal/config"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/anchore/syft/syft/source"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunTask_Panic(t *testing.T) {
+	original := appConfig
+	defer func() { appConfig = original }()
+	appConfig = &config.Application{}
+
+	panicking := func(*sbom.Artifacts, *source.Source) ([]artifact.Relationship, error) {
+		panic("cataloger bug")
+	}
+
+	c := make(chan artifact.Relationship)
+	errs := make(chan error, 1)
+	go runTask(panicking, &sbom.Artifacts{}, &source.Source{}, c, errs)
+
+	// the relationships channel is closed (so catalog does not wait forever) and the panic is reported as an error
+	for range c {
+	}
+	close(errs)
+
+	var reported []error
+	for err := range errs {
+		reported = append(reported, err)
+	}
+	require.Len(t, reported, 1)
+	assert.Contains(t, reported[0].Error(), "cataloger bug")
+}
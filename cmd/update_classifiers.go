@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/classifiers"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const updateClassifiersExample = `  {{.appName}} {{.command}} --url https://example.com/classifiers.json    fetch a classifier database, verified against the digest published at the URL + ".sha256"
+  {{.appName}} {{.command}} --url URL --digest sha256:...                 fetch a classifier database, verified against the given digest
+  {{.appName}} {{.command}} -f ./classifiers.json                         use a classifier database from a file (e.g. for offline environments)
+
+  The classifier database is used to classify files (e.g. binaries of language runtimes) by the power-user command.
+  An updated database is used instead of the classifiers built into {{.appName}} as long as it is newer.
+`
+
+var updateClassifiersCmd = &cobra.Command{
+	Use:   "update-classifiers [--url URL | -f DATABASE-FILE]",
+	Short: "Update the database of file classifiers",
+	Example: internal.Tprintf(updateClassifiersExample, map[string]interface{}{
+		"appName": internal.ApplicationName,
+		"command": "update-classifiers",
+	}),
+	Args:          cobra.NoArgs,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE:          updateClassifiersExec,
+}
+
+func init() {
+	flags := updateClassifiersCmd.Flags()
+	flags.StringP(
+		"file", "f", "",
+		"the classifier database file to use (instead of fetching the latest database)",
+	)
+
+	flags.StringP(
+		"url", "", "",
+		"the URL to fetch the latest classifier database from",
+	)
+	if err := viper.BindPFlag("file-classification.update-url", flags.Lookup("url")); err != nil {
+		panic(fmt.Sprintf("unable to bind flag 'url': %+v", err))
+	}
+
+	flags.StringP(
+		"digest", "", "",
+		"the expected sha256 digest of the classifier database (by default the digest published at the URL + \".sha256\")",
+	)
+	if err := viper.BindPFlag("file-classification.update-digest", flags.Lookup("digest")); err != nil {
+		panic(fmt.Sprintf("unable to bind flag 'digest': %+v", err))
+	}
+
+	rootCmd.AddCommand(updateClassifiersCmd)
+}
+
+func updateClassifiersExec(cmd *cobra.Command, _ []string) error {
+	source, err := cmd.Flags().GetString("file")
+	if err != nil {
+		return err
+	}
+	if source == "" {
+		if appConfig.Offline {
+			return fmt.Errorf("unable to fetch the latest classifier database: %w (use -f to update from a file instead)", errOffline)
+		}
+		source = appConfig.FileClassification.UpdateURL
+		if source == "" {
+			return fmt.Errorf("no classifier database to update from: provide one with --url (file-classification.update-url) or -f")
+		}
+	}
+
+	db, err := classifiers.Update(source, appConfig.FileClassification.UpdateDigest, appConfig.FileClassification.Database)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("updated classifier database to version %d (%d classifiers): %s\n", db.Version, len(db.Classifiers), appConfig.FileClassification.Database)
+	return nil
+}
@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/formats/syftjson"
+	"github.com/spf13/cobra"
+)
+
+const validateExample = `  {{.appName}} {{.command}} ./sbom.json                 validate a syft JSON document
+  {{.appName}} {{.command}} ./archive/*.json            validate several documents at once
+
+  Each document is validated against the JSON schema of the syft JSON version it declares (the schemas of all
+  published versions are built into {{.appName}}), so documents written by older releases can be validated as well.
+`
+
+var validateCmd = &cobra.Command{
+	Use:   "validate SBOM-FILE...",
+	Short: "Validate syft JSON documents against the JSON schema of their version",
+	Example: internal.Tprintf(validateExample, map[string]interface{}{
+		"appName": internal.ApplicationName,
+		"command": "validate",
+	}),
+	Args:          cobra.MinimumNArgs(1),
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE:          validateExec,
+}
+
+func init() {
+	rootCmd.AddCommand(validateCmd)
+}
+
+func validateExec(_ *cobra.Command, args []string) error {
+	var invalid int
+	for _, path := range args {
+		if err := validateFile(path); err != nil {
+			fmt.Printf("%s: %+v\n", path, err)
+			var schemaErr *syftjson.SchemaError
+			if errors.As(err, &schemaErr) {
+				for _, problem := range schemaErr.Problems {
+					fmt.Printf("  - %s\n", problem)
+				}
+			}
+			invalid++
+		}
+	}
+
+	if invalid > 0 {
+		return fmt.Errorf("%d of %d document(s) failed validation", invalid, len(args))
+	}
+	return nil
+}
+
+func validateFile(path string) error {
+	by, err := ioutil.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("unable to read document: %w", err)
+	}
+
+	version, err := syftjson.ValidateSchema(by)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s: valid (syft JSON schema version %s)\n", path, version)
+	return nil
+}
@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/internal/verify"
+	"github.com/anchore/syft/syft/source"
+)
+
+// verifyInput checks the signatures of the image referenced by the user input (when verification keys have been
+// configured), returning the result to record in the SBOM. An error is returned when the image cannot be trusted and
+// verification is enforced, in which case the image must not be cataloged.
+func verifyInput(userInput string) (*source.ImageVerification, error) {
+	if !appConfig.Verify.Enabled() {
+		return nil, nil
+	}
+
+	policy, err := appConfig.Verify.ToPolicy()
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := verify.Reference(userInput, appConfig.Registry.ToOptions())
+	if err != nil {
+		return verificationFailed(nil, err)
+	}
+
+	result, err := verify.Verify(context.Background(), ref, policy, appConfig.Registry.ToOptions())
+	if err != nil {
+		return verificationFailed(result, err)
+	}
+
+	log.Infof("verified %d signature(s) for image=%q digest=%s", result.Signatures, ref.String(), result.Digest)
+	return result, nil
+}
+
+// recordVerification attaches the verification result to the image metadata of the source, first ensuring that the
+// image that was read is the same image that was verified in the registry.
+func recordVerification(src *source.Source, result *source.ImageVerification) error {
+	if result == nil {
+		return nil
+	}
+
+	if result.Verified && !verify.Matches(src.Metadata.ImageMetadata, *result) {
+		var err error
+		result, err = verificationFailed(result, fmt.Errorf("the image read (digest %s) is not the image verified in the registry (digest %s)", src.Metadata.ImageMetadata.ManifestDigest, result.Digest))
+		if err != nil {
+			return err
+		}
+	}
+
+	src.Metadata.ImageMetadata.Verification = result
+	return nil
+}
+
+func verificationFailed(result *source.ImageVerification, err error) (*source.ImageVerification, error) {
+	if appConfig.Verify.Enforce {
+		return nil, fmt.Errorf("image signature verification failed: %w", err)
+	}
+
+	log.Warnf("image signature verification failed (not enforced): %+v", err)
+	if result == nil {
+		result = &source.ImageVerification{}
+	}
+	result.Verified = false
+	result.Error = err.Error()
+	return result, nil
+}
@@ -4,10 +4,18 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
 
+	"github.com/CycloneDX/cyclonedx-go"
 	"github.com/anchore/syft/internal"
-
+	"github.com/anchore/syft/internal/classifiers"
+	spdxJSONModel "github.com/anchore/syft/internal/formats/spdx22json/model"
+	"github.com/anchore/syft/internal/spdxlicense"
 	"github.com/anchore/syft/internal/version"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/format"
+	"github.com/anchore/syft/syft/pkg/cataloger"
 	"github.com/spf13/cobra"
 )
 
@@ -44,10 +52,12 @@ func printVersion(_ *cobra.Command, _ []string) {
 		enc.SetIndent("", " ")
 		err := enc.Encode(&struct {
 			version.Version
-			Application string `json:"application"`
+			Application  string       `json:"application"`
+			Capabilities capabilities `json:"capabilities"`
 		}{
-			Version:     versionInfo,
-			Application: internal.ApplicationName,
+			Version:      versionInfo,
+			Application:  internal.ApplicationName,
+			Capabilities: buildCapabilities(),
 		})
 		if err != nil {
 			fmt.Printf("failed to show version information: %+v\n", err)
@@ -58,3 +68,77 @@ func printVersion(_ *cobra.Command, _ []string) {
 		os.Exit(1)
 	}
 }
+
+// capabilities describes what this build is able to do, so that tooling can check for features programmatically.
+type capabilities struct {
+	Catalogers     []catalogerCapability `json:"catalogers"`
+	OutputFormats  []string              `json:"outputFormats"`
+	SchemaVersions map[string]string     `json:"schemaVersions"` // the version of each document (and data file) format written or read
+	DataVersions   map[string]string     `json:"dataVersions"`   // the version of each data set used while cataloging
+}
+
+// catalogerCapability describes a single compiled-in package cataloger and the sources it is used for by default.
+type catalogerCapability struct {
+	Name      string `json:"name"`
+	Image     bool   `json:"image"`
+	Directory bool   `json:"directory"`
+}
+
+func buildCapabilities() capabilities {
+	cfg := cataloger.DefaultConfig()
+
+	imageCatalogers := cataloger.ImageCatalogers(cfg)
+	directoryCatalogers := cataloger.DirectoryCatalogers(cfg)
+	imageNames, directoryNames := catalogerNames(imageCatalogers), catalogerNames(directoryCatalogers)
+
+	// note: not every image or directory cataloger is part of the full set of catalogers, so all sets are considered
+	var catalogers []catalogerCapability
+	seen := make(map[string]bool)
+	for _, set := range [][]cataloger.Cataloger{cataloger.AllCatalogers(cfg), imageCatalogers, directoryCatalogers} {
+		for _, c := range set {
+			if seen[c.Name()] {
+				continue
+			}
+			seen[c.Name()] = true
+			catalogers = append(catalogers, catalogerCapability{
+				Name:      c.Name(),
+				Image:     imageNames[c.Name()],
+				Directory: directoryNames[c.Name()],
+			})
+		}
+	}
+
+	var outputFormats []string
+	for _, o := range format.AllOptions {
+		outputFormats = append(outputFormats, string(o))
+	}
+
+	classifierDatabase := file.DefaultClassifierDatabase()
+	if appConfig != nil {
+		// report the updated classifier database when it is in use
+		classifierDatabase = classifiers.LoadDatabase(appConfig.FileClassification.Database)
+	}
+
+	return capabilities{
+		Catalogers:    catalogers,
+		OutputFormats: outputFormats,
+		SchemaVersions: map[string]string{
+			"syft-json":           internal.JSONSchemaVersion,
+			"spdx":                strings.TrimPrefix(spdxJSONModel.Version, "SPDX-"),
+			"cyclonedx":           cyclonedx.SpecVersion,
+			"classifier-database": strconv.Itoa(file.ClassifierDatabaseSchemaVersion),
+		},
+		DataVersions: map[string]string{
+			"spdx-license-list": spdxlicense.Version,
+			"classifiers":       strconv.Itoa(classifierDatabase.Version),
+		},
+	}
+}
+
+func catalogerNames(catalogers []cataloger.Cataloger) map[string]bool {
+	names := make(map[string]bool)
+	for _, c := range catalogers {
+		names[c.Name()] = true
+	}
+	return names
+}
@@ -0,0 +1,27 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/CycloneDX/cyclonedx-go"
+	"github.com/anchore/syft/internal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildCapabilities(t *testing.T) {
+	c := buildCapabilities()
+
+	assert.Contains(t, c.OutputFormats, "json")
+	assert.Equal(t, internal.JSONSchemaVersion, c.SchemaVersions["syft-json"])
+	assert.Equal(t, "2.2", c.SchemaVersions["spdx"])
+	assert.Equal(t, cyclonedx.SpecVersion, c.SchemaVersions["cyclonedx"])
+	assert.NotEmpty(t, c.DataVersions["spdx-license-list"])
+	assert.NotEmpty(t, c.DataVersions["classifiers"])
+
+	names := make(map[string]bool)
+	for _, cataloger := range c.Catalogers {
+		assert.False(t, names[cataloger.Name], "duplicate cataloger %q", cataloger.Name)
+		names[cataloger.Name] = true
+	}
+	assert.True(t, names["apkdb-cataloger"])
+}
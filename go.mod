@@ -23,6 +23,7 @@ require (
 	github.com/facebookincubator/nvdtools v0.1.4
 	github.com/go-test/deep v1.0.7
 	github.com/google/go-cmp v0.5.6
+	github.com/google/go-containerregistry v0.7.0
 	github.com/google/uuid v1.2.0
 	github.com/gookit/color v1.2.7
 	github.com/hashicorp/go-multierror v1.1.0
@@ -33,11 +34,17 @@ require (
 	github.com/mitchellh/go-homedir v1.1.0
 	github.com/mitchellh/hashstructure/v2 v2.0.2
 	github.com/mitchellh/mapstructure v1.4.1
+	github.com/nats-io/nats.go v1.13.0
 	github.com/olekukonko/tablewriter v0.0.4
 	github.com/pelletier/go-toml v1.9.3
 	github.com/pkg/profile v1.5.0
+	github.com/pkg/sftp v1.13.4
+	github.com/robfig/cron/v3 v3.0.1
 	github.com/scylladb/go-set v1.0.2
+	github.com/segmentio/kafka-go v0.4.28
 	github.com/sergi/go-diff v1.1.0
+	github.com/sigstore/cosign v1.4.1
+	github.com/sigstore/sigstore v1.1.0
 	github.com/sirupsen/logrus v1.8.1
 	github.com/spdx/tools-golang v0.1.0
 	github.com/spf13/afero v1.6.0
@@ -51,8 +58,14 @@ require (
 	github.com/wagoodman/jotframe v0.0.0-20211129225309-56b0d0a4aebb
 	github.com/x-cray/logrus-prefixed-formatter v0.5.2
 	github.com/xeipuuv/gojsonschema v1.2.0
+	go.opentelemetry.io/otel v1.3.0
+	go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp v1.3.0
+	go.opentelemetry.io/otel/sdk v1.3.0
+	go.opentelemetry.io/otel/trace v1.3.0
+	golang.org/x/crypto v0.0.0-20210421170649-83a5a9bb288b
 	golang.org/x/mod v0.4.2
 	golang.org/x/net v0.0.0-20211111160137-58aab5ef257a
 	golang.org/x/term v0.0.0-20210615171337-6886f2dfbf5b
+	golang.org/x/text v0.3.6
 	gopkg.in/yaml.v2 v2.4.0
 )
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"path"
 	"strings"
 	"unicode"
@@ -18,11 +19,17 @@ type Configuration struct {
 	Username  string
 	Password  string
 	UserAgent string
+	TLS       TLSConfiguration
+	Retry     RetryConfiguration
+	// stream the package SBOM upload with chunked transfer encoding instead of encoding it in memory first
+	ChunkedUpload bool
 }
 
 type Client struct {
-	config Configuration
-	client *external.APIClient
+	config     Configuration
+	client     *external.APIClient
+	httpClient *http.Client
+	baseURL    string
 }
 
 func NewClient(cfg Configuration) (*Client, error) {
@@ -37,11 +44,19 @@ func NewClient(cfg Configuration) (*Client, error) {
 		return nil, fmt.Errorf("unable to create client: %w", err)
 	}
 
+	httpClient, err := newHTTPClient(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create client: %w", err)
+	}
+
 	return &Client{
-		config: cfg,
+		config:     cfg,
+		httpClient: httpClient,
+		baseURL:    baseURL,
 		client: external.NewAPIClient(&external.Configuration{
-			BasePath:  baseURL,
-			UserAgent: cfg.UserAgent,
+			BasePath:   baseURL,
+			UserAgent:  cfg.UserAgent,
+			HTTPClient: httpClient,
 		}),
 	}, nil
 }
@@ -1,7 +1,9 @@
 package anchore
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
@@ -9,6 +11,8 @@ import (
 	"github.com/anchore/client-go/pkg/external"
 	"github.com/anchore/stereoscope/pkg/image"
 	"github.com/anchore/syft/internal/bus"
+	"github.com/anchore/syft/internal/formats/syftjson"
+	"github.com/anchore/syft/internal/log"
 	"github.com/anchore/syft/syft/event"
 	"github.com/anchore/syft/syft/sbom"
 	"github.com/antihax/optional"
@@ -68,7 +72,12 @@ func (c *Client) Import(ctx context.Context, cfg ImportConfig) error {
 	prog.N++
 	sessionID := startOperation.Uuid
 
-	packageDigest, err := importPackageSBOM(authedCtx, c.client.ImportsApi, sessionID, cfg.SBOM, stage)
+	var packageDigest string
+	if c.config.ChunkedUpload {
+		packageDigest, err = c.importPackageSBOMChunked(authedCtx, sessionID, cfg.SBOM, stage)
+	} else {
+		packageDigest, err = importPackageSBOM(authedCtx, c.client.ImportsApi, sessionID, cfg.SBOM, stage)
+	}
 	if err != nil {
 		return fmt.Errorf("failed to import Package SBOM: %w", err)
 	}
@@ -115,6 +124,53 @@ func (c *Client) Import(ctx context.Context, cfg ImportConfig) error {
 	return nil
 }
 
+// ValidateImport checks that all payloads of an import can be created and are well-formed without sending anything
+// to the server (a dry-run of Import).
+func ValidateImport(cfg ImportConfig) error {
+	var buf bytes.Buffer
+	if err := syftjson.Format().Encode(&buf, cfg.SBOM); err != nil {
+		return fmt.Errorf("unable to serialize package SBOM: %w", err)
+	}
+
+	if err := syftjson.Format().Validate(bytes.NewReader(buf.Bytes())); err != nil {
+		return fmt.Errorf("package SBOM does not conform to the JSON schema: %w", err)
+	}
+
+	if _, err := packageSbomModel(cfg.SBOM); err != nil {
+		return fmt.Errorf("unable to create PackageSBOM model: %w", err)
+	}
+	log.Infof("dry-run: package SBOM is valid (%d bytes)", buf.Len())
+
+	payloads := []struct {
+		name     string
+		contents []byte
+	}{
+		{name: "manifest", contents: cfg.SBOM.Source.ImageMetadata.RawManifest},
+		{name: "config", contents: cfg.SBOM.Source.ImageMetadata.RawConfig},
+	}
+	for _, payload := range payloads {
+		if len(payload.contents) == 0 {
+			continue
+		}
+		// the API requires an object for each of these payloads
+		var sender map[string]interface{}
+		if err := json.Unmarshal(payload.contents, &sender); err != nil {
+			return fmt.Errorf("invalid image %s: %w", payload.name, err)
+		}
+		log.Infof("dry-run: image %s is valid (%d bytes)", payload.name, len(payload.contents))
+	}
+
+	if len(cfg.Dockerfile) > 0 {
+		log.Infof("dry-run: dockerfile is valid (%d bytes)", len(cfg.Dockerfile))
+	}
+
+	if cfg.ImageMetadata.ManifestDigest == "" {
+		return fmt.Errorf("image manifest digest is missing")
+	}
+
+	return nil
+}
+
 func addImageModel(imageMetadata image.Metadata, packageDigest, manifestDigest, dockerfileDigest, configDigest, sessionID string) external.ImageAnalysisRequest {
 	var tags = make([]string, len(imageMetadata.Tags))
 	for i, t := range imageMetadata.Tags {
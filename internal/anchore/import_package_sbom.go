@@ -6,11 +6,15 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
+	"net/url"
 
 	"github.com/anchore/syft/syft/sbom"
 
 	"github.com/anchore/syft/internal/formats/syftjson"
+	syftjsonModel "github.com/anchore/syft/internal/formats/syftjson/model"
 
 	"github.com/wagoodman/go-progress"
 
@@ -65,3 +69,102 @@ func importPackageSBOM(ctx context.Context, api packageSBOMImportAPI, sessionID
 
 	return response.Digest, nil
 }
+
+// importPackageSBOMChunked uploads the package SBOM the same way as importPackageSBOM, but streams the request body
+// with chunked transfer encoding instead of sending it through the generated API client (which encodes the whole
+// request body in memory before sending it). This keeps the memory use of uploading huge SBOMs down to a single
+// package at a time.
+func (c *Client) importPackageSBOMChunked(ctx context.Context, sessionID string, s sbom.SBOM, stage *progress.Stage) (string, error) {
+	log.Debug("importing package SBOM (chunked)")
+	stage.Current = "package SBOM"
+
+	doc := syftjson.ToFormatModel(s)
+	newBody := func() (io.ReadCloser, error) {
+		reader, writer := io.Pipe()
+		go func() {
+			// the transport closes the reader when the request fails, which unblocks (and ends) the writer
+			writer.CloseWithError(writePackageSBOMModel(writer, doc))
+		}()
+		return reader, nil
+	}
+
+	body, _ := newBody()
+	endpoint := fmt.Sprintf("%s/imports/images/%s/packages", c.baseURL, url.PathEscape(sessionID))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, body)
+	if err != nil {
+		body.Close()
+		return "", fmt.Errorf("unable to create PackageSBOM request: %w", err)
+	}
+	// an unknown content length causes the body to be sent with chunked transfer encoding, while GetBody allows the
+	// retry transport to send the body again
+	req.ContentLength = -1
+	req.GetBody = newBody
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", c.config.UserAgent)
+	req.SetBasicAuth(c.config.Username, c.config.Password)
+
+	httpResponse, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("unable to import PackageSBOM: %w", err)
+	}
+	defer httpResponse.Body.Close()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		if detail, err := ioutil.ReadAll(io.LimitReader(httpResponse.Body, 64*1024)); err == nil {
+			log.Errorf("api response: %+v", string(detail))
+		}
+		return "", fmt.Errorf("unable to import PackageSBOM: %s", httpResponse.Status)
+	}
+
+	var response external.ImageImportContentResponse
+	if err := json.NewDecoder(httpResponse.Body).Decode(&response); err != nil {
+		return "", fmt.Errorf("unable to read PackageSBOM import response: %w", err)
+	}
+	return response.Digest, nil
+}
+
+// writePackageSBOMModel writes the import model for the given syft-json document (see packageSbomModel), encoding
+// one package at a time so that the encoded document is never held in memory as a whole.
+func writePackageSBOMModel(w io.Writer, doc syftjsonModel.Document) error {
+	encoder := json.NewEncoder(w)
+
+	if _, err := io.WriteString(w, `{"artifacts":[`); err != nil {
+		return err
+	}
+	for i, p := range doc.Artifacts {
+		if i > 0 {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		if err := encoder.Encode(p); err != nil {
+			return fmt.Errorf("unable to encode package %q: %w", p.Name, err)
+		}
+	}
+	if _, err := io.WriteString(w, "]"); err != nil {
+		return err
+	}
+
+	fields := []struct {
+		name  string
+		value interface{}
+	}{
+		{name: "artifactRelationships", value: doc.ArtifactRelationships},
+		{name: "source", value: doc.Source},
+		{name: "distro", value: doc.Distro},
+		{name: "descriptor", value: doc.Descriptor},
+		{name: "schema", value: doc.Schema},
+	}
+	for _, field := range fields {
+		if _, err := fmt.Fprintf(w, ",%q:", field.name); err != nil {
+			return err
+		}
+		if err := encoder.Encode(field.value); err != nil {
+			return fmt.Errorf("unable to encode %s: %w", field.name, err)
+		}
+	}
+
+	_, err := io.WriteString(w, "}")
+	return err
+}
@@ -6,8 +6,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/anchore/syft/syft/sbom"
 
@@ -19,6 +21,8 @@ import (
 	"github.com/anchore/syft/syft/source"
 	"github.com/docker/docker/pkg/ioutils"
 	"github.com/go-test/deep"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/wagoodman/go-progress"
 )
 
@@ -150,8 +154,8 @@ func (m *mockPackageSBOMImportAPI) ImportImagePackages(ctx context.Context, sess
 	return external.ImageImportContentResponse{Digest: m.responseDigest}, m.httpResponse, m.err
 }
 
-func TestPackageSbomImport(t *testing.T) {
-
+// packageSBOMFixture returns an SBOM with a single package for the import tests.
+func packageSBOMFixture() sbom.SBOM {
 	catalog := pkg.NewCatalog(pkg.Package{
 		Name:    "name",
 		Version: "version",
@@ -208,13 +212,18 @@ func TestPackageSbomImport(t *testing.T) {
 
 	d, _ := distro.NewDistro(distro.CentOS, "8.0", "")
 
-	sbomResult := sbom.SBOM{
+	return sbom.SBOM{
 		Artifacts: sbom.Artifacts{
 			PackageCatalog: catalog,
 			Distro:         &d,
 		},
 		Source: m,
 	}
+}
+
+func TestPackageSbomImport(t *testing.T) {
+
+	sbomResult := packageSBOMFixture()
 
 	theModel, err := packageSbomModel(sbomResult)
 	if err != nil {
@@ -280,3 +289,56 @@ func TestPackageSbomImport(t *testing.T) {
 		})
 	}
 }
+
+func TestPackageSbomImportChunked(t *testing.T) {
+	sbomResult := packageSBOMFixture()
+
+	theModel, err := packageSbomModel(sbomResult)
+	require.NoError(t, err)
+
+	// the first attempt is rate limited, so the streamed body must be sent again
+	statuses := []int{http.StatusTooManyRequests, http.StatusOK}
+	var attempts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/imports/images/my-session/packages", r.URL.Path)
+		assert.Equal(t, []string{"chunked"}, r.TransferEncoding)
+		assert.Equal(t, int64(-1), r.ContentLength)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		username, password, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "user", username)
+		assert.Equal(t, "pass", password)
+
+		var model external.ImagePackageManifest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&model))
+		for _, d := range deep.Equal(&model, theModel) {
+			t.Errorf("model difference: %s", d)
+		}
+
+		status := statuses[attempts]
+		attempts++
+		w.WriteHeader(status)
+		if status == http.StatusOK {
+			_, _ = w.Write([]byte(`{"digest": "digest!"}`))
+		}
+	}))
+	defer server.Close()
+
+	c, err := NewClient(Configuration{
+		BaseURL:  server.URL,
+		Username: "user",
+		Password: "pass",
+		Retry: RetryConfiguration{
+			MaxAttempts:    2,
+			InitialBackoff: time.Millisecond,
+		},
+		ChunkedUpload: true,
+	})
+	require.NoError(t, err)
+
+	digest, err := c.importPackageSBOMChunked(context.TODO(), "my-session", sbomResult, &progress.Stage{})
+	require.NoError(t, err)
+	assert.Equal(t, "digest!", digest)
+	assert.Equal(t, 2, attempts)
+}
@@ -0,0 +1,75 @@
+package anchore
+
+import (
+	"testing"
+
+	"github.com/anchore/stereoscope/pkg/image"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/anchore/syft/syft/source"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateImport(t *testing.T) {
+	newConfig := func(manifest, config string) ImportConfig {
+		catalog := pkg.NewCatalog()
+		catalog.Add(pkg.Package{Name: "musl", Version: "1.2.2-r7", Type: pkg.ApkPkg})
+
+		return ImportConfig{
+			ImageMetadata: image.Metadata{ManifestDigest: "sha256:digest"},
+			SBOM: sbom.SBOM{
+				Artifacts: sbom.Artifacts{PackageCatalog: catalog},
+				Source: source.Metadata{
+					Scheme: source.ImageScheme,
+					ImageMetadata: source.ImageMetadata{
+						UserInput:      "alpine:latest",
+						ManifestDigest: "sha256:digest",
+						RawManifest:    []byte(manifest),
+						RawConfig:      []byte(config),
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		cfg          ImportConfig
+		expectsError bool
+	}{
+		{
+			name: "valid payloads",
+			cfg:  newConfig(`{"schemaVersion": 2}`, `{"architecture": "amd64"}`),
+		},
+		{
+			name:         "invalid manifest",
+			cfg:          newConfig(`not json`, `{"architecture": "amd64"}`),
+			expectsError: true,
+		},
+		{
+			name:         "config is not an object",
+			cfg:          newConfig(`{"schemaVersion": 2}`, `["amd64"]`),
+			expectsError: true,
+		},
+		{
+			name: "missing manifest digest",
+			cfg: func() ImportConfig {
+				c := newConfig(`{"schemaVersion": 2}`, `{"architecture": "amd64"}`)
+				c.ImageMetadata.ManifestDigest = ""
+				return c
+			}(),
+			expectsError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateImport(test.cfg)
+			if test.expectsError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package anchore
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/anchore/syft/internal/log"
+)
+
+const (
+	defaultInitialBackoff = time.Second
+	defaultMaxBackoff     = 30 * time.Second
+)
+
+// TLSConfiguration describes how to verify the server and (optionally) authenticate the client with a certificate.
+type TLSConfiguration struct {
+	CACertFile     string // PEM encoded CA bundle used to verify the server (in addition to the system roots)
+	ClientCertFile string // PEM encoded client certificate for mutual TLS
+	ClientKeyFile  string // PEM encoded private key for the client certificate
+}
+
+// RetryConfiguration describes how requests that fail for transient reasons are retried. Requests that were not
+// processed by the server (connection failures and 429 responses) are always retried, while other network errors and
+// 5xx responses are only retried for idempotent requests, since a non-idempotent request (such as an import POST) may
+// already have taken effect. Retries back off exponentially, starting at InitialBackoff and capped at MaxBackoff.
+type RetryConfiguration struct {
+	MaxAttempts    uint // total number of attempts for a request (0 or 1 = no retries)
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+func newHTTPClient(cfg Configuration) (*http.Client, error) {
+	base := http.DefaultTransport.(*http.Transport).Clone()
+
+	tlsConfig, err := newTLSConfig(cfg.TLS)
+	if err != nil {
+		return nil, err
+	}
+	base.TLSClientConfig = tlsConfig
+
+	var transport http.RoundTripper = base
+	if cfg.Retry.MaxAttempts > 1 {
+		transport = newRetryTransport(transport, cfg.Retry)
+	}
+
+	return &http.Client{Transport: transport}, nil
+}
+
+func newTLSConfig(cfg TLSConfiguration) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if cfg.CACertFile != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		contents, err := ioutil.ReadFile(cfg.CACertFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read CA certificate: %w", err)
+		}
+		if !pool.AppendCertsFromPEM(contents) {
+			return nil, fmt.Errorf("no certificates found in CA certificate file=%q", cfg.CACertFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	switch {
+	case cfg.ClientCertFile != "" && cfg.ClientKeyFile != "":
+		cert, err := tls.LoadX509KeyPair(cfg.ClientCertFile, cfg.ClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	case cfg.ClientCertFile != "" || cfg.ClientKeyFile != "":
+		return nil, fmt.Errorf("both a client certificate and key must be provided for TLS client authentication")
+	}
+
+	return tlsConfig, nil
+}
+
+// retryTransport retries requests that fail for transient reasons with an exponential backoff.
+type retryTransport struct {
+	next   http.RoundTripper
+	config RetryConfiguration
+	sleep  func(time.Duration) <-chan time.Time
+}
+
+func newRetryTransport(next http.RoundTripper, cfg RetryConfiguration) *retryTransport {
+	if cfg.InitialBackoff == 0 {
+		cfg.InitialBackoff = defaultInitialBackoff
+	}
+	if cfg.MaxBackoff == 0 {
+		cfg.MaxBackoff = defaultMaxBackoff
+	}
+	return &retryTransport{
+		next:   next,
+		config: cfg,
+		sleep:  time.After,
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	backoff := t.config.InitialBackoff
+	for attempt := uint(1); ; attempt++ {
+		attemptReq := req
+		if attempt > 1 && req.Body != nil {
+			if req.GetBody == nil {
+				// the body cannot be replayed, so the request cannot be retried
+				return nil, fmt.Errorf("unable to retry request to %s: request body cannot be replayed", req.URL)
+			}
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq = req.Clone(req.Context())
+			attemptReq.Body = body
+		}
+
+		resp, err := t.next.RoundTrip(attemptReq)
+		if attempt >= t.config.MaxAttempts || !isRetryable(req, resp, err) {
+			return resp, err
+		}
+
+		if err != nil {
+			log.Warnf("request to %s failed (attempt %d of %d), retrying in %s: %+v", req.URL, attempt, t.config.MaxAttempts, backoff, err)
+		} else {
+			log.Warnf("request to %s failed with status %q (attempt %d of %d), retrying in %s", req.URL, resp.Status, attempt, t.config.MaxAttempts, backoff)
+			resp.Body.Close()
+		}
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-t.sleep(backoff):
+		}
+
+		backoff *= 2
+		if backoff > t.config.MaxBackoff {
+			backoff = t.config.MaxBackoff
+		}
+	}
+}
+
+func isRetryable(req *http.Request, resp *http.Response, err error) bool {
+	if err != nil {
+		// a request that could not be sent at all never reached the server
+		var opErr *net.OpError
+		if errors.As(err, &opErr) && opErr.Op == "dial" {
+			return true
+		}
+		// other network errors (connection reset, timeouts) may happen after the server has processed the request
+		return isIdempotent(req)
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		// the request was rejected before being processed
+		return true
+	}
+	return resp.StatusCode >= http.StatusInternalServerError && isIdempotent(req)
+}
+
+// isIdempotent indicates if sending the request more than once has the same effect as sending it once (RFC 7231).
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
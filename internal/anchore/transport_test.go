@@ -0,0 +1,220 @@
+package anchore
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryTransport(t *testing.T) {
+	tests := []struct {
+		name             string
+		method           string
+		statuses         []int
+		maxAttempts      uint
+		expectedStatus   int
+		expectedAttempts int
+	}{
+		{
+			name:             "succeeds after transient failures",
+			method:           http.MethodPut,
+			statuses:         []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK},
+			maxAttempts:      3,
+			expectedStatus:   http.StatusOK,
+			expectedAttempts: 3,
+		},
+		{
+			name:             "gives up after max attempts",
+			method:           http.MethodPut,
+			statuses:         []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway},
+			maxAttempts:      2,
+			expectedStatus:   http.StatusBadGateway,
+			expectedAttempts: 2,
+		},
+		{
+			name:             "client errors are not retried",
+			method:           http.MethodPut,
+			statuses:         []int{http.StatusBadRequest, http.StatusOK},
+			maxAttempts:      3,
+			expectedStatus:   http.StatusBadRequest,
+			expectedAttempts: 1,
+		},
+		{
+			name:             "non-idempotent requests are not retried on server errors",
+			method:           http.MethodPost,
+			statuses:         []int{http.StatusServiceUnavailable, http.StatusOK},
+			maxAttempts:      3,
+			expectedStatus:   http.StatusServiceUnavailable,
+			expectedAttempts: 1,
+		},
+		{
+			name:             "non-idempotent requests are retried when rate limited",
+			method:           http.MethodPost,
+			statuses:         []int{http.StatusTooManyRequests, http.StatusOK},
+			maxAttempts:      3,
+			expectedStatus:   http.StatusOK,
+			expectedAttempts: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var bodies []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := ioutil.ReadAll(r.Body)
+				bodies = append(bodies, string(body))
+				w.WriteHeader(test.statuses[len(bodies)-1])
+			}))
+			defer server.Close()
+
+			transport := newRetryTransport(http.DefaultTransport, RetryConfiguration{MaxAttempts: test.maxAttempts})
+			var backoffs []time.Duration
+			transport.sleep = func(d time.Duration) <-chan time.Time {
+				backoffs = append(backoffs, d)
+				return time.After(0)
+			}
+
+			req, err := http.NewRequest(test.method, server.URL, bytes.NewBufferString("the-sbom"))
+			require.NoError(t, err)
+
+			resp, err := (&http.Client{Transport: transport}).Do(req)
+			require.NoError(t, err)
+			resp.Body.Close()
+
+			assert.Equal(t, test.expectedStatus, resp.StatusCode)
+			assert.Len(t, bodies, test.expectedAttempts)
+			for _, body := range bodies {
+				// the body must be replayed in full for every attempt
+				assert.Equal(t, "the-sbom", body)
+			}
+			for i := 1; i < len(backoffs); i++ {
+				assert.Equal(t, backoffs[i-1]*2, backoffs[i])
+			}
+		})
+	}
+}
+
+func TestIsRetryable_NetworkErrors(t *testing.T) {
+	post, err := http.NewRequest(http.MethodPost, "http://localhost", nil)
+	require.NoError(t, err)
+	get, err := http.NewRequest(http.MethodGet, "http://localhost", nil)
+	require.NoError(t, err)
+
+	dialErr := &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+	readErr := &net.OpError{Op: "read", Net: "tcp", Err: errors.New("connection reset by peer")}
+
+	// requests that never reached the server are always safe to send again
+	assert.True(t, isRetryable(post, nil, &url.Error{Op: "Post", URL: "http://localhost", Err: dialErr}))
+	assert.False(t, isRetryable(post, nil, readErr))
+	assert.True(t, isRetryable(get, nil, readErr))
+}
+
+func writeCertificate(t *testing.T, dir, name string, template *x509.Certificate, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name+".crt"), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name+".key"), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+
+	return cert, key
+}
+
+func TestNewHTTPClient_ClientCertificate(t *testing.T) {
+	dir := t.TempDir()
+	notAfter := time.Now().Add(time.Hour)
+
+	ca, caKey := writeCertificate(t, dir, "ca", &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotAfter:              notAfter,
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}, nil, nil)
+
+	writeCertificate(t, dir, "client", &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "syft"},
+		NotAfter:     notAfter,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}, ca, caKey)
+
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(ca)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(r.TLS.PeerCertificates[0].Subject.CommonName))
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs}
+	server.StartTLS()
+	defer server.Close()
+
+	serverCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "server-ca.crt"), serverCert, 0600))
+
+	t.Run("with client certificate", func(t *testing.T) {
+		client, err := newHTTPClient(Configuration{TLS: TLSConfiguration{
+			CACertFile:     filepath.Join(dir, "server-ca.crt"),
+			ClientCertFile: filepath.Join(dir, "client.crt"),
+			ClientKeyFile:  filepath.Join(dir, "client.key"),
+		}})
+		require.NoError(t, err)
+
+		resp, err := client.Get(server.URL)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		body, err := ioutil.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Equal(t, "syft", string(body))
+	})
+
+	t.Run("without client certificate", func(t *testing.T) {
+		client, err := newHTTPClient(Configuration{TLS: TLSConfiguration{
+			CACertFile: filepath.Join(dir, "server-ca.crt"),
+		}})
+		require.NoError(t, err)
+
+		_, err = client.Get(server.URL)
+		assert.Error(t, err)
+	})
+
+	t.Run("certificate without key", func(t *testing.T) {
+		_, err := newHTTPClient(Configuration{TLS: TLSConfiguration{
+			ClientCertFile: filepath.Join(dir, "client.crt"),
+		}})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,37 @@
+package attest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/sigstore/sigstore/pkg/signature"
+	"github.com/sigstore/sigstore/pkg/signature/dsse"
+)
+
+// Envelope is a DSSE (Dead Simple Signing Envelope) as produced by "cosign attest".
+type Envelope struct {
+	PayloadType string      `json:"payloadType"`
+	Payload     []byte      `json:"payload"`
+	Signatures  []Signature `json:"signatures"`
+}
+
+type Signature struct {
+	KeyID string `json:"keyid"`
+	Sig   []byte `json:"sig"`
+}
+
+// Sign creates a DSSE envelope for the given payload signed by the given signer. With a local key this is performed
+// entirely offline (no transparency log or certificate authority is contacted).
+func Sign(payloadType string, payload []byte, signer signature.Signer) (*Envelope, error) {
+	signed, err := dsse.WrapSigner(signer, payloadType).SignMessage(bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign payload: %w", err)
+	}
+
+	var envelope Envelope
+	if err := json.Unmarshal(signed, &envelope); err != nil {
+		return nil, fmt.Errorf("unable to read signed envelope: %w", err)
+	}
+	return &envelope, nil
+}
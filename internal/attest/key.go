@@ -0,0 +1,106 @@
+package attest
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	sigs "github.com/sigstore/cosign/pkg/signature"
+	"github.com/sigstore/sigstore/pkg/signature"
+
+	// register the KMS providers supported by cosign
+	_ "github.com/sigstore/sigstore/pkg/signature/kms/aws"
+	_ "github.com/sigstore/sigstore/pkg/signature/kms/azure"
+	_ "github.com/sigstore/sigstore/pkg/signature/kms/gcp"
+	_ "github.com/sigstore/sigstore/pkg/signature/kms/hashivault"
+)
+
+const (
+	pkcs8PrivateKeyPemType = "PRIVATE KEY"
+	ecPrivateKeyPemType    = "EC PRIVATE KEY"
+)
+
+// kmsSchemes are the key reference prefixes used by cosign for keys held within a key management service.
+var kmsSchemes = []string{"awskms://", "gcpkms://", "azurekms://", "hashivault://"}
+
+// IsKMSReference indicates if the given key reference points to a key management service instead of a local file.
+func IsKMSReference(ref string) bool {
+	for _, scheme := range kmsSchemes {
+		if strings.HasPrefix(ref, scheme) {
+			return true
+		}
+	}
+	return false
+}
+
+// LoadSigner returns a signer for the given key reference, which is anything accepted by "cosign sign --key": the
+// path to a cosign private key (as created by "cosign generate-key-pair", decrypted with the given password) or a KMS
+// key reference (e.g. "awskms://...", "gcpkms://...", "azurekms://..." or "hashivault://..."). Unencrypted
+// PKCS8 / EC private keys are accepted as well. Signing with a local key requires no network access.
+func LoadSigner(ctx context.Context, ref string, password []byte) (signature.SignerVerifier, error) {
+	if !IsKMSReference(ref) {
+		signer, err := loadUnencryptedKey(ref)
+		if err != nil {
+			return nil, err
+		}
+		if signer != nil {
+			return signer, nil
+		}
+	}
+
+	signer, err := sigs.SignerVerifierFromKeyRef(ctx, ref, func(bool) ([]byte, error) {
+		return password, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to load key %q: %w", ref, err)
+	}
+	return signer, nil
+}
+
+// LoadPublicKey reads the public key for the given key reference: the path to a PEM encoded (PKIX) public key, such as
+// the cosign.pub file created by "cosign generate-key-pair", or a KMS key reference.
+func LoadPublicKey(ctx context.Context, ref string) (crypto.PublicKey, error) {
+	verifier, err := sigs.PublicKeyFromKeyRef(ctx, ref)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load key %q: %w", ref, err)
+	}
+	return verifier.PublicKey()
+}
+
+// loadUnencryptedKey returns a signer for the unencrypted PKCS8 / EC private key at the given path (which cosign
+// itself does not accept), or nil if the path does not hold such a key.
+func loadUnencryptedKey(path string) (signature.SignerVerifier, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		// leave reporting unreadable keys to cosign
+		return nil, nil
+	}
+
+	block, _ := pem.Decode(contents)
+	if block == nil {
+		return nil, nil
+	}
+
+	var key interface{}
+	switch block.Type {
+	case pkcs8PrivateKeyPemType:
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case ecPrivateKeyPemType:
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse private key: %w", err)
+	}
+
+	signer, err := signature.LoadSignerVerifier(key, crypto.SHA256)
+	if err != nil {
+		return nil, fmt.Errorf("private key cannot be used for signing: %w", err)
+	}
+	return signer, nil
+}
@@ -0,0 +1,87 @@
+package attest
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/anchore/syft/internal/testutils"
+	"github.com/sigstore/cosign/pkg/cosign"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeCosignKeyPair writes a key pair in the same (encrypted) format as "cosign generate-key-pair", returning the
+// paths to the private and public keys.
+func writeCosignKeyPair(t *testing.T, password []byte) (string, string) {
+	t.Helper()
+
+	keys, err := cosign.GenerateKeyPair(func(bool) ([]byte, error) {
+		return password, nil
+	})
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	privPath := filepath.Join(dir, "cosign.key")
+	pubPath := filepath.Join(dir, "cosign.pub")
+	require.NoError(t, ioutil.WriteFile(privPath, keys.PrivateBytes, 0600))
+	require.NoError(t, ioutil.WriteFile(pubPath, keys.PublicBytes, 0600))
+	return privPath, pubPath
+}
+
+func TestLoadSigner_Cosign(t *testing.T) {
+	privPath, pubPath := writeCosignKeyPair(t, []byte("secret"))
+
+	signer, err := LoadSigner(context.Background(), privPath, []byte("secret"))
+	require.NoError(t, err)
+
+	expected, err := LoadPublicKey(context.Background(), pubPath)
+	require.NoError(t, err)
+	actual, err := signer.PublicKey()
+	require.NoError(t, err)
+	assert.Equal(t, expected, actual)
+
+	_, err = LoadSigner(context.Background(), privPath, []byte("wrong"))
+	assert.Error(t, err)
+}
+
+func TestLoadSigner_Unencrypted(t *testing.T) {
+	key := testutils.NewECDSAKey(t)
+
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	ec, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	tests := []pem.Block{
+		{Type: pkcs8PrivateKeyPemType, Bytes: pkcs8},
+		{Type: ecPrivateKeyPemType, Bytes: ec},
+	}
+	for _, block := range tests {
+		t.Run(block.Type, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "key.pem")
+			require.NoError(t, ioutil.WriteFile(path, pem.EncodeToMemory(&block), 0600))
+
+			signer, err := LoadSigner(context.Background(), path, nil)
+			require.NoError(t, err)
+			actual, err := signer.PublicKey()
+			require.NoError(t, err)
+			assert.True(t, key.PublicKey.Equal(actual))
+		})
+	}
+}
+
+func TestLoadSigner_MissingKey(t *testing.T) {
+	_, err := LoadSigner(context.Background(), filepath.Join(t.TempDir(), "cosign.key"), nil)
+	assert.Error(t, err)
+}
+
+func TestIsKMSReference(t *testing.T) {
+	for _, ref := range []string{"awskms:///arn:aws:kms:us-east-1:123:key/abc", "gcpkms://projects/p/locations/l/keyRings/r/cryptoKeys/k", "azurekms://vault.vault.azure.net/key", "hashivault://key"} {
+		assert.True(t, IsKMSReference(ref), ref)
+	}
+	assert.False(t, IsKMSReference("cosign.key"))
+}
@@ -0,0 +1,96 @@
+package attest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/anchore/syft/internal/formats/syftjson"
+	"github.com/anchore/syft/syft/format"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/anchore/syft/syft/source"
+	"github.com/sigstore/sigstore/pkg/signature"
+)
+
+const (
+	// InTotoPayloadType is the DSSE payload type for in-toto statements.
+	InTotoPayloadType = "application/vnd.in-toto+json"
+	// StatementType is the in-toto statement version used (matching cosign).
+	StatementType = "https://in-toto.io/Statement/v0.1"
+	// SyftPredicateType is the predicate type for a syft-json SBOM.
+	SyftPredicateType = "https://syft.dev/bom"
+)
+
+// Statement is an in-toto statement describing the image that the SBOM predicate is about.
+type Statement struct {
+	Type          string          `json:"_type"`
+	PredicateType string          `json:"predicateType"`
+	Subject       []Subject       `json:"subject"`
+	Predicate     json.RawMessage `json:"predicate"`
+}
+
+type Subject struct {
+	Name   string            `json:"name"`
+	Digest map[string]string `json:"digest"`
+}
+
+// Format returns an encode-only format that writes the syft-json SBOM as a signed in-toto attestation (a DSSE
+// envelope) using the given signer.
+func Format(signer signature.Signer) format.Format {
+	return format.NewFormat(
+		format.JSONOption,
+		func(output io.Writer, s sbom.SBOM) error {
+			envelope, err := NewAttestation(s, signer)
+			if err != nil {
+				return err
+			}
+			return json.NewEncoder(output).Encode(envelope)
+		},
+		nil,
+		nil,
+	)
+}
+
+// NewAttestation creates a signed in-toto statement with the syft-json encoded SBOM as the predicate. Only image
+// sources can be attested since the statement subject is the image manifest digest.
+func NewAttestation(s sbom.SBOM, signer signature.Signer) (*Envelope, error) {
+	subject, err := newSubject(s.Source)
+	if err != nil {
+		return nil, err
+	}
+
+	var predicate bytes.Buffer
+	if err := syftjson.Format().Encode(&predicate, s); err != nil {
+		return nil, fmt.Errorf("unable to encode SBOM predicate: %w", err)
+	}
+
+	statement, err := json.Marshal(Statement{
+		Type:          StatementType,
+		PredicateType: SyftPredicateType,
+		Subject:       []Subject{*subject},
+		Predicate:     predicate.Bytes(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode attestation statement: %w", err)
+	}
+
+	return Sign(InTotoPayloadType, statement, signer)
+}
+
+func newSubject(src source.Metadata) (*Subject, error) {
+	if src.Scheme != source.ImageScheme {
+		return nil, fmt.Errorf("only image sources can be attested (got %q)", src.Scheme)
+	}
+
+	fields := strings.SplitN(src.ImageMetadata.ManifestDigest, ":", 2)
+	if len(fields) != 2 {
+		return nil, fmt.Errorf("unable to determine manifest digest for image %q", src.ImageMetadata.UserInput)
+	}
+
+	return &Subject{
+		Name:   src.ImageMetadata.UserInput,
+		Digest: map[string]string{fields[0]: fields[1]},
+	}, nil
+}
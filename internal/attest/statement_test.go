@@ -0,0 +1,69 @@
+package attest
+
+import (
+	"crypto"
+	"encoding/json"
+	"testing"
+
+	"github.com/anchore/syft/internal/testutils"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/anchore/syft/syft/source"
+	"github.com/sigstore/sigstore/pkg/signature"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAttestation(t *testing.T) {
+	key := testutils.NewECDSAKey(t)
+
+	catalog := pkg.NewCatalog()
+	catalog.Add(pkg.Package{Name: "musl", Version: "1.2.2-r7", Type: pkg.ApkPkg})
+
+	s := sbom.SBOM{
+		Artifacts: sbom.Artifacts{PackageCatalog: catalog},
+		Source: source.Metadata{
+			Scheme: source.ImageScheme,
+			ImageMetadata: source.ImageMetadata{
+				UserInput:      "alpine:latest",
+				ManifestDigest: "sha256:e7d88de73db3d3fd9b2d63aa7f447a10fd0220b7cbf39803c803f2af9ba256b3",
+			},
+		},
+	}
+
+	signer, err := signature.LoadSigner(key, crypto.SHA256)
+	require.NoError(t, err)
+
+	envelope, err := NewAttestation(s, signer)
+	require.NoError(t, err)
+
+	assert.Equal(t, InTotoPayloadType, envelope.PayloadType)
+	require.Len(t, envelope.Signatures, 1)
+	assert.NoError(t, envelope.Verify(key.Public()))
+
+	var statement Statement
+	require.NoError(t, json.Unmarshal(envelope.Payload, &statement))
+	assert.Equal(t, StatementType, statement.Type)
+	assert.Equal(t, SyftPredicateType, statement.PredicateType)
+	assert.Equal(t, []Subject{{
+		Name:   "alpine:latest",
+		Digest: map[string]string{"sha256": "e7d88de73db3d3fd9b2d63aa7f447a10fd0220b7cbf39803c803f2af9ba256b3"},
+	}}, statement.Subject)
+	assert.Contains(t, string(statement.Predicate), `"musl"`)
+}
+
+func TestNewAttestation_RequiresImage(t *testing.T) {
+	s := sbom.SBOM{
+		Artifacts: sbom.Artifacts{PackageCatalog: pkg.NewCatalog()},
+		Source: source.Metadata{
+			Scheme: source.DirectoryScheme,
+			Path:   "some/path",
+		},
+	}
+
+	signer, err := signature.LoadSigner(testutils.NewECDSAKey(t), crypto.SHA256)
+	require.NoError(t, err)
+
+	_, err = NewAttestation(s, signer)
+	assert.Error(t, err)
+}
@@ -0,0 +1,51 @@
+package attest
+
+import (
+	"bytes"
+	"crypto"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/sigstore/sigstore/pkg/signature"
+	"github.com/sigstore/sigstore/pkg/signature/dsse"
+)
+
+// ErrInvalidSignature is returned when a signature does not match the message for the given public key.
+var ErrInvalidSignature = errors.New("invalid signature")
+
+// VerifySignature checks the signature of the given message in the same way as cosign: ECDSA and RSA signatures are
+// over the SHA-256 digest of the message, ed25519 signatures are over the message itself.
+func VerifySignature(pub crypto.PublicKey, message, sig []byte) error {
+	verifier, err := signature.LoadVerifier(pub, crypto.SHA256)
+	if err != nil {
+		return fmt.Errorf("unsupported public key: %w", err)
+	}
+
+	if err := verifier.VerifySignature(bytes.NewReader(sig), bytes.NewReader(message)); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+	return nil
+}
+
+// Verify checks that at least one of the envelope signatures is valid for the given public key.
+func (e Envelope) Verify(pub crypto.PublicKey) error {
+	if len(e.Signatures) == 0 {
+		return fmt.Errorf("envelope is not signed")
+	}
+
+	verifier, err := signature.LoadVerifier(pub, crypto.SHA256)
+	if err != nil {
+		return fmt.Errorf("unsupported public key: %w", err)
+	}
+
+	contents, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("unable to encode envelope: %w", err)
+	}
+
+	if err := dsse.WrapVerifier(verifier).VerifySignature(bytes.NewReader(contents), nil); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package attest
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/anchore/syft/internal/testutils"
+	"github.com/sigstore/sigstore/pkg/signature"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvelope_Verify(t *testing.T) {
+	ecKey := testutils.NewECDSAKey(t)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		signer crypto.Signer
+	}{
+		{name: "ecdsa", signer: ecKey},
+		{name: "rsa", signer: rsaKey},
+		{name: "ed25519", signer: edKey},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			signer, err := signature.LoadSigner(test.signer, crypto.SHA256)
+			require.NoError(t, err)
+
+			envelope, err := Sign(InTotoPayloadType, []byte(`{"_type":"statement"}`), signer)
+			require.NoError(t, err)
+
+			assert.NoError(t, envelope.Verify(test.signer.Public()))
+
+			// a different key must not verify
+			assert.ErrorIs(t, envelope.Verify(testutils.NewECDSAKey(t).Public()), ErrInvalidSignature)
+
+			// nor may the payload (or payload type) be altered
+			tampered := *envelope
+			tampered.Payload = []byte(`{"_type":"other"}`)
+			assert.ErrorIs(t, tampered.Verify(test.signer.Public()), ErrInvalidSignature)
+
+			tampered = *envelope
+			tampered.PayloadType = "text/plain"
+			assert.ErrorIs(t, tampered.Verify(test.signer.Public()), ErrInvalidSignature)
+		})
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	key := testutils.NewECDSAKey(t)
+	message := []byte("payload")
+	digest := sha256.Sum256(message)
+	sig, err := key.Sign(rand.Reader, digest[:], crypto.SHA256)
+	require.NoError(t, err)
+
+	assert.NoError(t, VerifySignature(key.Public(), message, sig))
+	assert.ErrorIs(t, VerifySignature(key.Public(), []byte("other"), sig), ErrInvalidSignature)
+	assert.Error(t, VerifySignature("not a key", message, sig))
+}
+
+func TestLoadPublicKey(t *testing.T) {
+	key := testutils.NewECDSAKey(t)
+	der, err := x509.MarshalPKIXPublicKey(key.Public())
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	pubPath := filepath.Join(dir, "cosign.pub")
+	require.NoError(t, ioutil.WriteFile(pubPath, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0600))
+
+	actual, err := LoadPublicKey(context.Background(), pubPath)
+	require.NoError(t, err)
+	assert.Equal(t, key.Public(), actual)
+
+	// private keys are not public keys
+	privPath, _ := writeCosignKeyPair(t, []byte("password"))
+	_, err = LoadPublicKey(context.Background(), privPath)
+	assert.Error(t, err)
+}
@@ -0,0 +1,78 @@
+package batch
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"text/template"
+
+	"github.com/anchore/syft/syft/format"
+)
+
+// DefaultOutputTemplate names each output file after the target and the format (e.g. "alpine_3.15.spdx-json").
+const DefaultOutputTemplate = "{{.Name}}.{{.Format}}"
+
+// Output is a single report to write for a target.
+type Output struct {
+	Format format.Option
+	Path   string
+}
+
+// Job is a target along with all of the reports that should be written for it.
+type Job struct {
+	Index   int // the position of the target within the targets file (starting at 1)
+	Target  Target
+	Outputs []Output
+}
+
+// templateFields are the values available to the output naming template.
+type templateFields struct {
+	Index  int
+	Name   string
+	Input  string
+	Format string
+}
+
+// Plan renders the output paths for every target and format, returning an error if any two reports would be written
+// to the same path (since jobs run concurrently, this would otherwise result in clobbered output).
+func Plan(targets []Target, formats []format.Option, outputTemplate string) ([]Job, error) {
+	tmpl, err := template.New("output").Option("missingkey=error").Parse(outputTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("bad output template: %w", err)
+	}
+
+	owners := make(map[string]string)
+	var jobs []Job
+	for idx, t := range targets {
+		job := Job{
+			Index:  idx + 1,
+			Target: t,
+		}
+		for _, f := range formats {
+			var buf bytes.Buffer
+			err := tmpl.Execute(&buf, templateFields{
+				Index:  job.Index,
+				Name:   t.Name,
+				Input:  t.Input,
+				Format: string(f),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("unable to render output path for target %q: %w", t.Input, err)
+			}
+
+			path := filepath.Clean(buf.String())
+			if owner, exists := owners[path]; exists {
+				return nil, fmt.Errorf("targets %q and %q would both write to %q (use a unique name or add {{.Index}} to the output template)", owner, t.Input, path)
+			}
+			owners[path] = t.Input
+
+			job.Outputs = append(job.Outputs, Output{
+				Format: f,
+				Path:   path,
+			})
+		}
+		jobs = append(jobs, job)
+	}
+
+	return jobs, nil
+}
@@ -0,0 +1,74 @@
+package batch
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/format"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlan(t *testing.T) {
+	targets := []Target{
+		{Input: "alpine:3.15", Name: "alpine"},
+		{Input: "dir:.", Name: "project"},
+	}
+
+	jobs, err := Plan(targets, []format.Option{format.JSONOption, format.SPDXJSONOption}, "out/{{.Index}}/{{.Name}}.{{.Format}}")
+	require.NoError(t, err)
+
+	assert.Equal(t, []Job{
+		{
+			Index:  1,
+			Target: targets[0],
+			Outputs: []Output{
+				{Format: format.JSONOption, Path: "out/1/alpine.json"},
+				{Format: format.SPDXJSONOption, Path: "out/1/alpine.spdx-json"},
+			},
+		},
+		{
+			Index:  2,
+			Target: targets[1],
+			Outputs: []Output{
+				{Format: format.JSONOption, Path: "out/2/project.json"},
+				{Format: format.SPDXJSONOption, Path: "out/2/project.spdx-json"},
+			},
+		},
+	}, jobs)
+}
+
+func TestPlan_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		targets  []Target
+		template string
+	}{
+		{
+			name:     "colliding paths",
+			targets:  []Target{{Input: "alpine:3.15", Name: "alpine"}, {Input: "alpine:3.14", Name: "alpine"}},
+			template: DefaultOutputTemplate,
+		},
+		{
+			name:     "paths that are only equal once cleaned",
+			targets:  []Target{{Input: "alpine:3.15", Name: "a"}, {Input: "alpine:3.14", Name: "./a"}},
+			template: DefaultOutputTemplate,
+		},
+		{
+			name:     "bad template",
+			targets:  []Target{{Input: "alpine:3.15", Name: "alpine"}},
+			template: "{{.Name",
+		},
+		{
+			name:     "unknown template field",
+			targets:  []Target{{Input: "alpine:3.15", Name: "alpine"}},
+			template: "{{.Tag}}.json",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Plan(test.targets, []format.Option{format.JSONOption}, test.template)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package batch
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/anchore/stereoscope/pkg/image"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/internal/registry"
+	"github.com/bmatcuk/doublestar/v4"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+)
+
+// RegistryFilter selects which images found in a registry become targets. Patterns are globs (supporting "**")
+// matched against "<repository>:<tag>", for example "team-a/**" or "**/*:*-rc*" (note that "**" must be a
+// complete path segment).
+type RegistryFilter struct {
+	Include []string // when empty, all images are included
+	Exclude []string
+}
+
+// Validate checks that all patterns are well formed.
+func (f RegistryFilter) Validate() error {
+	for _, pattern := range append(append([]string{}, f.Include...), f.Exclude...) {
+		if !doublestar.ValidatePattern(pattern) {
+			return fmt.Errorf("bad registry pattern: %q", pattern)
+		}
+	}
+	return nil
+}
+
+// Matches indicates if the given "<repository>:<tag>" should be cataloged.
+func (f RegistryFilter) Matches(image string) bool {
+	for _, pattern := range f.Exclude {
+		if matched, _ := doublestar.Match(pattern, image); matched {
+			return false
+		}
+	}
+
+	if len(f.Include) == 0 {
+		return true
+	}
+	for _, pattern := range f.Include {
+		if matched, _ := doublestar.Match(pattern, image); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// RegistryTargets enumerates every repository and tag within the given registry (via the registry catalog API),
+// returning a target for each image that passes the filter. Repositories whose tags cannot be listed are skipped.
+func RegistryTargets(ctx context.Context, host string, filter RegistryFilter, registryOptions *image.RegistryOptions) ([]Target, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	nameOpts := registry.ReferenceOptions(registryOptions)
+
+	reg, err := name.NewRegistry(host, nameOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("bad registry %q: %w", host, err)
+	}
+
+	opts := registry.RemoteOptions(reg, registryOptions)
+
+	repos, err := remote.Catalog(ctx, reg, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list repositories in registry %q: %w", host, err)
+	}
+	sort.Strings(repos)
+
+	var targets []Target
+	for _, r := range repos {
+		repo, err := name.NewRepository(reg.Name()+"/"+r, nameOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("bad repository %q: %w", r, err)
+		}
+		tags, err := remote.ListWithContext(ctx, repo, opts...)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			// a single unreadable repository (e.g. without pull access) should not prevent cataloging the rest
+			log.Warnf("skipping registry repository=%q: unable to list tags: %+v", repo.String(), err)
+			continue
+		}
+		sort.Strings(tags)
+
+		for _, tag := range tags {
+			ref := r + ":" + tag
+			if !filter.Matches(ref) {
+				log.Debugf("skipping registry image=%q (filtered)", ref)
+				continue
+			}
+			targets = append(targets, Target{
+				Input: "registry:" + repo.Tag(tag).String(),
+				Name:  nameFromInput(ref),
+			})
+		}
+	}
+
+	return targets, nil
+}
@@ -0,0 +1,134 @@
+package batch
+
+import (
+	"context"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/anchore/stereoscope/pkg/image"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistryTargets(t *testing.T) {
+	server := httptest.NewServer(registry.New(registry.Logger(log.New(ioutil.Discard, "", 0))))
+	defer server.Close()
+	host := strings.TrimPrefix(server.URL, "http://")
+
+	img, err := random.Image(1024, 1)
+	require.NoError(t, err)
+
+	for _, ref := range []string{
+		"team-a/api:1.0.0",
+		"team-a/api:1.1.0-rc1",
+		"team-a/web:latest",
+		"team-b/worker:2.0",
+	} {
+		tag, err := name.NewTag(host+"/"+ref, name.Insecure)
+		require.NoError(t, err)
+		require.NoError(t, remote.Write(tag, img))
+	}
+
+	opts := &image.RegistryOptions{InsecureUseHTTP: true}
+
+	tests := []struct {
+		name     string
+		filter   RegistryFilter
+		expected []string
+	}{
+		{
+			name: "all images",
+			expected: []string{
+				"team-a/api:1.0.0",
+				"team-a/api:1.1.0-rc1",
+				"team-a/web:latest",
+				"team-b/worker:2.0",
+			},
+		},
+		{
+			name: "include and exclude",
+			filter: RegistryFilter{
+				Include: []string{"team-a/**"},
+				Exclude: []string{"**/*:*-rc*"},
+			},
+			expected: []string{
+				"team-a/api:1.0.0",
+				"team-a/web:latest",
+			},
+		},
+		{
+			name: "nothing matches",
+			filter: RegistryFilter{
+				Include: []string{"team-c/**"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			targets, err := RegistryTargets(context.Background(), host, test.filter, opts)
+			require.NoError(t, err)
+
+			var actual []string
+			for _, target := range targets {
+				assert.True(t, strings.HasPrefix(target.Input, "registry:"+host+"/"), target.Input)
+				actual = append(actual, strings.TrimPrefix(target.Input, "registry:"+host+"/"))
+			}
+			assert.Equal(t, test.expected, actual)
+
+			// names must be unique so that the default output template does not collide
+			names := make(map[string]bool)
+			for _, target := range targets {
+				assert.False(t, names[target.Name], "duplicate name %q", target.Name)
+				names[target.Name] = true
+			}
+		})
+	}
+}
+
+func TestRegistryTargets_SkipsUnreadableRepositories(t *testing.T) {
+	handler := registry.New(registry.Logger(log.New(ioutil.Discard, "", 0)))
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v2/team-a/private/tags/list" {
+			http.Error(w, "denied", http.StatusForbidden)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+	host := strings.TrimPrefix(server.URL, "http://")
+
+	img, err := random.Image(1024, 1)
+	require.NoError(t, err)
+
+	for _, ref := range []string{
+		"team-a/private:1.0.0",
+		"team-b/worker:2.0",
+	} {
+		tag, err := name.NewTag(host+"/"+ref, name.Insecure)
+		require.NoError(t, err)
+		require.NoError(t, remote.Write(tag, img))
+	}
+
+	targets, err := RegistryTargets(context.Background(), host, RegistryFilter{}, &image.RegistryOptions{InsecureUseHTTP: true})
+	require.NoError(t, err)
+
+	var actual []string
+	for _, target := range targets {
+		actual = append(actual, strings.TrimPrefix(target.Input, "registry:"+host+"/"))
+	}
+	assert.Equal(t, []string{"team-b/worker:2.0"}, actual)
+}
+
+func TestRegistryFilter_Validate(t *testing.T) {
+	assert.NoError(t, RegistryFilter{Include: []string{"**/app:*"}}.Validate())
+	assert.Error(t, RegistryFilter{Exclude: []string{"app:[v1"}}.Validate())
+}
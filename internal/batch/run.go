@@ -0,0 +1,99 @@
+package batch
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	SuccessStatus = "success"
+	FailureStatus = "failure"
+)
+
+// Result describes the outcome of a single job.
+type Result struct {
+	Name            string   `json:"name"`
+	Input           string   `json:"input"`
+	Status          string   `json:"status"`
+	Outputs         []string `json:"outputs,omitempty"`
+	Error           string   `json:"error,omitempty"`
+	DurationSeconds float64  `json:"durationSeconds"`
+	Err             error    `json:"-"` // the failure of the target (when failed)
+}
+
+// Summary is the machine-readable report of an entire batch.
+type Summary struct {
+	Succeeded int      `json:"succeeded"`
+	Failed    int      `json:"failed"`
+	Results   []Result `json:"results"`
+}
+
+// Run calls fn for every job with at most the given number of jobs in flight. Results are reported in the same order
+// as the given jobs, regardless of the order in which the jobs complete.
+func Run(jobs []Job, parallelism int, fn func(Job) error) Summary {
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	results := make([]Result, len(jobs))
+	sem := make(chan struct{}, parallelism)
+	var wg sync.WaitGroup
+
+	for idx, job := range jobs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(idx int, job Job) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			results[idx] = runJob(job, fn)
+		}(idx, job)
+	}
+	wg.Wait()
+
+	summary := Summary{Results: results}
+	for _, r := range results {
+		if r.Status == SuccessStatus {
+			summary.Succeeded++
+		} else {
+			summary.Failed++
+		}
+	}
+	return summary
+}
+
+func runJob(job Job, fn func(Job) error) Result {
+	result := Result{
+		Name:  job.Target.Name,
+		Input: job.Target.Input,
+	}
+
+	start := time.Now()
+	err := callSafely(job, fn)
+	result.DurationSeconds = time.Since(start).Seconds()
+
+	if err != nil {
+		result.Status = FailureStatus
+		result.Error = err.Error()
+		result.Err = err
+		return result
+	}
+
+	result.Status = SuccessStatus
+	for _, o := range job.Outputs {
+		result.Outputs = append(result.Outputs, o.Path)
+	}
+	return result
+}
+
+// callSafely calls fn, treating a panic as a failure of the single job instead of the entire batch.
+func callSafely(job Job, fn func(Job) error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic while cataloging: %+v", r)
+		}
+	}()
+	return fn(job)
+}
@@ -0,0 +1,66 @@
+package batch
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	var jobs []Job
+	for i := 1; i <= 10; i++ {
+		jobs = append(jobs, Job{
+			Index:   i,
+			Target:  Target{Input: fmt.Sprintf("target-%d", i), Name: fmt.Sprintf("t%d", i)},
+			Outputs: []Output{{Path: fmt.Sprintf("t%d.json", i)}},
+		})
+	}
+
+	var lock sync.Mutex
+	var inFlight, maxInFlight int
+
+	summary := Run(jobs, 3, func(job Job) error {
+		lock.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		lock.Unlock()
+
+		time.Sleep(5 * time.Millisecond)
+
+		lock.Lock()
+		inFlight--
+		lock.Unlock()
+
+		switch job.Index {
+		case 4:
+			return fmt.Errorf("cannot catalog")
+		case 7:
+			panic("unexpected")
+		}
+		return nil
+	})
+
+	assert.LessOrEqual(t, maxInFlight, 3)
+	assert.Equal(t, 8, summary.Succeeded)
+	assert.Equal(t, 2, summary.Failed)
+
+	// results are reported in the order of the targets, not the order of completion
+	for idx, r := range summary.Results {
+		assert.Equal(t, jobs[idx].Target.Input, r.Input)
+	}
+
+	assert.Equal(t, FailureStatus, summary.Results[3].Status)
+	assert.Equal(t, "cannot catalog", summary.Results[3].Error)
+	assert.Empty(t, summary.Results[3].Outputs)
+
+	assert.Equal(t, FailureStatus, summary.Results[6].Status)
+	assert.Contains(t, summary.Results[6].Error, "panic")
+
+	assert.Equal(t, SuccessStatus, summary.Results[0].Status)
+	assert.Equal(t, []string{"t1.json"}, summary.Results[0].Outputs)
+}
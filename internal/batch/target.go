@@ -0,0 +1,74 @@
+package batch
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+var unsafeNameChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// Target is a single source to catalog as part of a batch.
+type Target struct {
+	Input string `yaml:"input" json:"input"` // the source to catalog (any input accepted by the packages command)
+	Name  string `yaml:"name" json:"name"`   // used when naming output files; derived from the input when not given
+}
+
+type targetsDocument struct {
+	Targets []Target `yaml:"targets"`
+}
+
+// ReadTargets parses a targets file of the form:
+//
+//	targets:
+//	  - input: alpine:3.15
+//	  - input: dir:./my-project
+//	    name: my-project
+func ReadTargets(reader io.Reader) ([]Target, error) {
+	contents, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read targets: %w", err)
+	}
+
+	var doc targetsDocument
+	if err := yaml.UnmarshalStrict(contents, &doc); err != nil {
+		return nil, fmt.Errorf("unable to parse targets: %w", err)
+	}
+
+	if len(doc.Targets) == 0 {
+		return nil, fmt.Errorf("no targets given")
+	}
+
+	for idx, t := range doc.Targets {
+		if strings.TrimSpace(t.Input) == "" {
+			return nil, fmt.Errorf("target %d has no input", idx+1)
+		}
+		if t.Name == "" {
+			doc.Targets[idx].Name = nameFromInput(t.Input)
+		}
+	}
+
+	return doc.Targets, nil
+}
+
+// nameFromInput derives a name that is safe to use as a file name (e.g. "registry:alpine:3.15" -> "alpine_3.15").
+func nameFromInput(input string) string {
+	// drop any explicit scheme, which is not a useful part of the name
+	for _, scheme := range []string{"docker:", "podman:", "docker-archive:", "oci-archive:", "oci-dir:", "registry:", "dir:", "file:"} {
+		if strings.HasPrefix(input, scheme) {
+			input = strings.TrimPrefix(input, scheme)
+			break
+		}
+	}
+	input = strings.TrimPrefix(input, "ssh://")
+
+	name := strings.Trim(unsafeNameChars.ReplaceAllString(input, "_"), "_.")
+	if name == "" {
+		return "target"
+	}
+	return name
+}
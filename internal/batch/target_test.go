@@ -0,0 +1,85 @@
+package batch
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadTargets(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents string
+		expected []Target
+		wantErr  require.ErrorAssertionFunc
+	}{
+		{
+			name: "derive names",
+			contents: `
+targets:
+  - input: registry:alpine:3.15
+  - input: dir:./my-project
+    name: project
+  - input: ssh://user@host/srv/app
+`,
+			expected: []Target{
+				{Input: "registry:alpine:3.15", Name: "alpine_3.15"},
+				{Input: "dir:./my-project", Name: "project"},
+				{Input: "ssh://user@host/srv/app", Name: "user_host_srv_app"},
+			},
+		},
+		{
+			name:     "no targets",
+			contents: "targets: []",
+			wantErr:  require.Error,
+		},
+		{
+			name: "missing input",
+			contents: `
+targets:
+  - name: nothing
+`,
+			wantErr: require.Error,
+		},
+		{
+			name: "unknown field",
+			contents: `
+targets:
+  - input: alpine
+    output: alpine.json
+`,
+			wantErr: require.Error,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.wantErr == nil {
+				test.wantErr = require.NoError
+			}
+			actual, err := ReadTargets(strings.NewReader(test.contents))
+			test.wantErr(t, err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestNameFromInput(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: "alpine:latest", expected: "alpine_latest"},
+		{input: "docker-archive:/tmp/image.tar", expected: "tmp_image.tar"},
+		{input: "ghcr.io/anchore/syft@sha256:abc", expected: "ghcr.io_anchore_syft_sha256_abc"},
+		{input: "dir:.", expected: "target"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			assert.Equal(t, test.expected, nameFromInput(test.input))
+		})
+	}
+}
@@ -0,0 +1,178 @@
+/*
+Package classifiers manages the classifier database used to classify files, which may be updated (with the
+update-classifiers command) independently of syft releases.
+*/
+package classifiers
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/file"
+)
+
+const fetchTimeout = 30 * time.Second
+
+// Load returns the classifiers to catalog files with: the classifiers of the updated database at the given path when
+// it is newer than the database built into syft, otherwise the built-in classifiers. An updated database that cannot
+// be read (e.g. written for another schema version) is ignored.
+func Load(dbPath string) []file.Classifier {
+	return LoadDatabase(dbPath).Classifiers
+}
+
+// LoadDatabase returns the classifier database that Load selects the classifiers from.
+func LoadDatabase(dbPath string) *file.ClassifierDatabase {
+	builtIn := file.DefaultClassifierDatabase()
+	if dbPath == "" {
+		return builtIn
+	}
+
+	contents, err := ioutil.ReadFile(dbPath)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			log.Warnf("unable to read classifier database=%q (using built-in classifiers): %+v", dbPath, err)
+		}
+		return builtIn
+	}
+
+	db, err := file.ParseClassifierDatabase(bytes.NewReader(contents))
+	if err != nil {
+		log.Warnf("unable to parse classifier database=%q (using built-in classifiers): %+v", dbPath, err)
+		return builtIn
+	}
+
+	if db.Version <= builtIn.Version {
+		log.Debugf("classifier database=%q (version=%d) is not newer than the built-in classifiers (version=%d)", dbPath, db.Version, builtIn.Version)
+		return builtIn
+	}
+
+	log.Debugf("using classifier database=%q (version=%d)", dbPath, db.Version)
+	return db
+}
+
+// Update reads the classifier database from the given source (a local file or an http(s) URL), and once verified and
+// validated, writes it to the given path to be used by subsequent scans. The database must match the given sha256
+// digest; without one, a database fetched from a URL must match the digest published alongside it (at the URL with a
+// ".sha256" suffix, in sha256sum format), while a local file is used as given. Databases older than the built-in
+// classifiers are rejected.
+func Update(source, digest, dbPath string) (*file.ClassifierDatabase, error) {
+	contents, err := read(source)
+	if err != nil {
+		return nil, err
+	}
+
+	if digest == "" && isURL(source) {
+		published, err := read(source + ".sha256")
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch the published digest of the classifier database (configure the expected digest instead): %w", err)
+		}
+		fields := strings.Fields(string(published))
+		if len(fields) == 0 {
+			return nil, fmt.Errorf("empty published digest for classifier database from %q", source)
+		}
+		digest = fields[0]
+	}
+
+	if digest != "" {
+		if err := verifyDigest(contents, digest); err != nil {
+			return nil, fmt.Errorf("classifier database from %q failed verification: %w", source, err)
+		}
+	}
+
+	db, err := file.ParseClassifierDatabase(bytes.NewReader(contents))
+	if err != nil {
+		return nil, fmt.Errorf("invalid classifier database from %q: %w", source, err)
+	}
+
+	if builtIn := file.DefaultClassifierDatabase(); db.Version < builtIn.Version {
+		return nil, fmt.Errorf("classifier database from %q (version=%d) is older than the built-in classifiers (version=%d)", source, db.Version, builtIn.Version)
+	}
+
+	if err := write(dbPath, contents); err != nil {
+		return nil, fmt.Errorf("unable to write classifier database: %w", err)
+	}
+
+	return db, nil
+}
+
+// verifyDigest checks that the given contents match the given sha256 digest (hex encoded, optionally prefixed with
+// "sha256:").
+func verifyDigest(contents []byte, digest string) error {
+	expected := strings.ToLower(strings.TrimPrefix(digest, "sha256:"))
+	if len(expected) != sha256.Size*2 {
+		return fmt.Errorf("invalid sha256 digest %q", digest)
+	}
+	sum := sha256.Sum256(contents)
+	if actual := hex.EncodeToString(sum[:]); actual != expected {
+		return fmt.Errorf("digest mismatch: expected sha256:%s, got sha256:%s", expected, actual)
+	}
+	return nil
+}
+
+// write replaces the database at the given path through a temporary file, so that a concurrent scan never reads a
+// partially written database (and concurrent updates never write to the same temporary file).
+func write(dbPath string, contents []byte) error {
+	dir := filepath.Dir(dbPath)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	tempFile, err := ioutil.TempFile(dir, filepath.Base(dbPath)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tempFile.Name())
+
+	if _, err := tempFile.Write(contents); err != nil {
+		tempFile.Close()
+		return err
+	}
+	if err := tempFile.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tempFile.Name(), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tempFile.Name(), dbPath)
+}
+
+func isURL(source string) bool {
+	return strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://")
+}
+
+func read(source string) ([]byte, error) {
+	if !isURL(source) {
+		contents, err := ioutil.ReadFile(source)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read classifier database: %w", err)
+		}
+		return contents, nil
+	}
+
+	client := http.Client{Timeout: fetchTimeout}
+	resp, err := client.Get(source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch classifier database: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("HTTP %d on fetching classifier database: %s", resp.StatusCode, resp.Status)
+	}
+
+	contents, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read classifier database: %w", err)
+	}
+	return contents, nil
+}
@@ -0,0 +1,158 @@
+package classifiers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func database(version int) string {
+	return fmt.Sprintf(`{
+  "schemaVersion": %d,
+  "version": %d,
+  "classifiers": [
+    {
+      "class": "example-binary",
+      "filepathPatterns": ["(.*/|^)example$"],
+      "evidencePatternTemplates": ["(?m)example-(?P<version>[0-9]+\\.[0-9]+)"]
+    }
+  ]
+}`, file.ClassifierDatabaseSchemaVersion, version)
+}
+
+func writeFile(t *testing.T, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "source.json")
+	require.NoError(t, ioutil.WriteFile(path, []byte(contents), 0644))
+	return path
+}
+
+func TestUpdateAndLoad(t *testing.T) {
+	builtIn := file.DefaultClassifierDatabase()
+	dbPath := filepath.Join(t.TempDir(), "syft", "classifiers.json")
+
+	// without an update the built-in classifiers are used
+	assert.Equal(t, builtIn.Classifiers, Load(dbPath))
+
+	db, err := Update(writeFile(t, database(builtIn.Version+1)), "", dbPath)
+	require.NoError(t, err)
+	assert.Equal(t, builtIn.Version+1, db.Version)
+
+	classifiers := Load(dbPath)
+	require.Len(t, classifiers, 1)
+	assert.Equal(t, "example-binary", classifiers[0].Class)
+}
+
+func TestUpdate_FromURL(t *testing.T) {
+	contents := database(file.DefaultClassifierDatabase().Version + 1)
+	sum := sha256.Sum256([]byte(contents))
+	digest := hex.EncodeToString(sum[:])
+
+	tests := []struct {
+		name        string
+		published   string
+		digest      string
+		expectedErr bool
+	}{
+		{
+			name:      "verified against the published digest",
+			published: digest + "  classifiers.json\n",
+		},
+		{
+			name:   "verified against the given digest",
+			digest: "sha256:" + digest,
+		},
+		{
+			name:        "published digest mismatch",
+			published:   strings.Repeat("0", 64) + "  classifiers.json\n",
+			expectedErr: true,
+		},
+		{
+			name:        "given digest mismatch",
+			published:   digest + "  classifiers.json\n",
+			digest:      strings.Repeat("0", 64),
+			expectedErr: true,
+		},
+		{
+			name:        "no published digest",
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/classifiers.json":
+					fmt.Fprint(w, contents)
+				case "/classifiers.json.sha256":
+					if test.published == "" {
+						http.NotFound(w, r)
+						return
+					}
+					fmt.Fprint(w, test.published)
+				default:
+					http.NotFound(w, r)
+				}
+			}))
+			defer server.Close()
+
+			dbPath := filepath.Join(t.TempDir(), "classifiers.json")
+			db, err := Update(server.URL+"/classifiers.json", test.digest, dbPath)
+			if test.expectedErr {
+				assert.Error(t, err)
+				assert.NoFileExists(t, dbPath)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, file.DefaultClassifierDatabase().Version+1, db.Version)
+			assert.FileExists(t, dbPath)
+		})
+	}
+}
+
+func TestUpdate_Rejected(t *testing.T) {
+	builtIn := file.DefaultClassifierDatabase()
+	tests := []struct {
+		name     string
+		contents string
+	}{
+		{
+			name:     "older than the built-in classifiers",
+			contents: database(builtIn.Version - 1),
+		},
+		{
+			name:     "unsupported schema version",
+			contents: fmt.Sprintf(`{"schemaVersion": %d, "version": %d, "classifiers": []}`, file.ClassifierDatabaseSchemaVersion+1, builtIn.Version+1),
+		},
+		{
+			name:     "invalid pattern",
+			contents: fmt.Sprintf(`{"schemaVersion": %d, "version": %d, "classifiers": [{"class": "bad", "filepathPatterns": ["("]}]}`, file.ClassifierDatabaseSchemaVersion, builtIn.Version+1),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dbPath := filepath.Join(t.TempDir(), "classifiers.json")
+			_, err := Update(writeFile(t, test.contents), "", dbPath)
+			assert.Error(t, err)
+			assert.NoFileExists(t, dbPath)
+		})
+	}
+}
+
+func TestLoad_IgnoresStaleDatabase(t *testing.T) {
+	builtIn := file.DefaultClassifierDatabase()
+	assert.Equal(t, builtIn.Classifiers, Load(writeFile(t, database(builtIn.Version))))
+	assert.Equal(t, builtIn.Classifiers, Load(writeFile(t, "not a database")))
+}
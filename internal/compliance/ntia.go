@@ -0,0 +1,65 @@
+package compliance
+
+import (
+	"github.com/anchore/syft/internal/formats/common/spdxhelpers"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+)
+
+// ntia checks the given SBOM against the NTIA minimum elements (see
+// https://www.ntia.doc.gov/files/ntia/publications/sbom_minimum_elements_report.pdf). The supplier, version, and
+// identifiers are required for every package, where the author and timestamp are required for the document itself.
+func ntia(s sbom.SBOM) *Report {
+	report := Report{
+		Standard: NTIAStandard,
+	}
+
+	report.Total++
+	if s.Descriptor.Timestamp.IsZero() {
+		report.DocumentMissing = append(report.DocumentMissing, TimestampElement)
+	} else {
+		report.Present++
+	}
+
+	// the author may be a person or an organization (the tool that generated the document is not an author)
+	report.Total++
+	if s.Descriptor.Author == "" && s.Descriptor.Organization == "" {
+		report.DocumentMissing = append(report.DocumentMissing, AuthorElement)
+	} else {
+		report.Present++
+	}
+
+	if s.Artifacts.PackageCatalog == nil {
+		return &report
+	}
+
+	for _, p := range s.Artifacts.PackageCatalog.Sorted() {
+		missing := ntiaMissingPackageElements(p)
+		report.Total += 3
+		report.Present += 3 - len(missing)
+		if len(missing) > 0 {
+			report.Packages = append(report.Packages, PackageResult{
+				Package: p,
+				Missing: missing,
+			})
+		}
+	}
+
+	return &report
+}
+
+func ntiaMissingPackageElements(p pkg.Package) (missing []Element) {
+	if spdxhelpers.Originator(p) == "" {
+		missing = append(missing, SupplierElement)
+	}
+
+	if p.Version == "" {
+		missing = append(missing, VersionElement)
+	}
+
+	if p.PURL == "" && len(p.CPEs) == 0 {
+		missing = append(missing, IdentifierElement)
+	}
+
+	return missing
+}
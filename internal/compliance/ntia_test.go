@@ -0,0 +1,122 @@
+package compliance
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNTIA(t *testing.T) {
+	timestamp := time.Date(2021, 7, 12, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		descriptor      sbom.Descriptor
+		packages        []pkg.Package
+		expectedPresent int
+		expectedTotal   int
+		expectedDoc     []Element
+		expectedMissing map[string][]Element
+	}{
+		{
+			name:            "no packages",
+			descriptor:      sbom.Descriptor{Name: "syft", Organization: "Anchore, Inc", Timestamp: timestamp},
+			expectedPresent: 2,
+			expectedTotal:   2,
+			expectedMissing: map[string][]Element{},
+		},
+		{
+			name:            "person author",
+			descriptor:      sbom.Descriptor{Name: "syft", Author: "Jane Doe", Timestamp: timestamp},
+			expectedPresent: 2,
+			expectedTotal:   2,
+			expectedMissing: map[string][]Element{},
+		},
+		{
+			name:            "the tool is not an author",
+			descriptor:      sbom.Descriptor{Name: "syft", Timestamp: timestamp},
+			expectedPresent: 1,
+			expectedTotal:   2,
+			expectedDoc:     []Element{AuthorElement},
+			expectedMissing: map[string][]Element{},
+		},
+		{
+			name:            "missing timestamp and author",
+			expectedPresent: 0,
+			expectedTotal:   2,
+			expectedDoc:     []Element{TimestampElement, AuthorElement},
+			expectedMissing: map[string][]Element{},
+		},
+		{
+			name:       "mixed packages",
+			descriptor: sbom.Descriptor{Name: "syft", Organization: "Anchore, Inc", Timestamp: timestamp},
+			packages: []pkg.Package{
+				{
+					Name:         "complete",
+					Version:      "1.0.0",
+					PURL:         "pkg:deb/debian/complete@1.0.0",
+					MetadataType: pkg.DpkgMetadataType,
+					Metadata: pkg.DpkgMetadata{
+						Package:    "complete",
+						Maintainer: "someone <someone@example.com>",
+					},
+				},
+				{
+					Name: "bare",
+					Type: pkg.GemPkg,
+				},
+				{
+					Name:    "no-supplier",
+					Version: "2.0.0",
+					CPEs: []pkg.CPE{
+						pkg.MustCPE("cpe:2.3:*:no-supplier:no-supplier:2.0.0:*:*:*:*:*:*:*"),
+					},
+				},
+			},
+			expectedPresent: 2 + 3 + 0 + 2,
+			expectedTotal:   2 + 9,
+			expectedMissing: map[string][]Element{
+				"bare":        {SupplierElement, VersionElement, IdentifierElement},
+				"no-supplier": {SupplierElement},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := sbom.SBOM{
+				Artifacts: sbom.Artifacts{
+					PackageCatalog: pkg.NewCatalog(test.packages...),
+				},
+				Descriptor: test.descriptor,
+			}
+
+			report, err := Check(NTIAStandard, s)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expectedPresent, report.Present)
+			assert.Equal(t, test.expectedTotal, report.Total)
+			assert.Equal(t, test.expectedDoc, report.DocumentMissing)
+
+			actual := make(map[string][]Element)
+			for _, result := range report.Packages {
+				actual[result.Package.Name] = result.Missing
+			}
+			assert.Equal(t, test.expectedMissing, actual)
+
+			var buf bytes.Buffer
+			assert.NoError(t, report.Write(&buf))
+			assert.Contains(t, buf.String(), "NTIA compliance")
+		})
+	}
+}
+
+func TestCheck_UnknownStandard(t *testing.T) {
+	_, err := Check(ParseStandard("bogus"), sbom.SBOM{})
+	assert.Error(t, err)
+}
@@ -0,0 +1,130 @@
+package compliance
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/olekukonko/tablewriter"
+)
+
+// Element is a single data field that a compliance standard requires to be present.
+type Element string
+
+const (
+	SupplierElement   Element = "supplier"
+	VersionElement    Element = "version"
+	IdentifierElement Element = "identifiers"
+	TimestampElement  Element = "timestamp"
+	AuthorElement     Element = "author"
+)
+
+// PackageResult describes the elements that are missing for a single package.
+type PackageResult struct {
+	Package pkg.Package
+	Missing []Element
+}
+
+// Report is the result of checking an SBOM against a compliance standard.
+type Report struct {
+	Standard        Standard
+	Present         int             // the number of required elements found across the document and all packages
+	Total           int             // the number of required elements across the document and all packages
+	DocumentMissing []Element       // document-level elements that are missing
+	Packages        []PackageResult // only packages that are missing one or more elements
+}
+
+// Check scores the given SBOM against the given compliance standard.
+func Check(standard Standard, s sbom.SBOM) (*Report, error) {
+	switch standard {
+	case NTIAStandard:
+		return ntia(s), nil
+	}
+	return nil, fmt.Errorf("unsupported compliance standard: %q", standard)
+}
+
+// Score returns the percentage of required elements present (0-100).
+func (r Report) Score() float64 {
+	if r.Total == 0 {
+		return 100
+	}
+	return float64(r.Present) / float64(r.Total) * 100
+}
+
+// Passed indicates if every required element is present across the document and all packages.
+func (r Report) Passed() bool {
+	return len(r.DocumentMissing) == 0 && len(r.Packages) == 0
+}
+
+// Write a human-readable summary of the report to the given writer.
+func (r Report) Write(output io.Writer) error {
+	if _, err := fmt.Fprintf(output, "%s compliance: %.1f%% (%d/%d required elements present)\n", strings.ToUpper(r.Standard.String()), r.Score(), r.Present, r.Total); err != nil {
+		return err
+	}
+
+	if len(r.DocumentMissing) > 0 {
+		if _, err := fmt.Fprintf(output, "document is missing: %s\n", joinElements(r.DocumentMissing)); err != nil {
+			return err
+		}
+	}
+
+	if len(r.Packages) == 0 {
+		_, err := fmt.Fprintln(output, "all packages have the required elements")
+		return err
+	}
+
+	var rows [][]string
+	for _, result := range r.Packages {
+		rows = append(rows, []string{
+			result.Package.Name,
+			result.Package.Version,
+			string(result.Package.Type),
+			joinElements(result.Missing),
+		})
+	}
+
+	// sort by name, version, then type
+	sort.SliceStable(rows, func(i, j int) bool {
+		for col := 0; col < 3; col++ {
+			if rows[i][col] != rows[j][col] {
+				return rows[i][col] < rows[j][col]
+			}
+		}
+		return false
+	})
+
+	if _, err := fmt.Fprintln(output); err != nil {
+		return err
+	}
+
+	table := tablewriter.NewWriter(output)
+
+	table.SetHeader([]string{"Name", "Version", "Type", "Missing"})
+	table.SetHeaderLine(false)
+	table.SetBorder(false)
+	table.SetAutoWrapText(false)
+	table.SetAutoFormatHeaders(true)
+	table.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.SetCenterSeparator("")
+	table.SetColumnSeparator("")
+	table.SetRowSeparator("")
+	table.SetTablePadding("  ")
+	table.SetNoWhiteSpace(true)
+
+	table.AppendBulk(rows)
+	table.Render()
+
+	return nil
+}
+
+func joinElements(elements []Element) string {
+	var names []string
+	for _, e := range elements {
+		names = append(names, string(e))
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,35 @@
+/*
+Package compliance provides the ability to score a generated SBOM against a set of minimum data field requirements
+(such as the NTIA minimum elements) and to describe which packages do not meet those requirements.
+*/
+package compliance
+
+import "strings"
+
+// Standard indicates which set of minimum elements an SBOM should be checked against.
+type Standard string
+
+const (
+	// UnknownStandard is the default standard
+	UnknownStandard Standard = "UnknownStandard"
+	// NTIAStandard checks against the NTIA "minimum elements for a software bill of materials" (July 2021)
+	NTIAStandard Standard = "ntia"
+)
+
+// AllStandards is a slice containing all possible compliance standards
+var AllStandards = []Standard{
+	NTIAStandard,
+}
+
+// ParseStandard returns a compliance standard as indicated from the given string.
+func ParseStandard(userStr string) Standard {
+	switch strings.ToLower(userStr) {
+	case string(NTIAStandard), "ntia-minimum-elements":
+		return NTIAStandard
+	}
+	return UnknownStandard
+}
+
+func (s Standard) String() string {
+	return string(s)
+}
@@ -2,6 +2,12 @@ package config
 
 import "github.com/spf13/viper"
 
+type anchoreTLS struct {
+	CACert     string `yaml:"ca-cert" json:"ca-cert" mapstructure:"ca-cert"`             // PEM encoded CA bundle used to verify the server
+	ClientCert string `yaml:"client-cert" json:"client-cert" mapstructure:"client-cert"` // PEM encoded client certificate for mutual TLS
+	ClientKey  string `yaml:"client-key" json:"client-key" mapstructure:"client-key"`    // PEM encoded private key for the client certificate
+}
+
 type anchore struct {
 	// upload options
 	Host string `yaml:"host" json:"host" mapstructure:"host"` // -H , hostname of the engine/enterprise instance to upload to (setting this value enables upload)
@@ -14,8 +20,17 @@ type anchore struct {
 	OverwriteExistingImage bool   `yaml:"overwrite-existing-image" json:"overwrite-existing-image" mapstructure:"overwrite-existing-image"` // --overwrite-existing-image , if any of the SBOM components have already been uploaded this flag will ensure they are overwritten with the current upload
 	ImportTimeout          uint   `yaml:"import-timeout" json:"import-timeout" mapstructure:"import-timeout"`                               // --import-timeout
 	// , customize the number of seconds within which the SBOM import must be completed or canceled
+	ImportRetries uint       `yaml:"import-retries" json:"import-retries" mapstructure:"import-retries"` // total attempts for each upload request that was not processed by the server (imports are not idempotent)
+	ImportDryRun  bool       `yaml:"import-dry-run" json:"import-dry-run" mapstructure:"import-dry-run"` // --import-dry-run , validate the upload payloads without sending them
+	ImportChunked bool       `yaml:"import-chunked" json:"import-chunked" mapstructure:"import-chunked"` // stream the package SBOM upload with chunked transfer encoding (for huge SBOMs)
+	TLS           anchoreTLS `yaml:"tls" json:"tls" mapstructure:"tls"`
 }
 
 func (cfg anchore) loadDefaultValues(v *viper.Viper) {
 	v.SetDefault("anchore.path", "")
+	v.SetDefault("anchore.import-retries", 3)
+	v.SetDefault("anchore.import-chunked", false)
+	v.SetDefault("anchore.tls.ca-cert", "")
+	v.SetDefault("anchore.tls.client-cert", "")
+	v.SetDefault("anchore.tls.client-key", "")
 }
@@ -9,7 +9,11 @@ import (
 
 	"github.com/adrg/xdg"
 	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/compliance"
+	"github.com/anchore/syft/internal/formats/template"
+	"github.com/anchore/syft/syft/format"
 	"github.com/mitchellh/go-homedir"
+	"github.com/mitchellh/mapstructure"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
 	"gopkg.in/yaml.v2"
@@ -29,22 +33,44 @@ type parser interface {
 
 // Application is the main syft application configuration.
 type Application struct {
-	ConfigPath         string             `yaml:",omitempty" json:"configPath"`                                                         // the location where the application config was read from (either from -c or discovered while loading)
-	Output             []string           `yaml:"output" json:"output" mapstructure:"output"`                                           // -o, the format to use for output
-	File               string             `yaml:"file" json:"file" mapstructure:"file"`                                                 // --file, the file to write report output to
-	Quiet              bool               `yaml:"quiet" json:"quiet" mapstructure:"quiet"`                                              // -q, indicates to not show any status output to stderr (ETUI or logging UI)
-	CheckForAppUpdate  bool               `yaml:"check-for-app-update" json:"check-for-app-update" mapstructure:"check-for-app-update"` // whether to check for an application update on start up or not
-	Anchore            anchore            `yaml:"anchore" json:"anchore" mapstructure:"anchore"`                                        // options for interacting with Anchore Engine/Enterprise
-	CliOptions         CliOnlyOptions     `yaml:"-" json:"-"`                                                                           // all options only available through the CLI (not via env vars or config)
-	Dev                development        `yaml:"dev" json:"dev" mapstructure:"dev"`
-	Log                logging            `yaml:"log" json:"log" mapstructure:"log"` // all logging-related options
-	Package            pkg                `yaml:"package" json:"package" mapstructure:"package"`
-	FileMetadata       FileMetadata       `yaml:"file-metadata" json:"file-metadata" mapstructure:"file-metadata"`
-	FileClassification fileClassification `yaml:"file-classification" json:"file-classification" mapstructure:"file-classification"`
-	FileContents       fileContents       `yaml:"file-contents" json:"file-contents" mapstructure:"file-contents"`
-	Secrets            secrets            `yaml:"secrets" json:"secrets" mapstructure:"secrets"`
-	Registry           registry           `yaml:"registry" json:"registry" mapstructure:"registry"`
-	Exclusions         []string           `yaml:"exclude" json:"exclude" mapstructure:"exclude"`
+	ConfigPath         string              `yaml:",omitempty" json:"configPath"`                                                         // the location where the application config was read from (either from -c or discovered while loading)
+	Output             output              `yaml:"output" json:"output" mapstructure:"output"`                                           // options for presenting the SBOM (formats, destination and template)
+	CSV                csvConfig           `yaml:"csv" json:"csv" mapstructure:"csv"`                                                    // options for the "csv" output format
+	Table              tableConfig         `yaml:"table" json:"table" mapstructure:"table"`                                              // options for the "table" output format
+	Quiet              bool                `yaml:"quiet" json:"quiet" mapstructure:"quiet"`                                              // -q, indicates to not show any status output to stderr (ETUI or logging UI)
+	NoProgress         bool                `yaml:"no-progress" json:"no-progress" mapstructure:"no-progress"`                            // --no-progress, show plain log output instead of the interactive progress display (ETUI)
+	Events             string              `yaml:"events" json:"events" mapstructure:"events"`                                           // --events, stream cataloging events as NDJSON to a file (or "-" for stderr)
+	CheckForAppUpdate  bool                `yaml:"check-for-app-update" json:"check-for-app-update" mapstructure:"check-for-app-update"` // whether to check for an application update on start up or not
+	Offline            bool                `yaml:"offline" json:"offline" mapstructure:"offline"`                                        // --offline, disable every operation that requires network access
+	Anchore            anchore             `yaml:"anchore" json:"anchore" mapstructure:"anchore"`                                        // options for interacting with Anchore Engine/Enterprise
+	CliOptions         CliOnlyOptions      `yaml:"-" json:"-"`                                                                           // all options only available through the CLI (not via env vars or config)
+	Dev                development         `yaml:"dev" json:"dev" mapstructure:"dev"`
+	Log                logging             `yaml:"log" json:"log" mapstructure:"log"` // all logging-related options
+	Package            pkg                 `yaml:"package" json:"package" mapstructure:"package"`
+	FileMetadata       FileMetadata        `yaml:"file-metadata" json:"file-metadata" mapstructure:"file-metadata"`
+	FileClassification fileClassification  `yaml:"file-classification" json:"file-classification" mapstructure:"file-classification"`
+	FileContents       fileContents        `yaml:"file-contents" json:"file-contents" mapstructure:"file-contents"`
+	BinaryLinks        binaryLinks         `yaml:"binary-links" json:"binary-links" mapstructure:"binary-links"`
+	Executables        executables         `yaml:"executables" json:"executables" mapstructure:"executables"`
+	Secrets            secrets             `yaml:"secrets" json:"secrets" mapstructure:"secrets"`
+	Registry           registry            `yaml:"registry" json:"registry" mapstructure:"registry"`
+	Document           document            `yaml:"document" json:"document" mapstructure:"document"` // options describing the creators of the SBOM document
+	Exclusions         []string            `yaml:"exclude" json:"exclude" mapstructure:"exclude"`
+	Directory          directory           `yaml:"directory" json:"directory" mapstructure:"directory"`    // options for traversing directory sources
+	Scratch            scratchConfig       `yaml:"scratch" json:"scratch" mapstructure:"scratch"`          // where (and how much) image layers and archives may be extracted while cataloging
+	SSH                ssh                 `yaml:"ssh" json:"ssh" mapstructure:"ssh"`                      // options for scanning remote directories over SSH (ssh://user@host/path)
+	Attest             attest              `yaml:"attest" json:"attest" mapstructure:"attest"`             // options for signing SBOM attestations (attest subcommand)
+	Publish            publishConfig       `yaml:"publish" json:"publish" mapstructure:"publish"`          // options for publishing SBOMs to message brokers (kafka, NATS)
+	Verify             verifyConfig        `yaml:"verify" json:"verify" mapstructure:"verify"`             // options for verifying image signatures before cataloging
+	Enrichment         enrichmentConfig    `yaml:"enrichment" json:"enrichment" mapstructure:"enrichment"` // options for backfilling package details from package registries (--enrich)
+	EOL                eolConfig           `yaml:"eol" json:"eol" mapstructure:"eol"`                      // options for annotating runtimes and the distro with their end-of-life status (--eol)
+	Cache              resultCache         `yaml:"cache" json:"cache" mapstructure:"cache"`                // options for reusing the SBOMs of previously cataloged images (--cache)
+	Batch              batchConfig         `yaml:"batch" json:"batch" mapstructure:"batch"`                // options for cataloging many targets at once (batch subcommand)
+	Serve              serveConfig         `yaml:"serve" json:"serve" mapstructure:"serve"`                // options for the HTTP API server (serve subcommand)
+	Tracing            tracing             `yaml:"tracing" json:"tracing" mapstructure:"tracing"`          // options for exporting OpenTelemetry traces
+	Compliance         string              `yaml:"compliance" json:"compliance" mapstructure:"compliance"` // --compliance, the standard to score the SBOM against (e.g. "ntia")
+	ComplianceOpt      compliance.Standard `yaml:"-" json:"-"`
+	ExitCode           exitCode            `yaml:"exit-code" json:"exit-code" mapstructure:"exit-code"` // the process exit codes for scans without packages, with cataloger errors, or failing the compliance check
 }
 
 // PowerUserCatalogerEnabledDefault switches all catalogers to be enabled when running power-user command
@@ -65,11 +91,23 @@ func LoadApplicationConfig(v *viper.Viper, cliOpts CliOnlyOptions) (*Application
 	// the user may not have a config, and this is OK, we can use the default config + default cobra cli values instead
 	config := newApplicationConfig(v, cliOpts)
 
-	if err := readConfig(v, cliOpts.ConfigPath); err != nil && !errors.Is(err, ErrApplicationConfigNotFound) {
+	err := readConfig(v, cliOpts.ConfigPath)
+	switch {
+	case err == nil:
+		if err := migrateOutputConfig(v); err != nil {
+			return nil, fmt.Errorf("unable to read output options of config=%q: %w", v.ConfigFileUsed(), err)
+		}
+	case !errors.Is(err, ErrApplicationConfigNotFound):
 		return nil, err
 	}
 
-	if err := v.Unmarshal(config); err != nil {
+	// options given as environment variables are strings, which may need to be split into lists and maps
+	decodeHook := mapstructure.ComposeDecodeHookFunc(
+		mapstructure.StringToTimeDurationHookFunc(),
+		mapstructure.StringToSliceHookFunc(","),
+		stringToMapHookFunc(),
+	)
+	if err := v.Unmarshal(config, viper.DecodeHook(decodeHook)); err != nil {
 		return nil, fmt.Errorf("unable to parse config: %w", err)
 	}
 	config.ConfigPath = v.ConfigFileUsed()
@@ -102,6 +140,9 @@ func (cfg *Application) parseConfigValues() error {
 	for _, optionFn := range []func() error{
 		cfg.parseUploadOptions,
 		cfg.parseLogLevelOption,
+		cfg.parseComplianceOption,
+		cfg.parseOfflineOption,
+		cfg.parseTemplateOption,
 	} {
 		if err := optionFn(); err != nil {
 			return err
@@ -131,6 +172,75 @@ func (cfg *Application) parseUploadOptions() error {
 	return nil
 }
 
+func (cfg *Application) parseComplianceOption() error {
+	if cfg.Compliance == "" {
+		return nil
+	}
+
+	standard := compliance.ParseStandard(cfg.Compliance)
+	if standard == compliance.UnknownStandard {
+		return fmt.Errorf("bad compliance value %q, options=%v", cfg.Compliance, compliance.AllStandards)
+	}
+	cfg.ComplianceOpt = standard
+
+	return nil
+}
+
+// parseTemplateOption checks the output template up front when the "template" output format is requested, so that a
+// missing or malformed template is reported before cataloging.
+func (cfg *Application) parseTemplateOption() error {
+	outputs := append([]string{}, cfg.Output.Format...)
+	outputs = append(outputs, cfg.Batch.Output...)
+	if cfg.Publish.Enabled() {
+		outputs = append(outputs, cfg.Publish.Format)
+	}
+
+	for _, o := range outputs {
+		// outputs may be given as <format>=<file>
+		name := strings.SplitN(strings.TrimSpace(o), "=", 2)[0]
+		if format.ParseOption(name) != format.TemplateOption {
+			continue
+		}
+		if _, err := template.Parse(cfg.Output.TemplatePath); err != nil {
+			return fmt.Errorf("bad output template: %w", err)
+		}
+		return nil
+	}
+	return nil
+}
+
+// parseOfflineOption turns off the options that implicitly reach out to the network and rejects the options that
+// were explicitly requested but cannot work without network access.
+func (cfg *Application) parseOfflineOption() error {
+	if !cfg.Offline {
+		return nil
+	}
+
+	cfg.CheckForAppUpdate = false
+
+	var networked []string
+	if cfg.Anchore.Host != "" && !cfg.Anchore.ImportDryRun {
+		networked = append(networked, "uploading to Anchore Enterprise (anchore.host)")
+	}
+	if cfg.Publish.Enabled() {
+		networked = append(networked, "publishing to message brokers (publish.kafka.brokers, publish.nats.url)")
+	}
+	if cfg.Verify.Enabled() {
+		networked = append(networked, "verifying image signatures (verify.keys)")
+	}
+	if cfg.Tracing.Enabled {
+		networked = append(networked, "exporting traces (tracing.enabled)")
+	}
+	if cfg.Enrichment.Enabled {
+		networked = append(networked, "enriching packages from package registries (enrichment.enabled)")
+	}
+
+	if len(networked) > 0 {
+		return fmt.Errorf("cannot run in offline mode, the following options require network access: %s", strings.Join(networked, ", "))
+	}
+	return nil
+}
+
 func (cfg *Application) parseLogLevelOption() error {
 	switch {
 	case cfg.Quiet:
@@ -188,9 +298,14 @@ func readConfig(v *viper.Viper, configPath string) error {
 	var err error
 	v.AutomaticEnv()
 	v.SetEnvPrefix(internal.ApplicationName)
-	// allow for nested options to be specified via environment variables
-	// e.g. pod.context = APPNAME_POD_CONTEXT
-	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_", "-", "_"))
+	v.SetEnvKeyReplacer(envKeyReplacer)
+	// options without a default value are only read from the environment when explicitly bound
+	if err := bindEnvVars(v); err != nil {
+		return err
+	}
+	if err := migrateOutputEnvVars(); err != nil {
+		return err
+	}
 
 	// use explicitly the given user config
 	if configPath != "" {
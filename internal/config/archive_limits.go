@@ -0,0 +1,36 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/anchore/syft/syft/pkg/cataloger"
+	"github.com/spf13/viper"
+)
+
+type archiveLimits struct {
+	MaxNestingDepth     int   `yaml:"max-nesting-depth" json:"max-nesting-depth" mapstructure:"max-nesting-depth"`
+	MaxDecompressedSize int64 `yaml:"max-decompressed-size" json:"max-decompressed-size" mapstructure:"max-decompressed-size"`
+	MaxFileSize         int64 `yaml:"max-file-size" json:"max-file-size" mapstructure:"max-file-size"`
+}
+
+func (cfg archiveLimits) loadDefaultValues(v *viper.Viper) {
+	c := cataloger.DefaultArchiveLimits()
+	v.SetDefault("package.archive-limits.max-nesting-depth", c.MaxNestingDepth)
+	v.SetDefault("package.archive-limits.max-decompressed-size", c.MaxDecompressedSize)
+	v.SetDefault("package.archive-limits.max-file-size", c.MaxFileSize)
+}
+
+func (cfg *archiveLimits) parseConfigValues() error {
+	if cfg.MaxNestingDepth < 0 || cfg.MaxDecompressedSize < 0 || cfg.MaxFileSize < 0 {
+		return fmt.Errorf("archive limits must not be negative: %+v", *cfg)
+	}
+	return nil
+}
+
+func (cfg archiveLimits) ToConfig() cataloger.ArchiveLimits {
+	return cataloger.ArchiveLimits{
+		MaxNestingDepth:     cfg.MaxNestingDepth,
+		MaxDecompressedSize: cfg.MaxDecompressedSize,
+		MaxFileSize:         cfg.MaxFileSize,
+	}
+}
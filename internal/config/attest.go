@@ -0,0 +1,25 @@
+package config
+
+import (
+	"os"
+
+	"github.com/spf13/viper"
+)
+
+type attest struct {
+	Key      string `yaml:"key" json:"key" mapstructure:"key"`  // --key , path to the private key (or the KMS key reference) used to sign the attestation
+	Password string `yaml:"-" json:"-" mapstructure:"password"` // password for the (cosign encrypted) private key
+}
+
+func (cfg attest) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("attest.key", "cosign.key")
+	v.SetDefault("attest.password", "")
+}
+
+func (cfg *attest) parseConfigValues() error {
+	if cfg.Password == "" {
+		// allow for the same password environment variable that cosign uses
+		cfg.Password = os.Getenv("COSIGN_PASSWORD")
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/anchore/syft/internal/batch"
+	"github.com/anchore/syft/syft/format"
+	"github.com/spf13/viper"
+)
+
+type batchRegistry struct {
+	Include []string `yaml:"include" json:"include" mapstructure:"include"` // --registry-include, globs of "<repository>:<tag>" to catalog (default is all images)
+	Exclude []string `yaml:"exclude" json:"exclude" mapstructure:"exclude"` // --registry-exclude, globs of "<repository>:<tag>" to skip
+}
+
+type batchConfig struct {
+	Parallelism    int             `yaml:"parallelism" json:"parallelism" mapstructure:"parallelism"`             // --parallelism, the max number of targets to catalog at once
+	Output         []string        `yaml:"output" json:"output" mapstructure:"output"`                            // -o, the format(s) to write for every target
+	OutputOpts     []format.Option `yaml:"-" json:"-"`                                                            // the parsed output formats
+	OutputTemplate string          `yaml:"output-template" json:"output-template" mapstructure:"output-template"` // --output-template, the go template for the path of each output file
+	Summary        string          `yaml:"summary" json:"summary" mapstructure:"summary"`                         // --summary, the file to write the batch summary to (default is STDOUT)
+	Registry       batchRegistry   `yaml:"registry" json:"registry" mapstructure:"registry"`                      // filters for the images found when walking a registry (--registry)
+}
+
+func (cfg batchConfig) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("batch.parallelism", 4)
+	v.SetDefault("batch.output", []string{string(format.JSONOption)})
+	v.SetDefault("batch.output-template", batch.DefaultOutputTemplate)
+	v.SetDefault("batch.summary", "")
+	v.SetDefault("batch.registry.include", []string{})
+	v.SetDefault("batch.registry.exclude", []string{})
+}
+
+func (cfg *batchConfig) parseConfigValues() error {
+	if cfg.Parallelism < 1 {
+		return fmt.Errorf("batch parallelism must be at least 1 (got %d)", cfg.Parallelism)
+	}
+
+	cfg.OutputOpts = nil
+	for _, o := range cfg.Output {
+		option := format.ParseOption(o)
+		if option == format.UnknownFormatOption {
+			return fmt.Errorf("bad batch output format: %q", o)
+		}
+		cfg.OutputOpts = append(cfg.OutputOpts, option)
+	}
+
+	return cfg.RegistryFilter().Validate()
+}
+
+// RegistryFilter returns the filter to apply to the images found when walking a registry.
+func (cfg batchConfig) RegistryFilter() batch.RegistryFilter {
+	return batch.RegistryFilter{
+		Include: cfg.Registry.Include,
+		Exclude: cfg.Registry.Exclude,
+	}
+}
@@ -0,0 +1,19 @@
+package config
+
+import (
+	"github.com/anchore/syft/syft/source"
+	"github.com/spf13/viper"
+)
+
+type binaryLinks struct {
+	Cataloger catalogerOptions `yaml:"cataloger" json:"cataloger" mapstructure:"cataloger"`
+}
+
+func (cfg binaryLinks) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("binary-links.cataloger.enabled", catalogerEnabledDefault)
+	v.SetDefault("binary-links.cataloger.scope", source.SquashedScope)
+}
+
+func (cfg *binaryLinks) parseConfigValues() error {
+	return cfg.Cataloger.parseConfigValues()
+}
@@ -0,0 +1,36 @@
+package config
+
+import (
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/adrg/xdg"
+	"github.com/anchore/syft/internal"
+	"github.com/mitchellh/go-homedir"
+	"github.com/spf13/viper"
+)
+
+type resultCache struct {
+	Enabled bool          `yaml:"enabled" json:"enabled" mapstructure:"enabled"` // --cache, reuse the SBOM of a previous scan of the same image digest (with the same version and configuration)
+	Dir     string        `yaml:"dir" json:"dir" mapstructure:"dir"`             // where the SBOMs are kept between scans
+	TTL     time.Duration `yaml:"ttl" json:"ttl" mapstructure:"ttl"`             // how long cached SBOMs are used (0 = forever)
+}
+
+func (cfg resultCache) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("cache.enabled", false)
+	v.SetDefault("cache.dir", path.Join(xdg.CacheHome, internal.ApplicationName, "results"))
+	v.SetDefault("cache.ttl", 0)
+}
+
+func (cfg *resultCache) parseConfigValues() error {
+	if cfg.TTL < 0 {
+		return fmt.Errorf("bad cache ttl value: %s (must be >= 0)", cfg.TTL)
+	}
+	dir, err := homedir.Expand(cfg.Dir)
+	if err != nil {
+		return fmt.Errorf("unable to expand cache dir=%q: %w", cfg.Dir, err)
+	}
+	cfg.Dir = dir
+	return nil
+}
@@ -9,9 +9,10 @@ import (
 )
 
 type catalogerOptions struct {
-	Enabled  bool         `yaml:"enabled" json:"enabled" mapstructure:"enabled"`
-	Scope    string       `yaml:"scope" json:"scope" mapstructure:"scope"`
-	ScopeOpt source.Scope `yaml:"-" json:"-"`
+	Enabled     bool           `yaml:"enabled" json:"enabled" mapstructure:"enabled"`
+	Scope       string         `yaml:"scope" json:"scope" mapstructure:"scope"`
+	ScopeOpt    source.Scope   `yaml:"-" json:"-"`
+	SearchDepth map[string]int `yaml:"search-depth" json:"search-depth" mapstructure:"search-depth"` // cataloger name -> max depth relative to the source root
 }
 
 func (cfg catalogerOptions) loadDefaultValues(v *viper.Viper) {
@@ -25,5 +26,11 @@ func (cfg *catalogerOptions) parseConfigValues() error {
 	}
 	cfg.ScopeOpt = scopeOption
 
+	for name, depth := range cfg.SearchDepth {
+		if depth < 0 {
+			return fmt.Errorf("bad search-depth value for cataloger %q: %d (must be >= 0)", name, depth)
+		}
+	}
+
 	return nil
 }
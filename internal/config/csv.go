@@ -0,0 +1,18 @@
+package config
+
+import (
+	"github.com/anchore/syft/internal/formats/csv"
+	"github.com/spf13/viper"
+)
+
+type csvConfig struct {
+	Columns []string `yaml:"columns" json:"columns" mapstructure:"columns"`
+}
+
+func (cfg csvConfig) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("csv.columns", csv.AllColumns)
+}
+
+func (cfg *csvConfig) parseConfigValues() error {
+	return csv.ValidateColumns(cfg.Columns)
+}
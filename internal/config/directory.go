@@ -0,0 +1,55 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/anchore/syft/syft/source"
+	"github.com/mitchellh/go-homedir"
+	"github.com/spf13/viper"
+)
+
+type directory struct {
+	MaxDepth               int    `yaml:"max-depth" json:"max-depth" mapstructure:"max-depth"`                                              // the max number of path elements below the scan root to index (0 = unlimited)
+	FollowExternalSymlinks bool   `yaml:"follow-external-symlinks" json:"follow-external-symlinks" mapstructure:"follow-external-symlinks"` // index symlink targets that resolve outside of the scan root
+	IncludeSpecialFiles    bool   `yaml:"include-special-files" json:"include-special-files" mapstructure:"include-special-files"`          // index devices, sockets, and FIFOs (metadata only)
+	CaseInsensitive        bool   `yaml:"case-insensitive" json:"case-insensitive" mapstructure:"case-insensitive"`                         // match file patterns regardless of case
+	NormalizeUnicode       bool   `yaml:"normalize-unicode" json:"normalize-unicode" mapstructure:"normalize-unicode"`                      // match file patterns regardless of the unicode normalization form of paths
+	BasePath               string `yaml:"base-path" json:"base-path" mapstructure:"base-path"`                                              // --base-path, the directory to treat as the filesystem root (e.g. a mounted root filesystem)
+	IgnoreFiles            bool   `yaml:"ignore-files" json:"ignore-files" mapstructure:"ignore-files"`                                     // --ignore-files, exclude the paths listed in a .syftignore file at the scan root
+}
+
+func (cfg directory) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("directory.max-depth", 0)
+	v.SetDefault("directory.follow-external-symlinks", true)
+	v.SetDefault("directory.include-special-files", false)
+	v.SetDefault("directory.case-insensitive", false)
+	v.SetDefault("directory.normalize-unicode", false)
+	v.SetDefault("directory.base-path", "")
+	v.SetDefault("directory.ignore-files", true)
+}
+
+func (cfg *directory) parseConfigValues() error {
+	if cfg.MaxDepth < 0 {
+		return fmt.Errorf("bad directory max-depth value: %d (must be >= 0)", cfg.MaxDepth)
+	}
+	if cfg.BasePath != "" {
+		basePath, err := homedir.Expand(cfg.BasePath)
+		if err != nil {
+			return fmt.Errorf("unable to expand directory base-path=%q: %w", cfg.BasePath, err)
+		}
+		cfg.BasePath = basePath
+	}
+	return nil
+}
+
+func (cfg directory) ToConfig() source.DirectoryConfig {
+	return source.DirectoryConfig{
+		MaxDepth:             cfg.MaxDepth,
+		SkipExternalSymlinks: !cfg.FollowExternalSymlinks,
+		IncludeSpecialFiles:  cfg.IncludeSpecialFiles,
+		CaseInsensitive:      cfg.CaseInsensitive,
+		NormalizeUnicode:     cfg.NormalizeUnicode,
+		BasePath:             cfg.BasePath,
+		SkipIgnoreFile:       !cfg.IgnoreFiles,
+	}
+}
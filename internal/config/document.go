@@ -0,0 +1,144 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strconv"
+	"text/template"
+	"time"
+
+	"github.com/anchore/syft/internal/formats/common/spdxhelpers"
+	"github.com/anchore/syft/syft/source"
+	"github.com/google/uuid"
+	"github.com/spf13/viper"
+)
+
+// document holds options that describe who created the SBOM document and who supplies the software within it
+type document struct {
+	Author        string             `yaml:"author" json:"author" mapstructure:"author"`
+	Organization  string             `yaml:"organization" json:"organization" mapstructure:"organization"`
+	Supplier      string             `yaml:"supplier" json:"supplier" mapstructure:"supplier"`
+	Timestamp     string             `yaml:"timestamp" json:"timestamp" mapstructure:"timestamp"` // --document-timestamp, RFC3339 or seconds since the unix epoch
+	TimestampOpt  time.Time          `yaml:"-" json:"-"`
+	UUID          string             `yaml:"uuid" json:"uuid" mapstructure:"uuid"` // --document-uuid
+	UUIDOpt       uuid.UUID          `yaml:"-" json:"-"`
+	Name          string             `yaml:"name" json:"name" mapstructure:"name"`                            // a Go template for the document name (default is derived from the source)
+	Namespace     string             `yaml:"namespace" json:"namespace" mapstructure:"namespace"`             // a Go template for the SPDX document namespace (default is derived from the name and UUID)
+	Deterministic bool               `yaml:"deterministic" json:"deterministic" mapstructure:"deterministic"` // --deterministic, byte-identical output for identical inputs
+	NameOpt       *template.Template `yaml:"-" json:"-"`
+	NamespaceOpt  *template.Template `yaml:"-" json:"-"`
+}
+
+// documentTemplateData is what the document name and namespace templates are rendered with.
+type documentTemplateData struct {
+	Name   string          // the document name (for the name template, the name derived from the source)
+	UUID   string          // the unique identifier of the document
+	Source source.Metadata // the source that was cataloged (e.g. .Source.ImageMetadata.ManifestDigest)
+}
+
+// documentTemplateFuncs are the functions available to the document name and namespace templates.
+var documentTemplateFuncs = template.FuncMap{
+	// e.g. {{ env "CI_BUILD_ID" }}
+	"env": os.Getenv,
+}
+
+func (cfg document) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("document.author", "")
+	v.SetDefault("document.organization", "Anchore, Inc")
+	v.SetDefault("document.supplier", "")
+	v.SetDefault("document.timestamp", "")
+	v.SetDefault("document.uuid", "")
+	v.SetDefault("document.name", "")
+	v.SetDefault("document.namespace", "")
+	v.SetDefault("document.deterministic", false)
+}
+
+func (cfg *document) parseConfigValues() error {
+	timestamp := cfg.Timestamp
+	if timestamp == "" {
+		// honor the reproducible builds convention for pinning build timestamps (https://reproducible-builds.org/specs/source-date-epoch/)
+		timestamp = os.Getenv("SOURCE_DATE_EPOCH")
+	}
+
+	if timestamp != "" {
+		t, err := parseTimestamp(timestamp)
+		if err != nil {
+			return fmt.Errorf("bad document timestamp %q: %w", timestamp, err)
+		}
+		cfg.TimestampOpt = t.UTC()
+	}
+
+	if cfg.UUID != "" {
+		id, err := uuid.Parse(cfg.UUID)
+		if err != nil {
+			return fmt.Errorf("bad document UUID %q: %w", cfg.UUID, err)
+		}
+		cfg.UUIDOpt = id
+	}
+
+	var err error
+	if cfg.NameOpt, err = parseDocumentTemplate("document.name", cfg.Name); err != nil {
+		return err
+	}
+	if cfg.NamespaceOpt, err = parseDocumentTemplate("document.namespace", cfg.Namespace); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func parseDocumentTemplate(key, value string) (*template.Template, error) {
+	if value == "" {
+		return nil, nil
+	}
+	tmpl, err := template.New(key).Funcs(documentTemplateFuncs).Option("missingkey=error").Parse(value)
+	if err != nil {
+		return nil, fmt.Errorf("bad %s template: %w", key, err)
+	}
+	return tmpl, nil
+}
+
+// HasTemplates indicates if the document name or namespace are templated (rather than derived from the source).
+func (cfg document) HasTemplates() bool {
+	return cfg.NameOpt != nil || cfg.NamespaceOpt != nil
+}
+
+// RenderNames returns the document name and namespace for the given source and document UUID, as given by the
+// templates. A name or namespace without a template is returned empty, so that the default is used by the formats.
+func (cfg document) RenderNames(src source.Metadata, id uuid.UUID) (name, namespace string, err error) {
+	data := documentTemplateData{
+		UUID:   id.String(),
+		Source: src,
+	}
+	// the default name is not available for every source, and is only needed when a template refers to it
+	data.Name, _ = spdxhelpers.DocumentName(src)
+
+	if cfg.NameOpt != nil {
+		if name, err = renderDocumentTemplate(cfg.NameOpt, data); err != nil {
+			return "", "", err
+		}
+		data.Name = name
+	}
+	if cfg.NamespaceOpt != nil {
+		if namespace, err = renderDocumentTemplate(cfg.NamespaceOpt, data); err != nil {
+			return "", "", err
+		}
+	}
+	return name, namespace, nil
+}
+
+func renderDocumentTemplate(tmpl *template.Template, data documentTemplateData) (string, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("unable to render %s template: %w", tmpl.Name(), err)
+	}
+	return buf.String(), nil
+}
+
+func parseTimestamp(value string) (time.Time, error) {
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(seconds, 0), nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
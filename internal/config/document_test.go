@@ -0,0 +1,180 @@
+package config
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/anchore/syft/syft/source"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDocument_parseConfigValues(t *testing.T) {
+	tests := []struct {
+		name              string
+		input             document
+		sourceDateEpoch   string
+		expectedTimestamp time.Time
+		expectedUUID      uuid.UUID
+		wantErr           assert.ErrorAssertionFunc
+	}{
+		{
+			name:    "nothing pinned",
+			input:   document{},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "RFC3339 timestamp",
+			input: document{
+				Timestamp: "2021-12-01T15:08:43-05:00",
+			},
+			expectedTimestamp: time.Date(2021, 12, 1, 20, 8, 43, 0, time.UTC),
+			wantErr:           assert.NoError,
+		},
+		{
+			name: "unix epoch timestamp",
+			input: document{
+				Timestamp: "1638371323",
+			},
+			expectedTimestamp: time.Date(2021, 12, 1, 15, 8, 43, 0, time.UTC),
+			wantErr:           assert.NoError,
+		},
+		{
+			name:              "SOURCE_DATE_EPOCH",
+			input:             document{},
+			sourceDateEpoch:   "1638371323",
+			expectedTimestamp: time.Date(2021, 12, 1, 15, 8, 43, 0, time.UTC),
+			wantErr:           assert.NoError,
+		},
+		{
+			name: "explicit timestamp wins over SOURCE_DATE_EPOCH",
+			input: document{
+				Timestamp: "2021-12-01T20:08:43Z",
+			},
+			sourceDateEpoch:   "1",
+			expectedTimestamp: time.Date(2021, 12, 1, 20, 8, 43, 0, time.UTC),
+			wantErr:           assert.NoError,
+		},
+		{
+			name: "bad timestamp",
+			input: document{
+				Timestamp: "yesterday",
+			},
+			wantErr: assert.Error,
+		},
+		{
+			name: "pinned UUID",
+			input: document{
+				UUID: "4b896ded-7852-4e31-b764-136b53bdf346",
+			},
+			expectedUUID: uuid.MustParse("4b896ded-7852-4e31-b764-136b53bdf346"),
+			wantErr:      assert.NoError,
+		},
+		{
+			name: "bad UUID",
+			input: document{
+				UUID: "not-a-uuid",
+			},
+			wantErr: assert.Error,
+		},
+		{
+			name: "bad name template",
+			input: document{
+				Name: "{{ .Source",
+			},
+			wantErr: assert.Error,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			original, isSet := os.LookupEnv("SOURCE_DATE_EPOCH")
+			assert.NoError(t, os.Setenv("SOURCE_DATE_EPOCH", test.sourceDateEpoch))
+			defer func() {
+				if isSet {
+					_ = os.Setenv("SOURCE_DATE_EPOCH", original)
+				} else {
+					_ = os.Unsetenv("SOURCE_DATE_EPOCH")
+				}
+			}()
+
+			cfg := test.input
+			test.wantErr(t, cfg.parseConfigValues())
+			assert.True(t, test.expectedTimestamp.Equal(cfg.TimestampOpt), "expected %s, got %s", test.expectedTimestamp, cfg.TimestampOpt)
+			assert.Equal(t, test.expectedUUID, cfg.UUIDOpt)
+		})
+	}
+}
+
+func TestDocument_RenderNames(t *testing.T) {
+	original, isSet := os.LookupEnv("CI_BUILD_ID")
+	assert.NoError(t, os.Setenv("CI_BUILD_ID", "1234"))
+	defer func() {
+		if isSet {
+			_ = os.Setenv("CI_BUILD_ID", original)
+		} else {
+			_ = os.Unsetenv("CI_BUILD_ID")
+		}
+	}()
+
+	src := source.Metadata{
+		Scheme: source.ImageScheme,
+		ImageMetadata: source.ImageMetadata{
+			UserInput:      "alpine:3.15",
+			ManifestDigest: "sha256:e7d88de73db3",
+		},
+	}
+	id := uuid.MustParse("4b896ded-7852-4e31-b764-136b53bdf346")
+
+	tests := []struct {
+		name              string
+		input             document
+		expectedName      string
+		expectedNamespace string
+		wantErr           require.ErrorAssertionFunc
+	}{
+		{
+			name:    "no templates",
+			input:   document{},
+			wantErr: require.NoError,
+		},
+		{
+			name: "name and namespace templates",
+			input: document{
+				Name:      "{{ .Name }}@{{ .Source.ImageMetadata.ManifestDigest }}",
+				Namespace: "https://sboms.example.com/builds/{{ env \"CI_BUILD_ID\" }}/{{ .Name }}-{{ .UUID }}",
+			},
+			expectedName:      "alpine-3.15@sha256:e7d88de73db3",
+			expectedNamespace: "https://sboms.example.com/builds/1234/alpine-3.15@sha256:e7d88de73db3-4b896ded-7852-4e31-b764-136b53bdf346",
+			wantErr:           require.NoError,
+		},
+		{
+			name: "only a namespace template",
+			input: document{
+				Namespace: "https://sboms.example.com/{{ .Name }}",
+			},
+			expectedNamespace: "https://sboms.example.com/alpine-3.15",
+			wantErr:           require.NoError,
+		},
+		{
+			name: "unknown field",
+			input: document{
+				Name: "{{ .Digest }}",
+			},
+			wantErr: require.Error,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := test.input
+			require.NoError(t, cfg.parseConfigValues())
+			assert.Equal(t, cfg.Name != "" || cfg.Namespace != "", cfg.HasTemplates())
+
+			name, namespace, err := cfg.RenderNames(src, id)
+			test.wantErr(t, err)
+			assert.Equal(t, test.expectedName, name)
+			assert.Equal(t, test.expectedNamespace, namespace)
+		})
+	}
+}
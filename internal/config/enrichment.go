@@ -0,0 +1,48 @@
+package config
+
+import (
+	"path"
+	"time"
+
+	"github.com/adrg/xdg"
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/enrichment"
+	"github.com/spf13/viper"
+)
+
+type enrichmentConfig struct {
+	Enabled           bool          `yaml:"enabled" json:"enabled" mapstructure:"enabled"`                                     // --enrich, backfill missing package details from package registries (requires network access)
+	Registries        []string      `yaml:"registries" json:"registries" mapstructure:"registries"`                            // the registries to query (npm, pypi, maven)
+	CacheDir          string        `yaml:"cache-dir" json:"cache-dir" mapstructure:"cache-dir"`                               // where registry responses are kept between scans (empty = no caching between scans)
+	CacheTTL          time.Duration `yaml:"cache-ttl" json:"cache-ttl" mapstructure:"cache-ttl"`                               // how long cached registry responses are used (0 = forever)
+	RequestsPerSecond float64       `yaml:"requests-per-second" json:"requests-per-second" mapstructure:"requests-per-second"` // the max rate of registry requests (0 = unlimited)
+	Timeout           time.Duration `yaml:"timeout" json:"timeout" mapstructure:"timeout"`                                     // the timeout of each registry request
+}
+
+func (cfg enrichmentConfig) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("enrichment.enabled", false)
+	v.SetDefault("enrichment.registries", enrichment.AllRegistries)
+	v.SetDefault("enrichment.cache-dir", path.Join(xdg.CacheHome, internal.ApplicationName, "enrichment"))
+	v.SetDefault("enrichment.cache-ttl", 7*24*time.Hour)
+	v.SetDefault("enrichment.requests-per-second", 5)
+	v.SetDefault("enrichment.timeout", 10*time.Second)
+}
+
+func (cfg *enrichmentConfig) parseConfigValues() error {
+	if !cfg.Enabled {
+		return nil
+	}
+	// catch unknown registries before anything is cataloged
+	_, err := enrichment.NewEnricher(cfg.ToConfig())
+	return err
+}
+
+func (cfg enrichmentConfig) ToConfig() enrichment.Config {
+	return enrichment.Config{
+		Registries:        cfg.Registries,
+		CacheDir:          cfg.CacheDir,
+		CacheTTL:          cfg.CacheTTL,
+		RequestsPerSecond: cfg.RequestsPerSecond,
+		Timeout:           cfg.Timeout,
+	}
+}
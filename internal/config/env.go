@@ -0,0 +1,185 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/anchore/syft/internal"
+	"github.com/mitchellh/mapstructure"
+	"github.com/spf13/viper"
+)
+
+// allow for nested options to be specified via environment variables
+// e.g. pod.context = APPNAME_POD_CONTEXT
+var envKeyReplacer = strings.NewReplacer(".", "_", "-", "_")
+
+// EnvVar describes an application config option that can be set with an environment variable.
+type EnvVar struct {
+	Name  string // the environment variable (e.g. SYFT_PACKAGE_CATALOGER_SCOPE)
+	Key   string // the config option set by the environment variable (e.g. package.cataloger.scope)
+	Value string // the current value of the config option (sensitive values are redacted)
+}
+
+// configOption is a single (leaf) option within the application config.
+type configOption struct {
+	key       string
+	value     reflect.Value
+	sensitive bool // the value must never be shown (e.g. passwords and tokens)
+	entry     bool // the option describes a single entry of a list (which is only settable by environment variable)
+}
+
+// EnvVarName returns the environment variable that sets the given config option.
+func EnvVarName(key string) string {
+	return strings.ToUpper(internal.ApplicationName + "_" + envKeyReplacer.Replace(key))
+}
+
+// EnvVars returns every environment variable that can configure the application along with the current value of the
+// config option it sets.
+func (cfg Application) EnvVars() []EnvVar {
+	var envVars []EnvVar
+	for _, option := range configOptions(reflect.ValueOf(cfg), "", false, false) {
+		name := EnvVarName(option.key)
+
+		var value string
+		if option.entry {
+			// the entry is assembled from environment variables alone (not represented in the config directly)
+			value = os.Getenv(name)
+		} else {
+			value = formatConfigValue(option.value)
+		}
+		if option.sensitive && value != "" {
+			value = "<redacted>"
+		}
+
+		envVars = append(envVars, EnvVar{
+			Name:  name,
+			Key:   option.key,
+			Value: value,
+		})
+	}
+	return envVars
+}
+
+// bindEnvVars ensures that every config option is read from its environment variable, even when the option has no
+// default value and is not present in the config file.
+func bindEnvVars(v *viper.Viper) error {
+	for _, option := range configOptions(reflect.ValueOf(Application{}), "", false, false) {
+		if option.entry {
+			continue
+		}
+		if err := v.BindEnv(option.key, EnvVarName(option.key)); err != nil {
+			return fmt.Errorf("unable to bind env var for config option=%q: %w", option.key, err)
+		}
+	}
+	return nil
+}
+
+// configOptions returns all leaf options within the given config struct (as described by the mapstructure tags).
+func configOptions(value reflect.Value, prefix string, sensitive, entry bool) []configOption {
+	var options []configOption
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		name := strings.Split(field.Tag.Get("mapstructure"), ",")[0]
+		if name == "" || name == "-" {
+			// options only available through the CLI or derived while parsing the config
+			continue
+		}
+
+		option := configOption{
+			key:   prefix + name,
+			value: value.Field(i),
+			// fields hidden from the YAML/JSON output of the config hold sensitive information
+			sensitive: sensitive || field.Tag.Get("yaml") == "-",
+			entry:     entry,
+		}
+
+		switch {
+		case field.Type.Kind() == reflect.Struct:
+			options = append(options, configOptions(option.value, option.key+".", option.sensitive, entry)...)
+		case field.Type.Kind() == reflect.Slice && field.Type.Elem().Kind() == reflect.Struct:
+			// a single additional entry of a list of structs may be given by environment variables
+			// (e.g. SYFT_REGISTRY_AUTH_AUTHORITY, SYFT_REGISTRY_AUTH_USERNAME, ...)
+			options = append(options, configOptions(reflect.New(field.Type.Elem()).Elem(), option.key+".", option.sensitive, true)...)
+		default:
+			options = append(options, option)
+		}
+	}
+	return options
+}
+
+// formatConfigValue renders the given option value the same way it would be given as an environment variable.
+func formatConfigValue(value reflect.Value) string {
+	switch value.Kind() {
+	case reflect.Slice:
+		items := make([]string, value.Len())
+		for i := range items {
+			items[i] = fmt.Sprint(value.Index(i).Interface())
+		}
+		return strings.Join(items, ",")
+	case reflect.Map:
+		var items []string
+		iter := value.MapRange()
+		for iter.Next() {
+			items = append(items, fmt.Sprintf("%v=%v", iter.Key().Interface(), iter.Value().Interface()))
+		}
+		sort.Strings(items)
+		return strings.Join(items, ",")
+	default:
+		return fmt.Sprint(value.Interface())
+	}
+}
+
+// stringToMapHookFunc allows map options to be given as a single string of comma-separated key=value pairs (which is
+// how they are set with an environment variable). Keys and values are converted to the key and element types of the
+// target map (e.g. "a=1,b=2" for a map[string]int).
+func stringToMapHookFunc() mapstructure.DecodeHookFuncType {
+	return func(from reflect.Type, to reflect.Type, data interface{}) (interface{}, error) {
+		if from.Kind() != reflect.String || to.Kind() != reflect.Map {
+			return data, nil
+		}
+
+		result := reflect.MakeMap(to)
+		for _, pair := range strings.Split(data.(string), ",") {
+			pair = strings.TrimSpace(pair)
+			if pair == "" {
+				continue
+			}
+			fields := strings.SplitN(pair, "=", 2)
+			if len(fields) != 2 {
+				return nil, fmt.Errorf("invalid key=value pair: %q", pair)
+			}
+
+			key, err := decodeString(strings.TrimSpace(fields[0]), to.Key())
+			if err != nil {
+				return nil, fmt.Errorf("invalid key in pair %q: %w", pair, err)
+			}
+			value, err := decodeString(strings.TrimSpace(fields[1]), to.Elem())
+			if err != nil {
+				return nil, fmt.Errorf("invalid value in pair %q: %w", pair, err)
+			}
+			result.SetMapIndex(key, value)
+		}
+		return result.Interface(), nil
+	}
+}
+
+// decodeString converts the given string into a value of the given type (e.g. "5" for an int, or "30s" for a
+// time.Duration).
+func decodeString(s string, t reflect.Type) (reflect.Value, error) {
+	result := reflect.New(t)
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook:       mapstructure.StringToTimeDurationHookFunc(),
+		WeaklyTypedInput: true,
+		Result:           result.Interface(),
+	})
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	if err := decoder.Decode(s); err != nil {
+		return reflect.Value{}, err
+	}
+	return result.Elem(), nil
+}
@@ -0,0 +1,177 @@
+package config
+
+import (
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvVarName(t *testing.T) {
+	tests := []struct {
+		key      string
+		expected string
+	}{
+		{key: "output", expected: "SYFT_OUTPUT"},
+		{key: "check-for-app-update", expected: "SYFT_CHECK_FOR_APP_UPDATE"},
+		{key: "package.cataloger.search-depth", expected: "SYFT_PACKAGE_CATALOGER_SEARCH_DEPTH"},
+	}
+	for _, test := range tests {
+		t.Run(test.key, func(t *testing.T) {
+			assert.Equal(t, test.expected, EnvVarName(test.key))
+		})
+	}
+}
+
+func TestApplication_EnvVars(t *testing.T) {
+	setEnv(t, "SYFT_REGISTRY_AUTH_PASSWORD", "hunter2")
+	setEnv(t, "SYFT_REGISTRY_AUTH_AUTHORITY", "registry.example.com")
+
+	cfg := Application{
+		Exclusions: []string{"./out/**", "./vendor/**"},
+		Anchore: anchore{
+			Password: "secret",
+		},
+		Package: pkg{
+			Cataloger: catalogerOptions{
+				SearchDepth: map[string]int{"python-package-cataloger": 1, "java-cataloger": 2},
+			},
+		},
+	}
+
+	actual := make(map[string]EnvVar)
+	for _, envVar := range cfg.EnvVars() {
+		actual[envVar.Name] = envVar
+	}
+
+	assert.Equal(t, EnvVar{Name: "SYFT_EXCLUDE", Key: "exclude", Value: "./out/**,./vendor/**"}, actual["SYFT_EXCLUDE"])
+	assert.Equal(t, "java-cataloger=2,python-package-cataloger=1", actual["SYFT_PACKAGE_CATALOGER_SEARCH_DEPTH"].Value)
+	assert.Equal(t, "<redacted>", actual["SYFT_ANCHORE_PASSWORD"].Value)
+	// entries of a list are only given by environment variables
+	assert.Equal(t, "registry.example.com", actual["SYFT_REGISTRY_AUTH_AUTHORITY"].Value)
+	assert.Equal(t, "<redacted>", actual["SYFT_REGISTRY_AUTH_PASSWORD"].Value)
+	assert.Equal(t, "", actual["SYFT_REGISTRY_AUTH_TOKEN"].Value)
+
+	// options only available through the CLI are not listed
+	for _, envVar := range actual {
+		assert.NotEqual(t, "config", envVar.Key)
+	}
+}
+
+func TestLoadApplicationConfig_EnvVarsOnly(t *testing.T) {
+	setEnv(t, "SYFT_EXCLUDE", "./out/**,./vendor/**")
+	setEnv(t, "SYFT_PACKAGE_CATALOGER_SEARCH_DEPTH", "java-cataloger=2")
+	setEnv(t, "SYFT_SECRETS_ADDITIONAL_PATTERNS", "my-key=foo[a-z]+")
+	setEnv(t, "SYFT_ANCHORE_TLS_CA_CERT", "/certs/ca.pem")
+	setEnv(t, "SYFT_FILE_METADATA_DIGESTS", "sha1,sha256")
+
+	v := viper.New()
+	// the default scopes are otherwise provided by the CLI flags
+	for _, option := range configOptions(reflect.ValueOf(Application{}), "", false, false) {
+		if strings.HasSuffix(option.key, "cataloger.scope") {
+			v.SetDefault(option.key, "squashed")
+		}
+	}
+
+	cfg, err := LoadApplicationConfig(v, CliOnlyOptions{ConfigPath: "test-fixtures/empty.yaml"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"./out/**", "./vendor/**"}, cfg.Exclusions)
+	assert.Equal(t, map[string]int{"java-cataloger": 2}, cfg.Package.Cataloger.SearchDepth)
+	assert.Equal(t, map[string]string{"my-key": "foo[a-z]+"}, cfg.Secrets.AdditionalPatterns)
+	assert.Equal(t, "/certs/ca.pem", cfg.Anchore.TLS.CACert)
+	assert.Equal(t, []string{"sha1", "sha256"}, cfg.FileMetadata.Digests)
+}
+
+func TestStringToMapHookFunc(t *testing.T) {
+	hook := stringToMapHookFunc()
+
+	tests := []struct {
+		name     string
+		input    string
+		to       interface{}
+		expected interface{}
+		wantErr  require.ErrorAssertionFunc
+	}{
+		{
+			name:     "empty",
+			input:    "",
+			to:       map[string]string{},
+			expected: map[string]string{},
+		},
+		{
+			name:     "pairs",
+			input:    "a=1, b = 2,",
+			to:       map[string]string{},
+			expected: map[string]string{"a": "1", "b": "2"},
+		},
+		{
+			name:     "value containing equals",
+			input:    "a=b=c",
+			to:       map[string]string{},
+			expected: map[string]string{"a": "b=c"},
+		},
+		{
+			name:     "int values",
+			input:    "java-cataloger=2,python-cataloger=0",
+			to:       map[string]int{},
+			expected: map[string]int{"java-cataloger": 2, "python-cataloger": 0},
+		},
+		{
+			name:     "bool values",
+			input:    "a=true,b=false",
+			to:       map[string]bool{},
+			expected: map[string]bool{"a": true, "b": false},
+		},
+		{
+			name:     "duration values",
+			input:    "a=30s",
+			to:       map[string]time.Duration{},
+			expected: map[string]time.Duration{"a": 30 * time.Second},
+		},
+		{
+			name:    "bad int value",
+			input:   "a=many",
+			to:      map[string]int{},
+			wantErr: require.Error,
+		},
+		{
+			name:    "missing value",
+			input:   "a",
+			to:      map[string]string{},
+			wantErr: require.Error,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.wantErr == nil {
+				test.wantErr = require.NoError
+			}
+			actual, err := hook(reflect.TypeOf(""), reflect.TypeOf(test.to), test.input)
+			test.wantErr(t, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+// setEnv sets the given environment variable for the duration of the test.
+func setEnv(t *testing.T, key, value string) {
+	t.Helper()
+	original, exists := os.LookupEnv(key)
+	require.NoError(t, os.Setenv(key, value))
+	t.Cleanup(func() {
+		if exists {
+			os.Setenv(key, original)
+		} else {
+			os.Unsetenv(key)
+		}
+	})
+}
@@ -0,0 +1,50 @@
+package config
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/anchore/syft/syft/eol"
+	"github.com/mitchellh/go-homedir"
+	"github.com/spf13/viper"
+)
+
+type eolConfig struct {
+	Enabled bool   `yaml:"enabled" json:"enabled" mapstructure:"enabled"` // --eol, annotate runtimes and the distro with their end-of-life status
+	Dataset string `yaml:"dataset" json:"dataset" mapstructure:"dataset"` // an end-of-life dataset to use instead of the built-in one (empty = built-in dataset)
+}
+
+func (cfg eolConfig) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("eol.enabled", false)
+	v.SetDefault("eol.dataset", "")
+}
+
+func (cfg *eolConfig) parseConfigValues() error {
+	if cfg.Dataset == "" {
+		return nil
+	}
+	dataset, err := homedir.Expand(cfg.Dataset)
+	if err != nil {
+		return fmt.Errorf("unable to expand end-of-life dataset path=%q: %w", cfg.Dataset, err)
+	}
+	cfg.Dataset = dataset
+	if !cfg.Enabled {
+		return nil
+	}
+	// catch an invalid dataset before anything is cataloged
+	_, err = cfg.ToDataset()
+	return err
+}
+
+// ToDataset returns the configured end-of-life dataset (the built-in dataset unless another one is given).
+func (cfg eolConfig) ToDataset() (*eol.Dataset, error) {
+	if cfg.Dataset == "" {
+		return eol.DefaultDataset(), nil
+	}
+	f, err := os.Open(cfg.Dataset)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open end-of-life dataset: %w", err)
+	}
+	defer f.Close()
+	return eol.ParseDataset(f)
+}
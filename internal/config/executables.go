@@ -0,0 +1,19 @@
+package config
+
+import (
+	"github.com/anchore/syft/syft/source"
+	"github.com/spf13/viper"
+)
+
+type executables struct {
+	Cataloger catalogerOptions `yaml:"cataloger" json:"cataloger" mapstructure:"cataloger"`
+}
+
+func (cfg executables) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("executables.cataloger.enabled", catalogerEnabledDefault)
+	v.SetDefault("executables.cataloger.scope", source.SquashedScope)
+}
+
+func (cfg *executables) parseConfigValues() error {
+	return cfg.Cataloger.parseConfigValues()
+}
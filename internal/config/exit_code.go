@@ -0,0 +1,38 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/spf13/viper"
+)
+
+// exitCode holds the process exit codes used to distinguish the outcomes of a scan (a value of 0 disables the check).
+type exitCode struct {
+	NoPackages     int `yaml:"no-packages" json:"no-packages" mapstructure:"no-packages"`             // exit code when no packages were discovered
+	CatalogerError int `yaml:"cataloger-error" json:"cataloger-error" mapstructure:"cataloger-error"` // exit code when any cataloger failed
+	PolicyFailure  int `yaml:"policy-failure" json:"policy-failure" mapstructure:"policy-failure"`    // exit code when the SBOM fails the --compliance check
+}
+
+func (cfg exitCode) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("exit-code.no-packages", 0)
+	v.SetDefault("exit-code.cataloger-error", 1)
+	v.SetDefault("exit-code.policy-failure", 0)
+}
+
+func (cfg *exitCode) parseConfigValues() error {
+	for name, code := range map[string]int{
+		"no-packages":     cfg.NoPackages,
+		"cataloger-error": cfg.CatalogerError,
+		"policy-failure":  cfg.PolicyFailure,
+	} {
+		if code < 0 || code > 255 {
+			return fmt.Errorf("bad exit-code %s value: %d (must be between 0 and 255)", name, code)
+		}
+	}
+
+	if cfg.CatalogerError == 0 {
+		// a failed cataloger always results in an incomplete SBOM, which must never look like a successful scan
+		return fmt.Errorf("bad exit-code cataloger-error value: 0 (must be non-zero)")
+	}
+	return nil
+}
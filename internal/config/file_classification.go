@@ -1,17 +1,27 @@
 package config
 
 import (
+	"path"
+
+	"github.com/adrg/xdg"
+	"github.com/anchore/syft/internal"
 	"github.com/anchore/syft/syft/source"
 	"github.com/spf13/viper"
 )
 
 type fileClassification struct {
-	Cataloger catalogerOptions `yaml:"cataloger" json:"cataloger" mapstructure:"cataloger"`
+	Cataloger    catalogerOptions `yaml:"cataloger" json:"cataloger" mapstructure:"cataloger"`
+	Database     string           `yaml:"database" json:"database" mapstructure:"database"`                // the updated classifier database, used over the built-in classifiers when newer
+	UpdateURL    string           `yaml:"update-url" json:"update-url" mapstructure:"update-url"`          // where the update-classifiers command fetches the latest classifier database from (there is no default feed)
+	UpdateDigest string           `yaml:"update-digest" json:"update-digest" mapstructure:"update-digest"` // the expected sha256 digest of the fetched database (the digest published at the URL + ".sha256" when not given)
 }
 
 func (cfg fileClassification) loadDefaultValues(v *viper.Viper) {
 	v.SetDefault("file-classification.cataloger.enabled", catalogerEnabledDefault)
 	v.SetDefault("file-classification.cataloger.scope", source.SquashedScope)
+	v.SetDefault("file-classification.database", path.Join(xdg.CacheHome, internal.ApplicationName, "classifiers.json"))
+	v.SetDefault("file-classification.update-url", "")
+	v.SetDefault("file-classification.update-digest", "")
 }
 
 func (cfg *fileClassification) parseConfigValues() error {
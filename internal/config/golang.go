@@ -0,0 +1,23 @@
+package config
+
+import (
+	"github.com/anchore/syft/syft/pkg/cataloger/golang"
+	"github.com/spf13/viper"
+)
+
+type golangOptions struct {
+	SearchLocalModCache bool   `yaml:"search-local-mod-cache" json:"search-local-mod-cache" mapstructure:"search-local-mod-cache"`
+	LocalModCacheDir    string `yaml:"local-mod-cache-dir" json:"local-mod-cache-dir" mapstructure:"local-mod-cache-dir"`
+}
+
+func (cfg golangOptions) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("package.golang.search-local-mod-cache", false)
+	v.SetDefault("package.golang.local-mod-cache-dir", "")
+}
+
+func (cfg golangOptions) ToConfig() golang.Config {
+	return golang.Config{
+		SearchLocalModCache: cfg.SearchLocalModCache,
+		LocalModCacheDir:    cfg.LocalModCacheDir,
+	}
+}
@@ -0,0 +1,13 @@
+package config
+
+import (
+	"github.com/spf13/viper"
+)
+
+type javaOptions struct {
+	ResolveParentPoms bool `yaml:"resolve-parent-poms" json:"resolve-parent-poms" mapstructure:"resolve-parent-poms"`
+}
+
+func (cfg javaOptions) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("package.java.resolve-parent-poms", true)
+}
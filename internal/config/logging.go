@@ -11,8 +11,20 @@ type logging struct {
 	LevelOpt     logrus.Level `yaml:"-" json:"-"`                                             // the native log level object used by the logger
 	Level        string       `yaml:"level" json:"level" mapstructure:"level"`                // the log level string hint
 	FileLocation string       `yaml:"file" json:"file-location" mapstructure:"file"`          // the file path to write logs to
+	Syslog       syslog       `yaml:"syslog" json:"syslog" mapstructure:"syslog"`             // forward log entries to syslog
+}
+
+type syslog struct {
+	Enabled bool   `yaml:"enabled" json:"enabled" mapstructure:"enabled"`
+	Network string `yaml:"network" json:"network" mapstructure:"network"` // "udp" or "tcp" for a remote daemon, empty for the local daemon
+	Address string `yaml:"address" json:"address" mapstructure:"address"` // the remote daemon (host:port), empty for the local daemon
+	Tag     string `yaml:"tag" json:"tag" mapstructure:"tag"`
 }
 
 func (cfg logging) loadDefaultValues(v *viper.Viper) {
 	v.SetDefault("log.structured", false)
+	v.SetDefault("log.syslog.enabled", false)
+	v.SetDefault("log.syslog.network", "")
+	v.SetDefault("log.syslog.address", "")
+	v.SetDefault("log.syslog.tag", "syft")
 }
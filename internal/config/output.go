@@ -0,0 +1,101 @@
+package config
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v2"
+)
+
+// output holds the options for presenting the SBOM: which formats to write, where to write them and how.
+type output struct {
+	Format       []string `yaml:"format" json:"format" mapstructure:"format"`                      // -o, the format(s) to write (as <format> or <format>=<file>)
+	File         string   `yaml:"file" json:"file" mapstructure:"file"`                            // --file, the file to write report output to
+	TemplatePath string   `yaml:"template-path" json:"template-path" mapstructure:"template-path"` // -t, the Go text/template to render the "template" output format with
+	Pretty       bool     `yaml:"pretty" json:"pretty" mapstructure:"pretty"`                      // indent the JSON and XML output formats (otherwise they are written compactly)
+}
+
+// legacyOutputKeys are the top-level keys that held the output options before they were grouped in the output
+// section, mapped to the option that replaces each of them.
+var legacyOutputKeys = map[string]string{
+	"output":               "format",
+	"file":                 "file",
+	"output-template-path": "template-path",
+}
+
+func (cfg output) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("output.pretty", true)
+}
+
+// migrateOutputEnvVars sets the environment variables of the output section from those of the legacy output keys (e.g.
+// SYFT_OUTPUT_FORMAT from SYFT_OUTPUT), unless already set. The legacy variables are removed, since a SYFT_OUTPUT
+// variable would otherwise hide every option of the output section from viper.
+func migrateOutputEnvVars() error {
+	for legacy, option := range legacyOutputKeys {
+		legacyName, name := EnvVarName(legacy), EnvVarName("output."+option)
+		value, ok := os.LookupEnv(legacyName)
+		if !ok || legacyName == name {
+			// note: output-template-path and output.template-path share the same variable
+			continue
+		}
+		if err := os.Unsetenv(legacyName); err != nil {
+			return err
+		}
+		if _, exists := os.LookupEnv(name); exists {
+			continue
+		}
+		if err := os.Setenv(name, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// migrateOutputConfig moves the legacy output keys of the config file that was read (e.g. a top-level "output: json")
+// into the output section, so that older config files keep working. Keys already set in the output section win.
+func migrateOutputConfig(v *viper.Viper) error {
+	if v.ConfigFileUsed() == "" {
+		return nil
+	}
+	contents, err := ioutil.ReadFile(v.ConfigFileUsed())
+	if err != nil {
+		return err
+	}
+
+	var raw map[string]interface{}
+	if err := yaml.Unmarshal(contents, &raw); err != nil {
+		// not a YAML (or JSON) config, which never had the legacy keys
+		return nil
+	}
+
+	section, isSection := raw["output"].(map[interface{}]interface{})
+	if !isSection {
+		section = make(map[interface{}]interface{})
+	}
+
+	migrated := false
+	for legacy, option := range legacyOutputKeys {
+		value, ok := raw[legacy]
+		if !ok || (legacy == "output" && isSection) {
+			continue
+		}
+		if _, exists := section[option]; !exists {
+			section[option] = value
+		}
+		delete(raw, legacy)
+		migrated = true
+	}
+	if !migrated {
+		return nil
+	}
+	raw["output"] = section
+
+	migratedContents, err := yaml.Marshal(raw)
+	if err != nil {
+		return err
+	}
+	v.SetConfigType("yaml")
+	return v.ReadConfig(bytes.NewReader(migratedContents))
+}
@@ -0,0 +1,88 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestViper() *viper.Viper {
+	v := viper.New()
+	// the default scopes are otherwise provided by the CLI flags
+	for _, option := range configOptions(reflect.ValueOf(Application{}), "", false, false) {
+		if strings.HasSuffix(option.key, "cataloger.scope") {
+			v.SetDefault(option.key, "squashed")
+		}
+	}
+	return v
+}
+
+func TestLoadApplicationConfig_Output(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   string
+		expected output
+	}{
+		{
+			name:   "output section",
+			config: "output:\n  format: [spdx-json]\n  file: sbom.json\n  pretty: false\n",
+			expected: output{
+				Format: []string{"spdx-json"},
+				File:   "sbom.json",
+			},
+		},
+		{
+			name:   "legacy keys",
+			config: "output: cyclonedx-json\nfile: sbom.json\noutput-template-path: sbom.tmpl\n",
+			expected: output{
+				Format:       []string{"cyclonedx-json"},
+				File:         "sbom.json",
+				TemplatePath: "sbom.tmpl",
+				Pretty:       true,
+			},
+		},
+		{
+			name:   "output section takes precedence over legacy keys",
+			config: "output:\n  file: section.json\nfile: legacy.json\n",
+			expected: output{
+				File:   "section.json",
+				Pretty: true,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "syft.yaml")
+			require.NoError(t, ioutil.WriteFile(path, []byte(test.config), 0600))
+
+			cfg, err := LoadApplicationConfig(newTestViper(), CliOnlyOptions{ConfigPath: path})
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, cfg.Output)
+		})
+	}
+}
+
+func TestLoadApplicationConfig_LegacyOutputEnvVars(t *testing.T) {
+	setEnv(t, "SYFT_OUTPUT", "json")
+	setEnv(t, "SYFT_FILE", "sbom.json")
+	t.Cleanup(func() {
+		os.Unsetenv("SYFT_OUTPUT_FORMAT")
+		os.Unsetenv("SYFT_OUTPUT_FILE")
+	})
+
+	cfg, err := LoadApplicationConfig(newTestViper(), CliOnlyOptions{ConfigPath: "test-fixtures/empty.yaml"})
+	require.NoError(t, err)
+
+	assert.Equal(t, output{
+		Format: []string{"json"},
+		File:   "sbom.json",
+		Pretty: true,
+	}, cfg.Output)
+}
@@ -0,0 +1,90 @@
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/anchore/syft/syft/pkg/cataloger"
+	"github.com/mitchellh/go-homedir"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v2"
+)
+
+// ownershipRule attaches organizational metadata (e.g. the owning team or business unit) to the packages within the
+// given package URL namespaces or from the given suppliers.
+type ownershipRule struct {
+	Namespaces []string          `yaml:"namespaces" json:"namespaces" mapstructure:"namespaces"` // package URL namespaces, including the namespaces within them (e.g. "com.example" or "@example")
+	Suppliers  []string          `yaml:"suppliers" json:"suppliers" mapstructure:"suppliers"`    // case-insensitive fragments of the supplier declared by the package (e.g. a deb maintainer or an rpm vendor)
+	Properties map[string]string `yaml:"properties" json:"properties" mapstructure:"properties"` // the metadata attached to matching packages (e.g. team: payments)
+}
+
+type ownership struct {
+	File      string          `yaml:"file" json:"file" mapstructure:"file"`    // a YAML file of ownership rules (e.g. maintained centrally), applied after the rules given here
+	Rules     []ownershipRule `yaml:"rules" json:"rules" mapstructure:"rules"` // ownership rules, where the first rule giving a property to a package wins
+	FileRules []ownershipRule `yaml:"-" json:"-"`
+}
+
+type ownershipDocument struct {
+	Rules []ownershipRule `yaml:"rules"`
+}
+
+func (cfg ownership) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("package.ownership.file", "")
+}
+
+func (cfg *ownership) parseConfigValues() error {
+	for idx, r := range cfg.Rules {
+		if err := r.validate(); err != nil {
+			return fmt.Errorf("bad ownership rule %d: %w", idx+1, err)
+		}
+	}
+
+	if cfg.File == "" {
+		return nil
+	}
+
+	file, err := homedir.Expand(cfg.File)
+	if err != nil {
+		return fmt.Errorf("unable to expand ownership file path=%q: %w", cfg.File, err)
+	}
+	cfg.File = file
+
+	contents, err := ioutil.ReadFile(cfg.File)
+	if err != nil {
+		return fmt.Errorf("unable to read ownership file: %w", err)
+	}
+
+	var doc ownershipDocument
+	if err := yaml.UnmarshalStrict(contents, &doc); err != nil {
+		return fmt.Errorf("unable to parse ownership file=%q: %w", cfg.File, err)
+	}
+	for idx, r := range doc.Rules {
+		if err := r.validate(); err != nil {
+			return fmt.Errorf("bad ownership rule %d of file=%q: %w", idx+1, cfg.File, err)
+		}
+	}
+	cfg.FileRules = doc.Rules
+	return nil
+}
+
+func (cfg ownership) ToConfig() []cataloger.OwnershipRule {
+	var rules []cataloger.OwnershipRule
+	for _, r := range append(append([]ownershipRule{}, cfg.Rules...), cfg.FileRules...) {
+		rules = append(rules, cataloger.OwnershipRule{
+			Namespaces: r.Namespaces,
+			Suppliers:  r.Suppliers,
+			Properties: r.Properties,
+		})
+	}
+	return rules
+}
+
+func (r ownershipRule) validate() error {
+	if len(r.Namespaces) == 0 && len(r.Suppliers) == 0 {
+		return fmt.Errorf("no namespaces or suppliers to match packages by")
+	}
+	if len(r.Properties) == 0 {
+		return fmt.Errorf("no properties to attach to packages")
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg/cataloger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOwnership_ParseConfigValues(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      ownership
+		expected []cataloger.OwnershipRule
+		wantErr  require.ErrorAssertionFunc
+	}{
+		{
+			name: "rules given in the config come before rules from the file",
+			cfg: ownership{
+				File: "test-fixtures/ownership.yaml",
+				Rules: []ownershipRule{
+					{Namespaces: []string{"@example"}, Properties: map[string]string{"team": "web"}},
+				},
+			},
+			expected: []cataloger.OwnershipRule{
+				{Namespaces: []string{"@example"}, Properties: map[string]string{"team": "web"}},
+				{Namespaces: []string{"com.example.billing"}, Properties: map[string]string{"team": "billing"}},
+				{Suppliers: []string{"Example Corp"}, Properties: map[string]string{"team": "platform", "business-unit": "payments"}},
+			},
+		},
+		{
+			name: "rule without anything to match",
+			cfg: ownership{
+				Rules: []ownershipRule{
+					{Properties: map[string]string{"team": "web"}},
+				},
+			},
+			wantErr: require.Error,
+		},
+		{
+			name: "rule without properties",
+			cfg: ownership{
+				Rules: []ownershipRule{
+					{Namespaces: []string{"@example"}},
+				},
+			},
+			wantErr: require.Error,
+		},
+		{
+			name:    "missing file",
+			cfg:     ownership{File: "test-fixtures/does-not-exist.yaml"},
+			wantErr: require.Error,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.wantErr == nil {
+				test.wantErr = require.NoError
+			}
+			err := test.cfg.parseConfigValues()
+			test.wantErr(t, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, test.expected, test.cfg.ToConfig())
+		})
+	}
+}
@@ -9,25 +9,68 @@ type pkg struct {
 	Cataloger               catalogerOptions `yaml:"cataloger" json:"cataloger" mapstructure:"cataloger"`
 	SearchUnindexedArchives bool             `yaml:"search-unindexed-archives" json:"search-unindexed-archives" mapstructure:"search-unindexed-archives"`
 	SearchIndexedArchives   bool             `yaml:"search-indexed-archives" json:"search-indexed-archives" mapstructure:"search-indexed-archives"`
+	SearchSourceMaps        bool             `yaml:"search-source-maps" json:"search-source-maps" mapstructure:"search-source-maps"`
+	ArchiveLimits           archiveLimits    `yaml:"archive-limits" json:"archive-limits" mapstructure:"archive-limits"`
+	NestedImages            bool             `yaml:"nested-images" json:"nested-images" mapstructure:"nested-images"`
+	HistoryHints            bool             `yaml:"history-hints" json:"history-hints" mapstructure:"history-hints"`
+	Golang                  golangOptions    `yaml:"golang" json:"golang" mapstructure:"golang"`                            // options that only apply to go packages
+	Java                    javaOptions      `yaml:"java" json:"java" mapstructure:"java"`                                  // options that only apply to java packages
+	Python                  pythonOptions    `yaml:"python" json:"python" mapstructure:"python"`                            // options that only apply to python packages
+	Rust                    rustOptions      `yaml:"rust" json:"rust" mapstructure:"rust"`                                  // options that only apply to rust packages
+	PURLRegistries          []purlRegistry   `yaml:"purl-registries" json:"purl-registries" mapstructure:"purl-registries"` // internal registries whose packages are given canonical package URLs
+	Ownership               ownership        `yaml:"ownership" json:"ownership" mapstructure:"ownership"`                   // rules attaching organizational metadata (e.g. the owning team) to packages
 }
 
 func (cfg pkg) loadDefaultValues(v *viper.Viper) {
 	cfg.Cataloger.loadDefaultValues(v)
+	cfg.ArchiveLimits.loadDefaultValues(v)
+	cfg.Golang.loadDefaultValues(v)
+	cfg.Java.loadDefaultValues(v)
+	cfg.Python.loadDefaultValues(v)
+	cfg.Rust.loadDefaultValues(v)
+	cfg.Ownership.loadDefaultValues(v)
 	c := cataloger.DefaultSearchConfig()
 	v.SetDefault("package.search-unindexed-archives", c.IncludeUnindexedArchives)
 	v.SetDefault("package.search-indexed-archives", c.IncludeIndexedArchives)
+	v.SetDefault("package.search-source-maps", c.IncludeSourceMaps)
+	v.SetDefault("package.nested-images", false)
+	v.SetDefault("package.history-hints", false)
 }
 
 func (cfg *pkg) parseConfigValues() error {
-	return cfg.Cataloger.parseConfigValues()
+	if err := cfg.Cataloger.parseConfigValues(); err != nil {
+		return err
+	}
+	var err error
+	if cfg.PURLRegistries, err = parsePURLRegistries(cfg.PURLRegistries); err != nil {
+		return err
+	}
+	if err := cfg.Ownership.parseConfigValues(); err != nil {
+		return err
+	}
+	return cfg.ArchiveLimits.parseConfigValues()
 }
 
 func (cfg pkg) ToConfig() cataloger.Config {
+	var registryMappings []cataloger.RegistryMapping
+	for _, r := range cfg.PURLRegistries {
+		registryMappings = append(registryMappings, r.ToConfig())
+	}
+
 	return cataloger.Config{
 		Search: cataloger.SearchConfig{
 			IncludeIndexedArchives:   cfg.SearchIndexedArchives,
 			IncludeUnindexedArchives: cfg.SearchUnindexedArchives,
+			IncludeSourceMaps:        cfg.SearchSourceMaps,
 			Scope:                    cfg.Cataloger.ScopeOpt,
+			MaxDepthByCataloger:      cfg.Cataloger.SearchDepth,
+			ArchiveLimits:            cfg.ArchiveLimits.ToConfig(),
 		},
+		Golang:                cfg.Golang.ToConfig(),
+		Python:                cfg.Python.ToConfig(),
+		Rust:                  cfg.Rust.ToConfig(),
+		ResolveJavaParentPoms: cfg.Java.ResolveParentPoms,
+		RegistryMappings:      registryMappings,
+		OwnershipRules:        cfg.Ownership.ToConfig(),
 	}
 }
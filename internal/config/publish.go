@@ -0,0 +1,61 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/anchore/syft/internal/publish"
+	"github.com/anchore/syft/syft/format"
+	"github.com/spf13/viper"
+)
+
+type kafkaPublish struct {
+	Brokers []string `yaml:"brokers" json:"brokers" mapstructure:"brokers"` // broker addresses (host:port); setting this enables publishing to kafka
+	Topic   string   `yaml:"topic" json:"topic" mapstructure:"topic"`
+	// the largest message that will be sent (must not exceed the broker/topic max.message.bytes setting)
+	MaxMessageBytes int64 `yaml:"max-message-bytes" json:"max-message-bytes" mapstructure:"max-message-bytes"`
+}
+
+type natsPublish struct {
+	URL     string `yaml:"url" json:"url" mapstructure:"url"` // server URL (nats://host:port); setting this enables publishing to NATS
+	Subject string `yaml:"subject" json:"subject" mapstructure:"subject"`
+}
+
+type publishConfig struct {
+	Format    string        `yaml:"format" json:"format" mapstructure:"format"` // the format of the published SBOM (sbom mode only)
+	FormatOpt format.Option `yaml:"-" json:"-"`
+	Mode      string        `yaml:"mode" json:"mode" mapstructure:"mode"` // "sbom" (one message per SBOM) or "package" (one message per package)
+	ModeOpt   publish.Mode  `yaml:"-" json:"-"`
+	Kafka     kafkaPublish  `yaml:"kafka" json:"kafka" mapstructure:"kafka"`
+	NATS      natsPublish   `yaml:"nats" json:"nats" mapstructure:"nats"`
+}
+
+func (cfg publishConfig) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("publish.format", string(format.JSONOption))
+	v.SetDefault("publish.mode", string(publish.SBOMMode))
+	v.SetDefault("publish.kafka.brokers", []string{})
+	v.SetDefault("publish.kafka.topic", "syft-sboms")
+	v.SetDefault("publish.kafka.max-message-bytes", 1024*1024)
+	v.SetDefault("publish.nats.url", "")
+	v.SetDefault("publish.nats.subject", "syft.sboms")
+}
+
+func (cfg *publishConfig) parseConfigValues() error {
+	cfg.FormatOpt = format.ParseOption(cfg.Format)
+	if cfg.FormatOpt == format.UnknownFormatOption {
+		return fmt.Errorf("bad publish format: %q", cfg.Format)
+	}
+
+	cfg.ModeOpt = publish.Mode(cfg.Mode)
+	switch cfg.ModeOpt {
+	case publish.SBOMMode, publish.PackageMode:
+	default:
+		return fmt.Errorf("bad publish mode: %q (options: %v)", cfg.Mode, publish.AllModes)
+	}
+
+	return nil
+}
+
+// Enabled indicates if any message broker has been configured to publish to.
+func (cfg publishConfig) Enabled() bool {
+	return len(cfg.Kafka.Brokers) > 0 || cfg.NATS.URL != ""
+}
@@ -0,0 +1,60 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/anchore/syft/syft/pkg/cataloger"
+)
+
+// purlRegistry describes an internal package registry (e.g. a mirror or proxy of a public registry) so that the
+// packages downloaded from it are still identified canonically by their package URL.
+type purlRegistry struct {
+	Host          string            `yaml:"host" json:"host" mapstructure:"host"`                               // the hostname of the internal registry
+	RepositoryURL string            `yaml:"repository-url" json:"repository-url" mapstructure:"repository-url"` // the repository_url purl qualifier for its packages (empty to leave it out)
+	Namespaces    map[string]string `yaml:"namespaces" json:"namespaces" mapstructure:"namespaces"`             // purl namespaces used by the registry, mapped to the canonical namespace
+}
+
+// parsePURLRegistries adds the registry given by environment variables (if any) and checks that every registry is
+// given once.
+func parsePURLRegistries(registries []purlRegistry) ([]purlRegistry, error) {
+	if host := os.Getenv("SYFT_PACKAGE_PURL_REGISTRIES_HOST"); host != "" {
+		namespaces, err := stringToMapHookFunc()(reflect.TypeOf(""), reflect.TypeOf(map[string]string{}), os.Getenv("SYFT_PACKAGE_PURL_REGISTRIES_NAMESPACES"))
+		if err != nil {
+			return nil, fmt.Errorf("bad purl registry namespaces: %w", err)
+		}
+		// note: the registry given by environment variables takes precedence over on-disk configuration
+		registries = append([]purlRegistry{
+			{
+				Host:          host,
+				RepositoryURL: os.Getenv("SYFT_PACKAGE_PURL_REGISTRIES_REPOSITORY_URL"),
+				Namespaces:    namespaces.(map[string]string),
+			},
+		}, registries...)
+	}
+
+	seen := make(map[string]bool)
+	var result []purlRegistry
+	for _, r := range registries {
+		host := strings.ToLower(strings.TrimSpace(r.Host))
+		if host == "" {
+			return nil, fmt.Errorf("purl registry without a host: %+v", r)
+		}
+		if seen[host] {
+			continue
+		}
+		seen[host] = true
+		result = append(result, r)
+	}
+	return result, nil
+}
+
+func (cfg purlRegistry) ToConfig() cataloger.RegistryMapping {
+	return cataloger.RegistryMapping{
+		Host:          strings.TrimSpace(cfg.Host),
+		RepositoryURL: cfg.RepositoryURL,
+		Namespaces:    cfg.Namespaces,
+	}
+}
@@ -0,0 +1,20 @@
+package config
+
+import (
+	"github.com/anchore/syft/syft/pkg/cataloger/python"
+	"github.com/spf13/viper"
+)
+
+type pythonOptions struct {
+	GuessUnpinnedRequirements bool `yaml:"guess-unpinned-requirements" json:"guess-unpinned-requirements" mapstructure:"guess-unpinned-requirements"`
+}
+
+func (cfg pythonOptions) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("package.python.guess-unpinned-requirements", false)
+}
+
+func (cfg pythonOptions) ToConfig() python.Config {
+	return python.Config{
+		GuessUnpinnedRequirements: cfg.GuessUnpinnedRequirements,
+	}
+}
@@ -0,0 +1,23 @@
+package config
+
+import (
+	"github.com/anchore/syft/syft/pkg/cataloger/rust"
+	"github.com/spf13/viper"
+)
+
+type rustOptions struct {
+	SearchLocalRegistry bool   `yaml:"search-local-registry" json:"search-local-registry" mapstructure:"search-local-registry"`
+	LocalRegistryDir    string `yaml:"local-registry-dir" json:"local-registry-dir" mapstructure:"local-registry-dir"`
+}
+
+func (cfg rustOptions) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("package.rust.search-local-registry", false)
+	v.SetDefault("package.rust.local-registry-dir", "")
+}
+
+func (cfg rustOptions) ToConfig() rust.Config {
+	return rust.Config{
+		SearchLocalRegistry: cfg.SearchLocalRegistry,
+		LocalRegistryDir:    cfg.LocalRegistryDir,
+	}
+}
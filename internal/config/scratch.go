@@ -0,0 +1,32 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/mitchellh/go-homedir"
+	"github.com/spf13/viper"
+)
+
+type scratchConfig struct {
+	Dir     string `yaml:"dir" json:"dir" mapstructure:"dir"`                // where image layers and archives are extracted to (the platform temp dir when empty)
+	MaxSize int64  `yaml:"max-size" json:"max-size" mapstructure:"max-size"` // the max number of bytes to extract before abandoning the scan (0 = unlimited)
+}
+
+func (cfg scratchConfig) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("scratch.dir", "")
+	v.SetDefault("scratch.max-size", 0)
+}
+
+func (cfg *scratchConfig) parseConfigValues() error {
+	if cfg.MaxSize < 0 {
+		return fmt.Errorf("bad scratch max-size value: %d (must be >= 0)", cfg.MaxSize)
+	}
+	if cfg.Dir != "" {
+		dir, err := homedir.Expand(cfg.Dir)
+		if err != nil {
+			return fmt.Errorf("unable to expand scratch dir=%q: %w", cfg.Dir, err)
+		}
+		cfg.Dir = dir
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/server"
+	"github.com/spf13/viper"
+)
+
+type ScheduledTarget struct {
+	Name     string `yaml:"name" json:"name" mapstructure:"name"`             // identifies the target in logs (default is the input)
+	Input    string `yaml:"input" json:"input" mapstructure:"input"`          // any input accepted by the packages command
+	Schedule string `yaml:"schedule" json:"schedule" mapstructure:"schedule"` // a cron expression or "@every <duration>"
+}
+
+// ServeSchemes are the kinds of inputs that may be allowed to be scanned on request (see serve.allowed-schemes).
+var ServeSchemes = []string{"registry", "docker", "podman", "docker-archive", "oci-archive", "oci-dir", "dir", "file", "host", "ssh"}
+
+type serveConfig struct {
+	Listen  string `yaml:"listen" json:"listen" mapstructure:"listen"`    // --listen, the address to serve the HTTP API on
+	Workers int    `yaml:"workers" json:"workers" mapstructure:"workers"` // --workers, the max number of scans to run at once (further requests are queued)
+	// IMPORTANT: do not show the token in any YAML/JSON output (sensitive information)
+	Token          string                   `yaml:"-" json:"-" mapstructure:"token"`                                       // the bearer token that scan requests must carry (required by the serve command)
+	AllowedSchemes []string                 `yaml:"allowed-schemes" json:"allowed-schemes" mapstructure:"allowed-schemes"` // the kinds of inputs that may be scanned on request (e.g. "registry", "dir", "host")
+	Schedule       []ScheduledTarget        `yaml:"schedule" json:"schedule" mapstructure:"schedule"`                      // targets to rescan periodically, publishing SBOMs that changed
+	ScheduleOpt    []server.ScheduledTarget `yaml:"-" json:"-"`
+}
+
+func (cfg serveConfig) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("serve.listen", "localhost:8080")
+	v.SetDefault("serve.workers", 2)
+	v.SetDefault("serve.token", "")
+	v.SetDefault("serve.allowed-schemes", []string{"registry"})
+	v.SetDefault("serve.schedule", []ScheduledTarget{})
+}
+
+func (cfg *serveConfig) parseConfigValues() error {
+	if cfg.Workers < 1 {
+		return fmt.Errorf("serve workers must be at least 1 (got %d)", cfg.Workers)
+	}
+
+	allowable := internal.NewStringSetFromSlice(ServeSchemes)
+	for _, scheme := range cfg.AllowedSchemes {
+		if !allowable.Contains(scheme) {
+			return fmt.Errorf("bad serve allowed-schemes value: %q (allowable: %s)", scheme, strings.Join(ServeSchemes, ", "))
+		}
+	}
+
+	names := make(map[string]bool)
+	cfg.ScheduleOpt = nil
+	for i, t := range cfg.Schedule {
+		if t.Input == "" {
+			return fmt.Errorf("scheduled target %d has no input", i+1)
+		}
+		if t.Name == "" {
+			cfg.Schedule[i].Name = t.Input
+			t.Name = t.Input
+		}
+		if names[t.Name] {
+			return fmt.Errorf("scheduled target name %q is not unique", t.Name)
+		}
+		names[t.Name] = true
+
+		schedule, err := server.ParseSchedule(t.Schedule)
+		if err != nil {
+			return fmt.Errorf("scheduled target %q: %w", t.Name, err)
+		}
+		cfg.ScheduleOpt = append(cfg.ScheduleOpt, server.ScheduledTarget{
+			Name:     t.Name,
+			Input:    t.Input,
+			Schedule: schedule,
+		})
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServeConfig_ParseConfigValues(t *testing.T) {
+	tests := []struct {
+		name          string
+		schedule      []ScheduledTarget
+		expectedNames []string
+		wantErr       require.ErrorAssertionFunc
+	}{
+		{
+			name: "names default to the input",
+			schedule: []ScheduledTarget{
+				{Input: "alpine:latest", Schedule: "@daily"},
+				{Name: "web", Input: "registry:example.com/web:latest", Schedule: "*/30 * * * *"},
+			},
+			expectedNames: []string{"alpine:latest", "web"},
+		},
+		{
+			name: "missing input",
+			schedule: []ScheduledTarget{
+				{Name: "web", Schedule: "@daily"},
+			},
+			wantErr: require.Error,
+		},
+		{
+			name: "bad schedule",
+			schedule: []ScheduledTarget{
+				{Input: "alpine:latest", Schedule: "every day"},
+			},
+			wantErr: require.Error,
+		},
+		{
+			name: "duplicate names",
+			schedule: []ScheduledTarget{
+				{Input: "alpine:latest", Schedule: "@daily"},
+				{Input: "alpine:latest", Schedule: "@hourly"},
+			},
+			wantErr: require.Error,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.wantErr == nil {
+				test.wantErr = require.NoError
+			}
+			cfg := serveConfig{Workers: 1, Schedule: test.schedule}
+			err := cfg.parseConfigValues()
+			test.wantErr(t, err)
+			if err != nil {
+				return
+			}
+
+			var names []string
+			for _, target := range cfg.ScheduleOpt {
+				names = append(names, target.Name)
+				assert.NotNil(t, target.Schedule)
+			}
+			assert.Equal(t, test.expectedNames, names)
+		})
+	}
+}
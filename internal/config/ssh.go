@@ -0,0 +1,26 @@
+package config
+
+import (
+	"github.com/anchore/syft/syft/source"
+	"github.com/spf13/viper"
+)
+
+type ssh struct {
+	KeyFile               string `yaml:"key-file" json:"key-file" mapstructure:"key-file"`                                                 // private key used to authenticate (default: ~/.ssh/id_* and the SSH agent)
+	KnownHostsFile        string `yaml:"known-hosts-file" json:"known-hosts-file" mapstructure:"known-hosts-file"`                         // known_hosts file used to verify the remote host key (default: ~/.ssh/known_hosts)
+	InsecureIgnoreHostKey bool   `yaml:"insecure-ignore-host-key" json:"insecure-ignore-host-key" mapstructure:"insecure-ignore-host-key"` // skip host key verification entirely
+}
+
+func (cfg ssh) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("ssh.key-file", "")
+	v.SetDefault("ssh.known-hosts-file", "")
+	v.SetDefault("ssh.insecure-ignore-host-key", false)
+}
+
+func (cfg ssh) ToOptions() source.SSHOptions {
+	return source.SSHOptions{
+		KeyFile:               cfg.KeyFile,
+		KnownHostsFile:        cfg.KnownHostsFile,
+		InsecureIgnoreHostKey: cfg.InsecureIgnoreHostKey,
+	}
+}
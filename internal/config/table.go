@@ -0,0 +1,28 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/anchore/syft/internal/formats/table"
+	"github.com/spf13/viper"
+)
+
+type tableConfig struct {
+	Columns []string `yaml:"columns" json:"columns" mapstructure:"columns"`
+	Sort    []string `yaml:"sort" json:"sort" mapstructure:"sort"`
+}
+
+func (cfg tableConfig) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("table.columns", table.DefaultColumns)
+	v.SetDefault("table.sort", []string{})
+}
+
+func (cfg *tableConfig) parseConfigValues() error {
+	if err := table.ValidateColumns(cfg.Columns); err != nil {
+		return err
+	}
+	if err := table.ValidateColumns(cfg.Sort); err != nil {
+		return fmt.Errorf("unable to sort the table: %w", err)
+	}
+	return nil
+}
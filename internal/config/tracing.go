@@ -0,0 +1,16 @@
+package config
+
+import "github.com/spf13/viper"
+
+// tracing contains the options for exporting OpenTelemetry traces of each command invocation.
+type tracing struct {
+	Enabled  bool   `yaml:"enabled" json:"enabled" mapstructure:"enabled"`    // export a trace (with spans per cataloging stage) for each command
+	Endpoint string `yaml:"endpoint" json:"endpoint" mapstructure:"endpoint"` // the OTLP/HTTP collector (host:port); when empty OTEL_EXPORTER_OTLP_ENDPOINT or localhost:4318 is used
+	Insecure bool   `yaml:"insecure" json:"insecure" mapstructure:"insecure"` // use plain HTTP instead of HTTPS when sending to the collector
+}
+
+func (cfg tracing) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("tracing.enabled", false)
+	v.SetDefault("tracing.endpoint", "")
+	v.SetDefault("tracing.insecure", false)
+}
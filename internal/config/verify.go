@@ -0,0 +1,28 @@
+package config
+
+import (
+	"github.com/anchore/syft/internal/verify"
+	"github.com/spf13/viper"
+)
+
+type verifyConfig struct {
+	Keys             []string `yaml:"keys" json:"keys" mapstructure:"keys"`                                        // --verify-key, public keys trusted to sign images; setting this enables verification
+	AttestationTypes []string `yaml:"attestation-types" json:"attestation-types" mapstructure:"attestation-types"` // predicate types that must have a valid attestation
+	Enforce          bool     `yaml:"enforce" json:"enforce" mapstructure:"enforce"`                               // refuse to catalog images that cannot be verified
+}
+
+func (cfg verifyConfig) loadDefaultValues(v *viper.Viper) {
+	v.SetDefault("verify.keys", []string{})
+	v.SetDefault("verify.attestation-types", []string{})
+	v.SetDefault("verify.enforce", true)
+}
+
+// Enabled indicates if images should be verified before being cataloged.
+func (cfg verifyConfig) Enabled() bool {
+	return len(cfg.Keys) > 0
+}
+
+// ToPolicy loads all configured public keys into a verification policy.
+func (cfg verifyConfig) ToPolicy() (verify.Policy, error) {
+	return verify.NewPolicy(cfg.Keys, cfg.AttestationTypes)
+}
@@ -0,0 +1,93 @@
+package enrichment
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/anchore/syft/internal/log"
+)
+
+// cache keeps the package details of registry responses by URL, in memory for the current scan and optionally on disk
+// for subsequent scans.
+type cache struct {
+	dir     string
+	ttl     time.Duration
+	entries map[string]details
+}
+
+func newCache(dir string, ttl time.Duration) *cache {
+	return &cache{
+		dir:     dir,
+		ttl:     ttl,
+		entries: make(map[string]details),
+	}
+}
+
+func (c *cache) get(url string) (details, bool) {
+	if d, ok := c.entries[url]; ok {
+		return d, true
+	}
+	if c.dir == "" {
+		return details{}, false
+	}
+
+	path := c.path(url)
+	info, err := os.Stat(path)
+	if err != nil {
+		return details{}, false
+	}
+	if c.ttl > 0 && time.Since(info.ModTime()) > c.ttl {
+		return details{}, false
+	}
+
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		log.Debugf("unable to read enrichment cache entry=%q: %+v", path, err)
+		return details{}, false
+	}
+	var d details
+	if err := json.Unmarshal(contents, &d); err != nil {
+		log.Debugf("unable to parse enrichment cache entry=%q: %+v", path, err)
+		return details{}, false
+	}
+	c.entries[url] = d
+	return d, true
+}
+
+func (c *cache) set(url string, d details) {
+	c.entries[url] = d
+	if c.dir == "" {
+		return
+	}
+
+	contents, err := json.Marshal(d)
+	if err != nil {
+		log.Debugf("unable to encode enrichment cache entry for %s: %+v", url, err)
+		return
+	}
+	if err := os.MkdirAll(c.dir, 0755); err != nil {
+		log.Warnf("unable to create enrichment cache directory: %+v", err)
+		return
+	}
+
+	// write to a temporary file first so a concurrent scan never reads a partially written entry
+	path := c.path(url)
+	tempPath := path + ".tmp"
+	if err := ioutil.WriteFile(tempPath, contents, 0644); err != nil {
+		log.Warnf("unable to write enrichment cache entry: %+v", err)
+		return
+	}
+	if err := os.Rename(tempPath, path); err != nil {
+		log.Warnf("unable to write enrichment cache entry: %+v", err)
+	}
+}
+
+// path returns the cache file of the given URL.
+func (c *cache) path(url string) string {
+	return filepath.Join(c.dir, fmt.Sprintf("%x.json", sha256.Sum256([]byte(url))))
+}
@@ -0,0 +1,199 @@
+/*
+Package enrichment backfills the package details that could not be found within the scanned source (licenses and
+descriptions) from public package registries. This is the only part of package cataloging that reaches out to the
+network, so it is never performed unless explicitly enabled.
+*/
+package enrichment
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/source"
+)
+
+// maxResponseSize bounds the registry responses that are read (npm documents of popular packages can be large).
+const maxResponseSize = 10 * 1024 * 1024
+
+type Config struct {
+	Registries        []string      // the registries to query (see AllRegistries), all registries when empty
+	CacheDir          string        // where registry responses are kept between scans (responses are only cached in memory when empty)
+	CacheTTL          time.Duration // how long a cached response is used before it is fetched again (0 = forever)
+	RequestsPerSecond float64       // the max rate of registry requests (0 = unlimited)
+	Timeout           time.Duration // the timeout of each registry request
+}
+
+// Enricher backfills package details from package registries. An Enricher may be shared by concurrent scans, in which
+// case the packages are enriched one catalog at a time (so the request rate limit applies to all scans).
+type Enricher struct {
+	registries map[pkg.Type]registry
+	client     *http.Client
+	cache      *cache
+	limiter    *limiter
+	lock       sync.Mutex
+}
+
+// errRegistryUnavailable is returned for requests that could not reach a registry at all (as opposed to a registry
+// responding with an error).
+var errRegistryUnavailable = errors.New("registry unavailable")
+
+// NewEnricher creates an Enricher querying the configured registries.
+func NewEnricher(cfg Config) (*Enricher, error) {
+	names := cfg.Registries
+	if len(names) == 0 {
+		names = AllRegistries
+	}
+
+	registries := make(map[pkg.Type]registry)
+	for _, name := range names {
+		r, ok := defaultRegistries[strings.ToLower(name)]
+		if !ok {
+			return nil, fmt.Errorf("unknown enrichment registry %q (options: %s)", name, strings.Join(AllRegistries, ", "))
+		}
+		for _, t := range r.types() {
+			registries[t] = r
+		}
+	}
+
+	return &Enricher{
+		registries: registries,
+		client:     &http.Client{Timeout: cfg.Timeout},
+		cache:      newCache(cfg.CacheDir, cfg.CacheTTL),
+		limiter:    newLimiter(cfg.RequestsPerSecond),
+	}, nil
+}
+
+// Enrich returns a copy of the given catalog where the packages missing a license or description have been filled in
+// from their registry. Packages keep their IDs (so existing relationships remain valid), and details found within the
+// scanned source are never replaced. Packages that could not be looked up are reported as warnings.
+func (e *Enricher) Enrich(catalog *pkg.Catalog) (*pkg.Catalog, []source.Warning) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	var warnings []source.Warning
+	unavailable := make(map[string]bool)
+
+	var packages []pkg.Package
+	for _, p := range catalog.Sorted() {
+		r, ok := e.registries[p.Type]
+		if !ok || !missingDetails(p) {
+			packages = append(packages, p)
+			continue
+		}
+
+		url, ok := r.url(p)
+		if !ok || unavailable[r.name()] {
+			packages = append(packages, p)
+			continue
+		}
+
+		d, err := e.fetch(r, url)
+		if err != nil {
+			warning := source.Warning{
+				Message: fmt.Sprintf("unable to enrich package %s@%s from the %s registry: %v", p.Name, p.Version, r.name(), err),
+			}
+			if len(p.Locations) > 0 {
+				warning.Path = p.Locations[0].RealPath
+			}
+			if errors.Is(err, errRegistryUnavailable) {
+				// don't wait on every remaining package of an unreachable registry
+				warning.Message += " (skipping the remaining packages)"
+				unavailable[r.name()] = true
+			}
+			warnings = append(warnings, warning)
+			packages = append(packages, p)
+			continue
+		}
+
+		packages = append(packages, withDetails(p, d))
+	}
+
+	return pkg.NewCatalog(packages...), warnings
+}
+
+// fetch returns the package details found at the given registry URL, preferring cached responses.
+func (e *Enricher) fetch(r registry, url string) (details, error) {
+	if d, ok := e.cache.get(url); ok {
+		return d, nil
+	}
+
+	e.limiter.wait()
+	log.Debugf("fetching package details from %s", url)
+	resp, err := e.client.Get(url)
+	if err != nil {
+		return details{}, fmt.Errorf("%w: %v", errRegistryUnavailable, err)
+	}
+	defer resp.Body.Close()
+
+	var d details
+	switch resp.StatusCode {
+	case http.StatusOK:
+		contents, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+		if err != nil {
+			return details{}, fmt.Errorf("failed to read registry response: %w", err)
+		}
+		d, err = r.parse(contents)
+		if err != nil {
+			return details{}, fmt.Errorf("failed to parse registry response: %w", err)
+		}
+	case http.StatusNotFound:
+		// the package is not published to the registry (e.g. a private package), which is worth remembering so the
+		// registry isn't asked again
+	default:
+		return details{}, fmt.Errorf("HTTP %d on fetching %s", resp.StatusCode, url)
+	}
+
+	e.cache.set(url, d)
+	return d, nil
+}
+
+// missingDetails indicates if the package lacks any of the details that registries may provide.
+func missingDetails(p pkg.Package) bool {
+	if len(p.Licenses) == 0 {
+		return true
+	}
+	switch m := p.Metadata.(type) {
+	case pkg.NpmPackageJSONMetadata:
+		return m.Description == ""
+	case pkg.JavaMetadata:
+		return m.PomProject != nil && m.PomProject.Description == ""
+	}
+	return false
+}
+
+// withDetails fills in the missing details of the given package. Descriptions are only recorded for packages with
+// metadata that has a description field.
+func withDetails(p pkg.Package, d details) pkg.Package {
+	if len(p.Licenses) == 0 && len(d.Licenses) > 0 {
+		p.Licenses = internal.NewStringSetFromSlice(d.Licenses).ToSlice()
+	}
+
+	if d.Description == "" {
+		return p
+	}
+	switch m := p.Metadata.(type) {
+	case pkg.NpmPackageJSONMetadata:
+		if m.Description == "" {
+			m.Description = d.Description
+			p.Metadata = m
+		}
+	case pkg.JavaMetadata:
+		if m.PomProject != nil && m.PomProject.Description == "" {
+			// copy the project, the original may be shared with other packages
+			project := *m.PomProject
+			project.Description = d.Description
+			m.PomProject = &project
+			p.Metadata = m
+		}
+	}
+	return p
+}
@@ -0,0 +1,210 @@
+package enrichment
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/source"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testRegistryResponses = map[string]string{
+	"/lodash/4.17.21":            `{"name": "lodash", "license": "MIT", "description": "Lodash modular utilities."}`,
+	"/@babel%2Fcore/7.0.0":       `{"name": "@babel/core", "license": {"type": "MIT"}, "description": "Babel compiler core."}`,
+	"/express/4.17.1":            `{"name": "express", "license": "MIT", "description": "Fast, unopinionated, minimalist web framework"}`,
+	"/pypi/requests/2.25.1/json": `{"info": {"license": "Apache 2.0", "summary": "Python HTTP for Humans."}}`,
+	"/org/apache/commons/commons-text/1.9/commons-text-1.9.pom": `<project>
+  <description>
+    Apache Commons Text is a library focused on algorithms working on strings.
+  </description>
+  <licenses>
+    <license><name>Apache License, Version 2.0</name></license>
+  </licenses>
+</project>`,
+}
+
+func newTestRegistryServer(t *testing.T) (*httptest.Server, *int) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		response, ok := testRegistryResponses[r.URL.EscapedPath()]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, response)
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func newTestEnricher(baseURL, cacheDir string) *Enricher {
+	return &Enricher{
+		registries: map[pkg.Type]registry{
+			pkg.NpmPkg:    npmRegistry{baseURL: baseURL},
+			pkg.PythonPkg: pypiRegistry{baseURL: baseURL},
+			pkg.JavaPkg:   mavenRegistry{baseURL: baseURL},
+		},
+		client:  http.DefaultClient,
+		cache:   newCache(cacheDir, 0),
+		limiter: newLimiter(0),
+	}
+}
+
+func newTestCatalog() *pkg.Catalog {
+	packages := []pkg.Package{
+		{
+			// found in a lock file, without any details
+			Name:    "lodash",
+			Version: "4.17.21",
+			Type:    pkg.NpmPkg,
+		},
+		{
+			Name:    "@babel/core",
+			Version: "7.0.0",
+			Type:    pkg.NpmPkg,
+		},
+		{
+			// the license found in the source is kept, only the description is missing
+			Name:         "express",
+			Version:      "4.17.1",
+			Licenses:     []string{"MIT-0"},
+			Type:         pkg.NpmPkg,
+			MetadataType: pkg.NpmPackageJSONMetadataType,
+			Metadata:     pkg.NpmPackageJSONMetadata{Licenses: []string{"MIT-0"}},
+		},
+		{
+			// not published to the registry
+			Name:    "internal-tool",
+			Version: "1.0.0",
+			Type:    pkg.NpmPkg,
+		},
+		{
+			Name:    "requests",
+			Version: "2.25.1",
+			Type:    pkg.PythonPkg,
+		},
+		{
+			Name:         "commons-text",
+			Version:      "1.9",
+			Type:         pkg.JavaPkg,
+			MetadataType: pkg.JavaMetadataType,
+			Metadata: pkg.JavaMetadata{
+				PomProperties: &pkg.PomProperties{GroupID: "org.apache.commons", ArtifactID: "commons-text", Version: "1.9"},
+				PomProject:    &pkg.PomProject{GroupID: "org.apache.commons", ArtifactID: "commons-text", Version: "1.9"},
+			},
+		},
+		{
+			// no registry for this package type
+			Name:    "musl",
+			Version: "1.2.2",
+			Type:    pkg.ApkPkg,
+		},
+	}
+
+	for i := range packages {
+		packages[i].SetID()
+	}
+	return pkg.NewCatalog(packages...)
+}
+
+func TestEnricher_Enrich(t *testing.T) {
+	server, requests := newTestRegistryServer(t)
+	cacheDir := t.TempDir()
+
+	catalog := newTestCatalog()
+	enriched, warnings := newTestEnricher(server.URL, cacheDir).Enrich(catalog)
+	assert.Empty(t, warnings)
+	assert.Equal(t, 6, *requests)
+
+	byName := make(map[string]pkg.Package)
+	for p := range enriched.Enumerate() {
+		byName[p.Name] = p
+		// the package IDs are not affected
+		assert.NotNil(t, catalog.Package(p.ID()), p.Name)
+	}
+	require.Len(t, byName, 7)
+
+	assert.Equal(t, []string{"MIT"}, byName["lodash"].Licenses)
+	assert.Equal(t, []string{"MIT"}, byName["@babel/core"].Licenses)
+	assert.Equal(t, []string{"MIT-0"}, byName["express"].Licenses)
+	assert.Equal(t, "Fast, unopinionated, minimalist web framework", byName["express"].Metadata.(pkg.NpmPackageJSONMetadata).Description)
+	assert.Empty(t, byName["internal-tool"].Licenses)
+	assert.Equal(t, []string{"Apache 2.0"}, byName["requests"].Licenses)
+	assert.Equal(t, []string{"Apache License, Version 2.0"}, byName["commons-text"].Licenses)
+	assert.Equal(t, "Apache Commons Text is a library focused on algorithms working on strings.", byName["commons-text"].Metadata.(pkg.JavaMetadata).PomProject.Description)
+	assert.Empty(t, byName["musl"].Licenses)
+
+	// a later scan is served from the cache (including the packages that are not in the registry)
+	_, warnings = newTestEnricher(server.URL, cacheDir).Enrich(newTestCatalog())
+	assert.Empty(t, warnings)
+	assert.Equal(t, 6, *requests)
+}
+
+func TestEnricher_Enrich_UnavailableRegistry(t *testing.T) {
+	server, _ := newTestRegistryServer(t)
+	server.Close()
+
+	catalog := pkg.NewCatalog()
+	for _, name := range []string{"lodash", "express"} {
+		p := pkg.Package{
+			Name:      name,
+			Version:   "1.0.0",
+			Type:      pkg.NpmPkg,
+			Locations: []source.Location{source.NewLocation("/package-lock.json")},
+		}
+		p.SetID()
+		catalog.Add(p)
+	}
+
+	enriched, warnings := newTestEnricher(server.URL, "").Enrich(catalog)
+	assert.Equal(t, 2, enriched.PackageCount())
+
+	// the remaining packages of an unreachable registry are skipped
+	require.Len(t, warnings, 1)
+	assert.Equal(t, "/package-lock.json", warnings[0].Path)
+	assert.Contains(t, warnings[0].Message, "unable to enrich package express@1.0.0 from the npm registry")
+}
+
+func TestPyPIRegistry_Parse(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		expected []string
+	}{
+		{
+			name:     "license expression",
+			response: `{"info": {"license": "BSD", "license_expression": "BSD-3-Clause"}}`,
+			expected: []string{"BSD-3-Clause"},
+		},
+		{
+			name:     "license text falls back to classifiers",
+			response: `{"info": {"license": "Copyright (c) 2020\nPermission is hereby granted", "classifiers": ["Programming Language :: Python", "License :: OSI Approved :: MIT License"]}}`,
+			expected: []string{"MIT License"},
+		},
+		{
+			name:     "no license",
+			response: `{"info": {"classifiers": ["License :: OSI Approved"]}}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			d, err := pypiRegistry{}.parse([]byte(test.response))
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, d.Licenses)
+		})
+	}
+}
+
+func TestNewEnricher(t *testing.T) {
+	e, err := NewEnricher(Config{Registries: []string{"NPM"}})
+	require.NoError(t, err)
+	assert.Len(t, e.registries, 1)
+
+	_, err = NewEnricher(Config{Registries: []string{"cran"}})
+	assert.Error(t, err)
+}
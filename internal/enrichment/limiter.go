@@ -0,0 +1,33 @@
+package enrichment
+
+import (
+	"time"
+)
+
+// limiter spaces out registry requests to stay within a max request rate. Requests are made one at a time, so no
+// synchronization is needed.
+type limiter struct {
+	interval time.Duration
+	last     time.Time
+}
+
+func newLimiter(requestsPerSecond float64) *limiter {
+	var interval time.Duration
+	if requestsPerSecond > 0 {
+		interval = time.Duration(float64(time.Second) / requestsPerSecond)
+	}
+	return &limiter{interval: interval}
+}
+
+// wait blocks until the next request may be made.
+func (l *limiter) wait() {
+	if l.interval <= 0 {
+		return
+	}
+	if !l.last.IsZero() {
+		if remaining := l.interval - time.Since(l.last); remaining > 0 {
+			time.Sleep(remaining)
+		}
+	}
+	l.last = time.Now()
+}
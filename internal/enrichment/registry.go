@@ -0,0 +1,209 @@
+package enrichment
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/anchore/syft/syft/pkg"
+)
+
+const (
+	NpmRegistry   = "npm"
+	PyPIRegistry  = "pypi"
+	MavenRegistry = "maven"
+)
+
+// AllRegistries are the names of all registries packages can be enriched from.
+var AllRegistries = []string{NpmRegistry, PyPIRegistry, MavenRegistry}
+
+var defaultRegistries = map[string]registry{
+	NpmRegistry:   npmRegistry{baseURL: "https://registry.npmjs.org"},
+	PyPIRegistry:  pypiRegistry{baseURL: "https://pypi.org"},
+	MavenRegistry: mavenRegistry{baseURL: "https://repo1.maven.org/maven2"},
+}
+
+// details are the package details a registry can provide.
+type details struct {
+	Licenses    []string `json:"licenses,omitempty"`
+	Description string   `json:"description,omitempty"`
+}
+
+type registry interface {
+	name() string
+	// types are the package types published to the registry.
+	types() []pkg.Type
+	// url returns where the registry describes the given package, if the package can be looked up.
+	url(p pkg.Package) (string, bool)
+	// parse extracts the package details from the registry response.
+	parse(contents []byte) (details, error)
+}
+
+// npmRegistry reads the package version documents of the npm registry (https://registry.npmjs.org/<name>/<version>).
+type npmRegistry struct {
+	baseURL string
+}
+
+func (r npmRegistry) name() string {
+	return NpmRegistry
+}
+
+func (r npmRegistry) types() []pkg.Type {
+	return []pkg.Type{pkg.NpmPkg}
+}
+
+func (r npmRegistry) url(p pkg.Package) (string, bool) {
+	if p.Name == "" || p.Version == "" {
+		return "", false
+	}
+	// scoped packages keep the "@" but escape the "/" (e.g. "@babel%2fcore")
+	return fmt.Sprintf("%s/%s/%s", r.baseURL, url.PathEscape(p.Name), url.PathEscape(p.Version)), true
+}
+
+func (r npmRegistry) parse(contents []byte) (details, error) {
+	var doc struct {
+		Description string          `json:"description"`
+		License     json.RawMessage `json:"license"`
+		Licenses    []struct {
+			Type string `json:"type"`
+		} `json:"licenses"`
+	}
+	if err := json.Unmarshal(contents, &doc); err != nil {
+		return details{}, err
+	}
+
+	d := details{Description: strings.TrimSpace(doc.Description)}
+
+	// the license is either an SPDX expression or (deprecated) an object with a type
+	var license string
+	if err := json.Unmarshal(doc.License, &license); err != nil {
+		var licenseObj struct {
+			Type string `json:"type"`
+		}
+		if err := json.Unmarshal(doc.License, &licenseObj); err == nil {
+			license = licenseObj.Type
+		}
+	}
+	if license != "" {
+		d.Licenses = append(d.Licenses, license)
+	}
+	for _, l := range doc.Licenses {
+		if l.Type != "" {
+			d.Licenses = append(d.Licenses, l.Type)
+		}
+	}
+	return d, nil
+}
+
+// pypiRegistry reads the release metadata of the PyPI JSON API (https://pypi.org/pypi/<name>/<version>/json).
+type pypiRegistry struct {
+	baseURL string
+}
+
+func (r pypiRegistry) name() string {
+	return PyPIRegistry
+}
+
+func (r pypiRegistry) types() []pkg.Type {
+	return []pkg.Type{pkg.PythonPkg}
+}
+
+func (r pypiRegistry) url(p pkg.Package) (string, bool) {
+	if p.Name == "" || p.Version == "" {
+		return "", false
+	}
+	return fmt.Sprintf("%s/pypi/%s/%s/json", r.baseURL, url.PathEscape(p.Name), url.PathEscape(p.Version)), true
+}
+
+func (r pypiRegistry) parse(contents []byte) (details, error) {
+	var doc struct {
+		Info struct {
+			License           string   `json:"license"`
+			LicenseExpression string   `json:"license_expression"`
+			Summary           string   `json:"summary"`
+			Classifiers       []string `json:"classifiers"`
+		} `json:"info"`
+	}
+	if err := json.Unmarshal(contents, &doc); err != nil {
+		return details{}, err
+	}
+
+	d := details{Description: strings.TrimSpace(doc.Info.Summary)}
+
+	license := strings.TrimSpace(doc.Info.LicenseExpression)
+	if license == "" {
+		license = strings.TrimSpace(doc.Info.License)
+	}
+	// some projects put the full license text in the license field, which is not useful as a license name
+	if license != "" && !strings.Contains(license, "\n") && len(license) <= 100 {
+		d.Licenses = append(d.Licenses, license)
+		return d, nil
+	}
+
+	for _, classifier := range doc.Info.Classifiers {
+		// e.g. "License :: OSI Approved :: MIT License"
+		if !strings.HasPrefix(classifier, "License ::") {
+			continue
+		}
+		fields := strings.Split(classifier, "::")
+		if name := strings.TrimSpace(fields[len(fields)-1]); name != "" && name != "OSI Approved" {
+			d.Licenses = append(d.Licenses, name)
+		}
+	}
+	return d, nil
+}
+
+// mavenRegistry reads the published pom files of a maven repository (e.g. Maven Central).
+type mavenRegistry struct {
+	baseURL string
+}
+
+func (r mavenRegistry) name() string {
+	return MavenRegistry
+}
+
+func (r mavenRegistry) types() []pkg.Type {
+	return []pkg.Type{pkg.JavaPkg}
+}
+
+func (r mavenRegistry) url(p pkg.Package) (string, bool) {
+	metadata, ok := p.Metadata.(pkg.JavaMetadata)
+	if !ok || metadata.PomProperties == nil {
+		// the group ID is needed to find the artifact
+		return "", false
+	}
+	props := metadata.PomProperties
+	if props.GroupID == "" || props.ArtifactID == "" || props.Version == "" {
+		return "", false
+	}
+	return fmt.Sprintf("%s/%s/%s/%s/%s-%s.pom",
+		r.baseURL,
+		strings.ReplaceAll(props.GroupID, ".", "/"),
+		url.PathEscape(props.ArtifactID),
+		url.PathEscape(props.Version),
+		url.PathEscape(props.ArtifactID),
+		url.PathEscape(props.Version),
+	), true
+}
+
+func (r mavenRegistry) parse(contents []byte) (details, error) {
+	var project struct {
+		Description string `xml:"description"`
+		Licenses    []struct {
+			Name string `xml:"name"`
+		} `xml:"licenses>license"`
+	}
+	if err := xml.Unmarshal(contents, &project); err != nil {
+		return details{}, err
+	}
+
+	d := details{Description: strings.Join(strings.Fields(project.Description), " ")}
+	for _, l := range project.Licenses {
+		if name := strings.TrimSpace(l.Name); name != "" {
+			d.Licenses = append(d.Licenses, name)
+		}
+	}
+	return d, nil
+}
@@ -2,18 +2,88 @@ package file
 
 import (
 	"errors"
-	"fmt"
 	"io"
+	"sync"
 )
 
 const perFileReadLimit = 2 * GB
 
+// ErrReadLimitExceeded is returned when reading from an archive would exceed the configured limits.
+var ErrReadLimitExceeded = errors.New("archive read limit hit (potential decompression bomb attack)")
+
+// ReadBudget limits the number of bytes that may be read from archive entries, both for any single entry and in total
+// across all entries copied with the same budget (e.g. an archive and all archives nested within it). A nil budget
+// only enforces the default per-file limit.
+type ReadBudget struct {
+	maxFileSize  int64
+	maxTotalSize int64
+	total        int64
+	mutex        sync.Mutex
+}
+
+// NewReadBudget creates a budget with the given limits (in bytes). A non-positive max file size uses the default
+// per-file limit, and a non-positive max total size means there is no limit across entries.
+func NewReadBudget(maxFileSize, maxTotalSize int64) *ReadBudget {
+	if maxFileSize <= 0 {
+		maxFileSize = perFileReadLimit
+	}
+	return &ReadBudget{
+		maxFileSize:  maxFileSize,
+		maxTotalSize: maxTotalSize,
+	}
+}
+
+// limit returns the max number of bytes that the next entry may read.
+func (b *ReadBudget) limit() int64 {
+	if b == nil {
+		return perFileReadLimit
+	}
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.maxTotalSize <= 0 {
+		return b.maxFileSize
+	}
+	remaining := b.maxTotalSize - b.total
+	if remaining < b.maxFileSize {
+		return remaining
+	}
+	return b.maxFileSize
+}
+
+func (b *ReadBudget) consume(numBytes int64) {
+	if b == nil {
+		return
+	}
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.total += numBytes
+}
+
 // safeCopy limits the copy from the reader. This is useful when extracting files from archives to
 // protect against decompression bomb attacks.
-func safeCopy(writer io.Writer, reader io.Reader) error {
-	numBytes, err := io.Copy(writer, io.LimitReader(reader, perFileReadLimit))
-	if numBytes >= perFileReadLimit || errors.Is(err, io.EOF) {
-		return fmt.Errorf("zip read limit hit (potential decompression bomb attack)")
+func (b *ReadBudget) safeCopy(writer io.Writer, reader io.Reader) error {
+	limit := b.limit()
+	if limit <= 0 {
+		return ErrReadLimitExceeded
+	}
+	// read one byte past the limit to distinguish between an entry that is exactly at the limit and one that is over
+	numBytes, err := io.Copy(writer, io.LimitReader(reader, limit+1))
+	b.consume(numBytes)
+	if numBytes > limit || errors.Is(err, io.EOF) {
+		return ErrReadLimitExceeded
 	}
 	return nil
 }
+
+// Copy copies a single archive entry from the reader within the limits of the budget, for archive readers that do not
+// extract entries with the helpers of this package. ErrReadLimitExceeded is returned when the entry is over the limits.
+func (b *ReadBudget) Copy(writer io.Writer, reader io.Reader) error {
+	return b.safeCopy(writer, reader)
+}
+
+// safeCopy limits the copy from the reader using only the default per-file limit.
+func safeCopy(writer io.Writer, reader io.Reader) error {
+	var budget *ReadBudget
+	return budget.safeCopy(writer, reader)
+}
@@ -0,0 +1,74 @@
+package file
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadBudget_safeCopy(t *testing.T) {
+	tests := []struct {
+		name         string
+		maxFileSize  int64
+		maxTotalSize int64
+		entries      []string
+		// the index of the first entry expected to exceed the budget (-1 = none)
+		failsAt int
+	}{
+		{
+			name:    "default limits",
+			entries: []string{"hello", "world"},
+			failsAt: -1,
+		},
+		{
+			name:        "entry exactly at the per-file limit",
+			maxFileSize: 5,
+			entries:     []string{"hello", "world"},
+			failsAt:     -1,
+		},
+		{
+			name:        "entry over the per-file limit",
+			maxFileSize: 4,
+			entries:     []string{"hey", "hello"},
+			failsAt:     1,
+		},
+		{
+			name:         "total limit reached across entries",
+			maxTotalSize: 12,
+			entries:      []string{"hello", "world", "again"},
+			failsAt:      2,
+		},
+		{
+			name:         "total limit exhausted",
+			maxTotalSize: 10,
+			entries:      []string{"hello", "world", "!"},
+			failsAt:      2,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			budget := NewReadBudget(test.maxFileSize, test.maxTotalSize)
+			for idx, entry := range test.entries {
+				var buf bytes.Buffer
+				err := budget.safeCopy(&buf, strings.NewReader(entry))
+				if idx == test.failsAt {
+					require.ErrorIs(t, err, ErrReadLimitExceeded)
+					return
+				}
+				require.NoError(t, err)
+				assert.Equal(t, entry, buf.String())
+			}
+			assert.Equal(t, -1, test.failsAt, "expected the budget to be exceeded")
+		})
+	}
+}
+
+func TestReadBudget_nilUsesDefaultLimit(t *testing.T) {
+	var budget *ReadBudget
+	var buf bytes.Buffer
+	require.NoError(t, budget.safeCopy(&buf, strings.NewReader("hello")))
+	assert.Equal(t, "hello", buf.String())
+}
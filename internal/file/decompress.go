@@ -0,0 +1,67 @@
+package file
+
+import (
+	"compress/bzip2"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+)
+
+// compressedArchiveExtensions are compressed tarballs, which are archives (not compressed evidence files) and are left
+// for archive-aware parsers to handle.
+var compressedArchiveExtensions = []string{".tar.gz", ".tgz", ".tar.bz2", ".tbz2"}
+
+// IsCompressedEvidence indicates if the given path is a single compressed file (e.g. changelog.Debian.gz or a rotated
+// status.gz) whose uncompressed contents should be read instead of the raw bytes.
+func IsCompressedEvidence(path string) bool {
+	lower := strings.ToLower(path)
+	for _, ext := range compressedArchiveExtensions {
+		if strings.HasSuffix(lower, ext) {
+			return false
+		}
+	}
+	switch filepath.Ext(lower) {
+	case ".gz", ".bz2":
+		return true
+	}
+	return false
+}
+
+// decompressingReadCloser reads the uncompressed contents of a stream while closing the underlying compressed stream.
+type decompressingReadCloser struct {
+	io.Reader
+	closers []io.Closer
+}
+
+func (d decompressingReadCloser) Close() error {
+	var err error
+	for _, c := range d.closers {
+		if cerr := c.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
+
+// NewDecompressingReadCloser wraps the given reader such that the uncompressed contents are returned for compressed
+// evidence files (based on the path extension). Any other file contents are returned as-is.
+func NewDecompressingReadCloser(path string, reader io.ReadCloser) (io.ReadCloser, error) {
+	if !IsCompressedEvidence(path) {
+		return reader, nil
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".gz":
+		gzReader, err := gzip.NewReader(reader)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read gzip contents of %q: %w", path, err)
+		}
+		return decompressingReadCloser{Reader: gzReader, closers: []io.Closer{gzReader, reader}}, nil
+	case ".bz2":
+		return decompressingReadCloser{Reader: bzip2.NewReader(reader), closers: []io.Closer{reader}}, nil
+	}
+
+	return reader, nil
+}
@@ -0,0 +1,65 @@
+package file
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsCompressedEvidence(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected bool
+	}{
+		{path: "/usr/share/doc/zlib1g/changelog.Debian.gz", expected: true},
+		{path: "/var/lib/opkg/status.gz", expected: true},
+		{path: "/var/backups/dpkg.status.1.bz2", expected: true},
+		{path: "/var/lib/dpkg/status", expected: false},
+		{path: "/app/example-app-0.1.0.tar.gz", expected: false},
+		{path: "/app/example-app-0.1.0.TGZ", expected: false},
+		{path: "/app/example-app-0.1.0.tar.bz2", expected: false},
+	}
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			assert.Equal(t, test.expected, IsCompressedEvidence(test.path))
+		})
+	}
+}
+
+func TestNewDecompressingReadCloser(t *testing.T) {
+	expected, err := ioutil.ReadFile("test-fixtures/compressed/status.txt")
+	require.NoError(t, err)
+
+	tests := []string{
+		"test-fixtures/compressed/status.txt",
+		"test-fixtures/compressed/status.gz",
+		"test-fixtures/compressed/status.bz2",
+	}
+	for _, fixture := range tests {
+		t.Run(fixture, func(t *testing.T) {
+			f, err := os.Open(fixture)
+			require.NoError(t, err)
+
+			reader, err := NewDecompressingReadCloser(fixture, f)
+			require.NoError(t, err)
+
+			actual, err := ioutil.ReadAll(reader)
+			require.NoError(t, err)
+			require.NoError(t, reader.Close())
+
+			assert.Equal(t, string(expected), string(actual))
+		})
+	}
+}
+
+func TestNewDecompressingReadCloser_InvalidGzip(t *testing.T) {
+	f, err := os.Open("test-fixtures/compressed/status.txt")
+	require.NoError(t, err)
+	defer f.Close()
+
+	_, err = NewDecompressingReadCloser("status.gz", f)
+	assert.Error(t, err)
+}
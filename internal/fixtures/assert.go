@@ -0,0 +1,32 @@
+package fixtures
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"github.com/sergi/go-diff/diffmatchpatch"
+	"github.com/stretchr/testify/require"
+)
+
+// AssertCatalogerAgainstGolden catalogs the given fixture directory and compares the packages found with the golden
+// file of the fixture (see Snapshot). The golden file is replaced with the current results when update is set.
+func AssertCatalogerAgainstGolden(t *testing.T, c Cataloger, fixtureDir string, update bool) {
+	t.Helper()
+
+	actual, err := Render(c, fixtureDir)
+	require.NoError(t, err)
+
+	if update {
+		require.NoError(t, ioutil.WriteFile(GoldenPath(fixtureDir), actual, 0644))
+	}
+
+	expected, err := ioutil.ReadFile(GoldenPath(fixtureDir))
+	require.NoError(t, err, "unable to read the golden file (create it with 'syft internal fixtures')")
+
+	if !bytes.Equal(expected, actual) {
+		dmp := diffmatchpatch.New()
+		diffs := dmp.DiffMain(string(expected), string(actual), true)
+		t.Errorf("mismatched packages for fixture %q:\n%s", fixtureDir, dmp.DiffPrettyText(diffs))
+	}
+}
@@ -0,0 +1,225 @@
+/*
+Package fixtures snapshots real-world files (lockfiles, package databases, etc.) into cataloger test fixtures, along
+with a golden file describing the packages the cataloger finds within them, and asserts catalogers against these
+golden files.
+*/
+package fixtures
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/source"
+)
+
+// Cataloger is a package cataloger (see cataloger.Cataloger, which cannot be referenced from the tests of the
+// catalogers themselves).
+type Cataloger interface {
+	Name() string
+	Catalog(resolver source.FileResolver) ([]pkg.Package, []artifact.Relationship, error)
+}
+
+// goldenPackage is the description of a package within a golden file. The package ID is left out, so that golden files
+// do not change with the package ID hashing.
+type goldenPackage struct {
+	Name         string           `json:"name"`
+	Version      string           `json:"version"`
+	Type         pkg.Type         `json:"type"`
+	FoundBy      string           `json:"foundBy"`
+	Locations    []string         `json:"locations"`
+	Licenses     []string         `json:"licenses"`
+	Language     pkg.Language     `json:"language"`
+	PURL         string           `json:"purl"`
+	CPEs         []string         `json:"cpes"`
+	MetadataType pkg.MetadataType `json:"metadataType"`
+	Metadata     interface{}      `json:"metadata"`
+}
+
+type golden struct {
+	Packages      []goldenPackage `json:"packages"`
+	Relationships []string        `json:"relationships"` // "<from> <type> <to>", where packages are "<name>@<version>"
+}
+
+// GoldenPath returns the path of the golden file of the given fixture directory (<fixture-dir>.golden).
+func GoldenPath(fixtureDir string) string {
+	return filepath.Clean(fixtureDir) + ".golden"
+}
+
+// Snapshot copies the given files and directories into a new fixture directory (<dir>/<name>), and writes the packages
+// the cataloger finds within the fixture to its golden file. The number of packages found is returned.
+func Snapshot(c Cataloger, dir, name string, paths ...string) (int, error) {
+	if len(paths) == 0 {
+		return 0, fmt.Errorf("no files given to create fixture %q from", name)
+	}
+
+	fixtureDir := filepath.Join(dir, name)
+	if _, err := os.Stat(fixtureDir); err == nil {
+		return 0, fmt.Errorf("fixture already exists: %s", fixtureDir)
+	}
+
+	for _, p := range paths {
+		if err := copyPath(p, filepath.Join(fixtureDir, filepath.Base(p))); err != nil {
+			return 0, fmt.Errorf("unable to copy %q into the fixture: %w", p, err)
+		}
+	}
+
+	contents, count, err := render(c, fixtureDir)
+	if err != nil {
+		return 0, err
+	}
+	if err := ioutil.WriteFile(GoldenPath(fixtureDir), contents, 0644); err != nil {
+		return 0, fmt.Errorf("unable to write golden file: %w", err)
+	}
+	return count, nil
+}
+
+// Render catalogs the given fixture directory and returns the contents of its golden file.
+func Render(c Cataloger, fixtureDir string) ([]byte, error) {
+	contents, _, err := render(c, fixtureDir)
+	return contents, err
+}
+
+func render(c Cataloger, fixtureDir string) ([]byte, int, error) {
+	src, err := source.NewFromDirectory(fixtureDir)
+	if err != nil {
+		return nil, 0, fmt.Errorf("unable to create source for fixture %q: %w", fixtureDir, err)
+	}
+	resolver, err := src.FileResolver(source.SquashedScope)
+	if err != nil {
+		return nil, 0, fmt.Errorf("unable to create resolver for fixture %q: %w", fixtureDir, err)
+	}
+
+	packages, relationships, err := c.Catalog(resolver)
+	if err != nil {
+		return nil, 0, fmt.Errorf("cataloger %q failed on fixture %q: %w", c.Name(), fixtureDir, err)
+	}
+
+	doc := golden{
+		Packages:      toGoldenPackages(packages),
+		Relationships: toGoldenRelationships(relationships),
+	}
+	contents, err := json.MarshalIndent(doc, "", " ")
+	if err != nil {
+		return nil, 0, fmt.Errorf("unable to encode golden file: %w", err)
+	}
+	return append(contents, '\n'), len(packages), nil
+}
+
+func toGoldenPackages(packages []pkg.Package) []goldenPackage {
+	results := make([]goldenPackage, 0, len(packages))
+	for _, p := range packages {
+		locations := make([]string, 0, len(p.Locations))
+		for _, l := range p.Locations {
+			locations = append(locations, l.RealPath)
+		}
+		cpes := make([]string, 0, len(p.CPEs))
+		for _, c := range p.CPEs {
+			cpes = append(cpes, pkg.CPEString(c))
+		}
+		results = append(results, goldenPackage{
+			Name:         p.Name,
+			Version:      p.Version,
+			Type:         p.Type,
+			FoundBy:      p.FoundBy,
+			Locations:    locations,
+			Licenses:     p.Licenses,
+			Language:     p.Language,
+			PURL:         p.PURL,
+			CPEs:         cpes,
+			MetadataType: p.MetadataType,
+			Metadata:     p.Metadata,
+		})
+	}
+
+	// catalogers do not need to return packages in a stable order
+	sort.SliceStable(results, func(i, j int) bool {
+		a, b := results[i], results[j]
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		if a.Version != b.Version {
+			return a.Version < b.Version
+		}
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		return strings.Join(a.Locations, ",") < strings.Join(b.Locations, ",")
+	})
+	return results
+}
+
+func toGoldenRelationships(relationships []artifact.Relationship) []string {
+	results := make([]string, 0, len(relationships))
+	for _, r := range relationships {
+		results = append(results, fmt.Sprintf("%s %s %s", describe(r.From), r.Type, describe(r.To)))
+	}
+	sort.Strings(results)
+	return results
+}
+
+func describe(i artifact.Identifiable) string {
+	switch v := i.(type) {
+	case pkg.Package:
+		return v.Name + "@" + v.Version
+	case source.Coordinates:
+		return v.RealPath
+	case source.Location:
+		return v.RealPath
+	}
+	return string(i.ID())
+}
+
+// copyPath copies a file, or a directory recursively, to the given destination. Only regular files are copied.
+func copyPath(from, to string) error {
+	return filepath.Walk(from, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(from, path)
+		if err != nil {
+			return err
+		}
+		dest := filepath.Join(to, rel)
+
+		switch {
+		case info.IsDir():
+			return os.MkdirAll(dest, 0755)
+		case !info.Mode().IsRegular():
+			log.Warnf("skipping %q for the fixture: not a regular file", path)
+			return nil
+		}
+		return copyFile(path, dest)
+	})
+}
+
+func copyFile(from, to string) error {
+	if err := os.MkdirAll(filepath.Dir(to), 0755); err != nil {
+		return err
+	}
+
+	in, err := os.Open(from)
+	if err != nil {
+		return err
+	}
+	defer internal.CloseAndLogError(in, from)
+
+	out, err := os.OpenFile(to, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
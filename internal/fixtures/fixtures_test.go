@@ -0,0 +1,78 @@
+package fixtures
+
+import (
+	"bufio"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/source"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// requirementsCataloger finds a package for each pinned requirement within requirements.txt files.
+type requirementsCataloger struct{}
+
+func (requirementsCataloger) Name() string {
+	return "requirements-cataloger"
+}
+
+func (c requirementsCataloger) Catalog(resolver source.FileResolver) ([]pkg.Package, []artifact.Relationship, error) {
+	locations, err := resolver.FilesByGlob("**/requirements.txt")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var packages []pkg.Package
+	for _, location := range locations {
+		reader, err := resolver.FileContentsByLocation(location)
+		if err != nil {
+			return nil, nil, err
+		}
+		scanner := bufio.NewScanner(reader)
+		for scanner.Scan() {
+			fields := strings.SplitN(scanner.Text(), "==", 2)
+			if len(fields) != 2 {
+				continue
+			}
+			packages = append(packages, pkg.Package{
+				Name:      fields[0],
+				Version:   fields[1],
+				Type:      pkg.PythonPkg,
+				FoundBy:   c.Name(),
+				Locations: []source.Location{location},
+			})
+		}
+		_ = reader.Close()
+	}
+	return packages, nil, nil
+}
+
+func TestSnapshot(t *testing.T) {
+	dir := t.TempDir()
+	fixtureDir := filepath.Join(dir, "pip")
+
+	count, err := Snapshot(requirementsCataloger{}, dir, "pip", "test-fixtures/requirements.txt")
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.FileExists(t, filepath.Join(fixtureDir, "requirements.txt"))
+
+	contents, err := ioutil.ReadFile(GoldenPath(fixtureDir))
+	require.NoError(t, err)
+	golden := string(contents)
+	assert.Contains(t, golden, `"locations": [
+    "/requirements.txt"
+   ]`)
+	// packages are sorted by name
+	assert.Less(t, strings.Index(golden, `"name": "flask"`), strings.Index(golden, `"name": "requests"`))
+
+	AssertCatalogerAgainstGolden(t, requirementsCataloger{}, fixtureDir, false)
+
+	// an existing fixture is never overwritten
+	_, err = Snapshot(requirementsCataloger{}, dir, "pip", "test-fixtures/requirements.txt")
+	assert.Error(t, err)
+}
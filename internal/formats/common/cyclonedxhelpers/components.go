@@ -0,0 +1,99 @@
+package cyclonedxhelpers
+
+import (
+	"github.com/CycloneDX/cyclonedx-go"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/anchore/syft/syft/source"
+)
+
+// toComponents returns the components for the given packages, where packages discovered within another package (e.g.
+// a jar within a war) are nested within the component of that package, preserving the containment structure.
+func toComponents(packages []pkg.Package, digests map[source.Coordinates][]file.Digest, refs map[string]bool) []cyclonedx.Component {
+	ids := make(map[artifact.ID]bool)
+	for _, p := range packages {
+		ids[p.ID()] = true
+	}
+
+	var roots []pkg.Package
+	children := make(map[artifact.ID][]pkg.Package)
+	for _, p := range packages {
+		if parent, ok := containingPackage(p); ok && ids[parent] {
+			children[parent] = append(children[parent], p)
+			continue
+		}
+		roots = append(roots, p)
+	}
+
+	components := make([]cyclonedx.Component, 0, len(roots))
+	for _, p := range roots {
+		components = append(components, toComponentTree(p, children, digests, refs, make(map[artifact.ID]bool)))
+	}
+	return components
+}
+
+func toComponentTree(p pkg.Package, children map[artifact.ID][]pkg.Package, digests map[source.Coordinates][]file.Digest, refs map[string]bool, visited map[artifact.ID]bool) cyclonedx.Component {
+	c := toComponent(p, digests)
+	if refs[c.BOMRef] {
+		// bom-refs must be unique within the BOM (the same package may be found within a nested image)
+		c.BOMRef = ""
+	}
+	refs[c.BOMRef] = true
+
+	visited[p.ID()] = true
+	var nested []cyclonedx.Component
+	for _, child := range children[p.ID()] {
+		if visited[child.ID()] {
+			continue
+		}
+		nested = append(nested, toComponentTree(child, children, digests, refs, visited))
+	}
+	if len(nested) > 0 {
+		c.Components = &nested
+	}
+	return c
+}
+
+// containingPackage returns the ID of the package that the given package was discovered within.
+func containingPackage(p pkg.Package) (artifact.ID, bool) {
+	metadata, ok := p.Metadata.(pkg.JavaMetadata)
+	if !ok || metadata.Parent == nil || metadata.Parent.ID() == "" || metadata.Parent.ID() == p.ID() {
+		return "", false
+	}
+	return metadata.Parent.ID(), true
+}
+
+// toNestedComponents returns a container component for each image found within the source, holding the components of
+// the packages found within that image.
+func toNestedComponents(nested []sbom.NestedSBOM, refs map[string]bool) []cyclonedx.Component {
+	var components []cyclonedx.Component
+	for _, n := range nested {
+		c := toBomDescriptorComponent(n.SBOM.Source)
+		if c == nil {
+			continue
+		}
+		properties := []cyclonedx.Property{
+			{
+				Name:  "syft:nested:location",
+				Value: n.Location.RealPath,
+			},
+		}
+		if c.Properties != nil {
+			properties = append(properties, *c.Properties...)
+		}
+		c.Properties = &properties
+
+		var packages []pkg.Package
+		if n.SBOM.Artifacts.PackageCatalog != nil {
+			packages = n.SBOM.Artifacts.PackageCatalog.Sorted()
+		}
+		nestedComponents := append(toComponents(packages, n.SBOM.Artifacts.FileDigests, refs), toNestedComponents(n.SBOM.Nested, refs)...)
+		if len(nestedComponents) > 0 {
+			c.Components = &nestedComponents
+		}
+		components = append(components, *c)
+	}
+	return components
+}
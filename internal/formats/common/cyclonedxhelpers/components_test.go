@@ -0,0 +1,99 @@
+package cyclonedxhelpers
+
+import (
+	"testing"
+
+	"github.com/CycloneDX/cyclonedx-go"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/anchore/syft/syft/source"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_toComponents(t *testing.T) {
+	war := pkg.Package{
+		Name:         "app",
+		Version:      "1.0.0",
+		MetadataType: pkg.JavaMetadataType,
+		Metadata:     pkg.JavaMetadata{VirtualPath: "/app.war"},
+	}
+	war.SetID()
+	jar := pkg.Package{
+		Name:         "lib",
+		Version:      "2.0.0",
+		MetadataType: pkg.JavaMetadataType,
+		Metadata:     pkg.JavaMetadata{VirtualPath: "/app.war:WEB-INF/lib/lib.jar", Parent: &war},
+	}
+	jar.SetID()
+	shaded := pkg.Package{
+		Name:         "shaded",
+		Version:      "3.0.0",
+		MetadataType: pkg.JavaMetadataType,
+		Metadata:     pkg.JavaMetadata{VirtualPath: "/app.war:WEB-INF/lib/lib.jar:shaded.jar", Parent: &jar},
+	}
+	shaded.SetID()
+	orphan := pkg.Package{
+		Name:         "orphan",
+		Version:      "4.0.0",
+		MetadataType: pkg.JavaMetadataType,
+		// the parent is not within the catalog
+		Metadata: pkg.JavaMetadata{VirtualPath: "/other.war:orphan.jar", Parent: &pkg.Package{Name: "other"}},
+	}
+	orphan.SetID()
+	musl := pkg.Package{Name: "musl", Version: "1.2.2", Type: pkg.ApkPkg}
+	musl.SetID()
+
+	components := toComponents([]pkg.Package{war, jar, musl, orphan, shaded}, nil, make(map[string]bool))
+
+	require.Len(t, components, 3)
+	assert.Equal(t, "app", components[0].Name)
+	assert.Equal(t, "musl", components[1].Name)
+	assert.Nil(t, components[1].Components)
+	assert.Equal(t, "orphan", components[2].Name)
+
+	require.NotNil(t, components[0].Components)
+	nested := *components[0].Components
+	require.Len(t, nested, 1)
+	assert.Equal(t, string(jar.ID()), nested[0].BOMRef)
+
+	require.NotNil(t, nested[0].Components)
+	require.Len(t, *nested[0].Components, 1)
+	assert.Equal(t, string(shaded.ID()), (*nested[0].Components)[0].BOMRef)
+}
+
+func Test_toNestedComponents(t *testing.T) {
+	musl := pkg.Package{Name: "musl", Version: "1.2.2", Type: pkg.ApkPkg}
+	musl.SetID()
+
+	refs := map[string]bool{string(musl.ID()): true}
+	components := toNestedComponents([]sbom.NestedSBOM{
+		{
+			Location: source.Coordinates{RealPath: "/images/alpine.tar"},
+			SBOM: sbom.SBOM{
+				Source: source.Metadata{
+					Scheme: source.ImageScheme,
+					ImageMetadata: source.ImageMetadata{
+						UserInput:      "/images/alpine.tar",
+						ManifestDigest: "sha256:e7d88de73db3",
+					},
+				},
+				Artifacts: sbom.Artifacts{
+					PackageCatalog: pkg.NewCatalog(musl),
+				},
+			},
+		},
+	}, refs)
+
+	require.Len(t, components, 1)
+	image := components[0]
+	assert.Equal(t, cyclonedx.ComponentTypeContainer, image.Type)
+	assert.Equal(t, "sha256:e7d88de73db3", image.Version)
+	assert.Equal(t, &[]cyclonedx.Property{{Name: "syft:nested:location", Value: "/images/alpine.tar"}}, image.Properties)
+
+	require.NotNil(t, image.Components)
+	require.Len(t, *image.Components, 1)
+	assert.Equal(t, "musl", (*image.Components)[0].Name)
+	// the bom-ref is already used by a component of the source
+	assert.Empty(t, (*image.Components)[0].BOMRef)
+}
@@ -0,0 +1,349 @@
+package cyclonedxhelpers
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/CycloneDX/cyclonedx-go"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/format"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/anchore/syft/syft/source"
+)
+
+// GetValidator returns a validator that accepts CycloneDX documents in the given encoding.
+func GetValidator(fileFormat cyclonedx.BOMFileFormat) format.Validator {
+	return func(reader io.Reader) error {
+		bom := &cyclonedx.BOM{}
+		if err := cyclonedx.NewBOMDecoder(reader, fileFormat).Decode(bom); err != nil {
+			return err
+		}
+		// any JSON object (or XML document) decodes without error, so the document must identify itself as CycloneDX
+		switch fileFormat {
+		case cyclonedx.BOMFileFormatJSON:
+			if bom.BOMFormat != "CycloneDX" {
+				return fmt.Errorf("not a CycloneDX document")
+			}
+		case cyclonedx.BOMFileFormatXML:
+			if !strings.HasPrefix(bom.XMLNS, "http://cyclonedx.org/schema/bom/") {
+				return fmt.Errorf("not a CycloneDX document")
+			}
+		}
+		return nil
+	}
+}
+
+// GetDecoder returns a decoder of CycloneDX documents in the given encoding.
+func GetDecoder(fileFormat cyclonedx.BOMFileFormat) format.Decoder {
+	return func(reader io.Reader) (*sbom.SBOM, error) {
+		bom := &cyclonedx.BOM{}
+		if err := cyclonedx.NewBOMDecoder(reader, fileFormat).Decode(bom); err != nil {
+			return nil, fmt.Errorf("unable to decode CycloneDX document: %w", err)
+		}
+		return ToSyftModel(bom)
+	}
+}
+
+// ToSyftModel describes the components of the given BOM as packages (along with the dependencies between them). Only
+// what CycloneDX can express is recovered: package metadata and the files of the source are not part of the BOM.
+func ToSyftModel(bom *cyclonedx.BOM) (*sbom.SBOM, error) {
+	if bom == nil {
+		return nil, fmt.Errorf("no CycloneDX document given")
+	}
+
+	s := &sbom.SBOM{
+		Artifacts: sbom.Artifacts{
+			PackageCatalog: pkg.NewCatalog(),
+		},
+		Descriptor: toSyftDescriptor(bom.Metadata),
+	}
+	if bom.Metadata != nil {
+		s.Source = toSyftSource(bom.Metadata.Component)
+	}
+
+	packagesByRef := make(map[string]pkg.Package)
+	if bom.Components != nil {
+		collectPackages(*bom.Components, s.Artifacts.PackageCatalog, packagesByRef)
+	}
+	s.Relationships = toSyftRelationships(bom.Dependencies, packagesByRef)
+
+	return s, nil
+}
+
+// collectPackages adds a package for every library-like component (including components nested within other
+// components), keyed by the bom-ref of the component.
+func collectPackages(components []cyclonedx.Component, catalog *pkg.Catalog, packagesByRef map[string]pkg.Package) {
+	for _, c := range components {
+		switch c.Type {
+		case cyclonedx.ComponentTypeContainer, cyclonedx.ComponentTypeFile, cyclonedx.ComponentTypeDevice:
+			// these describe where packages were found rather than packages (e.g. a nested image)
+		default:
+			p := toSyftPackage(c)
+			catalog.Add(p)
+			if c.BOMRef != "" {
+				packagesByRef[c.BOMRef] = p
+			}
+		}
+		if c.Components != nil {
+			collectPackages(*c.Components, catalog, packagesByRef)
+		}
+	}
+}
+
+func toSyftPackage(c cyclonedx.Component) pkg.Package {
+	name := c.Name
+	if c.Group != "" && pkg.TypeFromPURL(c.PackageURL) == pkg.UnknownPkg {
+		// the group is part of the name for ecosystems without a package URL type of their own
+		name = c.Group + "/" + c.Name
+	}
+
+	p := pkg.Package{
+		Name:       name,
+		Version:    c.Version,
+		Locations:  toSyftLocations(c.Properties),
+		Licenses:   toSyftLicenses(c.Licenses),
+		Language:   pkg.LanguageFromPURL(c.PackageURL),
+		Type:       pkg.TypeFromPURL(c.PackageURL),
+		CPEs:       toSyftCPEs(c.CPE),
+		PURL:       c.PackageURL,
+		Confidence: pkg.Confidence(propertyValue(c.Properties, "syft:package:confidence")),
+		OriginURLs: toSyftOriginURLs(c.ExternalReferences),
+		Checksums:  toSyftChecksums(c.Hashes),
+		Ownership:  propertiesWithPrefix(c.Properties, "syft:ownership:"),
+	}
+	p.SetID()
+	return p
+}
+
+// toSyftLocations recovers the locations that lead to the discovery of the package, as recorded by syft.
+func toSyftLocations(properties *[]cyclonedx.Property) []source.Location {
+	if properties == nil {
+		return nil
+	}
+
+	coordinatesByIndex := make(map[int]*source.Coordinates)
+	for _, property := range *properties {
+		fields := strings.Split(property.Name, ":")
+		if len(fields) != 4 || fields[0] != "syft" || fields[1] != "location" {
+			continue
+		}
+		idx, err := strconv.Atoi(fields[2])
+		if err != nil {
+			continue
+		}
+		if coordinatesByIndex[idx] == nil {
+			coordinatesByIndex[idx] = &source.Coordinates{}
+		}
+		switch fields[3] {
+		case "path":
+			coordinatesByIndex[idx].RealPath = property.Value
+		case "layerID":
+			coordinatesByIndex[idx].FileSystemID = property.Value
+		}
+	}
+
+	indexes := make([]int, 0, len(coordinatesByIndex))
+	for idx := range coordinatesByIndex {
+		indexes = append(indexes, idx)
+	}
+	sort.Ints(indexes)
+
+	var locations []source.Location
+	for _, idx := range indexes {
+		if coordinatesByIndex[idx].RealPath == "" {
+			continue
+		}
+		locations = append(locations, source.NewLocationFromCoordinates(*coordinatesByIndex[idx]))
+	}
+	return locations
+}
+
+func toSyftLicenses(licenses *cyclonedx.Licenses) []string {
+	if licenses == nil {
+		return nil
+	}
+
+	var result []string
+	for _, l := range *licenses {
+		switch {
+		case l.License != nil && l.License.ID != "":
+			result = append(result, l.License.ID)
+		case l.License != nil && l.License.Name != "":
+			result = append(result, l.License.Name)
+		case l.Expression != "":
+			result = append(result, l.Expression)
+		}
+	}
+	return result
+}
+
+func toSyftCPEs(value string) []pkg.CPE {
+	if value == "" {
+		return nil
+	}
+	cpe, err := pkg.NewCPE(value)
+	if err != nil {
+		log.Warnf("unable to extract CycloneDX CPE=%q: %+v", value, err)
+		return nil
+	}
+	return []pkg.CPE{cpe}
+}
+
+func toSyftOriginURLs(refs *[]cyclonedx.ExternalReference) pkg.OriginURLs {
+	var urls pkg.OriginURLs
+	if refs == nil {
+		return urls
+	}
+	for _, ref := range *refs {
+		switch ref.Type {
+		case cyclonedx.ERTypeWebsite:
+			urls.Homepage = ref.URL
+		case cyclonedx.ERTypeVCS:
+			urls.Repository = ref.URL
+		case cyclonedx.ERTypeDistribution:
+			urls.Download = ref.URL
+		}
+	}
+	return urls
+}
+
+// toSyftChecksums returns the digests of the component, named after the digest algorithms used by syft.
+func toSyftChecksums(hashes *[]cyclonedx.Hash) []file.Digest {
+	if hashes == nil {
+		return nil
+	}
+
+	var digests []file.Digest
+	for _, h := range *hashes {
+		for algorithm, cdxAlgorithm := range hashAlgorithms {
+			if cdxAlgorithm == h.Algorithm {
+				digests = append(digests, file.Digest{
+					Algorithm: algorithm,
+					Value:     h.Value,
+				})
+			}
+		}
+	}
+	return digests
+}
+
+// toSyftRelationships expresses the dependencies of each component as the dependency being a dependency of the
+// dependent package.
+func toSyftRelationships(dependencies *[]cyclonedx.Dependency, packagesByRef map[string]pkg.Package) []artifact.Relationship {
+	if dependencies == nil {
+		return nil
+	}
+
+	var relationships []artifact.Relationship
+	for _, d := range *dependencies {
+		dependent, ok := packagesByRef[d.Ref]
+		if !ok || d.Dependencies == nil {
+			continue
+		}
+		for _, dependencyRef := range *d.Dependencies {
+			dependency, ok := packagesByRef[dependencyRef.Ref]
+			if !ok {
+				continue
+			}
+			relationships = append(relationships, artifact.Relationship{
+				From: dependency,
+				To:   dependent,
+				Type: artifact.DependencyOfRelationship,
+			})
+		}
+	}
+	return relationships
+}
+
+// toSyftSource describes the source from the component the BOM describes (e.g. the scanned image).
+func toSyftSource(c *cyclonedx.Component) source.Metadata {
+	if c == nil {
+		return source.Metadata{}
+	}
+
+	switch c.Type {
+	case cyclonedx.ComponentTypeContainer:
+		return source.Metadata{
+			Scheme: source.ImageScheme,
+			ImageMetadata: source.ImageMetadata{
+				UserInput:      c.Name,
+				ManifestDigest: c.Version,
+				Labels:         propertiesWithPrefix(c.Properties, "syft:image:label:"),
+				Annotations:    propertiesWithPrefix(c.Properties, "syft:image:annotation:"),
+			},
+		}
+	case cyclonedx.ComponentTypeFile:
+		return source.Metadata{
+			Scheme: source.DirectoryScheme,
+			Path:   c.Name,
+		}
+	}
+	return source.Metadata{}
+}
+
+func toSyftDescriptor(metadata *cyclonedx.Metadata) sbom.Descriptor {
+	var descriptor sbom.Descriptor
+	if metadata == nil {
+		return descriptor
+	}
+
+	if metadata.Tools != nil && len(*metadata.Tools) > 0 {
+		tool := (*metadata.Tools)[0]
+		descriptor.Name = tool.Name
+		descriptor.Version = tool.Version
+	}
+	if metadata.Timestamp != "" {
+		if timestamp, err := time.Parse(time.RFC3339, metadata.Timestamp); err == nil {
+			descriptor.Timestamp = timestamp
+		}
+	}
+	if metadata.Authors != nil {
+		// syft lists the author of the document followed by the organization it was created on behalf of
+		authors := *metadata.Authors
+		if len(authors) > 0 {
+			descriptor.Author = authors[0].Name
+		}
+		if len(authors) > 1 {
+			descriptor.Organization = authors[1].Name
+		}
+	}
+	if metadata.Supplier != nil {
+		descriptor.Supplier = metadata.Supplier.Name
+	}
+	return descriptor
+}
+
+func propertyValue(properties *[]cyclonedx.Property, name string) string {
+	if properties == nil {
+		return ""
+	}
+	for _, property := range *properties {
+		if property.Name == name {
+			return property.Value
+		}
+	}
+	return ""
+}
+
+func propertiesWithPrefix(properties *[]cyclonedx.Property, prefix string) map[string]string {
+	if properties == nil {
+		return nil
+	}
+	values := make(map[string]string)
+	for _, property := range *properties {
+		if strings.HasPrefix(property.Name, prefix) {
+			values[strings.TrimPrefix(property.Name, prefix)] = property.Value
+		}
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	return values
+}
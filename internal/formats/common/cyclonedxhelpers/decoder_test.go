@@ -0,0 +1,116 @@
+package cyclonedxhelpers
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/CycloneDX/cyclonedx-go"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/anchore/syft/syft/source"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecoder_RoundTrip(t *testing.T) {
+	app := pkg.Package{
+		Name:       "app",
+		Version:    "1.0.0",
+		Type:       pkg.NpmPkg,
+		Language:   pkg.JavaScript,
+		PURL:       "pkg:npm/app@1.0.0",
+		Locations:  []source.Location{source.NewLocationFromCoordinates(source.Coordinates{RealPath: "/app/package.json", FileSystemID: "sha256:abc"})},
+		Licenses:   []string{"MIT"},
+		Confidence: pkg.ExactMetadataConfidence,
+		Ownership:  map[string]string{"team": "web"},
+	}
+	app.SetID()
+	lodash := pkg.Package{
+		Name:       "lodash",
+		Version:    "4.17.21",
+		Type:       pkg.NpmPkg,
+		Language:   pkg.JavaScript,
+		PURL:       "pkg:npm/lodash@4.17.21",
+		Locations:  []source.Location{source.NewLocation("/app/package-lock.json")},
+		Licenses:   []string{"MIT"},
+		Confidence: pkg.LockfileDeclaredConfidence,
+		OriginURLs: pkg.OriginURLs{Download: "https://registry.npmjs.org/lodash/-/lodash-4.17.21.tgz"},
+		Checksums:  []file.Digest{{Algorithm: "sha512", Value: "bf690311ee7b95e713ba568322e3533f2dd1cb880b189e99d4edef13592b81764daec43e2c54c61d5c558dc5cfb35ecb85b65519e74026ff17675b6f8f916f4a"}},
+	}
+	lodash.SetID()
+
+	original := sbom.SBOM{
+		Artifacts: sbom.Artifacts{
+			PackageCatalog: pkg.NewCatalog(app, lodash),
+		},
+		Relationships: []artifact.Relationship{
+			{From: lodash, To: app, Type: artifact.DependencyOfRelationship},
+		},
+		Source: source.Metadata{
+			Scheme: source.ImageScheme,
+			ImageMetadata: source.ImageMetadata{
+				UserInput:      "example/app:latest",
+				ManifestDigest: "sha256:def",
+				Labels:         map[string]string{"maintainer": "someone"},
+			},
+		},
+		Descriptor: sbom.Descriptor{
+			Name:      "syft",
+			Version:   "v0.1.0",
+			Author:    "Jane Doe",
+			Timestamp: time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC),
+		},
+	}
+
+	for name, fileFormat := range map[string]cyclonedx.BOMFileFormat{
+		"json": cyclonedx.BOMFileFormatJSON,
+		"xml":  cyclonedx.BOMFileFormatXML,
+	} {
+		fileFormat := fileFormat
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, cyclonedx.NewBOMEncoder(&buf, fileFormat).Encode(ToFormatModel(original)))
+
+			require.NoError(t, GetValidator(fileFormat)(bytes.NewReader(buf.Bytes())))
+			decoded, err := GetDecoder(fileFormat)(bytes.NewReader(buf.Bytes()))
+			require.NoError(t, err)
+
+			assert.Equal(t, original.Source, decoded.Source)
+			assert.Equal(t, original.Descriptor.Author, decoded.Descriptor.Author)
+			assert.Equal(t, original.Descriptor.Timestamp, decoded.Descriptor.Timestamp.UTC())
+
+			packages := decoded.Artifacts.PackageCatalog.Sorted()
+			require.Len(t, packages, 2)
+			for i, expected := range []pkg.Package{app, lodash} {
+				actual := packages[i]
+				assert.Equal(t, expected.Name, actual.Name)
+				assert.Equal(t, expected.Version, actual.Version)
+				assert.Equal(t, expected.Type, actual.Type)
+				assert.Equal(t, expected.Language, actual.Language)
+				assert.Equal(t, expected.PURL, actual.PURL)
+				assert.Equal(t, expected.Locations, actual.Locations)
+				assert.Equal(t, expected.Licenses, actual.Licenses)
+				assert.Equal(t, expected.Confidence, actual.Confidence)
+				assert.Equal(t, expected.OriginURLs, actual.OriginURLs)
+				assert.Equal(t, expected.Checksums, actual.Checksums)
+				assert.Equal(t, expected.Ownership, actual.Ownership)
+			}
+
+			require.Len(t, decoded.Relationships, 1)
+			assert.Equal(t, "lodash", decoded.Relationships[0].From.(pkg.Package).Name)
+			assert.Equal(t, "app", decoded.Relationships[0].To.(pkg.Package).Name)
+			assert.Equal(t, artifact.DependencyOfRelationship, decoded.Relationships[0].Type)
+		})
+	}
+}
+
+func TestValidator_RejectsOtherDocuments(t *testing.T) {
+	assert.Error(t, GetValidator(cyclonedx.BOMFileFormatJSON)(strings.NewReader(`{"schema": {"url": "https://raw.githubusercontent.com/anchore/syft/main/schema/json/schema-2.0.3.json"}}`)))
+	assert.Error(t, GetValidator(cyclonedx.BOMFileFormatJSON)(strings.NewReader(`{"spdxVersion": "SPDX-2.2"}`)))
+	assert.Error(t, GetValidator(cyclonedx.BOMFileFormatXML)(strings.NewReader(`<project><name>not a bom</name></project>`)))
+	assert.Error(t, GetValidator(cyclonedx.BOMFileFormatXML)(strings.NewReader(`{"bomFormat": "CycloneDX"}`)))
+}
@@ -1,15 +1,19 @@
 package cyclonedxhelpers
 
 import (
+	"fmt"
+	"sort"
+	"strconv"
 	"time"
 
 	"github.com/CycloneDX/cyclonedx-go"
 	"github.com/anchore/syft/internal"
 	"github.com/anchore/syft/internal/version"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
 	"github.com/anchore/syft/syft/pkg"
 	"github.com/anchore/syft/syft/sbom"
 	"github.com/anchore/syft/syft/source"
-	"github.com/google/uuid"
 )
 
 func ToFormatModel(s sbom.SBOM) *cyclonedx.BOM {
@@ -19,23 +23,56 @@ func ToFormatModel(s sbom.SBOM) *cyclonedx.BOM {
 	// NOTE(jonasagx): cycloneDX requires URN uuids (URN returns the RFC 2141 URN form of uuid):
 	// https://github.com/CycloneDX/specification/blob/master/schema/bom-1.3-strict.schema.json#L36
 	// "pattern": "^urn:uuid:[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$"
-	cdxBOM.SerialNumber = uuid.New().URN()
-	cdxBOM.Metadata = toBomDescriptor(internal.ApplicationName, versionInfo.Version, s.Source)
+	cdxBOM.SerialNumber = s.Descriptor.DocumentUUID().URN()
+	cdxBOM.Metadata = toBomDescriptor(internal.ApplicationName, versionInfo.Version, s.Source, s.Descriptor.CreationTime())
+	addDocumentCreators(cdxBOM.Metadata, s.Descriptor)
+	cdxBOM.Metadata.Properties = toSummaryProperties(sbom.NewSummary(s))
 
 	packages := s.Artifacts.PackageCatalog.Sorted()
-	components := make([]cyclonedx.Component, len(packages))
-	for i, p := range packages {
-		components[i] = toComponent(p)
-	}
+	refs := make(map[string]bool)
+	components := append(toComponents(packages, s.Artifacts.FileDigests, refs), toNestedComponents(s.Nested, refs)...)
 	cdxBOM.Components = &components
+	cdxBOM.Dependencies = toDependencies(packages, sbom.DependencyRelationships(s))
 
 	return cdxBOM
 }
 
-// NewBomDescriptor returns a new BomDescriptor tailored for the current time and "syft" tool details.
-func toBomDescriptor(name, version string, srcMetadata source.Metadata) *cyclonedx.Metadata {
+// toDependencies returns the dependency graph between components, listing the components each component depends on.
+func toDependencies(packages []pkg.Package, relationships []artifact.Relationship) *[]cyclonedx.Dependency {
+	dependenciesByRef := make(map[string][]string)
+	for _, r := range relationships {
+		dependent := string(r.To.ID())
+		dependenciesByRef[dependent] = append(dependenciesByRef[dependent], string(r.From.ID()))
+	}
+	if len(dependenciesByRef) == 0 {
+		return nil
+	}
+
+	var dependencies []cyclonedx.Dependency
+	for _, p := range packages {
+		ref := string(p.ID())
+		refs := dependenciesByRef[ref]
+		if len(refs) == 0 {
+			continue
+		}
+		sort.Strings(refs)
+
+		dependsOn := make([]cyclonedx.Dependency, len(refs))
+		for i, dependencyRef := range refs {
+			dependsOn[i] = cyclonedx.Dependency{Ref: dependencyRef}
+		}
+		dependencies = append(dependencies, cyclonedx.Dependency{
+			Ref:          ref,
+			Dependencies: &dependsOn,
+		})
+	}
+	return &dependencies
+}
+
+// NewBomDescriptor returns a new BomDescriptor tailored for the given creation time and "syft" tool details.
+func toBomDescriptor(name, version string, srcMetadata source.Metadata, created time.Time) *cyclonedx.Metadata {
 	return &cyclonedx.Metadata{
-		Timestamp: time.Now().Format(time.RFC3339),
+		Timestamp: created.Format(time.RFC3339),
 		Tools: &[]cyclonedx.Tool{
 			{
 				Vendor:  "anchore",
@@ -47,23 +84,186 @@ func toBomDescriptor(name, version string, srcMetadata source.Metadata) *cyclone
 	}
 }
 
-func toComponent(p pkg.Package) cyclonedx.Component {
+// addDocumentCreators captures the (optional) document author, organization, and supplier within the BOM metadata. The
+// organization is listed as an author of the BOM, since metadata.manufacture describes the manufacturer of the
+// component rather than the creator of the document.
+func addDocumentCreators(metadata *cyclonedx.Metadata, descriptor sbom.Descriptor) {
+	var authors []cyclonedx.OrganizationalContact
+	for _, name := range []string{descriptor.Author, descriptor.Organization} {
+		if name != "" {
+			authors = append(authors, cyclonedx.OrganizationalContact{Name: name})
+		}
+	}
+	if len(authors) > 0 {
+		metadata.Authors = &authors
+	}
+
+	if descriptor.Supplier != "" {
+		metadata.Supplier = &cyclonedx.OrganizationalEntity{
+			Name: descriptor.Supplier,
+		}
+	}
+}
+
+// toSummaryProperties describes the composition of the BOM (the number of packages of each type and files, and how
+// long cataloging took) within its metadata, so that it can be reported on without processing all components.
+func toSummaryProperties(summary sbom.Summary) *[]cyclonedx.Property {
+	properties := []cyclonedx.Property{
+		{Name: "syft:summary:packages", Value: strconv.Itoa(summary.Packages)},
+	}
+	for _, t := range summary.Types() {
+		properties = append(properties, cyclonedx.Property{
+			Name:  "syft:summary:packages:" + string(t),
+			Value: strconv.Itoa(summary.PackagesByType[t]),
+		})
+	}
+	properties = append(properties, cyclonedx.Property{
+		Name:  "syft:summary:files",
+		Value: strconv.Itoa(summary.Files),
+	})
+	if summary.Duration > 0 {
+		properties = append(properties, cyclonedx.Property{
+			Name:  "syft:summary:duration",
+			Value: summary.Duration.String(),
+		})
+	}
+	return &properties
+}
+
+func toComponent(p pkg.Package, digests map[source.Coordinates][]file.Digest) cyclonedx.Component {
 	return cyclonedx.Component{
-		Type:       cyclonedx.ComponentTypeLibrary,
-		Name:       p.Name,
-		Version:    p.Version,
-		PackageURL: p.PURL,
-		Licenses:   toLicenses(p.Licenses),
+		BOMRef:             string(p.ID()),
+		Type:               cyclonedx.ComponentTypeLibrary,
+		Name:               p.Name,
+		Version:            p.Version,
+		Hashes:             toHashes(p, digests),
+		PackageURL:         p.PURL,
+		Licenses:           toLicenses(p.Licenses),
+		Properties:         toProperties(p),
+		ExternalReferences: toExternalReferences(p.OriginURLs),
+	}
+}
+
+// toExternalReferences describes where the package comes from (project website, source repository, and distribution artifact).
+func toExternalReferences(urls pkg.OriginURLs) *[]cyclonedx.ExternalReference {
+	var refs []cyclonedx.ExternalReference
+	for _, ref := range []cyclonedx.ExternalReference{
+		{URL: urls.Homepage, Type: cyclonedx.ERTypeWebsite},
+		{URL: urls.Repository, Type: cyclonedx.ERTypeVCS},
+		{URL: urls.Download, Type: cyclonedx.ERTypeDistribution},
+	} {
+		if ref.URL != "" {
+			refs = append(refs, ref)
+		}
+	}
+	if len(refs) == 0 {
+		return nil
 	}
+	return &refs
+}
+
+// toProperties captures syft-specific package details that have no equivalent CycloneDX component field.
+func toProperties(p pkg.Package) *[]cyclonedx.Property {
+	var properties []cyclonedx.Property
+	if p.Confidence != "" {
+		properties = append(properties, cyclonedx.Property{
+			Name:  "syft:package:confidence",
+			Value: string(p.Confidence),
+		})
+	}
+	if p.EOL != nil {
+		properties = append(properties, cyclonedx.Property{
+			Name:  "syft:package:eol",
+			Value: p.EOL.EOL,
+		}, cyclonedx.Property{
+			Name:  "syft:package:eol-expired",
+			Value: strconv.FormatBool(p.EOL.Expired),
+		})
+	}
+
+	// organizational metadata (e.g. the owning team) is recorded in the order of its keys, for stable output
+	ownershipKeys := make([]string, 0, len(p.Ownership))
+	for key := range p.Ownership {
+		ownershipKeys = append(ownershipKeys, key)
+	}
+	sort.Strings(ownershipKeys)
+	for _, key := range ownershipKeys {
+		properties = append(properties, cyclonedx.Property{
+			Name:  "syft:ownership:" + key,
+			Value: p.Ownership[key],
+		})
+	}
+
+	// CycloneDX 1.3 has no component evidence (of where a component was found), so the locations that lead to the
+	// discovery of the package are recorded as properties
+	for idx, location := range p.Locations {
+		properties = append(properties, cyclonedx.Property{
+			Name:  fmt.Sprintf("syft:location:%d:path", idx),
+			Value: location.RealPath,
+		})
+		if location.FileSystemID != "" {
+			properties = append(properties, cyclonedx.Property{
+				Name:  fmt.Sprintf("syft:location:%d:layerID", idx),
+				Value: location.FileSystemID,
+			})
+		}
+	}
+
+	// the same python package may be installed for several python installations or into several virtual environments,
+	// which are told apart by the installation (and the environment)
+	metadata, isPython := p.Metadata.(pkg.PythonPackageMetadata)
+	if isPython && metadata.Runtime != nil {
+		properties = append(properties, cyclonedx.Property{
+			Name:  "syft:python:runtime",
+			Value: metadata.Runtime.Version,
+		}, cyclonedx.Property{
+			Name:  "syft:python:runtime-prefix",
+			Value: metadata.Runtime.Prefix,
+		})
+	}
+	if isPython && metadata.VirtualEnv != nil {
+		properties = append(properties, cyclonedx.Property{
+			Name:  "syft:python:virtualenv",
+			Value: metadata.VirtualEnv.Path,
+		})
+		if interpreter := virtualEnvInterpreter(*metadata.VirtualEnv); interpreter != "" {
+			properties = append(properties, cyclonedx.Property{
+				Name:  "syft:python:interpreter",
+				Value: interpreter,
+			})
+		}
+	}
+
+	if len(properties) == 0 {
+		return nil
+	}
+	return &properties
+}
+
+// virtualEnvInterpreter describes the interpreter a virtual environment links against (the executable when recorded,
+// otherwise the directory of the interpreter), along with its version.
+func virtualEnvInterpreter(env pkg.PythonVirtualEnv) string {
+	interpreter := env.Executable
+	if interpreter == "" {
+		interpreter = env.Home
+	}
+	if env.Version != "" {
+		if interpreter == "" {
+			return env.Version
+		}
+		interpreter += " (" + env.Version + ")"
+	}
+	return interpreter
 }
 
 func toBomDescriptorComponent(srcMetadata source.Metadata) *cyclonedx.Component {
 	switch srcMetadata.Scheme {
 	case source.ImageScheme:
 		return &cyclonedx.Component{
-			Type:    cyclonedx.ComponentTypeContainer,
-			Name:    srcMetadata.ImageMetadata.UserInput,
-			Version: srcMetadata.ImageMetadata.ManifestDigest,
+			Type:       cyclonedx.ComponentTypeContainer,
+			Name:       srcMetadata.ImageMetadata.UserInput,
+			Version:    srcMetadata.ImageMetadata.ManifestDigest,
+			Properties: toImageProperties(srcMetadata.ImageMetadata),
 		}
 	case source.DirectoryScheme, source.FileScheme:
 		return &cyclonedx.Component{
@@ -75,6 +275,35 @@ func toBomDescriptorComponent(srcMetadata source.Metadata) *cyclonedx.Component
 	return nil
 }
 
+// toImageProperties captures the labels and annotations of the image, which often describe its provenance (e.g.
+// org.opencontainers.image.source), sorted by key.
+func toImageProperties(img source.ImageMetadata) *[]cyclonedx.Property {
+	var properties []cyclonedx.Property
+	for _, kind := range []struct {
+		prefix string
+		values map[string]string
+	}{
+		{prefix: "syft:image:label:", values: img.Labels},
+		{prefix: "syft:image:annotation:", values: img.Annotations},
+	} {
+		keys := make([]string, 0, len(kind.values))
+		for key := range kind.values {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			properties = append(properties, cyclonedx.Property{
+				Name:  kind.prefix + key,
+				Value: kind.values[key],
+			})
+		}
+	}
+	if len(properties) == 0 {
+		return nil
+	}
+	return &properties
+}
+
 func toLicenses(ls []string) *cyclonedx.Licenses {
 	if len(ls) == 0 {
 		return nil
@@ -0,0 +1,327 @@
+package cyclonedxhelpers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/CycloneDX/cyclonedx-go"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/eol"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/anchore/syft/syft/source"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_toDependencies(t *testing.T) {
+	app := pkg.Package{Name: "app"}
+	app.SetID()
+	libA := pkg.Package{Name: "lib-a"}
+	libA.SetID()
+	libB := pkg.Package{Name: "lib-b"}
+	libB.SetID()
+
+	packages := []pkg.Package{app, libA, libB}
+
+	tests := []struct {
+		name          string
+		relationships []artifact.Relationship
+		expected      *[]cyclonedx.Dependency
+	}{
+		{
+			name: "no dependencies",
+		},
+		{
+			name: "components with dependencies",
+			relationships: []artifact.Relationship{
+				{From: libB, To: app, Type: artifact.DependencyOfRelationship},
+				{From: libA, To: app, Type: artifact.DependencyOfRelationship},
+				{From: libB, To: libA, Type: artifact.DependencyOfRelationship},
+			},
+			expected: &[]cyclonedx.Dependency{
+				{
+					Ref:          string(app.ID()),
+					Dependencies: sortedDependencies(libA, libB),
+				},
+				{
+					Ref:          string(libA.ID()),
+					Dependencies: &[]cyclonedx.Dependency{{Ref: string(libB.ID())}},
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, toDependencies(packages, test.relationships))
+		})
+	}
+}
+
+func sortedDependencies(a, b pkg.Package) *[]cyclonedx.Dependency {
+	if a.ID() > b.ID() {
+		a, b = b, a
+	}
+	return &[]cyclonedx.Dependency{{Ref: string(a.ID())}, {Ref: string(b.ID())}}
+}
+
+func Test_toProperties(t *testing.T) {
+	tests := []struct {
+		name     string
+		pkg      pkg.Package
+		expected *[]cyclonedx.Property
+	}{
+		{
+			name: "no confidence",
+		},
+		{
+			name: "confidence",
+			pkg:  pkg.Package{Confidence: pkg.HeuristicBinaryMatchConfidence},
+			expected: &[]cyclonedx.Property{
+				{
+					Name:  "syft:package:confidence",
+					Value: "heuristic-binary-match",
+				},
+			},
+		},
+		{
+			name: "end of life",
+			pkg:  pkg.Package{EOL: &eol.Status{Product: "python", Cycle: "3.6", EOL: "2021-12-23", Expired: true}},
+			expected: &[]cyclonedx.Property{
+				{
+					Name:  "syft:package:eol",
+					Value: "2021-12-23",
+				},
+				{
+					Name:  "syft:package:eol-expired",
+					Value: "true",
+				},
+			},
+		},
+		{
+			name: "ownership",
+			pkg:  pkg.Package{Ownership: map[string]string{"team": "payments", "business-unit": "commerce"}},
+			expected: &[]cyclonedx.Property{
+				{
+					Name:  "syft:ownership:business-unit",
+					Value: "commerce",
+				},
+				{
+					Name:  "syft:ownership:team",
+					Value: "payments",
+				},
+			},
+		},
+		{
+			name: "locations",
+			pkg: pkg.Package{
+				Locations: []source.Location{
+					source.NewLocation("/lib/apk/db/installed"),
+					source.NewLocationFromCoordinates(source.Coordinates{RealPath: "/app.jar", FileSystemID: "sha256:abc"}),
+				},
+			},
+			expected: &[]cyclonedx.Property{
+				{
+					Name:  "syft:location:0:path",
+					Value: "/lib/apk/db/installed",
+				},
+				{
+					Name:  "syft:location:1:path",
+					Value: "/app.jar",
+				},
+				{
+					Name:  "syft:location:1:layerID",
+					Value: "sha256:abc",
+				},
+			},
+		},
+		{
+			name: "python virtual environment",
+			pkg: pkg.Package{
+				Metadata: pkg.PythonPackageMetadata{
+					VirtualEnv: &pkg.PythonVirtualEnv{
+						Path:       "/opt/app/.venv",
+						Home:       "/usr/local/bin",
+						Executable: "/usr/local/bin/python3.9",
+						Version:    "3.9.2",
+					},
+				},
+			},
+			expected: &[]cyclonedx.Property{
+				{
+					Name:  "syft:python:virtualenv",
+					Value: "/opt/app/.venv",
+				},
+				{
+					Name:  "syft:python:interpreter",
+					Value: "/usr/local/bin/python3.9 (3.9.2)",
+				},
+			},
+		},
+		{
+			name: "python runtime",
+			pkg: pkg.Package{
+				Metadata: pkg.PythonPackageMetadata{
+					Runtime: &pkg.PythonRuntime{Version: "3.8", Prefix: "/usr"},
+				},
+			},
+			expected: &[]cyclonedx.Property{
+				{
+					Name:  "syft:python:runtime",
+					Value: "3.8",
+				},
+				{
+					Name:  "syft:python:runtime-prefix",
+					Value: "/usr",
+				},
+			},
+		},
+		{
+			name: "python virtual environment without interpreter details",
+			pkg: pkg.Package{
+				Metadata: pkg.PythonPackageMetadata{
+					VirtualEnv: &pkg.PythonVirtualEnv{
+						Path: "/opt/app/.venv",
+					},
+				},
+			},
+			expected: &[]cyclonedx.Property{
+				{
+					Name:  "syft:python:virtualenv",
+					Value: "/opt/app/.venv",
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, toProperties(test.pkg))
+		})
+	}
+}
+
+func Test_addDocumentCreators(t *testing.T) {
+	tests := []struct {
+		name       string
+		descriptor sbom.Descriptor
+		expected   cyclonedx.Metadata
+	}{
+		{
+			name: "no creators",
+		},
+		{
+			name: "all creators",
+			descriptor: sbom.Descriptor{
+				Author:       "Jane Doe",
+				Organization: "Example, Inc",
+				Supplier:     "Example Supplier",
+			},
+			expected: cyclonedx.Metadata{
+				Authors: &[]cyclonedx.OrganizationalContact{
+					{Name: "Jane Doe"},
+					{Name: "Example, Inc"},
+				},
+				Supplier: &cyclonedx.OrganizationalEntity{Name: "Example Supplier"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var actual cyclonedx.Metadata
+			addDocumentCreators(&actual, test.descriptor)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func Test_toExternalReferences(t *testing.T) {
+	tests := []struct {
+		name     string
+		urls     pkg.OriginURLs
+		expected *[]cyclonedx.ExternalReference
+	}{
+		{
+			name: "no origin URLs",
+		},
+		{
+			name: "all origin URLs",
+			urls: pkg.OriginURLs{
+				Homepage:   "https://bundler.io",
+				Repository: "https://github.com/bundler/bundler/",
+				Download:   "https://rubygems.org/downloads/bundler-2.1.4.gem",
+			},
+			expected: &[]cyclonedx.ExternalReference{
+				{
+					URL:  "https://bundler.io",
+					Type: cyclonedx.ERTypeWebsite,
+				},
+				{
+					URL:  "https://github.com/bundler/bundler/",
+					Type: cyclonedx.ERTypeVCS,
+				},
+				{
+					URL:  "https://rubygems.org/downloads/bundler-2.1.4.gem",
+					Type: cyclonedx.ERTypeDistribution,
+				},
+			},
+		},
+		{
+			name: "download only",
+			urls: pkg.OriginURLs{
+				Download: "https://crates.io/api/v1/crates/matches/0.1.8/download",
+			},
+			expected: &[]cyclonedx.ExternalReference{
+				{
+					URL:  "https://crates.io/api/v1/crates/matches/0.1.8/download",
+					Type: cyclonedx.ERTypeDistribution,
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, toExternalReferences(test.urls))
+		})
+	}
+}
+
+func Test_toBomDescriptorComponent_ImageLabelsAndAnnotations(t *testing.T) {
+	component := toBomDescriptorComponent(source.Metadata{
+		Scheme: source.ImageScheme,
+		ImageMetadata: source.ImageMetadata{
+			UserInput:      "alpine:3.15",
+			ManifestDigest: "sha256:abc",
+			Labels: map[string]string{
+				"org.opencontainers.image.source": "https://github.com/example/image",
+				"maintainer":                      "someone@example.com",
+			},
+			Annotations: map[string]string{
+				"org.opencontainers.image.revision": "0123456789abcdef",
+			},
+		},
+	})
+
+	assert.Equal(t, &[]cyclonedx.Property{
+		{Name: "syft:image:label:maintainer", Value: "someone@example.com"},
+		{Name: "syft:image:label:org.opencontainers.image.source", Value: "https://github.com/example/image"},
+		{Name: "syft:image:annotation:org.opencontainers.image.revision", Value: "0123456789abcdef"},
+	}, component.Properties)
+
+	assert.Nil(t, toBomDescriptorComponent(source.Metadata{Scheme: source.ImageScheme}).Properties)
+}
+
+func Test_toSummaryProperties(t *testing.T) {
+	summary := sbom.Summary{
+		Packages:       3,
+		PackagesByType: map[pkg.Type]int{pkg.PythonPkg: 1, pkg.ApkPkg: 2},
+		Files:          40,
+		Duration:       2500 * time.Millisecond,
+	}
+
+	assert.Equal(t, &[]cyclonedx.Property{
+		{Name: "syft:summary:packages", Value: "3"},
+		{Name: "syft:summary:packages:apk", Value: "2"},
+		{Name: "syft:summary:packages:python", Value: "1"},
+		{Name: "syft:summary:files", Value: "40"},
+		{Name: "syft:summary:duration", Value: "2.5s"},
+	}, toSummaryProperties(summary))
+}
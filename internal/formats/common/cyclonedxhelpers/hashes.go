@@ -0,0 +1,61 @@
+package cyclonedxhelpers
+
+import (
+	"github.com/CycloneDX/cyclonedx-go"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/source"
+)
+
+// hashAlgorithms maps the digest algorithm names used by the file digest cataloger onto CycloneDX hash algorithms.
+var hashAlgorithms = map[string]cyclonedx.HashAlgorithm{
+	"md5":    cyclonedx.HashAlgoMD5,
+	"sha1":   cyclonedx.HashAlgoSHA1,
+	"sha256": cyclonedx.HashAlgoSHA256,
+	"sha384": cyclonedx.HashAlgoSHA384,
+	"sha512": cyclonedx.HashAlgoSHA512,
+}
+
+// toHashes returns the digests of the distributed package as recorded by the cataloger (e.g. from a lockfile), along
+// with the digests (computed by the file digest cataloger, when enabled) of the file that the package was discovered
+// from. The latter is only done when that file is the package itself (e.g. a java archive), since the digests of a
+// package manager database or a lock file say nothing about the packages listed within them.
+func toHashes(p pkg.Package, digests map[source.Coordinates][]file.Digest) *[]cyclonedx.Hash {
+	var candidates []file.Digest
+	candidates = append(candidates, p.Checksums...)
+	if isPackageArchive(p) && len(p.Locations) == 1 {
+		candidates = append(candidates, digests[p.Locations[0].Coordinates]...)
+	}
+
+	var hashes []cyclonedx.Hash
+	seen := make(map[cyclonedx.HashAlgorithm]struct{})
+	for _, digest := range candidates {
+		algorithm, ok := hashAlgorithms[digest.Algorithm]
+		if !ok {
+			continue
+		}
+		if _, exists := seen[algorithm]; exists {
+			continue
+		}
+		seen[algorithm] = struct{}{}
+		hashes = append(hashes, cyclonedx.Hash{
+			Algorithm: algorithm,
+			Value:     digest.Value,
+		})
+	}
+	if len(hashes) == 0 {
+		return nil
+	}
+	return &hashes
+}
+
+// isPackageArchive indicates if the file the package was discovered from is the package itself. Archives nested within
+// another archive are located by the outermost archive, so are excluded.
+func isPackageArchive(p pkg.Package) bool {
+	switch p.Type {
+	case pkg.JavaPkg, pkg.JenkinsPluginPkg:
+		metadata, ok := p.Metadata.(pkg.JavaMetadata)
+		return ok && metadata.Parent == nil
+	}
+	return false
+}
@@ -0,0 +1,106 @@
+package cyclonedxhelpers
+
+import (
+	"testing"
+
+	"github.com/CycloneDX/cyclonedx-go"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/source"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_toHashes(t *testing.T) {
+	jarLocation := source.NewLocation("/app.jar")
+	dbLocation := source.NewLocation("/lib/apk/db/installed")
+
+	digests := map[source.Coordinates][]file.Digest{
+		jarLocation.Coordinates: {
+			{Algorithm: "sha1", Value: "a1"},
+			{Algorithm: "sha256", Value: "b2"},
+			{Algorithm: "crc32", Value: "c3"},
+		},
+		dbLocation.Coordinates: {
+			{Algorithm: "sha256", Value: "d4"},
+		},
+	}
+
+	jar := pkg.Package{
+		Type:      pkg.JavaPkg,
+		Locations: []source.Location{jarLocation},
+		Metadata:  pkg.JavaMetadata{VirtualPath: "/app.jar"},
+	}
+
+	tests := []struct {
+		name     string
+		pkg      pkg.Package
+		digests  map[source.Coordinates][]file.Digest
+		expected *[]cyclonedx.Hash
+	}{
+		{
+			name:    "java archive",
+			pkg:     jar,
+			digests: digests,
+			expected: &[]cyclonedx.Hash{
+				{Algorithm: cyclonedx.HashAlgoSHA1, Value: "a1"},
+				{Algorithm: cyclonedx.HashAlgoSHA256, Value: "b2"},
+			},
+		},
+		{
+			name: "no digests cataloged",
+			pkg:  jar,
+		},
+		{
+			name: "archive nested within another archive",
+			pkg: pkg.Package{
+				Type:      pkg.JavaPkg,
+				Locations: []source.Location{jarLocation},
+				Metadata:  pkg.JavaMetadata{VirtualPath: "/app.jar:lib.jar", Parent: &pkg.Package{Name: "app"}},
+			},
+			digests: digests,
+		},
+		{
+			name: "package listed within a package manager database",
+			pkg: pkg.Package{
+				Type:      pkg.ApkPkg,
+				Locations: []source.Location{dbLocation},
+			},
+			digests: digests,
+		},
+		{
+			name: "checksums recorded by the cataloger",
+			pkg: pkg.Package{
+				Type:      pkg.NpmPkg,
+				Locations: []source.Location{dbLocation},
+				Checksums: []file.Digest{
+					{Algorithm: "sha512", Value: "e5"},
+				},
+			},
+			digests: digests,
+			expected: &[]cyclonedx.Hash{
+				{Algorithm: cyclonedx.HashAlgoSHA512, Value: "e5"},
+			},
+		},
+		{
+			name: "cataloger checksums take precedence over archive digests",
+			pkg: pkg.Package{
+				Type:      pkg.JavaPkg,
+				Locations: []source.Location{jarLocation},
+				Metadata:  pkg.JavaMetadata{VirtualPath: "/app.jar"},
+				Checksums: []file.Digest{
+					{Algorithm: "sha1", Value: "f6"},
+				},
+			},
+			digests: digests,
+			expected: &[]cyclonedx.Hash{
+				{Algorithm: cyclonedx.HashAlgoSHA1, Value: "f6"},
+				{Algorithm: cyclonedx.HashAlgoSHA256, Value: "b2"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, toHashes(test.pkg, test.digests))
+		})
+	}
+}
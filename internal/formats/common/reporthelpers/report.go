@@ -0,0 +1,150 @@
+/*
+Package reporthelpers provides the content of the human-readable report formats (markdown and html), which present the
+packages of an SBOM grouped by type along with the source and distro information.
+*/
+package reporthelpers
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/anchore/syft/syft/source"
+)
+
+// Report is the content of a human-readable report of an SBOM.
+type Report struct {
+	Title     string
+	Generator string  // the name and version of the tool that created the SBOM
+	Timestamp string  // the creation time of the SBOM (RFC3339)
+	Source    []Field // what was cataloged
+	Distro    []Field // the identified linux distribution (empty when none was identified)
+	Groups    []Group // the packages grouped by type, ordered by type
+	Total     int     // the number of packages in all groups
+}
+
+// Field is a single named value of the report (e.g. the path of the source).
+type Field struct {
+	Name  string
+	Value string
+}
+
+// Group holds all packages of a single type, ordered by name and version.
+type Group struct {
+	Type     pkg.Type
+	Packages []Package
+}
+
+// Package is a single row of a package table of the report.
+type Package struct {
+	Name      string
+	Version   string
+	Licenses  string
+	PURL      string
+	Locations string
+}
+
+const separator = ", "
+
+// New creates the report content for the given SBOM.
+func New(s sbom.SBOM) Report {
+	report := Report{
+		Title:     "Software Bill of Materials: " + sourceName(s.Source),
+		Generator: strings.TrimSpace(s.Descriptor.Name + " " + s.Descriptor.Version),
+		Timestamp: s.Descriptor.CreationTime().UTC().Format(time.RFC3339),
+		Source:    sourceFields(s.Source),
+	}
+
+	if d := s.Artifacts.Distro; d != nil {
+		report.Distro = []Field{
+			{Name: "Name", Value: d.Name()},
+			{Name: "Version", Value: d.FullVersion()},
+		}
+		if d.IDLike != "" {
+			report.Distro = append(report.Distro, Field{Name: "ID like", Value: d.IDLike})
+		}
+	}
+
+	if s.Artifacts.PackageCatalog == nil {
+		return report
+	}
+
+	byType := make(map[pkg.Type]*Group)
+	for _, p := range s.Artifacts.PackageCatalog.Sorted() {
+		group, ok := byType[p.Type]
+		if !ok {
+			group = &Group{Type: p.Type}
+			byType[p.Type] = group
+		}
+		group.Packages = append(group.Packages, toPackage(p))
+		report.Total++
+	}
+
+	for _, group := range byType {
+		report.Groups = append(report.Groups, *group)
+	}
+	sort.Slice(report.Groups, func(i, j int) bool {
+		return report.Groups[i].Type < report.Groups[j].Type
+	})
+
+	return report
+}
+
+func toPackage(p pkg.Package) Package {
+	var locations []string
+	for _, l := range p.Locations {
+		locations = append(locations, l.RealPath)
+	}
+	return Package{
+		Name:      p.Name,
+		Version:   p.Version,
+		Licenses:  strings.Join(p.Licenses, separator),
+		PURL:      p.PURL,
+		Locations: strings.Join(locations, separator),
+	}
+}
+
+func sourceName(m source.Metadata) string {
+	if m.Scheme == source.ImageScheme {
+		return m.ImageMetadata.UserInput
+	}
+	return m.Path
+}
+
+func sourceFields(m source.Metadata) []Field {
+	var fields []Field
+	switch m.Scheme {
+	case source.ImageScheme:
+		img := m.ImageMetadata
+		fields = []Field{
+			{Name: "Type", Value: "image"},
+			{Name: "Image", Value: img.UserInput},
+			{Name: "ID", Value: img.ID},
+			{Name: "Manifest digest", Value: img.ManifestDigest},
+			{Name: "Tags", Value: strings.Join(img.Tags, separator)},
+			{Name: "Size", Value: fmt.Sprintf("%d bytes", img.Size)},
+			{Name: "Layers", Value: fmt.Sprintf("%d", len(img.Layers))},
+		}
+	case source.FileScheme:
+		fields = []Field{
+			{Name: "Type", Value: "file"},
+			{Name: "Path", Value: m.Path},
+		}
+	default:
+		fields = []Field{
+			{Name: "Type", Value: "directory"},
+			{Name: "Path", Value: m.Path},
+		}
+	}
+
+	if h := m.Host; h != nil {
+		fields = append(fields,
+			Field{Name: "Hostname", Value: h.Hostname},
+			Field{Name: "Operating system", Value: h.OperatingSystem},
+		)
+	}
+	return fields
+}
@@ -0,0 +1,86 @@
+package reporthelpers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/anchore/syft/syft/source"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	catalog := pkg.NewCatalog(
+		pkg.Package{Name: "musl", Version: "1.2.2", Type: pkg.ApkPkg, Licenses: []string{"MIT"}},
+		pkg.Package{Name: "busybox", Version: "1.33.1", Type: pkg.ApkPkg, Licenses: []string{"GPL-2.0-only"}},
+		pkg.Package{
+			Name:    "requests",
+			Version: "2.26.0",
+			Type:    pkg.PythonPkg,
+			PURL:    "pkg:pypi/requests@2.26.0",
+			Locations: []source.Location{
+				source.NewLocation("/usr/lib/python3.9/site-packages/requests-2.26.0.dist-info/METADATA"),
+				source.NewLocation("/usr/lib/python3.9/site-packages/requests-2.26.0.dist-info/RECORD"),
+			},
+		},
+	)
+
+	s := sbom.SBOM{
+		Artifacts: sbom.Artifacts{PackageCatalog: catalog},
+		Source: source.Metadata{
+			Scheme: source.ImageScheme,
+			ImageMetadata: source.ImageMetadata{
+				UserInput:      "alpine:3.14",
+				ID:             "sha256:abc",
+				ManifestDigest: "sha256:def",
+				Tags:           []string{"alpine:3.14", "alpine:latest"},
+				Size:           5600000,
+				Layers:         []source.LayerMetadata{{Digest: "sha256:123"}},
+			},
+		},
+		Descriptor: sbom.Descriptor{
+			Name:      "syft",
+			Version:   "v0.42.0",
+			Timestamp: time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC),
+		},
+	}
+
+	expected := Report{
+		Title:     "Software Bill of Materials: alpine:3.14",
+		Generator: "syft v0.42.0",
+		Timestamp: "2022-03-01T12:00:00Z",
+		Source: []Field{
+			{Name: "Type", Value: "image"},
+			{Name: "Image", Value: "alpine:3.14"},
+			{Name: "ID", Value: "sha256:abc"},
+			{Name: "Manifest digest", Value: "sha256:def"},
+			{Name: "Tags", Value: "alpine:3.14, alpine:latest"},
+			{Name: "Size", Value: "5600000 bytes"},
+			{Name: "Layers", Value: "1"},
+		},
+		Groups: []Group{
+			{
+				Type: pkg.ApkPkg,
+				Packages: []Package{
+					{Name: "busybox", Version: "1.33.1", Licenses: "GPL-2.0-only"},
+					{Name: "musl", Version: "1.2.2", Licenses: "MIT"},
+				},
+			},
+			{
+				Type: pkg.PythonPkg,
+				Packages: []Package{
+					{
+						Name:      "requests",
+						Version:   "2.26.0",
+						PURL:      "pkg:pypi/requests@2.26.0",
+						Locations: "/usr/lib/python3.9/site-packages/requests-2.26.0.dist-info/METADATA, /usr/lib/python3.9/site-packages/requests-2.26.0.dist-info/RECORD",
+					},
+				},
+			},
+		},
+		Total: 3,
+	}
+
+	assert.Equal(t, expected, New(s))
+}
@@ -0,0 +1,33 @@
+package spdxhelpers
+
+import (
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// checksumAlgorithms maps syft digest algorithm names to the algorithm names allowed by SPDX.
+var checksumAlgorithms = map[string]string{
+	"md5":    "MD5",
+	"sha1":   "SHA1",
+	"sha224": "SHA224",
+	"sha256": "SHA256",
+	"sha384": "SHA384",
+	"sha512": "SHA512",
+}
+
+// Checksums returns the digests of the distributed package (e.g. as recorded in a lockfile) using the SPDX algorithm
+// names. Digests made with an algorithm that SPDX cannot describe are left out.
+func Checksums(p pkg.Package) []file.Digest {
+	var checksums []file.Digest
+	for _, digest := range p.Checksums {
+		algorithm, ok := checksumAlgorithms[digest.Algorithm]
+		if !ok {
+			continue
+		}
+		checksums = append(checksums, file.Digest{
+			Algorithm: algorithm,
+			Value:     digest.Value,
+		})
+	}
+	return checksums
+}
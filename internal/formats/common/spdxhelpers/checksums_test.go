@@ -0,0 +1,53 @@
+package spdxhelpers
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Checksums(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    pkg.Package
+		expected []file.Digest
+	}{
+		{
+			name:     "no checksums",
+			input:    pkg.Package{},
+			expected: nil,
+		},
+		{
+			name: "algorithm names are converted",
+			input: pkg.Package{
+				Checksums: []file.Digest{
+					{Algorithm: "sha1", Value: "ed0317c322064f79466c02966bddb605ab37d998"},
+					{Algorithm: "sha512", Value: "abcd"},
+				},
+			},
+			expected: []file.Digest{
+				{Algorithm: "SHA1", Value: "ed0317c322064f79466c02966bddb605ab37d998"},
+				{Algorithm: "SHA512", Value: "abcd"},
+			},
+		},
+		{
+			name: "unsupported algorithms are dropped",
+			input: pkg.Package{
+				Checksums: []file.Digest{
+					{Algorithm: "blake2b", Value: "abcd"},
+					{Algorithm: "sha256", Value: "ef01"},
+				},
+			},
+			expected: []file.Digest{
+				{Algorithm: "SHA256", Value: "ef01"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, Checksums(test.input))
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package spdxhelpers
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/anchore/syft/syft/sbom"
+)
+
+// CreatorPersons returns the SPDX person creators for the document.
+func CreatorPersons(descriptor sbom.Descriptor) []string {
+	if descriptor.Author == "" {
+		return nil
+	}
+	return []string{descriptor.Author}
+}
+
+// defaultOrganization is the organization creator of documents that do not name one.
+const defaultOrganization = "Anchore, Inc"
+
+// CreatorOrganizations returns the SPDX organization creators for the document.
+func CreatorOrganizations(descriptor sbom.Descriptor) []string {
+	if descriptor.Organization == "" {
+		return []string{defaultOrganization}
+	}
+	return []string{descriptor.Organization}
+}
+
+// CreatorComment returns a comment for the SPDX creation info. SPDX 2.2 has no document-level fields for the supplier
+// of the described software, for the documents the document was derived from, or for the composition of the document,
+// so these are captured here instead.
+func CreatorComment(descriptor sbom.Descriptor, summary sbom.Summary) string {
+	var lines []string
+	if descriptor.Supplier != "" {
+		lines = append(lines, fmt.Sprintf("Supplier: %s", descriptor.Supplier))
+	}
+	lines = append(lines, originLines(descriptor.Origins, "")...)
+	lines = append(lines, summaryLines(summary)...)
+	return strings.Join(lines, "\n")
+}
+
+// summaryLines describes the number of packages (by type) and files within the document, and how long cataloging took.
+func summaryLines(summary sbom.Summary) []string {
+	var counts []string
+	for _, t := range summary.Types() {
+		counts = append(counts, fmt.Sprintf("%s: %d", t, summary.PackagesByType[t]))
+	}
+	packages := fmt.Sprintf("Packages: %d", summary.Packages)
+	if len(counts) > 0 {
+		packages += " (" + strings.Join(counts, ", ") + ")"
+	}
+
+	lines := []string{packages, fmt.Sprintf("Files: %d", summary.Files)}
+	if summary.Duration > 0 {
+		lines = append(lines, fmt.Sprintf("Scan duration: %s", summary.Duration))
+	}
+	return lines
+}
+
+// originLines describes the chain of documents a document was derived from, with the origins of an origin indented
+// beneath it.
+func originLines(origins []sbom.DocumentOrigin, indent string) []string {
+	var lines []string
+	for _, o := range origins {
+		line := fmt.Sprintf("%sDerived from: %s document by %s %s (%s)", indent, o.Format, o.Tool, o.Version, o.Digest)
+		if !o.Timestamp.IsZero() {
+			line += fmt.Sprintf(" created %s", o.Timestamp.UTC().Format(time.RFC3339))
+		}
+		lines = append(lines, line)
+		lines = append(lines, originLines(o.Origins, indent+"  ")...)
+	}
+	return lines
+}
@@ -0,0 +1,79 @@
+package spdxhelpers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Creators(t *testing.T) {
+	tests := []struct {
+		name                  string
+		input                 sbom.Descriptor
+		summary               sbom.Summary
+		expectedPersons       []string
+		expectedOrganizations []string
+		expectedComment       string
+	}{
+		{
+			name:                  "no creators",
+			input:                 sbom.Descriptor{Name: "syft"},
+			expectedOrganizations: []string{"Anchore, Inc"},
+			expectedComment:       "Packages: 0\nFiles: 0",
+		},
+		{
+			name: "all creators",
+			input: sbom.Descriptor{
+				Name:         "syft",
+				Author:       "Jane Doe (jane@example.com)",
+				Organization: "Example, Inc",
+				Supplier:     "Example Supplier",
+			},
+			expectedPersons:       []string{"Jane Doe (jane@example.com)"},
+			expectedOrganizations: []string{"Example, Inc"},
+			expectedComment:       "Supplier: Example Supplier\nPackages: 0\nFiles: 0",
+		},
+		{
+			name: "derived document",
+			input: sbom.Descriptor{
+				Name: "syft",
+				Origins: []sbom.DocumentOrigin{
+					{
+						Tool:      "syft",
+						Version:   "0.40.0",
+						Format:    "json",
+						Timestamp: time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC),
+						Digest:    "sha256:1a2b",
+						Origins: []sbom.DocumentOrigin{
+							{Tool: "syft", Version: "0.39.0", Format: "spdx-json", Digest: "sha256:3c4d"},
+						},
+					},
+				},
+			},
+			expectedOrganizations: []string{"Anchore, Inc"},
+			expectedComment:       "Derived from: json document by syft 0.40.0 (sha256:1a2b) created 2022-03-01T12:00:00Z\n  Derived from: spdx-json document by syft 0.39.0 (sha256:3c4d)\nPackages: 0\nFiles: 0",
+		},
+		{
+			name:  "summary",
+			input: sbom.Descriptor{Name: "syft"},
+			summary: sbom.Summary{
+				Packages:       3,
+				PackagesByType: map[pkg.Type]int{pkg.PythonPkg: 1, pkg.DebPkg: 2},
+				Files:          12,
+				Duration:       1500 * time.Millisecond,
+			},
+			expectedOrganizations: []string{"Anchore, Inc"},
+			expectedComment:       "Packages: 3 (deb: 2, python: 1)\nFiles: 12\nScan duration: 1.5s",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expectedPersons, CreatorPersons(test.input))
+			assert.Equal(t, test.expectedOrganizations, CreatorOrganizations(test.input))
+			assert.Equal(t, test.expectedComment, CreatorComment(test.input, test.summary))
+		})
+	}
+}
@@ -6,19 +6,30 @@ import (
 	"path"
 
 	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/syft/sbom"
 	"github.com/anchore/syft/syft/source"
 	"github.com/google/uuid"
 )
 
-func DocumentNameAndNamespace(srcMetadata source.Metadata) (string, string, error) {
-	name, err := DocumentName(srcMetadata)
-	if err != nil {
-		return "", "", err
+// DocumentNameAndNamespace returns the name and namespace of the document, as pinned by the descriptor or else derived
+// from the source.
+func DocumentNameAndNamespace(srcMetadata source.Metadata, descriptor sbom.Descriptor) (string, string, error) {
+	name := descriptor.DocumentName
+	if name == "" {
+		var err error
+		if name, err = DocumentName(srcMetadata); err != nil {
+			return "", "", err
+		}
 	}
-	return name, DocumentNamespace(name, srcMetadata), nil
+
+	namespace := descriptor.Namespace
+	if namespace == "" {
+		namespace = DocumentNamespace(cleanName(name), srcMetadata, descriptor.DocumentUUID())
+	}
+	return name, namespace, nil
 }
 
-func DocumentNamespace(name string, srcMetadata source.Metadata) string {
+func DocumentNamespace(name string, srcMetadata source.Metadata, uniqueID uuid.UUID) string {
 	input := "unknown-source-type"
 	switch srcMetadata.Scheme {
 	case source.ImageScheme:
@@ -29,7 +40,6 @@ func DocumentNamespace(name string, srcMetadata source.Metadata) string {
 		input = "file"
 	}
 
-	uniqueID := uuid.Must(uuid.NewRandom())
 	identifier := path.Join(input, uniqueID.String())
 	if name != "." {
 		identifier = path.Join(input, fmt.Sprintf("%s-%s", name, uniqueID.String()))
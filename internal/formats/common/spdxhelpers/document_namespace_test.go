@@ -1,11 +1,11 @@
 package spdxhelpers
 
 import (
-	"fmt"
-	"strings"
 	"testing"
 
+	"github.com/anchore/syft/syft/sbom"
 	"github.com/anchore/syft/syft/source"
+	"github.com/google/uuid"
 	"github.com/scylladb/go-set/strset"
 	"github.com/stretchr/testify/assert"
 )
@@ -57,9 +57,9 @@ func Test_documentNamespace(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			actual := DocumentNamespace(test.inputName, test.srcMetadata)
-			// note: since the namespace ends with a UUID we check the prefix
-			assert.True(t, strings.HasPrefix(actual, test.expected), fmt.Sprintf("actual namespace %q", actual))
+			uniqueID := uuid.New()
+			actual := DocumentNamespace(test.inputName, test.srcMetadata, uniqueID)
+			assert.Equal(t, test.expected+uniqueID.String(), actual)
 
 			// track each scheme tested (passed or not)
 			testedSchemes.Add(string(test.srcMetadata.Scheme))
@@ -69,3 +69,45 @@ func Test_documentNamespace(t *testing.T) {
 	// assert all possible schemes were under test
 	assert.ElementsMatch(t, allSchemes.List(), testedSchemes.List(), "not all source.Schemes are under test")
 }
+
+func TestDocumentNameAndNamespace(t *testing.T) {
+	src := source.Metadata{
+		Scheme: source.DirectoryScheme,
+		Path:   "some/path",
+	}
+	id := uuid.MustParse("4b896ded-7852-4e31-b764-136b53bdf346")
+
+	tests := []struct {
+		name              string
+		descriptor        sbom.Descriptor
+		expectedName      string
+		expectedNamespace string
+	}{
+		{
+			name:              "derived from the source",
+			descriptor:        sbom.Descriptor{UUID: id},
+			expectedName:      "some/path",
+			expectedNamespace: "https://anchore.com/syft/dir/some/path-4b896ded-7852-4e31-b764-136b53bdf346",
+		},
+		{
+			name:              "pinned name",
+			descriptor:        sbom.Descriptor{UUID: id, DocumentName: "my-app:1.0"},
+			expectedName:      "my-app:1.0",
+			expectedNamespace: "https://anchore.com/syft/dir/my-app-1.0-4b896ded-7852-4e31-b764-136b53bdf346",
+		},
+		{
+			name:              "pinned name and namespace",
+			descriptor:        sbom.Descriptor{UUID: id, DocumentName: "my-app", Namespace: "https://sboms.example.com/my-app/1234"},
+			expectedName:      "my-app",
+			expectedNamespace: "https://sboms.example.com/my-app/1234",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			name, namespace, err := DocumentNameAndNamespace(src, test.descriptor)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedName, name)
+			assert.Equal(t, test.expectedNamespace, namespace)
+		})
+	}
+}
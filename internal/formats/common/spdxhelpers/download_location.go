@@ -11,6 +11,14 @@ func DownloadLocation(p pkg.Package) string {
 	//   (ii) the SPDX file creator has made no attempt to determine this field; or
 	//   (iii) the SPDX file creator has intentionally provided no information (no meaning should be implied by doing so).
 
+	// prefer the origin URLs captured by the cataloger, where the artifact itself is the most specific location
+	switch {
+	case p.OriginURLs.Download != "":
+		return p.OriginURLs.Download
+	case p.OriginURLs.Repository != "":
+		return p.OriginURLs.Repository
+	}
+
 	if hasMetadata(p) {
 		switch metadata := p.Metadata.(type) {
 		case pkg.ApkMetadata:
@@ -36,6 +36,30 @@ func Test_DownloadLocation(t *testing.T) {
 			},
 			expected: "http://a-place.gov",
 		},
+		{
+			name: "from origin download URL",
+			input: pkg.Package{
+				OriginURLs: pkg.OriginURLs{
+					Homepage:   "http://a-place.gov",
+					Repository: "git+https://a-place.gov/repo.git",
+					Download:   "http://a-place.gov/download.tgz",
+				},
+				Metadata: pkg.NpmPackageJSONMetadata{
+					URL: "http://another-place.gov",
+				},
+			},
+			expected: "http://a-place.gov/download.tgz",
+		},
+		{
+			name: "from origin repository URL",
+			input: pkg.Package{
+				OriginURLs: pkg.OriginURLs{
+					Homepage:   "http://a-place.gov",
+					Repository: "git+https://a-place.gov/repo.git",
+				},
+			},
+			expected: "git+https://a-place.gov/repo.git",
+		},
 		{
 			name: "empty",
 			input: pkg.Package{
@@ -3,6 +3,9 @@ package spdxhelpers
 import "github.com/anchore/syft/syft/pkg"
 
 func Homepage(p pkg.Package) string {
+	if p.OriginURLs.Homepage != "" {
+		return p.OriginURLs.Homepage
+	}
 	if hasMetadata(p) {
 		switch metadata := p.Metadata.(type) {
 		case pkg.GemMetadata:
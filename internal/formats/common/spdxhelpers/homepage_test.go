@@ -37,6 +37,16 @@ func Test_Homepage(t *testing.T) {
 			},
 			expected: "http://a-place.gov",
 		},
+		{
+			name: "from origin URLs",
+			input: pkg.Package{
+				OriginURLs: pkg.OriginURLs{
+					Homepage: "http://a-place.gov",
+				},
+				Metadata: pkg.PythonPackageMetadata{},
+			},
+			expected: "http://a-place.gov",
+		},
 		{
 			// note: since this is an optional field, no value is preferred over NONE or NOASSERTION
 			name: "empty",
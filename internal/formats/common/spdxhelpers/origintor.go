@@ -6,32 +6,46 @@ import (
 	"github.com/anchore/syft/syft/pkg"
 )
 
+// Originator returns the party the package originates from in the SPDX format, either "Person: <name> (<email>)" or
+// "Organization: <name>". An empty string is returned when the originator is not known.
 func Originator(p pkg.Package) string {
 	if hasMetadata(p) {
 		switch metadata := p.Metadata.(type) {
 		case pkg.ApkMetadata:
-			return metadata.Maintainer
+			return person(metadata.Maintainer, "")
 		case pkg.NpmPackageJSONMetadata:
-			return metadata.Author
+			return person(metadata.Author, "")
 		case pkg.PythonPackageMetadata:
-			author := metadata.Author
-			if author == "" {
-				return metadata.AuthorEmail
-			}
-			if metadata.AuthorEmail != "" {
-				author += fmt.Sprintf(" <%s>", metadata.AuthorEmail)
-			}
-			return author
+			return person(metadata.Author, metadata.AuthorEmail)
 		case pkg.GemMetadata:
 			if len(metadata.Authors) > 0 {
-				return metadata.Authors[0]
+				return person(metadata.Authors[0], "")
 			}
 			return ""
 		case pkg.RpmdbMetadata:
-			return metadata.Vendor
+			return organization(metadata.Vendor)
 		case pkg.DpkgMetadata:
-			return metadata.Maintainer
+			return person(metadata.Maintainer, "")
 		}
 	}
 	return ""
 }
+
+func person(name, email string) string {
+	switch {
+	case name == "" && email == "":
+		return ""
+	case name == "":
+		return "Person: " + email
+	case email == "":
+		return "Person: " + name
+	}
+	return fmt.Sprintf("Person: %s (%s)", name, email)
+}
+
+func organization(name string) string {
+	if name == "" {
+		return ""
+	}
+	return "Organization: " + name
+}
@@ -0,0 +1,16 @@
+package spdxhelpers
+
+import (
+	"github.com/anchore/syft/internal/formats/spdx22json/model"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// PrimaryPackagePurpose describes what a cataloged package is used for. Language runtimes (e.g. a JVM or a python
+// interpreter) are frameworks that other packages execute within, everything else is consumed as a library.
+func PrimaryPackagePurpose(p pkg.Package) model.PrimaryPackagePurpose {
+	switch p.Type {
+	case pkg.RuntimePkg:
+		return model.FrameworkPurpose
+	}
+	return model.LibraryPurpose
+}
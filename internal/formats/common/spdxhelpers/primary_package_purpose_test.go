@@ -0,0 +1,49 @@
+package spdxhelpers
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/internal/formats/spdx22json/model"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_PrimaryPackagePurpose(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    pkg.Package
+		expected model.PrimaryPackagePurpose
+	}{
+		{
+			name:     "no type",
+			input:    pkg.Package{},
+			expected: model.LibraryPurpose,
+		},
+		{
+			name: "language package",
+			input: pkg.Package{
+				Type: pkg.PythonPkg,
+			},
+			expected: model.LibraryPurpose,
+		},
+		{
+			name: "os package",
+			input: pkg.Package{
+				Type: pkg.DebPkg,
+			},
+			expected: model.LibraryPurpose,
+		},
+		{
+			name: "language runtime",
+			input: pkg.Package{
+				Type: pkg.RuntimePkg,
+			},
+			expected: model.FrameworkPurpose,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, PrimaryPackagePurpose(test.input))
+		})
+	}
+}
@@ -29,6 +29,20 @@ func SourceInfo(p pkg.Package) string {
 		answer = "acquired package info from rust cargo manifest"
 	case pkg.PhpComposerPkg:
 		answer = "acquired package info from PHP composer manifest"
+	case pkg.BuildrootPkg:
+		answer = "acquired package info from buildroot package manifest"
+	case pkg.YoctoPkg:
+		answer = "acquired package info from yocto image license manifest"
+	case pkg.OpkgPkg:
+		answer = "acquired package info from OPKG DB"
+	case pkg.RuntimePkg:
+		answer = "acquired package info from language runtime installation"
+	case pkg.StaticLibraryPkg:
+		answer = "acquired package info from static library archive"
+	case pkg.PhpPeclPkg:
+		answer = "acquired package info from PECL/PEAR registry or compiled PHP extension"
+	case pkg.WebServerModulePkg:
+		answer = "acquired package info from web server module"
 	default:
 		answer = "acquired package info from the following paths"
 	}
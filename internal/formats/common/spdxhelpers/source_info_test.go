@@ -126,6 +126,62 @@ func Test_SourceInfo(t *testing.T) {
 				"from PHP composer manifest",
 			},
 		},
+		{
+			input: pkg.Package{
+				Type: pkg.BuildrootPkg,
+			},
+			expected: []string{
+				"from buildroot package manifest",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.YoctoPkg,
+			},
+			expected: []string{
+				"from yocto image license manifest",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.OpkgPkg,
+			},
+			expected: []string{
+				"from OPKG DB",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.RuntimePkg,
+			},
+			expected: []string{
+				"from language runtime installation",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.StaticLibraryPkg,
+			},
+			expected: []string{
+				"from static library archive",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.PhpPeclPkg,
+			},
+			expected: []string{
+				"from PECL/PEAR registry or compiled PHP extension",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.WebServerModulePkg,
+			},
+			expected: []string{
+				"from web server module",
+			},
+		},
 	}
 	var pkgTypes []pkg.Type
 	for _, test := range tests {
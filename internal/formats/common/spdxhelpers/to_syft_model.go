@@ -0,0 +1,232 @@
+package spdxhelpers
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/formats/spdx22json/model"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/anchore/syft/syft/source"
+)
+
+const (
+	imageElementPrefix = "SPDXRef-Image-"
+	layerElementPrefix = "SPDXRef-Layer-"
+	sourceInfoPrefix   = "acquired package info from"
+)
+
+// ToSyftModel describes the packages of the given SPDX document (along with the dependencies between them). Only what
+// SPDX can express is recovered: package metadata and the files of the source are not part of the document.
+func ToSyftModel(doc model.Document) (*sbom.SBOM, error) {
+	s := &sbom.SBOM{
+		Artifacts: sbom.Artifacts{
+			PackageCatalog: pkg.NewCatalog(),
+		},
+		Source:     toSyftSource(doc),
+		Descriptor: toSyftDescriptor(doc.CreationInfo),
+	}
+
+	packagesByID := make(map[string]pkg.Package)
+	for _, p := range doc.Packages {
+		if isImageElement(p.SPDXID) {
+			continue
+		}
+		syftPkg := toSyftPackage(p)
+		s.Artifacts.PackageCatalog.Add(syftPkg)
+		packagesByID[p.SPDXID] = syftPkg
+	}
+	s.Relationships = toSyftRelationships(doc.Relationships, packagesByID)
+
+	return s, nil
+}
+
+// isImageElement indicates if the given element describes the scanned image (or one of its layers) rather than a
+// package found within it.
+func isImageElement(id string) bool {
+	return strings.HasPrefix(id, imageElementPrefix) || strings.HasPrefix(id, layerElementPrefix)
+}
+
+func toSyftPackage(p model.Package) pkg.Package {
+	purl := ExtractPURL(p.ExternalRefs)
+	syftPkg := pkg.Package{
+		Name:       p.Name,
+		Version:    p.VersionInfo,
+		Locations:  toSyftLocations(p.SourceInfo),
+		Licenses:   toSyftLicenses(p.LicenseDeclared),
+		Language:   pkg.LanguageFromPURL(purl),
+		Type:       pkg.TypeFromPURL(purl),
+		CPEs:       ExtractCPEs(p.ExternalRefs),
+		PURL:       purl,
+		OriginURLs: toSyftOriginURLs(p),
+		Checksums:  toSyftChecksums(p.Checksums),
+	}
+	syftPkg.SetID()
+	return syftPkg
+}
+
+// toSyftLocations recovers the paths the package was found by from the source info written by syft.
+func toSyftLocations(sourceInfo string) []source.Location {
+	if !strings.HasPrefix(sourceInfo, sourceInfoPrefix) {
+		return nil
+	}
+	fields := strings.SplitN(sourceInfo, ": ", 2)
+	if len(fields) != 2 || fields[1] == "" {
+		return nil
+	}
+
+	var locations []source.Location
+	for _, path := range strings.Split(fields[1], ", ") {
+		locations = append(locations, source.NewLocation(path))
+	}
+	return locations
+}
+
+// toSyftLicenses splits the declared license expression into the licenses syft joins with "AND".
+func toSyftLicenses(expression string) []string {
+	switch expression {
+	case "", "NONE", "NOASSERTION":
+		return nil
+	}
+	return strings.Split(expression, " AND ")
+}
+
+func toSyftOriginURLs(p model.Package) pkg.OriginURLs {
+	urls := pkg.OriginURLs{
+		Homepage: p.Homepage,
+	}
+	switch location := p.DownloadLocation; {
+	case location == "", location == "NONE", location == "NOASSERTION":
+	case strings.HasPrefix(location, "git+"):
+		urls.Repository = location
+	default:
+		urls.Download = location
+	}
+	return urls
+}
+
+// toSyftChecksums returns the checksums of the package, named after the digest algorithms used by syft.
+func toSyftChecksums(checksums []model.Checksum) []file.Digest {
+	var digests []file.Digest
+	for _, c := range checksums {
+		for algorithm, spdxAlgorithm := range checksumAlgorithms {
+			if spdxAlgorithm == c.Algorithm {
+				digests = append(digests, file.Digest{
+					Algorithm: algorithm,
+					Value:     c.ChecksumValue,
+				})
+			}
+		}
+	}
+	return digests
+}
+
+// toSyftRelationships recovers the dependencies between packages (and the packages found within other packages).
+func toSyftRelationships(relationships []model.Relationship, packagesByID map[string]pkg.Package) []artifact.Relationship {
+	var result []artifact.Relationship
+	for _, r := range relationships {
+		a, aExists := packagesByID[r.SpdxElementID]
+		b, bExists := packagesByID[r.RelatedSpdxElement]
+		if !aExists || !bExists {
+			continue
+		}
+
+		switch r.RelationshipType {
+		case model.DependsOnRelationship:
+			result = append(result, artifact.Relationship{From: b, To: a, Type: artifact.DependencyOfRelationship})
+		case model.DependencyOfRelationship:
+			result = append(result, artifact.Relationship{From: a, To: b, Type: artifact.DependencyOfRelationship})
+		case model.ContainsRelationship:
+			result = append(result, artifact.Relationship{From: a, To: b, Type: artifact.ContainsRelationship})
+		}
+	}
+	return result
+}
+
+// toSyftSource describes the source from the image the document describes, or from the kind of source recorded within
+// a document namespace generated by syft.
+func toSyftSource(doc model.Document) source.Metadata {
+	for _, p := range doc.Packages {
+		if p.PrimaryPackagePurpose != model.ContainerPurpose || !strings.HasPrefix(p.SPDXID, imageElementPrefix) {
+			continue
+		}
+
+		img := source.ImageMetadata{
+			UserInput:      p.Name,
+			ID:             strings.Replace(strings.TrimPrefix(p.SPDXID, imageElementPrefix), "-", ":", 1),
+			ManifestDigest: p.VersionInfo,
+		}
+		for _, layer := range doc.Packages {
+			if strings.HasPrefix(layer.SPDXID, layerElementPrefix) {
+				img.Layers = append(img.Layers, source.LayerMetadata{Digest: layer.Name})
+			}
+		}
+		return source.Metadata{
+			Scheme:        source.ImageScheme,
+			ImageMetadata: img,
+		}
+	}
+
+	namespace, err := url.Parse(doc.DocumentNamespace)
+	if err != nil {
+		return source.Metadata{}
+	}
+	switch {
+	case strings.HasPrefix(namespace.Path, "/"+internal.ApplicationName+"/dir/"):
+		return source.Metadata{Scheme: source.DirectoryScheme, Path: doc.Name}
+	case strings.HasPrefix(namespace.Path, "/"+internal.ApplicationName+"/file/"):
+		return source.Metadata{Scheme: source.FileScheme, Path: doc.Name}
+	}
+	return source.Metadata{}
+}
+
+func toSyftDescriptor(info model.CreationInfo) sbom.Descriptor {
+	descriptor := sbom.Descriptor{
+		Timestamp: info.Created,
+	}
+
+	for _, creator := range info.Creators {
+		fields := strings.SplitN(creator, ": ", 2)
+		if len(fields) != 2 {
+			continue
+		}
+		switch fields[0] {
+		case "Tool":
+			if descriptor.Name == "" {
+				descriptor.Name, descriptor.Version = splitToolCreator(fields[1])
+			}
+		case "Person":
+			if descriptor.Author == "" {
+				descriptor.Author = fields[1]
+			}
+		case "Organization":
+			if descriptor.Organization == "" {
+				descriptor.Organization = fields[1]
+			}
+		}
+	}
+
+	for _, line := range strings.Split(info.Comment, "\n") {
+		if strings.HasPrefix(line, "Supplier: ") {
+			descriptor.Supplier = strings.TrimPrefix(line, "Supplier: ")
+		}
+	}
+
+	return descriptor
+}
+
+// splitToolCreator splits a tool creator ("<name>-<version>") into the name and version of the tool.
+func splitToolCreator(tool string) (string, string) {
+	idx := strings.LastIndex(tool, "-")
+	if strings.HasPrefix(tool, internal.ApplicationName+"-") {
+		// the version of syft may itself contain dashes (e.g. a pre-release)
+		idx = len(internal.ApplicationName)
+	}
+	if idx < 0 {
+		return tool, ""
+	}
+	return tool[:idx], tool[idx+1:]
+}
@@ -0,0 +1,21 @@
+package spdxhelpers
+
+import (
+	"fmt"
+
+	"github.com/anchore/syft/syft/source"
+)
+
+// WarningComments returns a document annotation comment for each non-fatal problem found while cataloging, since
+// these may have caused the document to be incomplete.
+func WarningComments(warnings []source.Warning) []string {
+	var comments []string
+	for _, w := range warnings {
+		if w.Path == "" {
+			comments = append(comments, fmt.Sprintf("Warning: %s", w.Message))
+			continue
+		}
+		comments = append(comments, fmt.Sprintf("Warning: %s (path=%s)", w.Message, w.Path))
+	}
+	return comments
+}
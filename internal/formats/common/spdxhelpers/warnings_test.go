@@ -0,0 +1,21 @@
+package spdxhelpers
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/source"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_WarningComments(t *testing.T) {
+	warnings := []source.Warning{
+		{Path: "/etc/shadow", Message: "unable to access path: permission denied"},
+		{Message: "cataloging was incomplete"},
+	}
+
+	assert.Equal(t, []string{
+		"Warning: unable to access path: permission denied (path=/etc/shadow)",
+		"Warning: cataloging was incomplete",
+	}, WarningComments(warnings))
+	assert.Nil(t, WarningComments(nil))
+}
@@ -0,0 +1,64 @@
+package testutils
+
+import (
+	"regexp"
+
+	"github.com/anchore/syft/syft/format"
+)
+
+var (
+	rfc3339Pattern = regexp.MustCompile(`([0-9]+)-(0[1-9]|1[012])-(0[1-9]|[12][0-9]|3[01])[Tt]([01][0-9]|2[0-3]):([0-5][0-9]):([0-5][0-9]|60)(\.[0-9]+)?(([Zz])|([\+|\-]([01][0-9]|2[0-3]):[0-5][0-9]))`)
+
+	cycloneDxJSONSerialPattern = regexp.MustCompile(`urn:uuid:[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}`)
+	cycloneDxXMLSerialPattern  = regexp.MustCompile(`serialNumber="[a-zA-Z0-9\-:]+"`)
+
+	spdxJSONPatterns = []*regexp.Regexp{
+		// each SBOM reports the time it was generated, which is not useful during snapshot testing
+		regexp.MustCompile(`"created": .*`),
+		// each SBOM reports a unique documentNamespace when generated, this is not useful for snapshot testing
+		regexp.MustCompile(`"documentNamespace": .*`),
+		// the license list will be updated periodically, the value here should not be directly tested in snapshot tests
+		regexp.MustCompile(`"licenseListVersion": .*`),
+	}
+	spdxTagValuePatterns = []*regexp.Regexp{
+		regexp.MustCompile(`Created: .*`),
+		regexp.MustCompile(`DocumentNamespace: https://anchore.com/syft/.*`),
+		regexp.MustCompile(`LicenseListVersion: .*`),
+	}
+	gitHubPatterns = []*regexp.Regexp{
+		// the job, commit and ref are taken from the environment (e.g. when the tests run within GitHub Actions)
+		regexp.MustCompile(`(?s)"job": \{.*?\}`),
+		regexp.MustCompile(`"sha": .*`),
+		regexp.MustCompile(`"ref": .*`),
+		regexp.MustCompile(`"scanned": .*`),
+	}
+)
+
+// Redactors returns the rules that remove the values of the given format that change with every encoding (such as
+// timestamps, document identifiers, and values taken from the environment), which should be tested independently.
+func Redactors(option format.Option) []Redactor {
+	switch option {
+	case format.CycloneDxJSONOption:
+		return []Redactor{patternRedactor(cycloneDxJSONSerialPattern, rfc3339Pattern)}
+	case format.CycloneDxXMLOption:
+		return []Redactor{patternRedactor(cycloneDxXMLSerialPattern, rfc3339Pattern)}
+	case format.SPDXJSONOption, format.SPDX23JSONOption:
+		return []Redactor{patternRedactor(spdxJSONPatterns...)}
+	case format.SPDXTagValueOption:
+		return []Redactor{patternRedactor(spdxTagValuePatterns...)}
+	case format.GitHubOption:
+		return []Redactor{patternRedactor(gitHubPatterns...)}
+	case format.MarkdownOption, format.HTMLOption:
+		return []Redactor{patternRedactor(rfc3339Pattern)}
+	}
+	return nil
+}
+
+func patternRedactor(patterns ...*regexp.Regexp) Redactor {
+	return func(s []byte) []byte {
+		for _, pattern := range patterns {
+			s = pattern.ReplaceAll(s, []byte("redacted"))
+		}
+		return s
+	}
+}
@@ -2,6 +2,7 @@ package testutils
 
 import (
 	"bytes"
+	"io/ioutil"
 	"strings"
 	"testing"
 
@@ -18,7 +19,8 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-type redactor func(s []byte) []byte
+// Redactor removes dynamic values from encoded output before it is compared against a golden file.
+type Redactor func(s []byte) []byte
 
 type imageCfg struct {
 	fromSnapshot bool
@@ -32,7 +34,7 @@ func FromSnapshot() ImageOption {
 	}
 }
 
-func AssertEncoderAgainstGoldenImageSnapshot(t *testing.T, format format.Format, sbom sbom.SBOM, testImage string, updateSnapshot bool, redactors ...redactor) {
+func AssertEncoderAgainstGoldenImageSnapshot(t *testing.T, format format.Format, sbom sbom.SBOM, testImage string, updateSnapshot bool, redactors ...Redactor) {
 	var buffer bytes.Buffer
 
 	// grab the latest image contents and persist
@@ -66,7 +68,7 @@ func AssertEncoderAgainstGoldenImageSnapshot(t *testing.T, format format.Format,
 	}
 }
 
-func AssertEncoderAgainstGoldenSnapshot(t *testing.T, format format.Format, sbom sbom.SBOM, updateSnapshot bool, redactors ...redactor) {
+func AssertEncoderAgainstGoldenSnapshot(t *testing.T, format format.Format, sbom sbom.SBOM, updateSnapshot bool, redactors ...Redactor) {
 	var buffer bytes.Buffer
 
 	err := format.Encode(&buffer, sbom)
@@ -80,6 +82,29 @@ func AssertEncoderAgainstGoldenSnapshot(t *testing.T, format format.Format, sbom
 
 	var expected = testutils.GetGoldenFileContents(t)
 
+	assertGoldenContents(t, expected, actual, redactors...)
+}
+
+// AssertEncoderAgainstGoldenFile asserts the encoded SBOM against the golden file at the given path (instead of the
+// golden file named after the test, see AssertEncoderAgainstGoldenSnapshot).
+func AssertEncoderAgainstGoldenFile(t *testing.T, format format.Format, sbom sbom.SBOM, goldenPath string, redactors ...Redactor) {
+	t.Helper()
+
+	var buffer bytes.Buffer
+	err := format.Encode(&buffer, sbom)
+	assert.NoError(t, err)
+
+	expected, err := ioutil.ReadFile(goldenPath)
+	if err != nil {
+		t.Fatalf("could not read golden file (%s): %+v", goldenPath, err)
+	}
+
+	assertGoldenContents(t, expected, buffer.Bytes(), redactors...)
+}
+
+func assertGoldenContents(t *testing.T, expected, actual []byte, redactors ...Redactor) {
+	t.Helper()
+
 	// remove dynamic values, which should be tested independently
 	redactors = append(redactors, carriageRedactor)
 	for _, r := range redactors {
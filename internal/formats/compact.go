@@ -0,0 +1,42 @@
+package formats
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+
+	"github.com/anchore/syft/internal/formats/cyclonedx13xml"
+	"github.com/anchore/syft/syft/format"
+	"github.com/anchore/syft/syft/sbom"
+)
+
+// Compact returns the given format changed to write its JSON or XML output without indentation (e.g. for output that
+// is only read by other tools). Any other format is returned as-is.
+func Compact(f format.Format) format.Format {
+	switch f.Option {
+	case format.JSONOption, format.CycloneDxJSONOption, format.SPDXJSONOption, format.SPDX23JSONOption, format.GitHubOption, format.SARIFOption:
+		return format.NewFormat(f.Option, compactJSONEncoder(f), f.Decode, f.Validate)
+	case format.CycloneDxXMLOption:
+		return cyclonedx13xml.CompactFormat()
+	}
+	return f
+}
+
+// compactJSONEncoder removes the insignificant whitespace from the JSON written by the given format.
+func compactJSONEncoder(f format.Format) format.Encoder {
+	return func(output io.Writer, s sbom.SBOM) error {
+		var indented bytes.Buffer
+		if err := f.Encode(&indented, s); err != nil {
+			return err
+		}
+
+		var compacted bytes.Buffer
+		if err := json.Compact(&compacted, indented.Bytes()); err != nil {
+			return err
+		}
+		compacted.WriteByte('\n')
+
+		_, err := compacted.WriteTo(output)
+		return err
+	}
+}
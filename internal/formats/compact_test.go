@@ -0,0 +1,42 @@
+package formats
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/anchore/syft/internal/formats/common/testutils"
+	"github.com/anchore/syft/syft/format"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompact(t *testing.T) {
+	s := testutils.DirectoryInput(t)
+
+	for _, option := range []format.Option{format.JSONOption, format.SPDXJSONOption, format.CycloneDxJSONOption, format.CycloneDxXMLOption} {
+		t.Run(string(option), func(t *testing.T) {
+			f := ByOption(option)
+			require.NotNil(t, f)
+
+			var compacted bytes.Buffer
+			require.NoError(t, Compact(*f).Encode(&compacted, s))
+
+			output := strings.TrimSuffix(compacted.String(), "\n")
+			assert.NotContains(t, output, "\n ")
+			assert.NotContains(t, output, "\n\t")
+			if option != format.CycloneDxXMLOption {
+				assert.True(t, json.Valid(compacted.Bytes()))
+			}
+		})
+	}
+
+	// formats that are not JSON or XML are unaffected
+	table := ByOption(format.TableOption)
+	require.NotNil(t, table)
+	var expected, actual bytes.Buffer
+	require.NoError(t, table.Encode(&expected, s))
+	require.NoError(t, Compact(*table).Encode(&actual, s))
+	assert.Equal(t, expected.String(), actual.String())
+}
@@ -0,0 +1,77 @@
+package csv
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+const (
+	NameColumn      = "name"
+	VersionColumn   = "version"
+	TypeColumn      = "type"
+	FoundByColumn   = "found-by"
+	LocationsColumn = "locations"
+	LicensesColumn  = "licenses"
+	PURLColumn      = "purl"
+	CPEsColumn      = "cpes"
+)
+
+// AllColumns are the columns that can be selected for the CSV output, in their default order.
+var AllColumns = []string{
+	NameColumn,
+	VersionColumn,
+	TypeColumn,
+	FoundByColumn,
+	LocationsColumn,
+	LicensesColumn,
+	PURLColumn,
+	CPEsColumn,
+}
+
+// valueSeparator joins the values of a multi-valued column within a single cell.
+const valueSeparator = ";"
+
+// ValidateColumns returns an error when any of the given columns is unknown.
+func ValidateColumns(columns []string) error {
+	known := internal.NewStringSet(AllColumns...)
+	for _, c := range columns {
+		if !known.Contains(c) {
+			return fmt.Errorf("unknown csv column %q (options: %v)", c, AllColumns)
+		}
+	}
+	return nil
+}
+
+// value returns the cell of the given column for the package.
+func value(p pkg.Package, column string) string {
+	switch column {
+	case NameColumn:
+		return p.Name
+	case VersionColumn:
+		return p.Version
+	case TypeColumn:
+		return string(p.Type)
+	case FoundByColumn:
+		return p.FoundBy
+	case LocationsColumn:
+		var paths []string
+		for _, l := range p.Locations {
+			paths = append(paths, l.RealPath)
+		}
+		return strings.Join(paths, valueSeparator)
+	case LicensesColumn:
+		return strings.Join(p.Licenses, valueSeparator)
+	case PURLColumn:
+		return p.PURL
+	case CPEsColumn:
+		var cpes []string
+		for _, c := range p.CPEs {
+			cpes = append(cpes, pkg.CPEString(c))
+		}
+		return strings.Join(cpes, valueSeparator)
+	}
+	return ""
+}
@@ -0,0 +1,31 @@
+package csv
+
+import (
+	"encoding/csv"
+	"io"
+
+	"github.com/anchore/syft/syft/format"
+	"github.com/anchore/syft/syft/sbom"
+)
+
+func newEncoder(columns []string) format.Encoder {
+	return func(output io.Writer, s sbom.SBOM) error {
+		w := csv.NewWriter(output)
+
+		if err := w.Write(columns); err != nil {
+			return err
+		}
+		for _, p := range s.Artifacts.PackageCatalog.Sorted() {
+			row := make([]string, len(columns))
+			for i, column := range columns {
+				row[i] = value(p, column)
+			}
+			if err := w.Write(row); err != nil {
+				return err
+			}
+		}
+
+		w.Flush()
+		return w.Error()
+	}
+}
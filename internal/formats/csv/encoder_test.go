@@ -0,0 +1,33 @@
+package csv
+
+import (
+	"bytes"
+	"flag"
+	"testing"
+
+	"github.com/anchore/syft/internal/formats/common/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var updateCSVGoldenFiles = flag.Bool("update-csv", false, "update the *.golden files for csv format")
+
+func TestCSVEncoder(t *testing.T) {
+	testutils.AssertEncoderAgainstGoldenSnapshot(t,
+		Format(),
+		testutils.DirectoryInput(t),
+		*updateCSVGoldenFiles,
+	)
+}
+
+func TestCSVEncoder_SelectedColumns(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, Format(PURLColumn, NameColumn).Encode(&buf, testutils.DirectoryInput(t)))
+
+	assert.Equal(t, "purl,name\na-purl-2,package-1\na-purl-2,package-2\n", buf.String())
+}
+
+func TestValidateColumns(t *testing.T) {
+	assert.NoError(t, ValidateColumns([]string{NameColumn, CPEsColumn}))
+	assert.Error(t, ValidateColumns([]string{NameColumn, "description"}))
+}
@@ -0,0 +1,18 @@
+package csv
+
+import (
+	"github.com/anchore/syft/syft/format"
+)
+
+// Format returns a format that writes one row per package with the given columns (all columns when none are given).
+func Format(columns ...string) format.Format {
+	if len(columns) == 0 {
+		columns = AllColumns
+	}
+	return format.NewFormat(
+		format.CSVOption,
+		newEncoder(columns),
+		nil,
+		nil,
+	)
+}
@@ -5,14 +5,17 @@ import (
 
 	"github.com/CycloneDX/cyclonedx-go"
 	"github.com/anchore/syft/internal/formats/common/cyclonedxhelpers"
+	"github.com/anchore/syft/syft/format"
 	"github.com/anchore/syft/syft/sbom"
 )
 
-func encoder(output io.Writer, s sbom.SBOM) error {
-	bom := cyclonedxhelpers.ToFormatModel(s)
-	enc := cyclonedx.NewBOMEncoder(output, cyclonedx.BOMFileFormatXML)
-	enc.SetPretty(true)
+func newEncoder(pretty bool) format.Encoder {
+	return func(output io.Writer, s sbom.SBOM) error {
+		bom := cyclonedxhelpers.ToFormatModel(s)
+		enc := cyclonedx.NewBOMEncoder(output, cyclonedx.BOMFileFormatXML)
+		enc.SetPretty(pretty)
 
-	err := enc.Encode(bom)
-	return err
+		err := enc.Encode(bom)
+		return err
+	}
 }
@@ -1,12 +1,26 @@
 package cyclonedx13xml
 
-import "github.com/anchore/syft/syft/format"
+import (
+	"github.com/CycloneDX/cyclonedx-go"
+	"github.com/anchore/syft/internal/formats/common/cyclonedxhelpers"
+	"github.com/anchore/syft/syft/format"
+)
 
 func Format() format.Format {
 	return format.NewFormat(
 		format.CycloneDxXMLOption,
-		encoder,
-		nil,
-		nil,
+		newEncoder(true),
+		cyclonedxhelpers.GetDecoder(cyclonedx.BOMFileFormatXML),
+		cyclonedxhelpers.GetValidator(cyclonedx.BOMFileFormatXML),
+	)
+}
+
+// CompactFormat is the same as Format, but writes the XML without indentation.
+func CompactFormat() format.Format {
+	return format.NewFormat(
+		format.CycloneDxXMLOption,
+		newEncoder(false),
+		cyclonedxhelpers.GetDecoder(cyclonedx.BOMFileFormatXML),
+		cyclonedxhelpers.GetValidator(cyclonedx.BOMFileFormatXML),
 	)
 }
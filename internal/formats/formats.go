@@ -3,10 +3,16 @@ package formats
 import (
 	"bytes"
 
+	"github.com/anchore/syft/internal/formats/csv"
 	"github.com/anchore/syft/internal/formats/cyclonedx13json"
 	"github.com/anchore/syft/internal/formats/cyclonedx13xml"
+	"github.com/anchore/syft/internal/formats/github"
+	"github.com/anchore/syft/internal/formats/html"
+	"github.com/anchore/syft/internal/formats/markdown"
+	"github.com/anchore/syft/internal/formats/sarif"
 	"github.com/anchore/syft/internal/formats/spdx22json"
 	"github.com/anchore/syft/internal/formats/spdx22tagvalue"
+	"github.com/anchore/syft/internal/formats/spdx23json"
 	"github.com/anchore/syft/internal/formats/syftjson"
 	"github.com/anchore/syft/internal/formats/table"
 	"github.com/anchore/syft/internal/formats/text"
@@ -20,9 +26,15 @@ func All() []format.Format {
 		table.Format(),
 		cyclonedx13xml.Format(),
 		cyclonedx13json.Format(),
+		spdx23json.Format(), // before spdx22json, which identifies any SPDX 2.x JSON document
 		spdx22json.Format(),
 		spdx22tagvalue.Format(),
 		text.Format(),
+		csv.Format(),
+		github.Format(),
+		sarif.Format(),
+		markdown.Format(),
+		html.Format(),
 	}
 }
 
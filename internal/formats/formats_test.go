@@ -3,8 +3,10 @@ package formats
 import (
 	"io"
 	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/anchore/syft/internal/formats/common/testutils"
 	"github.com/anchore/syft/syft/format"
 	"github.com/stretchr/testify/assert"
 )
@@ -18,6 +20,26 @@ func TestIdentify(t *testing.T) {
 			fixture:  "test-fixtures/alpine-syft.json",
 			expected: format.JSONOption,
 		},
+		{
+			fixture:  directorySnapshots[format.CycloneDxJSONOption],
+			expected: format.CycloneDxJSONOption,
+		},
+		{
+			fixture:  directorySnapshots[format.CycloneDxXMLOption],
+			expected: format.CycloneDxXMLOption,
+		},
+		{
+			fixture:  directorySnapshots[format.SPDXJSONOption],
+			expected: format.SPDXJSONOption,
+		},
+		{
+			fixture:  directorySnapshots[format.SPDX23JSONOption],
+			expected: format.SPDX23JSONOption,
+		},
+		{
+			fixture:  directorySnapshots[format.SPDXTagValueOption],
+			expected: format.SPDXTagValueOption,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.fixture, func(t *testing.T) {
@@ -32,3 +54,33 @@ func TestIdentify(t *testing.T) {
 		})
 	}
 }
+
+// directorySnapshots are the golden files of each format for the canonical directory SBOM (testutils.DirectoryInput),
+// which are kept up to date by the encoder tests of each format (see the -update-* flags of each package).
+var directorySnapshots = map[format.Option]string{
+	format.JSONOption:          "syftjson/test-fixtures/snapshot/TestDirectoryEncoder.golden",
+	format.TableOption:         "table/test-fixtures/snapshot/TestTableEncoder.golden",
+	format.CycloneDxXMLOption:  "cyclonedx13xml/test-fixtures/snapshot/TestCycloneDxDirectoryEncoder.golden",
+	format.CycloneDxJSONOption: "cyclonedx13json/test-fixtures/snapshot/TestCycloneDxDirectoryEncoder.golden",
+	format.SPDXJSONOption:      "spdx22json/test-fixtures/snapshot/TestSPDXJSONDirectoryEncoder.golden",
+	format.SPDX23JSONOption:    "spdx23json/test-fixtures/snapshot/TestSPDXJSONDirectoryEncoder.golden",
+	format.SPDXTagValueOption:  "spdx22tagvalue/test-fixtures/snapshot/TestSPDXTagValueDirectoryEncoder.golden",
+	format.TextOption:          "text/test-fixtures/snapshot/TestTextDirectoryEncoder.golden",
+	format.CSVOption:           "csv/test-fixtures/snapshot/TestCSVEncoder.golden",
+	format.GitHubOption:        "github/test-fixtures/snapshot/TestGitHubDirectoryEncoder.golden",
+	format.SARIFOption:         "sarif/test-fixtures/snapshot/TestSARIFDirectoryEncoder.golden",
+	format.MarkdownOption:      "markdown/test-fixtures/snapshot/TestMarkdownDirectoryEncoder.golden",
+	format.HTMLOption:          "html/test-fixtures/snapshot/TestHTMLDirectoryEncoder.golden",
+}
+
+func TestAllFormatsAgainstGoldenSnapshots(t *testing.T) {
+	for _, f := range All() {
+		t.Run(string(f.Option), func(t *testing.T) {
+			snapshot, ok := directorySnapshots[f.Option]
+			if !ok {
+				t.Fatalf("no golden snapshot registered for the %q format: add a directory encoder test (with testutils.DirectoryInput) to the format package and register its golden file here", f.Option)
+			}
+			testutils.AssertEncoderAgainstGoldenFile(t, f, testutils.DirectoryInput(t), filepath.FromSlash(snapshot), testutils.Redactors(f.Option)...)
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package github
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+
+	"github.com/anchore/syft/syft/sbom"
+)
+
+func encoder(output io.Writer, s sbom.SBOM) error {
+	doc := toFormatModel(s, os.Getenv)
+
+	enc := json.NewEncoder(output)
+	// prevent > and < from being escaped in the payload
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", " ")
+
+	return enc.Encode(doc)
+}
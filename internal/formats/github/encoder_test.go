@@ -0,0 +1,20 @@
+package github
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/anchore/syft/internal/formats/common/testutils"
+	"github.com/anchore/syft/syft/format"
+)
+
+var updateGitHubGoldenFiles = flag.Bool("update-github", false, "update the *.golden files for github-json format")
+
+func TestGitHubDirectoryEncoder(t *testing.T) {
+	testutils.AssertEncoderAgainstGoldenSnapshot(t,
+		Format(),
+		testutils.DirectoryInput(t),
+		*updateGitHubGoldenFiles,
+		testutils.Redactors(format.GitHubOption)...,
+	)
+}
@@ -0,0 +1,71 @@
+package github
+
+// note: the types below follow the GitHub dependency submission API, see
+// https://docs.github.com/en/rest/dependency-graph/dependency-submission
+
+// DependencySnapshot is the snapshot of the dependencies of a repository at a given commit.
+type DependencySnapshot struct {
+	Version   int                 `json:"version"`
+	Job       Job                 `json:"job"`
+	Sha       string              `json:"sha"` // the commit the snapshot was generated for
+	Ref       string              `json:"ref"` // the git ref of the commit (e.g. refs/heads/main)
+	Detector  Detector            `json:"detector"`
+	Metadata  Metadata            `json:"metadata,omitempty"`
+	Manifests map[string]Manifest `json:"manifests,omitempty"`
+	Scanned   string              `json:"scanned"` // RFC3339 timestamp of the scan
+}
+
+// Job identifies the CI job that generated the snapshot; snapshots with the same correlator replace each other.
+type Job struct {
+	Correlator string `json:"correlator"`
+	ID         string `json:"id"`
+	HTMLURL    string `json:"html_url,omitempty"`
+}
+
+// Detector describes the tool that generated the snapshot.
+type Detector struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	URL     string `json:"url"`
+}
+
+// Metadata is a set of arbitrary key-value pairs attached to a snapshot, manifest or dependency.
+type Metadata map[string]string
+
+// Manifest is a collection of the dependencies found within a file (or the source itself when no file is known).
+type Manifest struct {
+	Name     string                    `json:"name"`
+	File     *FileInfo                 `json:"file,omitempty"`
+	Metadata Metadata                  `json:"metadata,omitempty"`
+	Resolved map[string]DependencyNode `json:"resolved,omitempty"`
+}
+
+// FileInfo is the path of a manifest relative to the root of the repository.
+type FileInfo struct {
+	SourceLocation string `json:"source_location,omitempty"`
+}
+
+// DependencyRelationship indicates whether a dependency is required by the project itself or by another dependency.
+type DependencyRelationship string
+
+const (
+	DependencyRelationshipDirect   DependencyRelationship = "direct"
+	DependencyRelationshipIndirect DependencyRelationship = "indirect"
+)
+
+// DependencyScope indicates whether a dependency is needed at runtime or only during development.
+type DependencyScope string
+
+const (
+	DependencyScopeRuntime     DependencyScope = "runtime"
+	DependencyScopeDevelopment DependencyScope = "development"
+)
+
+// DependencyNode is a single resolved dependency, referring to its own dependencies by package URL.
+type DependencyNode struct {
+	PackageURL   string                 `json:"package_url"`
+	Metadata     Metadata               `json:"metadata,omitempty"`
+	Relationship DependencyRelationship `json:"relationship"`
+	Scope        DependencyScope        `json:"scope"`
+	Dependencies []string               `json:"dependencies,omitempty"`
+}
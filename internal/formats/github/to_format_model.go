@@ -0,0 +1,169 @@
+package github
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/internal/version"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/anchore/syft/syft/source"
+)
+
+const detectorURL = "https://github.com/anchore/syft"
+
+// toFormatModel creates a GitHub dependency snapshot from the given cataloging results. The job, commit and ref are
+// taken from the environment variables set by GitHub Actions (read with the given getenv function).
+func toFormatModel(s sbom.SBOM, getenv func(string) string) DependencySnapshot {
+	return DependencySnapshot{
+		Version: 0,
+		Job:     toJob(getenv),
+		Sha:     getenv("GITHUB_SHA"),
+		Ref:     getenv("GITHUB_REF"),
+		Detector: Detector{
+			Name:    internal.ApplicationName,
+			Version: version.FromBuild().Version,
+			URL:     detectorURL,
+		},
+		Metadata:  toSnapshotMetadata(s),
+		Manifests: toManifests(s),
+		Scanned:   s.Descriptor.CreationTime().UTC().Format(time.RFC3339),
+	}
+}
+
+func toJob(getenv func(string) string) Job {
+	job := Job{
+		Correlator: strings.Trim(getenv("GITHUB_WORKFLOW")+"_"+getenv("GITHUB_JOB"), "_"),
+		ID:         getenv("GITHUB_RUN_ID"),
+	}
+	if job.Correlator == "" {
+		job.Correlator = internal.ApplicationName
+	}
+	if server, repository := getenv("GITHUB_SERVER_URL"), getenv("GITHUB_REPOSITORY"); server != "" && repository != "" && job.ID != "" {
+		job.HTMLURL = server + "/" + repository + "/actions/runs/" + job.ID
+	}
+	return job
+}
+
+func toSnapshotMetadata(s sbom.SBOM) Metadata {
+	metadata := Metadata{}
+	switch s.Source.Scheme {
+	case source.ImageScheme:
+		metadata["syft:source-type"] = "image"
+		metadata["syft:source-target"] = s.Source.ImageMetadata.UserInput
+	case source.DirectoryScheme:
+		metadata["syft:source-type"] = "directory"
+		metadata["syft:source-target"] = s.Source.Path
+	case source.FileScheme:
+		metadata["syft:source-type"] = "file"
+		metadata["syft:source-target"] = s.Source.Path
+	}
+	if d := s.Artifacts.Distro; d != nil {
+		metadata["syft:distro"] = d.String()
+	}
+
+	// snapshots are limited to 8 metadata keys, so the package counts by type are left out
+	summary := sbom.NewSummary(s)
+	metadata["syft:summary:packages"] = strconv.Itoa(summary.Packages)
+	metadata["syft:summary:files"] = strconv.Itoa(summary.Files)
+	if summary.Duration > 0 {
+		metadata["syft:summary:duration"] = summary.Duration.String()
+	}
+	return metadata
+}
+
+// toManifests groups the packages by the file they were found in (the first location of each package). Packages without
+// a package URL cannot be represented in the dependency graph and are left out.
+func toManifests(s sbom.SBOM) map[string]Manifest {
+	catalog := s.Artifacts.PackageCatalog
+	if catalog == nil {
+		return nil
+	}
+
+	dependencies, dependents := toDependencyPURLs(s)
+
+	manifests := make(map[string]Manifest)
+	for _, p := range catalog.Sorted() {
+		if p.PURL == "" {
+			log.Debugf("package %s@%s has no package URL, skipping it in the github snapshot", p.Name, p.Version)
+			continue
+		}
+
+		name, manifest := toManifest(s.Source, p)
+		if existing, ok := manifests[name]; ok {
+			manifest = existing
+		}
+
+		relationship := DependencyRelationshipDirect
+		if dependents[p.ID()] {
+			relationship = DependencyRelationshipIndirect
+		}
+		manifest.Resolved[p.PURL] = DependencyNode{
+			PackageURL:   p.PURL,
+			Relationship: relationship,
+			Scope:        DependencyScopeRuntime,
+			Dependencies: dependencies[p.ID()],
+		}
+		manifests[name] = manifest
+	}
+	return manifests
+}
+
+// toManifest returns the name and an empty manifest for the file the package was found in.
+func toManifest(src source.Metadata, p pkg.Package) (string, Manifest) {
+	manifest := Manifest{
+		Resolved: make(map[string]DependencyNode),
+	}
+
+	var location *source.Location
+	if len(p.Locations) > 0 {
+		location = &p.Locations[0]
+	}
+
+	switch {
+	case location == nil:
+		// the package is not tied to a file, so it is attributed to the source itself
+		manifest.Name = sourceName(src)
+	case src.Scheme == source.ImageScheme:
+		manifest.Name = src.ImageMetadata.UserInput + ":" + location.RealPath
+		if location.FileSystemID != "" {
+			manifest.Metadata = Metadata{"syft:filesystem": location.FileSystemID}
+		}
+	default:
+		path := strings.TrimPrefix(location.RealPath, "/")
+		manifest.Name = path
+		manifest.File = &FileInfo{SourceLocation: path}
+	}
+	return manifest.Name, manifest
+}
+
+func sourceName(src source.Metadata) string {
+	if src.Scheme == source.ImageScheme {
+		return src.ImageMetadata.UserInput
+	}
+	return src.Path
+}
+
+// toDependencyPURLs returns the (sorted) package URLs of the dependencies of each package, and the set of packages that
+// are a dependency of another package.
+func toDependencyPURLs(s sbom.SBOM) (map[artifact.ID][]string, map[artifact.ID]bool) {
+	dependencies := make(map[artifact.ID][]string)
+	dependents := make(map[artifact.ID]bool)
+	for _, r := range sbom.DependencyRelationships(s) {
+		dependency, ok := r.From.(pkg.Package)
+		if !ok || dependency.PURL == "" {
+			continue
+		}
+		dependencies[r.To.ID()] = append(dependencies[r.To.ID()], dependency.PURL)
+		dependents[dependency.ID()] = true
+	}
+	for id := range dependencies {
+		sort.Strings(dependencies[id])
+	}
+	return dependencies, dependents
+}
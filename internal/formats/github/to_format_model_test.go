@@ -0,0 +1,133 @@
+package github
+
+import (
+	"testing"
+	"time"
+
+	"github.com/anchore/syft/internal/version"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/anchore/syft/syft/source"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_toFormatModel(t *testing.T) {
+	lodash := pkg.Package{
+		Name:      "lodash",
+		Version:   "4.17.21",
+		Type:      pkg.NpmPkg,
+		PURL:      "pkg:npm/lodash@4.17.21",
+		Locations: []source.Location{source.NewLocation("/app/package-lock.json")},
+	}
+	express := pkg.Package{
+		Name:      "express",
+		Version:   "4.17.1",
+		Type:      pkg.NpmPkg,
+		PURL:      "pkg:npm/express@4.17.1",
+		Locations: []source.Location{source.NewLocation("/app/package-lock.json")},
+	}
+	requests := pkg.Package{
+		Name:    "requests",
+		Version: "2.25.1",
+		Type:    pkg.PythonPkg,
+		PURL:    "pkg:pypi/requests@2.25.1",
+	}
+	noPURL := pkg.Package{
+		Name:      "internal-tool",
+		Version:   "1.0.0",
+		Type:      pkg.NpmPkg,
+		Locations: []source.Location{source.NewLocation("/app/package-lock.json")},
+	}
+	for _, p := range []*pkg.Package{&lodash, &express, &requests, &noPURL} {
+		p.SetID()
+	}
+
+	s := sbom.SBOM{
+		Artifacts: sbom.Artifacts{
+			PackageCatalog: pkg.NewCatalog(lodash, express, requests, noPURL),
+		},
+		Relationships: []artifact.Relationship{
+			{
+				From: lodash,
+				To:   express,
+				Type: artifact.DependencyOfRelationship,
+			},
+		},
+		Source: source.Metadata{
+			Scheme: source.DirectoryScheme,
+			Path:   "/some/path",
+		},
+		Descriptor: sbom.Descriptor{
+			Timestamp: time.Date(2022, 3, 1, 12, 0, 0, 0, time.FixedZone("EST", -5*60*60)),
+		},
+	}
+
+	env := map[string]string{
+		"GITHUB_WORKFLOW":   "ci",
+		"GITHUB_JOB":        "sbom",
+		"GITHUB_RUN_ID":     "42",
+		"GITHUB_SERVER_URL": "https://github.com",
+		"GITHUB_REPOSITORY": "anchore/example",
+		"GITHUB_SHA":        "0123456789abcdef",
+		"GITHUB_REF":        "refs/heads/main",
+	}
+
+	expected := DependencySnapshot{
+		Version: 0,
+		Job: Job{
+			Correlator: "ci_sbom",
+			ID:         "42",
+			HTMLURL:    "https://github.com/anchore/example/actions/runs/42",
+		},
+		Sha: "0123456789abcdef",
+		Ref: "refs/heads/main",
+		Detector: Detector{
+			Name:    "syft",
+			Version: version.FromBuild().Version,
+			URL:     "https://github.com/anchore/syft",
+		},
+		Metadata: Metadata{
+			"syft:source-type":      "directory",
+			"syft:source-target":    "/some/path",
+			"syft:summary:packages": "4",
+			"syft:summary:files":    "0",
+		},
+		Manifests: map[string]Manifest{
+			"app/package-lock.json": {
+				Name: "app/package-lock.json",
+				File: &FileInfo{SourceLocation: "app/package-lock.json"},
+				Resolved: map[string]DependencyNode{
+					"pkg:npm/express@4.17.1": {
+						PackageURL:   "pkg:npm/express@4.17.1",
+						Relationship: DependencyRelationshipDirect,
+						Scope:        DependencyScopeRuntime,
+						Dependencies: []string{"pkg:npm/lodash@4.17.21"},
+					},
+					"pkg:npm/lodash@4.17.21": {
+						PackageURL:   "pkg:npm/lodash@4.17.21",
+						Relationship: DependencyRelationshipIndirect,
+						Scope:        DependencyScopeRuntime,
+					},
+				},
+			},
+			"/some/path": {
+				Name: "/some/path",
+				Resolved: map[string]DependencyNode{
+					"pkg:pypi/requests@2.25.1": {
+						PackageURL:   "pkg:pypi/requests@2.25.1",
+						Relationship: DependencyRelationshipDirect,
+						Scope:        DependencyScopeRuntime,
+					},
+				},
+			},
+		},
+		Scanned: "2022-03-01T17:00:00Z",
+	}
+
+	assert.Equal(t, expected, toFormatModel(s, func(key string) string { return env[key] }))
+}
+
+func Test_toJob_OutsideOfGitHubActions(t *testing.T) {
+	assert.Equal(t, Job{Correlator: "syft"}, toJob(func(string) string { return "" }))
+}
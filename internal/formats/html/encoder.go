@@ -0,0 +1,19 @@
+package html
+
+import (
+	_ "embed"
+	"html/template"
+	"io"
+
+	"github.com/anchore/syft/internal/formats/common/reporthelpers"
+	"github.com/anchore/syft/syft/sbom"
+)
+
+//go:embed report.html.tmpl
+var reportTemplate string
+
+var tmpl = template.Must(template.New("report").Parse(reportTemplate))
+
+func encoder(output io.Writer, s sbom.SBOM) error {
+	return tmpl.Execute(output, reporthelpers.New(s))
+}
@@ -0,0 +1,34 @@
+package html
+
+import (
+	"bytes"
+	"flag"
+	"testing"
+
+	"github.com/anchore/syft/internal/formats/common/testutils"
+	"github.com/anchore/syft/syft/format"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var updateHTMLGoldenFiles = flag.Bool("update-html", false, "update the *.golden files for html format")
+
+func TestHTMLDirectoryEncoder(t *testing.T) {
+	testutils.AssertEncoderAgainstGoldenSnapshot(t,
+		Format(),
+		testutils.DirectoryInput(t),
+		*updateHTMLGoldenFiles,
+		testutils.Redactors(format.HTMLOption)...,
+	)
+}
+
+func TestHTMLEncoder_EscapesValues(t *testing.T) {
+	s := testutils.DirectoryInput(t)
+	s.Artifacts.PackageCatalog.Add(pkg.Package{Name: "<script>alert(1)</script>", Version: "1.0", Type: pkg.NpmPkg})
+
+	var buf bytes.Buffer
+	require.NoError(t, Format().Encode(&buf, s))
+	assert.NotContains(t, buf.String(), "<script>")
+	assert.Contains(t, buf.String(), "&lt;script&gt;alert(1)&lt;/script&gt;")
+}
@@ -0,0 +1,14 @@
+package html
+
+import "github.com/anchore/syft/syft/format"
+
+// Format returns a format that writes a self-contained, human-readable HTML report of the SBOM (e.g. for release
+// pages), with a table of packages for each package type.
+func Format() format.Format {
+	return format.NewFormat(
+		format.HTMLOption,
+		encoder,
+		nil,
+		nil,
+	)
+}
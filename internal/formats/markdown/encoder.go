@@ -0,0 +1,27 @@
+package markdown
+
+import (
+	_ "embed"
+	"io"
+	"strings"
+	"text/template"
+
+	"github.com/anchore/syft/internal/formats/common/reporthelpers"
+	"github.com/anchore/syft/syft/sbom"
+)
+
+//go:embed report.md.tmpl
+var reportTemplate string
+
+var tmpl = template.Must(template.New("report").Funcs(template.FuncMap{"cell": cell}).Parse(reportTemplate))
+
+func encoder(output io.Writer, s sbom.SBOM) error {
+	return tmpl.Execute(output, reporthelpers.New(s))
+}
+
+// cellEscaper escapes the values of table cells, which must not end the cell (|) or the row (newlines).
+var cellEscaper = strings.NewReplacer(`\`, `\\`, "|", `\|`, "\r\n", " ", "\n", " ")
+
+func cell(value string) string {
+	return cellEscaper.Replace(value)
+}
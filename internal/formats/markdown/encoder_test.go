@@ -0,0 +1,27 @@
+package markdown
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/anchore/syft/internal/formats/common/testutils"
+	"github.com/anchore/syft/syft/format"
+	"github.com/stretchr/testify/assert"
+)
+
+var updateMarkdownGoldenFiles = flag.Bool("update-markdown", false, "update the *.golden files for markdown format")
+
+func TestMarkdownDirectoryEncoder(t *testing.T) {
+	testutils.AssertEncoderAgainstGoldenSnapshot(t,
+		Format(),
+		testutils.DirectoryInput(t),
+		*updateMarkdownGoldenFiles,
+		testutils.Redactors(format.MarkdownOption)...,
+	)
+}
+
+func TestCell(t *testing.T) {
+	assert.Equal(t, `a \| b`, cell("a | b"))
+	assert.Equal(t, `C:\\path`, cell(`C:\path`))
+	assert.Equal(t, "first second", cell("first\nsecond"))
+}
@@ -0,0 +1,14 @@
+package markdown
+
+import "github.com/anchore/syft/syft/format"
+
+// Format returns a format that writes a human-readable markdown report of the SBOM (e.g. for release pages), with a
+// table of packages for each package type.
+func Format() format.Format {
+	return format.NewFormat(
+		format.MarkdownOption,
+		encoder,
+		nil,
+		nil,
+	)
+}
@@ -0,0 +1,18 @@
+package sarif
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/anchore/syft/internal/formats/common/testutils"
+)
+
+var updateSARIFGoldenFiles = flag.Bool("update-sarif", false, "update the *.golden files for sarif format")
+
+func TestSARIFDirectoryEncoder(t *testing.T) {
+	testutils.AssertEncoderAgainstGoldenSnapshot(t,
+		Format(),
+		testutils.DirectoryInput(t),
+		*updateSARIFGoldenFiles,
+	)
+}
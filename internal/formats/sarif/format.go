@@ -0,0 +1,14 @@
+package sarif
+
+import "github.com/anchore/syft/syft/format"
+
+// Format returns a format that reports the file classifications and secrets found as SARIF results, for tools such as
+// GitHub code scanning. Packages are not reported.
+func Format() format.Format {
+	return format.NewFormat(
+		format.SARIFOption,
+		encoder,
+		nil,
+		nil,
+	)
+}
@@ -0,0 +1,89 @@
+package sarif
+
+// note: the types below are the subset of the SARIF 2.1.0 format that is needed to report file findings, see
+// https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html
+
+const (
+	Schema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	Version = "2.1.0"
+)
+
+// Level is the severity of a result.
+type Level string
+
+const (
+	LevelError Level = "error"
+	LevelNote  Level = "note"
+)
+
+type Document struct {
+	Schema  string `json:"$schema"`
+	Version string `json:"version"`
+	Runs    []Run  `json:"runs"`
+}
+
+type Run struct {
+	Tool    Tool     `json:"tool"`
+	Results []Result `json:"results"`
+}
+
+type Tool struct {
+	Driver Driver `json:"driver"`
+}
+
+type Driver struct {
+	Name           string `json:"name"`
+	Version        string `json:"version"`
+	InformationURI string `json:"informationUri"`
+	Rules          []Rule `json:"rules"`
+}
+
+type Rule struct {
+	ID                   string               `json:"id"`
+	Name                 string               `json:"name"`
+	ShortDescription     Message              `json:"shortDescription"`
+	DefaultConfiguration DefaultConfiguration `json:"defaultConfiguration"`
+	Properties           *RuleProperties      `json:"properties,omitempty"`
+}
+
+type DefaultConfiguration struct {
+	Level Level `json:"level"`
+}
+
+// RuleProperties are the properties of a rule that are understood by GitHub code scanning.
+type RuleProperties struct {
+	Tags             []string `json:"tags,omitempty"`
+	SecuritySeverity string   `json:"security-severity,omitempty"` // a score from 0.0 to 10.0
+}
+
+type Message struct {
+	Text string `json:"text"`
+}
+
+type Result struct {
+	RuleID    string     `json:"ruleId"`
+	RuleIndex int        `json:"ruleIndex"`
+	Level     Level      `json:"level"`
+	Message   Message    `json:"message"`
+	Locations []Location `json:"locations"`
+}
+
+type Location struct {
+	PhysicalLocation PhysicalLocation `json:"physicalLocation"`
+}
+
+type PhysicalLocation struct {
+	ArtifactLocation ArtifactLocation `json:"artifactLocation"`
+	Region           *Region          `json:"region,omitempty"`
+}
+
+type ArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type Region struct {
+	StartLine   int64 `json:"startLine"`
+	StartColumn int64 `json:"startColumn"`
+	ByteOffset  int64 `json:"byteOffset"`
+	ByteLength  int64 `json:"byteLength"`
+}
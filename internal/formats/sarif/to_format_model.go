@@ -0,0 +1,179 @@
+package sarif
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/version"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/anchore/syft/syft/source"
+)
+
+const (
+	informationURI = "https://github.com/anchore/syft"
+
+	secretRulePrefix         = "secret/"
+	classificationRulePrefix = "classification/"
+
+	// secretSecuritySeverity is the severity GitHub code scanning reports secrets with ("high")
+	secretSecuritySeverity = "8.0"
+)
+
+// toFormatModel creates a SARIF document with a result for each secret and file classification found, where each
+// secret or class of file is a rule.
+func toFormatModel(s sbom.SBOM) Document {
+	rules := newRuleIndex()
+	var results []Result
+
+	secretCoordinates := source.NewCoordinateSet()
+	for coordinates := range s.Artifacts.Secrets {
+		secretCoordinates.Add(coordinates)
+	}
+	for _, coordinates := range secretCoordinates.ToSlice() {
+		// sort a copy, the results are shared with the other outputs
+		secrets := append([]file.SearchResult(nil), s.Artifacts.Secrets[coordinates]...)
+		sort.SliceStable(secrets, func(i, j int) bool {
+			return secrets[i].SeekPosition < secrets[j].SeekPosition
+		})
+		for _, secret := range secrets {
+			ruleIdx := rules.add(Rule{
+				ID:               secretRulePrefix + secret.Classification,
+				Name:             "Secret",
+				ShortDescription: Message{Text: fmt.Sprintf("Possible secret (%s)", secret.Classification)},
+				DefaultConfiguration: DefaultConfiguration{
+					Level: LevelError,
+				},
+				Properties: &RuleProperties{
+					Tags:             []string{"security", "secret"},
+					SecuritySeverity: secretSecuritySeverity,
+				},
+			})
+			results = append(results, Result{
+				RuleID:    secretRulePrefix + secret.Classification,
+				RuleIndex: ruleIdx,
+				Level:     LevelError,
+				// note: the secret value is never included, even when it was captured
+				Message: Message{Text: fmt.Sprintf("A possible secret (%s) was found in %s at line %d", secret.Classification, coordinates.RealPath, secret.LineNumber)},
+				Locations: []Location{
+					{
+						PhysicalLocation: PhysicalLocation{
+							ArtifactLocation: toArtifactLocation(coordinates),
+							Region: &Region{
+								StartLine:   secret.LineNumber,
+								StartColumn: secret.LineOffset + 1,
+								ByteOffset:  secret.SeekPosition,
+								ByteLength:  secret.Length,
+							},
+						},
+					},
+				},
+			})
+		}
+	}
+
+	classifiedCoordinates := source.NewCoordinateSet()
+	for coordinates := range s.Artifacts.FileClassifications {
+		classifiedCoordinates.Add(coordinates)
+	}
+	for _, coordinates := range classifiedCoordinates.ToSlice() {
+		for _, classification := range s.Artifacts.FileClassifications[coordinates] {
+			ruleIdx := rules.add(Rule{
+				ID:               classificationRulePrefix + classification.Class,
+				Name:             "FileClassification",
+				ShortDescription: Message{Text: fmt.Sprintf("File classified as %s", classification.Class)},
+				DefaultConfiguration: DefaultConfiguration{
+					Level: LevelNote,
+				},
+			})
+			results = append(results, Result{
+				RuleID:    classificationRulePrefix + classification.Class,
+				RuleIndex: ruleIdx,
+				Level:     LevelNote,
+				Message:   Message{Text: classificationMessage(coordinates, classification.Class, classification.Metadata)},
+				Locations: []Location{
+					{
+						PhysicalLocation: PhysicalLocation{
+							ArtifactLocation: toArtifactLocation(coordinates),
+						},
+					},
+				},
+			})
+		}
+	}
+
+	if results == nil {
+		// a run without results must still report an (empty) list of results
+		results = []Result{}
+	}
+
+	return Document{
+		Schema:  Schema,
+		Version: Version,
+		Runs: []Run{
+			{
+				Tool: Tool{
+					Driver: Driver{
+						Name:           internal.ApplicationName,
+						Version:        version.FromBuild().Version,
+						InformationURI: informationURI,
+						Rules:          rules.rules,
+					},
+				},
+				Results: results,
+			},
+		},
+	}
+}
+
+// ruleIndex keeps the rules of a run in order of first use.
+type ruleIndex struct {
+	rules []Rule
+	byID  map[string]int
+}
+
+func newRuleIndex() *ruleIndex {
+	return &ruleIndex{
+		rules: []Rule{},
+		byID:  make(map[string]int),
+	}
+}
+
+// add returns the index of the rule with the ID of the given rule, adding the given rule when there is none yet.
+func (r *ruleIndex) add(rule Rule) int {
+	if idx, ok := r.byID[rule.ID]; ok {
+		return idx
+	}
+	r.byID[rule.ID] = len(r.rules)
+	r.rules = append(r.rules, rule)
+	return len(r.rules) - 1
+}
+
+// toArtifactLocation returns the location of the file relative to the root of the source, which is how SARIF
+// consumers resolve files against a repository checkout.
+func toArtifactLocation(coordinates source.Coordinates) ArtifactLocation {
+	return ArtifactLocation{
+		URI: strings.TrimPrefix(coordinates.RealPath, "/"),
+	}
+}
+
+func classificationMessage(coordinates source.Coordinates, class string, metadata map[string]string) string {
+	message := fmt.Sprintf("%s was classified as %s", coordinates.RealPath, class)
+
+	keys := make([]string, 0, len(metadata))
+	for key := range metadata {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var details []string
+	for _, key := range keys {
+		details = append(details, key+"="+metadata[key])
+	}
+	if len(details) > 0 {
+		message += " (" + strings.Join(details, ", ") + ")"
+	}
+	return message
+}
@@ -0,0 +1,115 @@
+package sarif
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/internal/version"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/anchore/syft/syft/source"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_toFormatModel(t *testing.T) {
+	s := sbom.SBOM{
+		Artifacts: sbom.Artifacts{
+			Secrets: map[source.Coordinates][]file.SearchResult{
+				{RealPath: "/config/settings.env"}: {
+					{Classification: "aws-secret-key", LineNumber: 3, LineOffset: 22, SeekPosition: 80, Length: 40, Value: "never-reported"},
+					{Classification: "aws-access-key", LineNumber: 2, LineOffset: 18, SeekPosition: 40, Length: 20},
+				},
+				{RealPath: "/app/.env"}: {
+					{Classification: "aws-access-key", LineNumber: 1, LineOffset: 18, SeekPosition: 18, Length: 20},
+				},
+			},
+			FileClassifications: map[source.Coordinates][]file.Classification{
+				{RealPath: "/usr/bin/python3.9"}: {
+					{Class: "python-binary", Metadata: map[string]string{"version": "3.9.2"}},
+				},
+			},
+		},
+	}
+
+	secretRule := func(classification string) Rule {
+		return Rule{
+			ID:                   "secret/" + classification,
+			Name:                 "Secret",
+			ShortDescription:     Message{Text: "Possible secret (" + classification + ")"},
+			DefaultConfiguration: DefaultConfiguration{Level: LevelError},
+			Properties: &RuleProperties{
+				Tags:             []string{"security", "secret"},
+				SecuritySeverity: "8.0",
+			},
+		}
+	}
+	secretLocation := func(uri string, region Region) []Location {
+		return []Location{{PhysicalLocation: PhysicalLocation{ArtifactLocation: ArtifactLocation{URI: uri}, Region: &region}}}
+	}
+
+	expected := Document{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs: []Run{
+			{
+				Tool: Tool{
+					Driver: Driver{
+						Name:           "syft",
+						Version:        version.FromBuild().Version,
+						InformationURI: "https://github.com/anchore/syft",
+						Rules: []Rule{
+							secretRule("aws-access-key"),
+							secretRule("aws-secret-key"),
+							{
+								ID:                   "classification/python-binary",
+								Name:                 "FileClassification",
+								ShortDescription:     Message{Text: "File classified as python-binary"},
+								DefaultConfiguration: DefaultConfiguration{Level: LevelNote},
+							},
+						},
+					},
+				},
+				Results: []Result{
+					{
+						RuleID:    "secret/aws-access-key",
+						RuleIndex: 0,
+						Level:     LevelError,
+						Message:   Message{Text: "A possible secret (aws-access-key) was found in /app/.env at line 1"},
+						Locations: secretLocation("app/.env", Region{StartLine: 1, StartColumn: 19, ByteOffset: 18, ByteLength: 20}),
+					},
+					{
+						RuleID:    "secret/aws-access-key",
+						RuleIndex: 0,
+						Level:     LevelError,
+						Message:   Message{Text: "A possible secret (aws-access-key) was found in /config/settings.env at line 2"},
+						Locations: secretLocation("config/settings.env", Region{StartLine: 2, StartColumn: 19, ByteOffset: 40, ByteLength: 20}),
+					},
+					{
+						RuleID:    "secret/aws-secret-key",
+						RuleIndex: 1,
+						Level:     LevelError,
+						Message:   Message{Text: "A possible secret (aws-secret-key) was found in /config/settings.env at line 3"},
+						Locations: secretLocation("config/settings.env", Region{StartLine: 3, StartColumn: 23, ByteOffset: 80, ByteLength: 40}),
+					},
+					{
+						RuleID:    "classification/python-binary",
+						RuleIndex: 2,
+						Level:     LevelNote,
+						Message:   Message{Text: "/usr/bin/python3.9 was classified as python-binary (version=3.9.2)"},
+						Locations: []Location{{PhysicalLocation: PhysicalLocation{ArtifactLocation: ArtifactLocation{URI: "usr/bin/python3.9"}}}},
+					},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, expected, toFormatModel(s))
+
+	// the order of the findings of the SBOM is not changed
+	assert.Equal(t, "aws-secret-key", s.Artifacts.Secrets[source.Coordinates{RealPath: "/config/settings.env"}][0].Classification)
+}
+
+func Test_toFormatModel_NoFindings(t *testing.T) {
+	doc := toFormatModel(sbom.SBOM{})
+	assert.Equal(t, []Result{}, doc.Runs[0].Results)
+	assert.Equal(t, []Rule{}, doc.Runs[0].Tool.Driver.Rules)
+}
@@ -0,0 +1,37 @@
+package spdx22json
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/anchore/syft/internal/formats/common/testutils"
+	"github.com/anchore/syft/syft/source"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecoder_RoundTrip(t *testing.T) {
+	original := testutils.DirectoryInput(t)
+
+	var buf bytes.Buffer
+	require.NoError(t, Format().Encode(&buf, original))
+
+	require.NoError(t, Format().Validate(bytes.NewReader(buf.Bytes())))
+	decoded, err := Format().Decode(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+
+	assert.Equal(t, source.DirectoryScheme, decoded.Source.Scheme)
+	assert.Equal(t, "/some/path", decoded.Source.Path)
+	assert.Equal(t, "Anchore, Inc", decoded.Descriptor.Organization)
+
+	expected := original.Artifacts.PackageCatalog.Sorted()
+	actual := decoded.Artifacts.PackageCatalog.Sorted()
+	require.Len(t, actual, len(expected))
+	for i := range expected {
+		assert.Equal(t, expected[i].Name, actual[i].Name)
+		assert.Equal(t, expected[i].Version, actual[i].Version)
+		assert.Equal(t, expected[i].PURL, actual[i].PURL)
+		assert.Equal(t, expected[i].CPEs, actual[i].CPEs)
+		assert.Equal(t, expected[i].Locations, actual[i].Locations)
+	}
+}
@@ -8,7 +8,7 @@ import (
 )
 
 func encoder(output io.Writer, s sbom.SBOM) error {
-	doc, err := toFormatModel(s)
+	doc, err := ToFormatModel(s)
 	if err != nil {
 		return err
 	}
@@ -37,6 +37,8 @@ type Package struct {
 	// is identical to the SPDX item from which the data was produced. This algorithm works even if the SPDX document
 	// is included in the SPDX item.
 	PackageVerificationCode *PackageVerificationCode `json:"packageVerificationCode,omitempty"`
+	// Provides information about the primary purpose of the package (e.g. a library, an application, or a container image), added in SPDX 2.3.
+	PrimaryPackagePurpose PrimaryPackagePurpose `json:"primaryPackagePurpose,omitempty"`
 	// Allows the producer(s) of the SPDX document to describe how the package was acquired and/or changed from the original source.
 	SourceInfo string `json:"sourceInfo,omitempty"`
 	// Provides a short description of the package.
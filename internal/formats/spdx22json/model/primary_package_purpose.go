@@ -0,0 +1,33 @@
+package model
+
+// PrimaryPackagePurpose provides information about the primary purpose of a package (added in SPDX 2.3).
+// source: https://spdx.github.io/spdx-spec/v2.3/package-information/#724-primary-package-purpose-field
+type PrimaryPackagePurpose string
+
+const (
+	// ApplicationPurpose is used when the package is a software application.
+	ApplicationPurpose PrimaryPackagePurpose = "APPLICATION"
+	// FrameworkPurpose is used when the package is a software framework.
+	FrameworkPurpose PrimaryPackagePurpose = "FRAMEWORK"
+	// LibraryPurpose is used when the package is a software library.
+	LibraryPurpose PrimaryPackagePurpose = "LIBRARY"
+	// ContainerPurpose is used when the package refers to a container image which can be used by a container runtime application.
+	ContainerPurpose PrimaryPackagePurpose = "CONTAINER"
+	// OperatingSystemPurpose is used when the package refers to an operating system.
+	// note: the spec lists this as "OPERATING-SYSTEM", however, the JSON schema only allows "OPERATING_SYSTEM"
+	OperatingSystemPurpose PrimaryPackagePurpose = "OPERATING_SYSTEM"
+	// DevicePurpose is used when the package refers to a chipset, processor, or electronic board.
+	DevicePurpose PrimaryPackagePurpose = "DEVICE"
+	// FirmwarePurpose is used when the package provides low level control over a device's hardware.
+	FirmwarePurpose PrimaryPackagePurpose = "FIRMWARE"
+	// SourcePurpose is used when the package is a collection of source files.
+	SourcePurpose PrimaryPackagePurpose = "SOURCE"
+	// ArchivePurpose is used when the package refers to an archived collection of files (.tar, .zip, etc).
+	ArchivePurpose PrimaryPackagePurpose = "ARCHIVE"
+	// FilePurpose is used when the package is a single file which can be independently distributed (configuration file, statically linked binary, Kubernetes deployment, etc).
+	FilePurpose PrimaryPackagePurpose = "FILE"
+	// InstallPurpose is used when the package is used to install software on disk.
+	InstallPurpose PrimaryPackagePurpose = "INSTALL"
+	// OtherPurpose is used when the package doesn't fit into the above categories.
+	OtherPurpose PrimaryPackagePurpose = "OTHER"
+)
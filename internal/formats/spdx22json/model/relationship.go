@@ -14,6 +14,10 @@ type Relationship struct {
 type RelationshipType string
 
 const (
+	// DescribesRelationship is to be used when SPDXRef-DOCUMENT describes SPDXRef-A.
+	// Example: An SPDX document WildFly.spdx describes package 'WildFly'.
+	DescribesRelationship RelationshipType = "DESCRIBES"
+
 	// DescribedByRelationship is to be used when SPDXRef-A is described by SPDXREF-Document.
 	// Example: The package 'WildFly' is described by SPDX document WildFly.spdx.
 	DescribedByRelationship RelationshipType = "DESCRIBED_BY"
@@ -178,6 +182,14 @@ const (
 	// Example: An APPLICATION foo.exe has prerequisite or dependency on bar.dll
 	HasPrerequisiteRelationship RelationshipType = "HAS_PREREQUISITE"
 
+	// RequirementDescriptionForRelationship is to be used when SPDXRef-A describes, illustrates, or specifies a requirement statement for SPDXRef-B.
+	// Example: A DOCUMENTATION file requirements.txt describes the requirements for a PACKAGE 'myapp'.
+	RequirementDescriptionForRelationship RelationshipType = "REQUIREMENT_DESCRIPTION_FOR"
+
+	// SpecificationForRelationship is to be used when SPDXRef-A describes, illustrates, or defines a design specification for SPDXRef-B.
+	// Example: A DOCUMENTATION file design.md describes the design of a PACKAGE 'myapp'.
+	SpecificationForRelationship RelationshipType = "SPECIFICATION_FOR"
+
 	// OtherRelationship is to be used for a relationship which has not been defined in the formal SPDX specification. A description of the relationship should be included in the Relationship comments field.
 	OtherRelationship RelationshipType = "OTHER"
 )
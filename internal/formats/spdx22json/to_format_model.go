@@ -5,7 +5,6 @@ import (
 	"path/filepath"
 	"sort"
 	"strings"
-	"time"
 
 	"github.com/anchore/syft/internal"
 	"github.com/anchore/syft/internal/formats/common/spdxhelpers"
@@ -20,36 +19,58 @@ import (
 	"github.com/anchore/syft/syft/source"
 )
 
-// toFormatModel creates and populates a new JSON document struct that follows the SPDX 2.2 spec from the given cataloging results.
-func toFormatModel(s sbom.SBOM) (*model.Document, error) {
-	name, namespace, err := spdxhelpers.DocumentNameAndNamespace(s.Source)
+// ToFormatModel creates and populates a new JSON document struct that follows the SPDX 2.2 spec from the given cataloging results.
+func ToFormatModel(s sbom.SBOM) (*model.Document, error) {
+	name, namespace, err := spdxhelpers.DocumentNameAndNamespace(s.Source, s.Descriptor)
 	if err != nil {
 		return nil, err
 	}
 
 	return &model.Document{
 		Element: model.Element{
-			SPDXID: model.ElementID("DOCUMENT").String(),
-			Name:   name,
+			SPDXID:      model.ElementID("DOCUMENT").String(),
+			Name:        name,
+			Annotations: toAnnotations(s),
 		},
 		SPDXVersion: model.Version,
 		CreationInfo: model.CreationInfo{
-			Created: time.Now().UTC(),
-			Creators: []string{
-				// note: key-value format derived from the JSON example document examples: https://github.com/spdx/spdx-spec/blob/v2.2/examples/SPDXJSONExample-v2.2.spdx.json
-				"Organization: Anchore, Inc",
-				"Tool: " + internal.ApplicationName + "-" + version.FromBuild().Version,
-			},
+			Comment:            spdxhelpers.CreatorComment(s.Descriptor, sbom.NewSummary(s)),
+			Created:            s.Descriptor.CreationTime().UTC(),
+			Creators:           toCreators(s.Descriptor),
 			LicenseListVersion: spdxlicense.Version,
 		},
 		DataLicense:       "CC0-1.0",
 		DocumentNamespace: namespace,
 		Packages:          toPackages(s.Artifacts.PackageCatalog, s.Relationships),
 		Files:             toFiles(s),
-		Relationships:     toRelationships(s.Relationships),
+		Relationships:     append(toRelationships(s.Relationships), toDependencyRelationships(sbom.DependencyRelationships(s))...),
 	}, nil
 }
 
+func toCreators(descriptor sbom.Descriptor) (creators []string) {
+	// note: key-value format derived from the JSON example document examples: https://github.com/spdx/spdx-spec/blob/v2.2/examples/SPDXJSONExample-v2.2.spdx.json
+	for _, person := range spdxhelpers.CreatorPersons(descriptor) {
+		creators = append(creators, "Person: "+person)
+	}
+	for _, organization := range spdxhelpers.CreatorOrganizations(descriptor) {
+		creators = append(creators, "Organization: "+organization)
+	}
+	return append(creators, "Tool: "+internal.ApplicationName+"-"+version.FromBuild().Version)
+}
+
+// toAnnotations records the non-fatal problems found while cataloging as annotations of the document.
+func toAnnotations(s sbom.SBOM) (annotations []model.Annotation) {
+	for _, comment := range spdxhelpers.WarningComments(s.Artifacts.Warnings) {
+		annotations = append(annotations, model.Annotation{
+			AnnotationDate: s.Descriptor.CreationTime().UTC(),
+			AnnotationType: model.OtherAnnotationType,
+			Annotator:      "Tool: " + internal.ApplicationName + "-" + version.FromBuild().Version,
+			Comment:        comment,
+		})
+	}
+	return annotations
+}
+
 func toPackages(catalog *pkg.Catalog, relationships []artifact.Relationship) []model.Package {
 	packages := make([]model.Package, 0)
 
@@ -60,6 +81,7 @@ func toPackages(catalog *pkg.Catalog, relationships []artifact.Relationship) []m
 		// note: the license concluded and declared should be the same since we are collecting license information
 		// from the project data itself (the installed package files).
 		packages = append(packages, model.Package{
+			Checksums:        toFileChecksums(spdxhelpers.Checksums(p)),
 			Description:      spdxhelpers.Description(p),
 			DownloadLocation: spdxhelpers.DownloadLocation(p),
 			ExternalRefs:     spdxhelpers.ExternalRefs(p),
@@ -198,6 +220,11 @@ func toFileTypes(metadata *source.FileMetadata) (ty []string) {
 
 func toRelationships(relationships []artifact.Relationship) (result []model.Relationship) {
 	for _, r := range relationships {
+		if r.Type == artifact.DependencyOfRelationship {
+			// dependencies are captured separately from the perspective of the dependent package (DEPENDS_ON)
+			continue
+		}
+
 		exists, relationshipType, comment := lookupRelationship(r.Type)
 
 		if !exists {
@@ -215,10 +242,26 @@ func toRelationships(relationships []artifact.Relationship) (result []model.Rela
 	return result
 }
 
+// toDependencyRelationships expresses each package dependency as the dependent package DEPENDS_ON the dependency.
+func toDependencyRelationships(relationships []artifact.Relationship) (result []model.Relationship) {
+	for _, r := range relationships {
+		result = append(result, model.Relationship{
+			SpdxElementID:      model.ElementID(r.To.ID()).String(),
+			RelationshipType:   model.DependsOnRelationship,
+			RelatedSpdxElement: model.ElementID(r.From.ID()).String(),
+		})
+	}
+	return result
+}
+
 func lookupRelationship(ty artifact.RelationshipType) (bool, model.RelationshipType, string) {
 	switch ty {
 	case artifact.ContainsRelationship:
 		return true, model.ContainsRelationship, ""
+	case artifact.DynamicLinkRelationship:
+		return true, model.DynamicLinkRelationship, ""
+	case artifact.DebugSymbolsOfRelationship:
+		return true, model.OtherRelationship, fmt.Sprintf("%s: indicates that the parent file holds the split debug symbols for the child binary", ty)
 	case artifact.OwnershipByFileOverlapRelationship:
 		return true, model.OtherRelationship, fmt.Sprintf("%s: indicates that the parent package claims ownership of a child package since the parent metadata indicates overlap with a location that a cataloger found the child package by", ty)
 	}
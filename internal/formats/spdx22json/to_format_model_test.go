@@ -113,6 +113,12 @@ func Test_lookupRelationship(t *testing.T) {
 			ty:      model.OtherRelationship,
 			comment: "ownership-by-file-overlap: indicates that the parent package claims ownership of a child package since the parent metadata indicates overlap with a location that a cataloger found the child package by",
 		},
+		{
+			input:   artifact.DebugSymbolsOfRelationship,
+			exists:  true,
+			ty:      model.OtherRelationship,
+			comment: "debug-symbols-of: indicates that the parent file holds the split debug symbols for the child binary",
+		},
 		{
 			input:  "made-up",
 			exists: false,
@@ -254,3 +260,26 @@ func Test_fileIDsForPackage(t *testing.T) {
 		})
 	}
 }
+
+func Test_toDependencyRelationships(t *testing.T) {
+	app := pkg.Package{Name: "app"}
+	app.SetID()
+	lib := pkg.Package{Name: "lib"}
+	lib.SetID()
+
+	actual := toDependencyRelationships([]artifact.Relationship{
+		{
+			From: lib,
+			To:   app,
+			Type: artifact.DependencyOfRelationship,
+		},
+	})
+
+	assert.Equal(t, []model.Relationship{
+		{
+			SpdxElementID:      model.ElementID(app.ID()).String(),
+			RelationshipType:   model.DependsOnRelationship,
+			RelatedSpdxElement: model.ElementID(lib.ID()).String(),
+		},
+	}, actual)
+}
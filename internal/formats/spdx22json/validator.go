@@ -0,0 +1,27 @@
+package spdx22json
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+func validator(reader io.Reader) error {
+	type Document struct {
+		SPDXVersion string `json:"spdxVersion"`
+	}
+
+	dec := json.NewDecoder(reader)
+
+	var doc Document
+	if err := dec.Decode(&doc); err != nil {
+		return fmt.Errorf("unable to decode: %w", err)
+	}
+
+	// note: we accept all SPDX 2.x versions
+	if strings.HasPrefix(doc.SPDXVersion, "SPDX-2.") {
+		return nil
+	}
+	return fmt.Errorf("could not extract SPDX version")
+}
@@ -0,0 +1,19 @@
+package spdx22tagvalue
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/anchore/syft/internal/formats/common/spdxhelpers"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/spdx/tools-golang/tvloader"
+)
+
+func decoder(reader io.Reader) (*sbom.SBOM, error) {
+	doc, err := tvloader.Load2_2(reader)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode spdx-tag-value: %w", err)
+	}
+
+	return spdxhelpers.ToSyftModel(toJSONModel(doc))
+}
@@ -2,10 +2,10 @@ package spdx22tagvalue
 
 import (
 	"flag"
-	"regexp"
 	"testing"
 
 	"github.com/anchore/syft/internal/formats/common/testutils"
+	"github.com/anchore/syft/syft/format"
 )
 
 var updateSpdxTagValue = flag.Bool("update-spdx-tv", false, "update the *.golden files for spdx-tv encoders")
@@ -16,7 +16,7 @@ func TestSPDXTagValueDirectoryEncoder(t *testing.T) {
 		Format(),
 		testutils.DirectoryInput(t),
 		*updateSpdxTagValue,
-		spdxTagValueRedactor,
+		testutils.Redactors(format.SPDXTagValueOption)...,
 	)
 }
 
@@ -27,17 +27,6 @@ func TestSPDXTagValueImageEncoder(t *testing.T) {
 		testutils.ImageInput(t, testImage, testutils.FromSnapshot()),
 		testImage,
 		*updateSpdxTagValue,
-		spdxTagValueRedactor,
+		testutils.Redactors(format.SPDXTagValueOption)...,
 	)
 }
-
-func spdxTagValueRedactor(s []byte) []byte {
-	// each SBOM reports the time it was generated, which is not useful during snapshot testing
-	s = regexp.MustCompile(`Created: .*`).ReplaceAll(s, []byte("redacted"))
-
-	// each SBOM reports a unique documentNamespace when generated, this is not useful for snapshot testing
-	s = regexp.MustCompile(`DocumentNamespace: https://anchore.com/syft/.*`).ReplaceAll(s, []byte("redacted"))
-
-	// the license list will be updated periodically, the value here should not be directly tested in snapshot tests
-	return regexp.MustCompile(`LicenseListVersion: .*`).ReplaceAll(s, []byte("redacted"))
-}
@@ -10,6 +10,7 @@ import (
 	"github.com/anchore/syft/internal/formats/common/spdxhelpers"
 	"github.com/anchore/syft/internal/spdxlicense"
 	"github.com/anchore/syft/internal/version"
+	"github.com/anchore/syft/syft/artifact"
 	"github.com/anchore/syft/syft/pkg"
 	"github.com/spdx/tools-golang/spdx"
 )
@@ -17,7 +18,7 @@ import (
 // toFormatModel creates and populates a new JSON document struct that follows the SPDX 2.2 spec from the given cataloging results.
 // nolint:funlen
 func toFormatModel(s sbom.SBOM) (*spdx.Document2_2, error) {
-	name, namespace, err := spdxhelpers.DocumentNameAndNamespace(s.Source)
+	name, namespace, err := spdxhelpers.DocumentNameAndNamespace(s.Source, s.Descriptor)
 	if err != nil {
 		return nil, err
 	}
@@ -69,41 +70,89 @@ func toFormatModel(s sbom.SBOM) (*spdx.Document2_2, error) {
 			// 2.8: Creators: may have multiple keys for Person, Organization
 			//      and/or Tool
 			// Cardinality: mandatory, one or many
-			CreatorPersons:       nil,
-			CreatorOrganizations: []string{"Anchore, Inc"},
+			CreatorPersons:       spdxhelpers.CreatorPersons(s.Descriptor),
+			CreatorOrganizations: spdxhelpers.CreatorOrganizations(s.Descriptor),
 			CreatorTools:         []string{internal.ApplicationName + "-" + version.FromBuild().Version},
 
 			// 2.9: Created: data format YYYY-MM-DDThh:mm:ssZ
 			// Cardinality: mandatory, one
-			Created: time.Now().UTC().Format(time.RFC3339),
+			Created: s.Descriptor.CreationTime().UTC().Format(time.RFC3339),
 
 			// 2.10: Creator Comment
 			// Cardinality: optional, one
-			CreatorComment: "",
+			CreatorComment: spdxhelpers.CreatorComment(s.Descriptor, sbom.NewSummary(s)),
 
 			// 2.11: Document Comment
 			// Cardinality: optional, one
 			DocumentComment: "",
 		},
-		Packages: toFormatPackages(s.Artifacts.PackageCatalog),
+		Packages:      toFormatPackages(s.Artifacts.PackageCatalog),
+		Relationships: toFormatRelationships(sbom.DependencyRelationships(s)),
+		Annotations:   toFormatAnnotations(s),
 	}, nil
 }
 
+// toFormatAnnotations records the non-fatal problems found while cataloging as annotations of the document.
+func toFormatAnnotations(s sbom.SBOM) (results []*spdx.Annotation2_2) {
+	for _, comment := range spdxhelpers.WarningComments(s.Artifacts.Warnings) {
+		results = append(results, &spdx.Annotation2_2{
+			Annotator:                internal.ApplicationName + "-" + version.FromBuild().Version,
+			AnnotatorType:            "Tool",
+			AnnotationDate:           s.Descriptor.CreationTime().UTC().Format(time.RFC3339),
+			AnnotationType:           "OTHER",
+			AnnotationSPDXIdentifier: spdx.MakeDocElementID("", "DOCUMENT"),
+			AnnotationComment:        comment,
+		})
+	}
+	return results
+}
+
+// toFormatRelationships expresses each package dependency as the dependent package DEPENDS_ON the dependency.
+func toFormatRelationships(relationships []artifact.Relationship) (results []*spdx.Relationship2_2) {
+	for _, r := range relationships {
+		dependency, ok := r.From.(pkg.Package)
+		if !ok {
+			continue
+		}
+		dependent, ok := r.To.(pkg.Package)
+		if !ok {
+			continue
+		}
+
+		results = append(results, &spdx.Relationship2_2{
+			RefA:         spdx.MakeDocElementID("", string(toElementID(dependent))),
+			RefB:         spdx.MakeDocElementID("", string(toElementID(dependency))),
+			Relationship: "DEPENDS_ON",
+		})
+	}
+	return results
+}
+
+// toElementID returns the identifier of the given package within the document.
+func toElementID(p pkg.Package) spdx.ElementID {
+	// name should be guaranteed to be unique, but semantically useful and stable
+	return spdx.ElementID(fmt.Sprintf("Package-%+v-%s", p.Type, p.Name))
+}
+
 // packages populates all Package Information from the package Catalog (see https://spdx.github.io/spdx-spec/3-package-information/)
 // nolint: funlen
 func toFormatPackages(catalog *pkg.Catalog) map[spdx.ElementID]*spdx.Package2_2 {
 	results := make(map[spdx.ElementID]*spdx.Package2_2)
 
 	for p := range catalog.Enumerate() {
-		// name should be guaranteed to be unique, but semantically useful and stable
-		id := fmt.Sprintf("Package-%+v-%s", p.Type, p.Name)
+		id := toElementID(p)
 
 		// If the Concluded License is not the same as the Declared License, a written explanation should be provided
 		// in the Comments on License field (section 3.16). With respect to NOASSERTION, a written explanation in
 		// the Comments on License field (section 3.16) is preferred.
 		license := spdxhelpers.License(p)
 
-		results[spdx.ElementID(id)] = &spdx.Package2_2{
+		checksums := make(map[string]string)
+		for _, digest := range spdxhelpers.Checksums(p) {
+			checksums[digest.Algorithm] = digest.Value
+		}
+
+		results[id] = &spdx.Package2_2{
 
 			// NOT PART OF SPEC
 			// flag: does this "package" contain files that were in fact "unpackaged",
@@ -116,7 +165,7 @@ func toFormatPackages(catalog *pkg.Catalog) map[spdx.ElementID]*spdx.Package2_2
 
 			// 3.2: Package SPDX Identifier: "SPDXRef-[idstring]"
 			// Cardinality: mandatory, one
-			PackageSPDXIdentifier: spdx.ElementID(id),
+			PackageSPDXIdentifier: id,
 
 			// 3.3: Package Version
 			// Cardinality: optional, one
@@ -147,7 +196,7 @@ func toFormatPackages(catalog *pkg.Catalog) map[spdx.ElementID]*spdx.Package2_2
 			//   (i) the SPDX file creator has attempted to but cannot reach a reasonable objective determination;
 			//   (ii) the SPDX file creator has made no attempt to determine this field; or
 			//   (iii) the SPDX file creator has intentionally provided no information (no meaning should be implied by doing so).
-			PackageDownloadLocation: "NOASSERTION",
+			PackageDownloadLocation: spdxhelpers.DownloadLocation(p),
 
 			// 3.8: FilesAnalyzed
 			// Cardinality: optional, one; default value is "true" if omitted
@@ -181,19 +230,19 @@ func toFormatPackages(catalog *pkg.Catalog) map[spdx.ElementID]*spdx.Package2_2
 			// in a package, this value should not be calculated. The SHA-1 algorithm will be used to provide the
 			// checksum by default.
 
-			// note: based on the purpose above no discovered checksums should be provided, but instead, only
-			// tool-derived checksums.
-			PackageChecksumSHA1:   "",
-			PackageChecksumSHA256: "",
-			PackageChecksumMD5:    "",
+			// note: only the checksums of the distributed package archive (e.g. recorded in a lockfile) are
+			// provided, never checksums of the files that happen to describe the package.
+			PackageChecksumSHA1:   checksums["SHA1"],
+			PackageChecksumSHA256: checksums["SHA256"],
+			PackageChecksumMD5:    checksums["MD5"],
 
 			// 3.11: Package Home Page
 			// Cardinality: optional, one
-			PackageHomePage: "",
+			PackageHomePage: spdxhelpers.Homepage(p),
 
 			// 3.12: Source Information
 			// Cardinality: optional, one
-			PackageSourceInfo: "",
+			PackageSourceInfo: spdxhelpers.SourceInfo(p),
 
 			// 3.13: Concluded License: SPDX License Expression, "NONE" or "NOASSERTION"
 			// Cardinality: mandatory, one
@@ -235,7 +284,7 @@ func toFormatPackages(catalog *pkg.Catalog) map[spdx.ElementID]*spdx.Package2_2
 
 			// 3.19: Package Detailed Description
 			// Cardinality: optional, one
-			PackageDescription: "",
+			PackageDescription: spdxhelpers.Description(p),
 
 			// 3.20: Package Comment
 			// Cardinality: optional, one
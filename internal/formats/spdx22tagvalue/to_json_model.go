@@ -0,0 +1,107 @@
+package spdx22tagvalue
+
+import (
+	"sort"
+	"time"
+
+	"github.com/anchore/syft/internal/formats/spdx22json/model"
+	"github.com/spdx/tools-golang/spdx"
+)
+
+// toJSONModel describes the given tag-value document with the model of the SPDX JSON format, so that both formats are
+// decoded the same way.
+func toJSONModel(doc *spdx.Document2_2) model.Document {
+	var result model.Document
+	if info := doc.CreationInfo; info != nil {
+		result.SPDXID = model.ElementID(info.SPDXIdentifier).String()
+		result.Name = info.DocumentName
+		result.SPDXVersion = info.SPDXVersion
+		result.DataLicense = info.DataLicense
+		result.DocumentNamespace = info.DocumentNamespace
+		result.CreationInfo = toJSONCreationInfo(info)
+	}
+
+	ids := make([]string, 0, len(doc.Packages))
+	for id := range doc.Packages {
+		ids = append(ids, string(id))
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		result.Packages = append(result.Packages, toJSONPackage(doc.Packages[spdx.ElementID(id)]))
+	}
+
+	for _, r := range doc.Relationships {
+		if r.RefA.DocumentRefID != "" || r.RefB.DocumentRefID != "" {
+			// elements of other documents are not known
+			continue
+		}
+		result.Relationships = append(result.Relationships, model.Relationship{
+			SpdxElementID:      model.ElementID(r.RefA.ElementRefID).String(),
+			RelationshipType:   model.RelationshipType(r.Relationship),
+			RelatedSpdxElement: model.ElementID(r.RefB.ElementRefID).String(),
+		})
+	}
+
+	return result
+}
+
+func toJSONCreationInfo(info *spdx.CreationInfo2_2) model.CreationInfo {
+	result := model.CreationInfo{
+		Comment:            info.CreatorComment,
+		LicenseListVersion: info.LicenseListVersion,
+	}
+	if created, err := time.Parse(time.RFC3339, info.Created); err == nil {
+		result.Created = created
+	}
+	for _, person := range info.CreatorPersons {
+		result.Creators = append(result.Creators, "Person: "+person)
+	}
+	for _, organization := range info.CreatorOrganizations {
+		result.Creators = append(result.Creators, "Organization: "+organization)
+	}
+	for _, tool := range info.CreatorTools {
+		result.Creators = append(result.Creators, "Tool: "+tool)
+	}
+	return result
+}
+
+func toJSONPackage(p *spdx.Package2_2) model.Package {
+	var checksums []model.Checksum
+	for _, c := range []model.Checksum{
+		{Algorithm: "SHA1", ChecksumValue: p.PackageChecksumSHA1},
+		{Algorithm: "SHA256", ChecksumValue: p.PackageChecksumSHA256},
+		{Algorithm: "MD5", ChecksumValue: p.PackageChecksumMD5},
+	} {
+		if c.ChecksumValue != "" {
+			checksums = append(checksums, c)
+		}
+	}
+
+	var refs []model.ExternalRef
+	for _, r := range p.PackageExternalReferences {
+		refs = append(refs, model.ExternalRef{
+			ReferenceCategory: model.ReferenceCategory(r.Category),
+			ReferenceLocator:  r.Locator,
+			ReferenceType:     model.ExternalRefType(r.RefType),
+		})
+	}
+
+	return model.Package{
+		Item: model.Item{
+			Element: model.Element{
+				SPDXID: model.ElementID(p.PackageSPDXIdentifier).String(),
+				Name:   p.PackageName,
+			},
+			LicenseConcluded: p.PackageLicenseConcluded,
+		},
+		Checksums:        checksums,
+		Description:      p.PackageDescription,
+		DownloadLocation: p.PackageDownloadLocation,
+		ExternalRefs:     refs,
+		FilesAnalyzed:    p.FilesAnalyzed,
+		Homepage:         p.PackageHomePage,
+		LicenseDeclared:  p.PackageLicenseDeclared,
+		SourceInfo:       p.PackageSourceInfo,
+		VersionInfo:      p.PackageVersion,
+	}
+}
@@ -0,0 +1,27 @@
+package spdx22tagvalue
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+func validator(reader io.Reader) error {
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		// the document creation information (starting with the SPDX version) comes first
+		if strings.HasPrefix(line, "SPDXVersion: SPDX-2.") {
+			return nil
+		}
+		break
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("unable to read: %w", err)
+	}
+	return fmt.Errorf("could not extract SPDX version")
+}
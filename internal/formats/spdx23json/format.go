@@ -0,0 +1,14 @@
+package spdx23json
+
+import "github.com/anchore/syft/syft/format"
+
+// note: this format is LOSSY relative to the syftjson formation, decoding only recovers the packages (without their
+// metadata), the relationships between them, and the image that was cataloged (with its layers)
+func Format() format.Format {
+	return format.NewFormat(
+		format.SPDX23JSONOption,
+		encoder,
+		decoder,
+		validator,
+	)
+}
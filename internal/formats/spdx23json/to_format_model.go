@@ -0,0 +1,151 @@
+package spdx23json
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/formats/common/spdxhelpers"
+	"github.com/anchore/syft/internal/formats/spdx22json"
+	"github.com/anchore/syft/internal/formats/spdx22json/model"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/anchore/syft/syft/source"
+)
+
+const spdxVersion = "SPDX-2.3"
+
+// toFormatModel creates and populates a new JSON document struct that follows the SPDX 2.3 spec from the given
+// cataloging results. This is the SPDX 2.2 document with the primary purpose of every package and, for image sources,
+// with the image and each of its layers described as packages.
+func toFormatModel(s sbom.SBOM) (*model.Document, error) {
+	doc, err := spdx22json.ToFormatModel(s)
+	if err != nil {
+		return nil, err
+	}
+	doc.SPDXVersion = spdxVersion
+
+	purposes := make(map[string]model.PrimaryPackagePurpose)
+	for _, p := range s.Artifacts.PackageCatalog.Sorted() {
+		purposes[model.ElementID(p.ID()).String()] = spdxhelpers.PrimaryPackagePurpose(p)
+	}
+	for i := range doc.Packages {
+		doc.Packages[i].PrimaryPackagePurpose = purposes[doc.Packages[i].SPDXID]
+	}
+
+	doc.Packages = append(doc.Packages, toImagePackages(s.Source)...)
+	doc.Relationships = append(doc.Relationships, toImageRelationships(s)...)
+	return doc, nil
+}
+
+// toImagePackages describes the cataloged image, and each of its layers, as packages so that the packages found within
+// the image can be attributed to the layer that introduced them.
+func toImagePackages(src source.Metadata) []model.Package {
+	if src.Scheme != source.ImageScheme {
+		return nil
+	}
+
+	packages := []model.Package{
+		{
+			DownloadLocation:      "NOASSERTION",
+			FilesAnalyzed:         false,
+			LicenseDeclared:       "NOASSERTION",
+			PrimaryPackagePurpose: model.ContainerPurpose,
+			VersionInfo:           src.ImageMetadata.ManifestDigest,
+			Item: model.Item{
+				LicenseConcluded: "NOASSERTION",
+				Element: model.Element{
+					SPDXID: imageElementID(src.ImageMetadata.ID).String(),
+					Name:   src.ImageMetadata.UserInput,
+				},
+			},
+		},
+	}
+
+	for idx, layer := range src.ImageMetadata.Layers {
+		packages = append(packages, model.Package{
+			DownloadLocation:      "NOASSERTION",
+			FilesAnalyzed:         false,
+			LicenseDeclared:       "NOASSERTION",
+			PrimaryPackagePurpose: model.ArchivePurpose,
+			Item: model.Item{
+				LicenseConcluded: "NOASSERTION",
+				Element: model.Element{
+					SPDXID:  layerElementID(idx, layer.Digest).String(),
+					Name:    layer.Digest,
+					Comment: fmt.Sprintf("image layer %d of %d (%s)", idx+1, len(src.ImageMetadata.Layers), layer.MediaType),
+				},
+			},
+		})
+	}
+
+	return packages
+}
+
+// imageElementID derives the SPDX identifier of an image from its ID (a digest, where the ':' is not allowed within
+// an SPDX identifier).
+func imageElementID(imageID string) model.ElementID {
+	return model.ElementID("Image-" + strings.ReplaceAll(imageID, ":", "-"))
+}
+
+// layerElementID derives the SPDX identifier of an image layer from its position within the image and its digest. The
+// digest alone is not unique: an image may have several identical layers (e.g. empty layers).
+func layerElementID(idx int, digest string) model.ElementID {
+	return model.ElementID(fmt.Sprintf("Layer-%d-%s", idx+1, strings.ReplaceAll(digest, ":", "-")))
+}
+
+// toImageRelationships relates the document to the image it describes, the image to each of its layers, and each
+// package to the layer(s) it was found in. SPDX has no relationship type for a package being built from an image
+// layer, so this is expressed as an OTHER relationship (as with the other syft-specific relationships).
+func toImageRelationships(s sbom.SBOM) (result []model.Relationship) {
+	if s.Source.Scheme != source.ImageScheme {
+		return nil
+	}
+
+	imageID := imageElementID(s.Source.ImageMetadata.ID).String()
+	result = append(result, model.Relationship{
+		SpdxElementID:      model.ElementID("DOCUMENT").String(),
+		RelationshipType:   model.DescribesRelationship,
+		RelatedSpdxElement: imageID,
+	})
+
+	// packages are only known by the digest of the layer they were found in, which is attributed to the topmost layer
+	// with that digest (the layer that the files of the squashed image are read from)
+	layerIDs := make(map[string]string)
+	for idx, layer := range s.Source.ImageMetadata.Layers {
+		layerID := layerElementID(idx, layer.Digest).String()
+		layerIDs[layer.Digest] = layerID
+		result = append(result, model.Relationship{
+			SpdxElementID:      imageID,
+			RelationshipType:   model.ContainsRelationship,
+			RelatedSpdxElement: layerID,
+		})
+	}
+
+	for _, p := range s.Artifacts.PackageCatalog.Sorted() {
+		for _, layerDigest := range layerDigestsForPackage(p) {
+			layerID, ok := layerIDs[layerDigest]
+			if !ok {
+				continue
+			}
+			result = append(result, model.Relationship{
+				SpdxElementID:      model.ElementID(p.ID()).String(),
+				RelationshipType:   model.OtherRelationship,
+				RelatedSpdxElement: layerID,
+				Comment:            "built-from: indicates that the package was installed by the image layer",
+			})
+		}
+	}
+	return result
+}
+
+// layerDigestsForPackage returns the (sorted) digests of the layers that the package was found in.
+func layerDigestsForPackage(p pkg.Package) []string {
+	digests := internal.NewStringSet()
+	for _, location := range p.Locations {
+		if location.FileSystemID != "" {
+			digests.Add(location.FileSystemID)
+		}
+	}
+	return digests.ToSlice()
+}
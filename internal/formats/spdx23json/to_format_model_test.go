@@ -0,0 +1,136 @@
+package spdx23json
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/internal/formats/spdx22json/model"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/anchore/syft/syft/source"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_toFormatModel(t *testing.T) {
+	library := pkg.Package{Name: "library", Type: pkg.PythonPkg}
+	library.SetID()
+	runtime := pkg.Package{Name: "runtime", Type: pkg.RuntimePkg}
+	runtime.SetID()
+
+	doc, err := toFormatModel(sbom.SBOM{
+		Artifacts: sbom.Artifacts{
+			PackageCatalog: pkg.NewCatalog(library, runtime),
+		},
+		Source: source.Metadata{Scheme: source.DirectoryScheme, Path: "/some/path"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "SPDX-2.3", doc.SPDXVersion)
+	purposes := make(map[string]model.PrimaryPackagePurpose)
+	for _, p := range doc.Packages {
+		purposes[p.Name] = p.PrimaryPackagePurpose
+	}
+	assert.Equal(t, map[string]model.PrimaryPackagePurpose{
+		"library": model.LibraryPurpose,
+		"runtime": model.FrameworkPurpose,
+	}, purposes)
+}
+
+func Test_toImagePackages(t *testing.T) {
+	src := source.Metadata{
+		Scheme: source.ImageScheme,
+		ImageMetadata: source.ImageMetadata{
+			ID: "sha256:abc",
+			Layers: []source.LayerMetadata{
+				{Digest: "sha256:1"},
+				{Digest: "sha256:2"},
+				{Digest: "sha256:1"},
+			},
+		},
+	}
+
+	var ids []string
+	for _, p := range toImagePackages(src) {
+		ids = append(ids, p.SPDXID)
+	}
+	// layers sharing a digest are still distinct elements
+	assert.Equal(t, []string{
+		"SPDXRef-Image-sha256-abc",
+		"SPDXRef-Layer-1-sha256-1",
+		"SPDXRef-Layer-2-sha256-2",
+		"SPDXRef-Layer-3-sha256-1",
+	}, ids)
+}
+
+func Test_toImageRelationships(t *testing.T) {
+	p := pkg.Package{
+		Name: "pkg",
+		Locations: []source.Location{
+			source.NewLocationFromCoordinates(source.Coordinates{RealPath: "/b", FileSystemID: "sha256:2"}),
+			source.NewLocationFromCoordinates(source.Coordinates{RealPath: "/a", FileSystemID: "sha256:1"}),
+			source.NewLocationFromCoordinates(source.Coordinates{RealPath: "/c", FileSystemID: "sha256:1"}),
+		},
+	}
+	p.SetID()
+
+	src := source.Metadata{
+		Scheme: source.ImageScheme,
+		ImageMetadata: source.ImageMetadata{
+			ID: "sha256:abc",
+			Layers: []source.LayerMetadata{
+				{Digest: "sha256:1"},
+				{Digest: "sha256:2"},
+				{Digest: "sha256:1"},
+			},
+		},
+	}
+
+	s := sbom.SBOM{
+		Artifacts: sbom.Artifacts{
+			PackageCatalog: pkg.NewCatalog(p),
+		},
+		Source: src,
+	}
+
+	builtFrom := "built-from: indicates that the package was installed by the image layer"
+	assert.Equal(t, []model.Relationship{
+		{
+			SpdxElementID:      "SPDXRef-DOCUMENT",
+			RelationshipType:   model.DescribesRelationship,
+			RelatedSpdxElement: "SPDXRef-Image-sha256-abc",
+		},
+		{
+			SpdxElementID:      "SPDXRef-Image-sha256-abc",
+			RelationshipType:   model.ContainsRelationship,
+			RelatedSpdxElement: "SPDXRef-Layer-1-sha256-1",
+		},
+		{
+			SpdxElementID:      "SPDXRef-Image-sha256-abc",
+			RelationshipType:   model.ContainsRelationship,
+			RelatedSpdxElement: "SPDXRef-Layer-2-sha256-2",
+		},
+		{
+			SpdxElementID:      "SPDXRef-Image-sha256-abc",
+			RelationshipType:   model.ContainsRelationship,
+			RelatedSpdxElement: "SPDXRef-Layer-3-sha256-1",
+		},
+		{
+			// the topmost of the layers sharing the digest
+			SpdxElementID:      model.ElementID(p.ID()).String(),
+			RelationshipType:   model.OtherRelationship,
+			RelatedSpdxElement: "SPDXRef-Layer-3-sha256-1",
+			Comment:            builtFrom,
+		},
+		{
+			SpdxElementID:      model.ElementID(p.ID()).String(),
+			RelationshipType:   model.OtherRelationship,
+			RelatedSpdxElement: "SPDXRef-Layer-2-sha256-2",
+			Comment:            builtFrom,
+		},
+	}, toImageRelationships(s))
+
+	// directory sources have no layers to attribute packages to
+	s.Source = source.Metadata{Scheme: source.DirectoryScheme}
+	assert.Empty(t, toImageRelationships(s))
+	assert.Empty(t, toImagePackages(s.Source))
+}
@@ -0,0 +1,26 @@
+package spdx23json
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+func validator(reader io.Reader) error {
+	type Document struct {
+		SPDXVersion string `json:"spdxVersion"`
+	}
+
+	dec := json.NewDecoder(reader)
+
+	var doc Document
+	if err := dec.Decode(&doc); err != nil {
+		return fmt.Errorf("unable to decode: %w", err)
+	}
+
+	// note: any other SPDX 2.x version is identified as the spdx22json format
+	if doc.SPDXVersion == spdxVersion {
+		return nil
+	}
+	return fmt.Errorf("not an %s document", spdxVersion)
+}
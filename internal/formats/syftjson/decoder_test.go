@@ -4,10 +4,16 @@ import (
 	"bytes"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/anchore/syft/internal/formats/common/testutils"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/anchore/syft/syft/source"
 	"github.com/go-test/deep"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestEncodeDecodeCycle(t *testing.T) {
@@ -44,3 +50,177 @@ func TestEncodeDecodeCycle(t *testing.T) {
 		}
 	}
 }
+
+func TestEncodeDecodeCycle_Nested(t *testing.T) {
+	originalSBOM := testutils.DirectoryInput(t)
+	nestedSBOM := testutils.DirectoryInput(t)
+	nestedSBOM.Source = source.Metadata{
+		Scheme: source.ImageScheme,
+		ImageMetadata: source.ImageMetadata{
+			UserInput: "mirror/library/busybox/index.json",
+			Layers:    []source.LayerMetadata{},
+			Tags:      []string{},
+		},
+	}
+	originalSBOM.Nested = []sbom.NestedSBOM{
+		{
+			Location: source.Coordinates{RealPath: "mirror/library/busybox/index.json"},
+			SBOM:     nestedSBOM,
+		},
+	}
+
+	var buf bytes.Buffer
+	assert.NoError(t, encoder(&buf, originalSBOM))
+
+	actualSBOM, err := decoder(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	require.Len(t, actualSBOM.Nested, 1)
+
+	actual := actualSBOM.Nested[0]
+	assert.Equal(t, originalSBOM.Nested[0].Location, actual.Location)
+	for _, d := range deep.Equal(nestedSBOM.Source, actual.SBOM.Source) {
+		t.Errorf("metadata difference: %+v", d)
+	}
+	assert.Equal(t, nestedSBOM.Artifacts.PackageCatalog.PackageCount(), actual.SBOM.Artifacts.PackageCatalog.PackageCount())
+}
+
+func TestEncodeDecodeCycle_Warnings(t *testing.T) {
+	originalSBOM := testutils.DirectoryInput(t)
+	originalSBOM.Artifacts.Warnings = []source.Warning{
+		{Path: "/etc/shadow", Message: "unable to access path: permission denied"},
+		{Message: "cataloging was incomplete"},
+	}
+
+	var buf bytes.Buffer
+	assert.NoError(t, encoder(&buf, originalSBOM))
+	assert.Contains(t, buf.String(), `"warnings"`)
+
+	actualSBOM, err := decoder(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	assert.Equal(t, originalSBOM.Artifacts.Warnings, actualSBOM.Artifacts.Warnings)
+}
+
+func TestEncodeDecodeCycle_Origins(t *testing.T) {
+	originalSBOM := testutils.DirectoryInput(t)
+	originalSBOM.Descriptor.Timestamp = time.Date(2022, 3, 2, 8, 30, 0, 0, time.UTC)
+	originalSBOM.Descriptor.Origins = []sbom.DocumentOrigin{
+		{
+			Tool:      "syft",
+			Version:   "0.40.0",
+			Format:    "spdx-json",
+			Timestamp: time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC),
+			Digest:    "sha256:1a2b",
+			Origins: []sbom.DocumentOrigin{
+				{Tool: "syft", Version: "0.39.0", Format: "json", Digest: "sha256:3c4d"},
+			},
+		},
+		{Tool: "other-tool", Version: "1.0.0", Format: "cyclonedx-json", Digest: "sha256:5e6f"},
+	}
+
+	var buf bytes.Buffer
+	assert.NoError(t, encoder(&buf, originalSBOM))
+
+	actualSBOM, err := decoder(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	assert.Equal(t, originalSBOM.Descriptor.Timestamp, actualSBOM.Descriptor.Timestamp)
+	assert.Equal(t, originalSBOM.Descriptor.Origins, actualSBOM.Descriptor.Origins)
+}
+
+func TestEncodeDecodeCycle_Summary(t *testing.T) {
+	originalSBOM := testutils.DirectoryInput(t)
+	originalSBOM.Descriptor.Duration = 1500 * time.Millisecond
+
+	var buf bytes.Buffer
+	assert.NoError(t, encoder(&buf, originalSBOM))
+	assert.Contains(t, buf.String(), `"durationSeconds": 1.5`)
+
+	actualSBOM, err := decoder(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	assert.Equal(t, originalSBOM.Descriptor.Duration, actualSBOM.Descriptor.Duration)
+	assert.Equal(t, sbom.NewSummary(originalSBOM), sbom.NewSummary(*actualSBOM))
+}
+
+func TestEncodeDecodeCycle_Relationships(t *testing.T) {
+	originalSBOM := testutils.DirectoryInput(t)
+	packages := originalSBOM.Artifacts.PackageCatalog.Sorted()
+	require.Len(t, packages, 2)
+
+	coordinates := source.Coordinates{RealPath: "/some/path/pkg1/file"}
+	originalSBOM.Relationships = []artifact.Relationship{
+		{
+			From: packages[0],
+			To:   packages[1],
+			Type: artifact.DependencyOfRelationship,
+		},
+		{
+			From: packages[0],
+			To:   coordinates,
+			Type: artifact.ContainsRelationship,
+		},
+	}
+
+	var buf bytes.Buffer
+	assert.NoError(t, encoder(&buf, originalSBOM))
+
+	actualSBOM, err := decoder(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+
+	// the IDs written to the document are kept (and not regenerated) when reading it back
+	for _, p := range packages {
+		actual := actualSBOM.Artifacts.PackageCatalog.Package(p.ID())
+		require.NotNil(t, actual, "missing package %q", p.Name)
+		assert.Equal(t, p.Name, actual.Name)
+	}
+
+	require.Len(t, actualSBOM.Relationships, 2)
+	for idx, expected := range originalSBOM.Relationships {
+		actual := actualSBOM.Relationships[idx]
+		assert.Equal(t, expected.From.ID(), actual.From.ID())
+		assert.Equal(t, expected.To.ID(), actual.To.ID())
+		assert.Equal(t, expected.Type, actual.Type)
+	}
+	assert.Equal(t, coordinates, actualSBOM.Relationships[1].To)
+}
+
+func TestEncodeDecodeCycle_Files(t *testing.T) {
+	originalSBOM := testutils.DirectoryInput(t)
+
+	binary := source.Coordinates{RealPath: "/usr/bin/app"}
+	config := source.Coordinates{RealPath: "/etc/app.conf"}
+	originalSBOM.Artifacts.FileMetadata = map[source.Coordinates]source.FileMetadata{
+		binary: {Mode: 0755, Type: source.RegularFile, UserID: 1, GroupID: 2, MIMEType: "application/x-executable"},
+		config: {Mode: 0640, Type: source.RegularFile},
+	}
+	originalSBOM.Artifacts.FileDigests = map[source.Coordinates][]file.Digest{
+		binary: {{Algorithm: "sha256", Value: "abc"}},
+	}
+	originalSBOM.Artifacts.FileClassifications = map[source.Coordinates][]file.Classification{
+		binary: {{Class: "app-binary", Metadata: map[string]string{"version": "1.0"}}},
+	}
+	originalSBOM.Artifacts.FileExecutables = map[source.Coordinates]file.Executable{
+		binary: {Format: file.ELF, PositionIndependent: true},
+	}
+	originalSBOM.Artifacts.FileImports = map[source.Coordinates][]string{
+		binary: {"libc.so.6"},
+	}
+	originalSBOM.Artifacts.FileContents = map[source.Coordinates]string{
+		config: "a2V5PXZhbHVl",
+	}
+	originalSBOM.Artifacts.Secrets = map[source.Coordinates][]file.SearchResult{
+		config: {{Classification: "generic-api-key", LineNumber: 1, Length: 9}},
+	}
+
+	var buf bytes.Buffer
+	assert.NoError(t, encoder(&buf, originalSBOM))
+
+	actualSBOM, err := decoder(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+
+	assert.Equal(t, originalSBOM.Artifacts.FileMetadata, actualSBOM.Artifacts.FileMetadata)
+	assert.Equal(t, originalSBOM.Artifacts.FileDigests, actualSBOM.Artifacts.FileDigests)
+	assert.Equal(t, originalSBOM.Artifacts.FileClassifications, actualSBOM.Artifacts.FileClassifications)
+	assert.Equal(t, originalSBOM.Artifacts.FileExecutables, actualSBOM.Artifacts.FileExecutables)
+	assert.Equal(t, originalSBOM.Artifacts.FileImports, actualSBOM.Artifacts.FileImports)
+	assert.Equal(t, originalSBOM.Artifacts.FileContents, actualSBOM.Artifacts.FileContents)
+	assert.Equal(t, originalSBOM.Artifacts.Secrets, actualSBOM.Artifacts.Secrets)
+}
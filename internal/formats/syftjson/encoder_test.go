@@ -139,6 +139,16 @@ func TestEncodeFullJSONDocument(t *testing.T) {
 			FileContents: map[source.Coordinates]string{
 				source.NewLocation("/a/place/a").Coordinates: "the-contents",
 			},
+			FileExecutables: map[source.Coordinates]file.Executable{
+				source.NewLocation("/b/place/b").Coordinates: {
+					Format:              file.ELF,
+					Toolchains:          []file.Toolchain{{Name: "gcc", Version: "10.2.1"}},
+					PositionIndependent: true,
+					RelocationReadOnly:  file.RelocationReadOnlyFull,
+					StackProtector:      true,
+					NonExecutableStack:  true,
+				},
+			},
 			Distro: &distro.Distro{
 				Type:       distro.RedHat,
 				RawVersion: "7",
@@ -1,8 +1,11 @@
 package model
 
+import "github.com/anchore/syft/syft/eol"
+
 // Distro provides information about a detected Linux Distro.
 type Distro struct {
-	Name    string `json:"name"`    // Name of the Linux distribution
-	Version string `json:"version"` // Version of the Linux distribution (major or major.minor version)
-	IDLike  string `json:"idLike"`  // the ID_LIKE field found within the /etc/os-release file
+	Name    string      `json:"name"`          // Name of the Linux distribution
+	Version string      `json:"version"`       // Version of the Linux distribution (major or major.minor version)
+	IDLike  string      `json:"idLike"`        // the ID_LIKE field found within the /etc/os-release file
+	EOL     *eol.Status `json:"eol,omitempty"` // the end-of-life status of the release (only with --eol)
 }
@@ -1,22 +1,56 @@
 package model
 
+import "github.com/anchore/syft/syft/source"
+
 // Document represents the syft cataloging findings as a JSON document
 type Document struct {
-	Artifacts             []Package      `json:"artifacts"` // Artifacts is the list of packages discovered and placed into the catalog
-	ArtifactRelationships []Relationship `json:"artifactRelationships"`
-	Files                 []File         `json:"files,omitempty"`   // note: must have omitempty
-	Secrets               []Secrets      `json:"secrets,omitempty"` // note: must have omitempty
-	Source                Source         `json:"source"`            // Source represents the original object that was cataloged
-	Distro                Distro         `json:"distro"`            // Distro represents the Linux distribution that was detected from the source
-	Descriptor            Descriptor     `json:"descriptor"`        // Descriptor is a block containing self-describing information about syft
-	Schema                Schema         `json:"schema"`            // Schema is a block reserved for defining the version for the shape of this JSON document and where to find the schema document to validate the shape
+	Artifacts             []Package        `json:"artifacts"` // Artifacts is the list of packages discovered and placed into the catalog
+	ArtifactRelationships []Relationship   `json:"artifactRelationships"`
+	Files                 []File           `json:"files,omitempty"`    // note: must have omitempty
+	Secrets               []Secrets        `json:"secrets,omitempty"`  // note: must have omitempty
+	Source                Source           `json:"source"`             // Source represents the original object that was cataloged
+	Distro                Distro           `json:"distro"`             // Distro represents the Linux distribution that was detected from the source
+	Descriptor            Descriptor       `json:"descriptor"`         // Descriptor is a block containing self-describing information about syft
+	Schema                Schema           `json:"schema"`             // Schema is a block reserved for defining the version for the shape of this JSON document and where to find the schema document to validate the shape
+	Nested                []NestedDocument `json:"nested,omitempty"`   // Nested are the findings for container images stored within the source (e.g. OCI layouts or docker-archive tarballs)
+	Warnings              []Warning        `json:"warnings,omitempty"` // Warnings are the non-fatal problems found while cataloging (e.g. unreadable or skipped files)
+}
+
+// NestedDocument represents the syft cataloging findings for a container image found within the source
+type NestedDocument struct {
+	Location              source.Coordinates `json:"location"` // Location is where the image was found within the parent source
+	Artifacts             []Package          `json:"artifacts"`
+	ArtifactRelationships []Relationship     `json:"artifactRelationships"`
+	Source                Source             `json:"source"`
+	Distro                Distro             `json:"distro"`
 }
 
 // Descriptor describes what created the document as well as surrounding metadata
 type Descriptor struct {
-	Name          string      `json:"name"`
-	Version       string      `json:"version"`
-	Configuration interface{} `json:"configuration,omitempty"`
+	Name          string           `json:"name"`
+	Version       string           `json:"version"`
+	Configuration interface{}      `json:"configuration,omitempty"`
+	Timestamp     string           `json:"timestamp,omitempty"` // Timestamp is when the document was created (only when pinned, RFC3339)
+	Origins       []DocumentOrigin `json:"origins,omitempty"`   // Origins are the documents this document was derived from (e.g. when converted or merged)
+	Summary       *Summary         `json:"summary,omitempty"`   // Summary describes the composition of the document (e.g. for dashboards)
+}
+
+// Summary describes the composition of the document, so it can be reported on without processing all artifacts
+type Summary struct {
+	Packages        int            `json:"packages"`
+	PackagesByType  map[string]int `json:"packagesByType"`
+	Files           int            `json:"files"`
+	DurationSeconds float64        `json:"durationSeconds,omitempty"` // DurationSeconds is how long cataloging took (when known)
+}
+
+// DocumentOrigin describes an SBOM document that the document was derived from
+type DocumentOrigin struct {
+	Tool      string           `json:"tool"`
+	Version   string           `json:"version"`
+	Format    string           `json:"format"`
+	Timestamp string           `json:"timestamp,omitempty"`
+	Digest    string           `json:"digest"`
+	Origins   []DocumentOrigin `json:"origins,omitempty"`
 }
 
 type Schema struct {
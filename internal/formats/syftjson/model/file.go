@@ -13,6 +13,8 @@ type File struct {
 	Contents        string                `json:"contents,omitempty"`
 	Digests         []file.Digest         `json:"digests,omitempty"`
 	Classifications []file.Classification `json:"classifications,omitempty"`
+	Executable      *file.Executable      `json:"executable,omitempty"`
+	Imports         []string              `json:"imports,omitempty"`
 }
 
 type FileMetadataEntry struct {
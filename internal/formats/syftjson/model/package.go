@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/anchore/syft/syft/eol"
+	"github.com/anchore/syft/syft/file"
 	"github.com/anchore/syft/syft/source"
 
 	"github.com/anchore/syft/internal/log"
@@ -18,16 +20,22 @@ type Package struct {
 
 // PackageBasicData contains non-ambiguous values (type-wise) from pkg.Package.
 type PackageBasicData struct {
-	ID        string               `json:"id"`
-	Name      string               `json:"name"`
-	Version   string               `json:"version"`
-	Type      pkg.Type             `json:"type"`
-	FoundBy   string               `json:"foundBy"`
-	Locations []source.Coordinates `json:"locations"`
-	Licenses  []string             `json:"licenses"`
-	Language  pkg.Language         `json:"language"`
-	CPEs      []string             `json:"cpes"`
-	PURL      string               `json:"purl"`
+	ID                string                 `json:"id"`
+	Name              string                 `json:"name"`
+	Version           string                 `json:"version"`
+	Type              pkg.Type               `json:"type"`
+	FoundBy           string                 `json:"foundBy"`
+	Locations         []source.Coordinates   `json:"locations"`
+	Licenses          []string               `json:"licenses"`
+	Language          pkg.Language           `json:"language"`
+	CPEs              []string               `json:"cpes"`
+	PURL              string                 `json:"purl"`
+	Confidence        pkg.Confidence         `json:"confidence,omitempty"`
+	NormalizedVersion *pkg.NormalizedVersion `json:"normalizedVersion,omitempty"`
+	OriginURLs        *pkg.OriginURLs        `json:"originUrls,omitempty"`
+	Checksums         []file.Digest          `json:"checksums,omitempty"`
+	EOL               *eol.Status            `json:"eol,omitempty"`
+	Ownership         map[string]string      `json:"ownership,omitempty"`
 }
 
 // PackageCustomData contains ambiguous values (type-wise) from pkg.Package.
@@ -82,6 +90,18 @@ func (p *Package) UnmarshalJSON(b []byte) error {
 			return err
 		}
 		p.Metadata = payload
+	case pkg.DpkgBuildDependencyMetadataType:
+		var payload pkg.DpkgBuildDependencyMetadata
+		if err := json.Unmarshal(unpacker.Metadata, &payload); err != nil {
+			return err
+		}
+		p.Metadata = payload
+	case pkg.DpkgUploadMetadataType:
+		var payload pkg.DpkgUploadMetadata
+		if err := json.Unmarshal(unpacker.Metadata, &payload); err != nil {
+			return err
+		}
+		p.Metadata = payload
 	case pkg.JavaMetadataType:
 		var payload pkg.JavaMetadata
 		if err := json.Unmarshal(unpacker.Metadata, &payload); err != nil {
@@ -118,6 +138,54 @@ func (p *Package) UnmarshalJSON(b []byte) error {
 			return err
 		}
 		p.Metadata = payload
+	case pkg.BuildrootMetadataType:
+		var payload pkg.BuildrootMetadata
+		if err := json.Unmarshal(unpacker.Metadata, &payload); err != nil {
+			return err
+		}
+		p.Metadata = payload
+	case pkg.YoctoMetadataType:
+		var payload pkg.YoctoMetadata
+		if err := json.Unmarshal(unpacker.Metadata, &payload); err != nil {
+			return err
+		}
+		p.Metadata = payload
+	case pkg.OpkgMetadataType:
+		var payload pkg.OpkgMetadata
+		if err := json.Unmarshal(unpacker.Metadata, &payload); err != nil {
+			return err
+		}
+		p.Metadata = payload
+	case pkg.RuntimeMetadataType:
+		var payload pkg.RuntimeMetadata
+		if err := json.Unmarshal(unpacker.Metadata, &payload); err != nil {
+			return err
+		}
+		p.Metadata = payload
+	case pkg.StaticLibraryMetadataType:
+		var payload pkg.StaticLibraryMetadata
+		if err := json.Unmarshal(unpacker.Metadata, &payload); err != nil {
+			return err
+		}
+		p.Metadata = payload
+	case pkg.PhpPeclMetadataType:
+		var payload pkg.PhpPeclMetadata
+		if err := json.Unmarshal(unpacker.Metadata, &payload); err != nil {
+			return err
+		}
+		p.Metadata = payload
+	case pkg.GolangModMetadataType:
+		var payload pkg.GolangModMetadata
+		if err := json.Unmarshal(unpacker.Metadata, &payload); err != nil {
+			return err
+		}
+		p.Metadata = payload
+	case pkg.WebServerModuleMetadataType:
+		var payload pkg.WebServerModuleMetadata
+		if err := json.Unmarshal(unpacker.Metadata, &payload); err != nil {
+			return err
+		}
+		p.Metadata = payload
 	}
 
 	return nil
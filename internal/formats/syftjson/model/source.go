@@ -10,14 +10,16 @@ import (
 
 // Source object represents the thing that was cataloged
 type Source struct {
-	Type   string      `json:"type"`
-	Target interface{} `json:"target"`
+	Type   string               `json:"type"`
+	Target interface{}          `json:"target"`
+	Host   *source.HostMetadata `json:"host,omitempty"`
 }
 
 // sourceUnpacker is used to unmarshal Source objects
 type sourceUnpacker struct {
-	Type   string          `json:"type"`
-	Target json.RawMessage `json:"target"`
+	Type   string               `json:"type"`
+	Target json.RawMessage      `json:"target"`
+	Host   *source.HostMetadata `json:"host,omitempty"`
 }
 
 // UnmarshalJSON populates a source object from JSON bytes.
@@ -28,6 +30,7 @@ func (s *Source) UnmarshalJSON(b []byte) error {
 	}
 
 	s.Type = unpacker.Type
+	s.Host = unpacker.Host
 
 	switch s.Type {
 	case "directory":
@@ -0,0 +1,7 @@
+package model
+
+// Warning is a non-fatal problem found while cataloging, which may have caused the results to be incomplete
+type Warning struct {
+	Path    string `json:"path,omitempty"`
+	Message string `json:"message"`
+}
@@ -0,0 +1,59 @@
+package syftjson
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/anchore/syft/internal/formats/syftjson/model"
+	schema "github.com/anchore/syft/schema/json"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// SchemaError describes how a document does not conform to the JSON schema of the version it declares.
+type SchemaError struct {
+	Version  string
+	Problems []string
+}
+
+func (e *SchemaError) Error() string {
+	return fmt.Sprintf("document does not conform to the syft JSON schema version %s (%d problem(s))", e.Version, len(e.Problems))
+}
+
+// ValidateSchema validates a syft JSON document against the JSON schema of the version it declares (which may be older
+// than the version written by this release), returning the declared version. A *SchemaError is returned when the
+// document does not conform to the schema.
+func ValidateSchema(by []byte) (string, error) {
+	var doc struct {
+		Schema model.Schema `json:"schema"`
+	}
+	if err := json.Unmarshal(by, &doc); err != nil {
+		return "", fmt.Errorf("unable to decode syft JSON document: %w", err)
+	}
+	if doc.Schema.Version == "" {
+		return "", fmt.Errorf("document does not declare a syft JSON schema version")
+	}
+
+	contents, err := schema.Get(doc.Schema.Version)
+	if err != nil {
+		return doc.Schema.Version, err
+	}
+
+	result, err := gojsonschema.Validate(gojsonschema.NewBytesLoader(contents), gojsonschema.NewBytesLoader(by))
+	if err != nil {
+		return doc.Schema.Version, fmt.Errorf("unable to validate against the syft JSON schema version %s: %w", doc.Schema.Version, err)
+	}
+	if result.Valid() {
+		return doc.Schema.Version, nil
+	}
+
+	var problems []string
+	for _, desc := range result.Errors() {
+		problems = append(problems, desc.String())
+	}
+	sort.Strings(problems)
+	return doc.Schema.Version, &SchemaError{
+		Version:  doc.Schema.Version,
+		Problems: problems,
+	}
+}
@@ -0,0 +1,71 @@
+package syftjson
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/formats/common/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSchema(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, Format().Encode(&buf, testutils.DirectoryInput(t)))
+
+	version, err := ValidateSchema(buf.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, internal.JSONSchemaVersion, version)
+}
+
+func TestValidateSchema_Invalid(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, Format().Encode(&buf, testutils.DirectoryInput(t)))
+
+	var doc map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &doc))
+	doc["artifacts"] = "not-a-list"
+	by, err := json.Marshal(doc)
+	require.NoError(t, err)
+
+	version, err := ValidateSchema(by)
+	assert.Equal(t, internal.JSONSchemaVersion, version)
+
+	var schemaErr *SchemaError
+	require.True(t, errors.As(err, &schemaErr), "expected a schema error, got: %+v", err)
+	assert.Equal(t, internal.JSONSchemaVersion, schemaErr.Version)
+	require.Len(t, schemaErr.Problems, 1)
+	assert.Contains(t, schemaErr.Problems[0], "artifacts")
+}
+
+func TestValidateSchema_UnknownVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		document string
+	}{
+		{
+			name:     "unknown version",
+			document: `{"schema": {"version": "0.0.1"}}`,
+		},
+		{
+			name:     "no version",
+			document: `{"artifacts": []}`,
+		},
+		{
+			name:     "not json",
+			document: `not json`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ValidateSchema([]byte(test.document))
+			require.Error(t, err)
+
+			var schemaErr *SchemaError
+			assert.False(t, errors.As(err, &schemaErr))
+		})
+	}
+}
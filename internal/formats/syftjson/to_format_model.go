@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"sort"
 	"strconv"
+	"time"
 
 	"github.com/anchore/syft/syft/file"
 
@@ -19,7 +20,8 @@ import (
 	"github.com/anchore/syft/syft/source"
 )
 
-func toFormatModel(s sbom.SBOM) model.Document {
+// ToFormatModel creates and populates a new syft-json document from the given cataloging results.
+func ToFormatModel(s sbom.SBOM) model.Document {
 	src, err := toSourceModel(s.Source)
 	if err != nil {
 		log.Warnf("unable to create syft-json source object: %+v", err)
@@ -32,22 +34,97 @@ func toFormatModel(s sbom.SBOM) model.Document {
 		Secrets:               toSecrets(s.Artifacts.Secrets),
 		Source:                src,
 		Distro:                toDistroModel(s.Artifacts.Distro),
-		Descriptor:            toDescriptor(s.Descriptor),
+		Descriptor:            toDescriptor(s.Descriptor, sbom.NewSummary(s)),
 		Schema: model.Schema{
 			Version: internal.JSONSchemaVersion,
 			URL:     fmt.Sprintf("https://raw.githubusercontent.com/anchore/syft/main/schema/json/schema-%s.json", internal.JSONSchemaVersion),
 		},
+		Nested:   toNestedModels(s.Nested),
+		Warnings: toWarnings(s.Artifacts.Warnings),
 	}
 }
 
-func toDescriptor(d sbom.Descriptor) model.Descriptor {
+func toWarnings(warnings []source.Warning) []model.Warning {
+	var results []model.Warning
+	for _, w := range warnings {
+		results = append(results, model.Warning{
+			Path:    w.Path,
+			Message: w.Message,
+		})
+	}
+	return results
+}
+
+func toNestedModels(nested []sbom.NestedSBOM) []model.NestedDocument {
+	var results []model.NestedDocument
+	for _, n := range nested {
+		src, err := toSourceModel(n.SBOM.Source)
+		if err != nil {
+			log.Warnf("unable to create syft-json source object for nested image: %+v", err)
+		}
+
+		results = append(results, model.NestedDocument{
+			Location:              n.Location,
+			Artifacts:             toPackageModels(n.SBOM.Artifacts.PackageCatalog),
+			ArtifactRelationships: toRelationshipModel(n.SBOM.Relationships),
+			Source:                src,
+			Distro:                toDistroModel(n.SBOM.Artifacts.Distro),
+		})
+	}
+
+	// sort by real path to ensure the result is stable across multiple runs
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Location.RealPath < results[j].Location.RealPath
+	})
+	return results
+}
+
+func toDescriptor(d sbom.Descriptor, summary sbom.Summary) model.Descriptor {
 	return model.Descriptor{
 		Name:          d.Name,
 		Version:       d.Version,
 		Configuration: d.Configuration,
+		Timestamp:     toTimestamp(d.Timestamp),
+		Origins:       toDocumentOrigins(d.Origins),
+		Summary:       toSummary(summary),
+	}
+}
+
+func toSummary(summary sbom.Summary) *model.Summary {
+	packagesByType := make(map[string]int)
+	for t, count := range summary.PackagesByType {
+		packagesByType[string(t)] = count
+	}
+	return &model.Summary{
+		Packages:        summary.Packages,
+		PackagesByType:  packagesByType,
+		Files:           summary.Files,
+		DurationSeconds: summary.Duration.Seconds(),
 	}
 }
 
+func toDocumentOrigins(origins []sbom.DocumentOrigin) []model.DocumentOrigin {
+	var results []model.DocumentOrigin
+	for _, o := range origins {
+		results = append(results, model.DocumentOrigin{
+			Tool:      o.Tool,
+			Version:   o.Version,
+			Format:    o.Format,
+			Timestamp: toTimestamp(o.Timestamp),
+			Digest:    o.Digest,
+			Origins:   toDocumentOrigins(o.Origins),
+		})
+	}
+	return results
+}
+
+func toTimestamp(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
 func toSecrets(data map[source.Coordinates][]file.SearchResult) []model.Secrets {
 	results := make([]model.Secrets, 0)
 	for coordinates, secrets := range data {
@@ -84,6 +161,16 @@ func toFile(s sbom.SBOM) []model.File {
 			classifications = classificationsForLocation
 		}
 
+		var executable *file.Executable
+		if executableForLocation, exists := artifacts.FileExecutables[coordinates]; exists {
+			executable = &executableForLocation
+		}
+
+		var imports []string
+		if importsForLocation, exists := artifacts.FileImports[coordinates]; exists {
+			imports = importsForLocation
+		}
+
 		var contents string
 		if contentsForLocation, exists := artifacts.FileContents[coordinates]; exists {
 			contents = contentsForLocation
@@ -95,6 +182,8 @@ func toFile(s sbom.SBOM) []model.File {
 			Metadata:        toFileMetadataEntry(coordinates, metadata),
 			Digests:         digests,
 			Classifications: classifications,
+			Executable:      executable,
+			Imports:         imports,
 			Contents:        contents,
 		})
 	}
@@ -155,18 +244,29 @@ func toPackageModel(p pkg.Package) model.Package {
 		coordinates[i] = l.Coordinates
 	}
 
+	var originURLs *pkg.OriginURLs
+	if !p.OriginURLs.IsEmpty() {
+		originURLs = &p.OriginURLs
+	}
+
 	return model.Package{
 		PackageBasicData: model.PackageBasicData{
-			ID:        string(p.ID()),
-			Name:      p.Name,
-			Version:   p.Version,
-			Type:      p.Type,
-			FoundBy:   p.FoundBy,
-			Locations: coordinates,
-			Licenses:  licenses,
-			Language:  p.Language,
-			CPEs:      cpes,
-			PURL:      p.PURL,
+			ID:                string(p.ID()),
+			Name:              p.Name,
+			Version:           p.Version,
+			Type:              p.Type,
+			FoundBy:           p.FoundBy,
+			Locations:         coordinates,
+			Licenses:          licenses,
+			Language:          p.Language,
+			CPEs:              cpes,
+			PURL:              p.PURL,
+			Confidence:        p.Confidence,
+			NormalizedVersion: p.NormalizedVersion,
+			OriginURLs:        originURLs,
+			Checksums:         p.Checksums,
+			EOL:               p.EOL,
+			Ownership:         p.Ownership,
 		},
 		PackageCustomData: model.PackageCustomData{
 			MetadataType: p.MetadataType,
@@ -200,6 +300,7 @@ func toSourceModel(src source.Metadata) (model.Source, error) {
 		return model.Source{
 			Type:   "directory",
 			Target: src.Path,
+			Host:   src.Host,
 		}, nil
 	case source.FileScheme:
 		return model.Source{
@@ -221,5 +322,6 @@ func toDistroModel(d *distro.Distro) model.Distro {
 		Name:    d.Name(),
 		Version: d.FullVersion(),
 		IDLike:  d.IDLike,
+		EOL:     d.EOL,
 	}
 }
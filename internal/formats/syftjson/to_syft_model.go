@@ -1,9 +1,15 @@
 package syftjson
 
 import (
+	"os"
+	"strconv"
+	"time"
+
 	"github.com/anchore/syft/internal/formats/syftjson/model"
 	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
 	"github.com/anchore/syft/syft/distro"
+	"github.com/anchore/syft/syft/file"
 	"github.com/anchore/syft/syft/pkg"
 	"github.com/anchore/syft/syft/sbom"
 	"github.com/anchore/syft/syft/source"
@@ -14,23 +20,176 @@ func toSyftModel(doc model.Document) (*sbom.SBOM, error) {
 	if err != nil {
 		return nil, err
 	}
+	dist.EOL = doc.Distro.EOL
+
+	catalog := toSyftCatalog(doc.Artifacts)
+
+	artifacts := sbom.Artifacts{
+		PackageCatalog: catalog,
+		Distro:         &dist,
+		Warnings:       toSyftWarnings(doc.Warnings),
+	}
+	toSyftFileArtifacts(&artifacts, doc.Files, doc.Secrets)
 
 	return &sbom.SBOM{
-		Artifacts: sbom.Artifacts{
-			PackageCatalog: toSyftCatalog(doc.Artifacts),
-			Distro:         &dist,
-		},
-		Source:     *toSyftSourceData(doc.Source),
-		Descriptor: toSyftDescriptor(doc.Descriptor),
+		Artifacts:     artifacts,
+		Relationships: toSyftRelationships(doc.ArtifactRelationships, catalog, doc.Files),
+		Source:        *toSyftSourceData(doc.Source),
+		Descriptor:    toSyftDescriptor(doc.Descriptor),
+		Nested:        toSyftNested(doc.Nested),
 	}, nil
 }
 
+// toSyftFileArtifacts restores the findings about each file of the document (metadata, digests, classifications,
+// executable details, imports, contents, and secrets). Only the findings present in the document are restored, such
+// that the artifacts of a cataloger that did not run remain empty.
+func toSyftFileArtifacts(artifacts *sbom.Artifacts, files []model.File, secrets []model.Secrets) {
+	for _, f := range files {
+		coordinates := f.Location
+		if f.Metadata != nil {
+			if artifacts.FileMetadata == nil {
+				artifacts.FileMetadata = make(map[source.Coordinates]source.FileMetadata)
+			}
+			artifacts.FileMetadata[coordinates] = toSyftFileMetadata(coordinates, *f.Metadata)
+		}
+		if len(f.Digests) > 0 {
+			if artifacts.FileDigests == nil {
+				artifacts.FileDigests = make(map[source.Coordinates][]file.Digest)
+			}
+			artifacts.FileDigests[coordinates] = f.Digests
+		}
+		if len(f.Classifications) > 0 {
+			if artifacts.FileClassifications == nil {
+				artifacts.FileClassifications = make(map[source.Coordinates][]file.Classification)
+			}
+			artifacts.FileClassifications[coordinates] = f.Classifications
+		}
+		if f.Executable != nil {
+			if artifacts.FileExecutables == nil {
+				artifacts.FileExecutables = make(map[source.Coordinates]file.Executable)
+			}
+			artifacts.FileExecutables[coordinates] = *f.Executable
+		}
+		if len(f.Imports) > 0 {
+			if artifacts.FileImports == nil {
+				artifacts.FileImports = make(map[source.Coordinates][]string)
+			}
+			artifacts.FileImports[coordinates] = f.Imports
+		}
+		if f.Contents != "" {
+			if artifacts.FileContents == nil {
+				artifacts.FileContents = make(map[source.Coordinates]string)
+			}
+			artifacts.FileContents[coordinates] = f.Contents
+		}
+	}
+
+	for _, s := range secrets {
+		if artifacts.Secrets == nil {
+			artifacts.Secrets = make(map[source.Coordinates][]file.SearchResult)
+		}
+		artifacts.Secrets[s.Location] = s.Secrets
+	}
+}
+
+// toSyftFileMetadata reverses toFileMetadataEntry, where the mode is given as the digits of its octal representation.
+// The file size is not part of the document.
+func toSyftFileMetadata(coordinates source.Coordinates, entry model.FileMetadataEntry) source.FileMetadata {
+	mode, err := strconv.ParseUint(strconv.Itoa(entry.Mode), 8, 32)
+	if err != nil {
+		log.Warnf("invalid mode found in file metadata @ location=%+v mode=%d: %+v", coordinates, entry.Mode, err)
+		mode = 0
+	}
+
+	return source.FileMetadata{
+		Mode:            os.FileMode(mode),
+		Type:            entry.Type,
+		UserID:          entry.UserID,
+		GroupID:         entry.GroupID,
+		LinkDestination: entry.LinkDestination,
+		MIMEType:        entry.MIMEType,
+	}
+}
+
+func toSyftWarnings(warnings []model.Warning) []source.Warning {
+	var results []source.Warning
+	for _, w := range warnings {
+		results = append(results, source.Warning{
+			Path:    w.Path,
+			Message: w.Message,
+		})
+	}
+	return results
+}
+
+func toSyftNested(docs []model.NestedDocument) []sbom.NestedSBOM {
+	var results []sbom.NestedSBOM
+	for _, doc := range docs {
+		dist, err := distro.NewDistro(distro.Type(doc.Distro.Name), doc.Distro.Version, doc.Distro.IDLike)
+		if err != nil {
+			log.Warnf("unable to read distro for nested image (path=%q): %+v", doc.Location.RealPath, err)
+		}
+		dist.EOL = doc.Distro.EOL
+
+		var metadata source.Metadata
+		if m := toSyftSourceData(doc.Source); m != nil {
+			metadata = *m
+		}
+
+		catalog := toSyftCatalog(doc.Artifacts)
+
+		results = append(results, sbom.NestedSBOM{
+			Location: doc.Location,
+			SBOM: sbom.SBOM{
+				Artifacts: sbom.Artifacts{
+					PackageCatalog: catalog,
+					Distro:         &dist,
+				},
+				Relationships: toSyftRelationships(doc.ArtifactRelationships, catalog, nil),
+				Source:        metadata,
+			},
+		})
+	}
+	return results
+}
+
 func toSyftDescriptor(d model.Descriptor) sbom.Descriptor {
-	return sbom.Descriptor{
+	descriptor := sbom.Descriptor{
 		Name:          d.Name,
 		Version:       d.Version,
 		Configuration: d.Configuration,
+		Timestamp:     toSyftTimestamp(d.Timestamp),
+		Origins:       toSyftDocumentOrigins(d.Origins),
+	}
+	// the rest of the summary is derived from the artifacts of the document
+	if d.Summary != nil {
+		descriptor.Duration = time.Duration(d.Summary.DurationSeconds * float64(time.Second))
+	}
+	return descriptor
+}
+
+func toSyftDocumentOrigins(origins []model.DocumentOrigin) []sbom.DocumentOrigin {
+	var results []sbom.DocumentOrigin
+	for _, o := range origins {
+		results = append(results, sbom.DocumentOrigin{
+			Tool:      o.Tool,
+			Version:   o.Version,
+			Format:    o.Format,
+			Timestamp: toSyftTimestamp(o.Timestamp),
+			Digest:    o.Digest,
+			Origins:   toSyftDocumentOrigins(o.Origins),
+		})
 	}
+	return results
+}
+
+func toSyftTimestamp(value string) time.Time {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		// the timestamp is optional
+		return time.Time{}
+	}
+	return t
 }
 
 func toSyftSourceData(s model.Source) *source.Metadata {
@@ -39,6 +198,7 @@ func toSyftSourceData(s model.Source) *source.Metadata {
 		return &source.Metadata{
 			Scheme: source.DirectoryScheme,
 			Path:   s.Target.(string),
+			Host:   s.Host,
 		}
 	case "file":
 		return &source.Metadata{
@@ -79,17 +239,72 @@ func toSyftPackage(p model.Package) pkg.Package {
 		locations[i] = source.NewLocationFromCoordinates(c)
 	}
 
-	return pkg.Package{
-		Name:         p.Name,
-		Version:      p.Version,
-		FoundBy:      p.FoundBy,
-		Locations:    locations,
-		Licenses:     p.Licenses,
-		Language:     p.Language,
-		Type:         p.Type,
-		CPEs:         cpes,
-		PURL:         p.PURL,
-		MetadataType: p.MetadataType,
-		Metadata:     p.Metadata,
+	var originURLs pkg.OriginURLs
+	if p.OriginURLs != nil {
+		originURLs = *p.OriginURLs
+	}
+
+	result := pkg.Package{
+		Name:              p.Name,
+		Version:           p.Version,
+		FoundBy:           p.FoundBy,
+		Locations:         locations,
+		Licenses:          p.Licenses,
+		Language:          p.Language,
+		Type:              p.Type,
+		CPEs:              cpes,
+		PURL:              p.PURL,
+		Confidence:        p.Confidence,
+		NormalizedVersion: p.NormalizedVersion,
+		OriginURLs:        originURLs,
+		Checksums:         p.Checksums,
+		EOL:               p.EOL,
+		Ownership:         p.Ownership,
+		MetadataType:      p.MetadataType,
+		Metadata:          p.Metadata,
+	}
+
+	// keep the ID from the document, so that packages (and relationships) are identified in the same way as when the
+	// document was written, even if the package cannot be fingerprinted identically from the decoded values
+	if p.ID != "" {
+		result.OverrideID(artifact.ID(p.ID))
+	} else {
+		result.SetID()
+	}
+	return result
+}
+
+// toSyftRelationships resolves the parent and child IDs of each relationship against the packages and files of the
+// document. Relationships that refer to unknown artifacts are dropped.
+func toSyftRelationships(relationships []model.Relationship, catalog *pkg.Catalog, files []model.File) []artifact.Relationship {
+	coordinatesByID := make(map[string]source.Coordinates)
+	for _, f := range files {
+		coordinatesByID[f.ID] = f.Location
+	}
+
+	lookup := func(id string) artifact.Identifiable {
+		if p := catalog.Package(artifact.ID(id)); p != nil {
+			return *p
+		}
+		if c, exists := coordinatesByID[id]; exists {
+			return c
+		}
+		return nil
+	}
+
+	var results []artifact.Relationship
+	for _, r := range relationships {
+		from, to := lookup(r.Parent), lookup(r.Child)
+		if from == nil || to == nil {
+			log.Warnf("dropping relationship with unknown artifacts (parent=%q child=%q type=%q)", r.Parent, r.Child, r.Type)
+			continue
+		}
+		results = append(results, artifact.Relationship{
+			From: from,
+			To:   to,
+			Type: artifact.RelationshipType(r.Type),
+			Data: r.Metadata,
+		})
 	}
+	return results
 }
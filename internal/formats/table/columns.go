@@ -0,0 +1,91 @@
+package table
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+const (
+	NameColumn      = "name"
+	VersionColumn   = "version"
+	TypeColumn      = "type"
+	LanguageColumn  = "language"
+	FoundByColumn   = "found-by"
+	LicensesColumn  = "licenses"
+	PURLColumn      = "purl"
+	LocationsColumn = "locations"
+)
+
+// AllColumns are the columns that can be selected for the table output.
+var AllColumns = []string{
+	NameColumn,
+	VersionColumn,
+	TypeColumn,
+	LanguageColumn,
+	FoundByColumn,
+	LicensesColumn,
+	PURLColumn,
+	LocationsColumn,
+}
+
+// DefaultColumns are the columns of the table output when none are configured, which are also the default sort order.
+var DefaultColumns = []string{
+	NameColumn,
+	VersionColumn,
+	TypeColumn,
+}
+
+var headers = map[string]string{
+	NameColumn:      "Name",
+	VersionColumn:   "Version",
+	TypeColumn:      "Type",
+	LanguageColumn:  "Language",
+	FoundByColumn:   "Found By",
+	LicensesColumn:  "Licenses",
+	PURLColumn:      "PURL",
+	LocationsColumn: "Locations",
+}
+
+// valueSeparator joins the values of a multi-valued column within a single cell.
+const valueSeparator = ", "
+
+// ValidateColumns returns an error when any of the given columns is unknown.
+func ValidateColumns(columns []string) error {
+	known := internal.NewStringSet(AllColumns...)
+	for _, c := range columns {
+		if !known.Contains(c) {
+			return fmt.Errorf("unknown table column %q (options: %v)", c, AllColumns)
+		}
+	}
+	return nil
+}
+
+// value returns the cell of the given column for the package.
+func value(p pkg.Package, column string) string {
+	switch column {
+	case NameColumn:
+		return p.Name
+	case VersionColumn:
+		return p.Version
+	case TypeColumn:
+		return string(p.Type)
+	case LanguageColumn:
+		return string(p.Language)
+	case FoundByColumn:
+		return p.FoundBy
+	case LicensesColumn:
+		return strings.Join(p.Licenses, valueSeparator)
+	case PURLColumn:
+		return p.PURL
+	case LocationsColumn:
+		var paths []string
+		for _, l := range p.Locations {
+			paths = append(paths, l.RealPath)
+		}
+		return strings.Join(paths, valueSeparator)
+	}
+	return ""
+}
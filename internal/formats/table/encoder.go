@@ -6,22 +6,39 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/anchore/syft/syft/format"
 	"github.com/anchore/syft/syft/sbom"
 
 	"github.com/olekukonko/tablewriter"
 )
 
-func encoder(output io.Writer, s sbom.SBOM) error {
-	var rows [][]string
+func newEncoder(columns, sortBy []string) format.Encoder {
+	return func(output io.Writer, s sbom.SBOM) error {
+		return encode(output, s, columns, sortBy)
+	}
+}
+
+func encode(output io.Writer, s sbom.SBOM, columns, sortBy []string) error {
+	// sort by the configured columns first, where rows with the same values are ordered by the written columns
+	sortColumns := append(append([]string{}, sortBy...), columns...)
 
-	columns := []string{"Name", "Version", "Type"}
+	type row struct {
+		cells []string
+		keys  []string
+	}
+	var rows []row
 	for _, p := range s.Artifacts.PackageCatalog.Sorted() {
-		row := []string{
-			p.Name,
-			p.Version,
-			string(p.Type),
+		r := row{
+			cells: make([]string, len(columns)),
+			keys:  make([]string, len(sortColumns)),
+		}
+		for i, column := range columns {
+			r.cells[i] = value(p, column)
 		}
-		rows = append(rows, row)
+		for i, column := range sortColumns {
+			r.keys[i] = value(p, column)
+		}
+		rows = append(rows, r)
 	}
 
 	if len(rows) == 0 {
@@ -29,20 +46,29 @@ func encoder(output io.Writer, s sbom.SBOM) error {
 		return err
 	}
 
-	// sort by name, version, then type
 	sort.SliceStable(rows, func(i, j int) bool {
-		for col := 0; col < len(columns); col++ {
-			if rows[i][col] != rows[j][col] {
-				return rows[i][col] < rows[j][col]
+		for col := range sortColumns {
+			if rows[i].keys[col] != rows[j].keys[col] {
+				return rows[i].keys[col] < rows[j].keys[col]
 			}
 		}
 		return false
 	})
-	rows = removeDuplicateRows(rows)
+
+	cells := make([][]string, 0, len(rows))
+	for _, r := range rows {
+		cells = append(cells, r.cells)
+	}
+	cells = removeDuplicateRows(cells)
+
+	header := make([]string, 0, len(columns))
+	for _, column := range columns {
+		header = append(header, headers[column])
+	}
 
 	table := tablewriter.NewWriter(output)
 
-	table.SetHeader(columns)
+	table.SetHeader(header)
 	table.SetHeaderLine(false)
 	table.SetBorder(false)
 	table.SetAutoWrapText(false)
@@ -55,7 +81,7 @@ func encoder(output io.Writer, s sbom.SBOM) error {
 	table.SetTablePadding("  ")
 	table.SetNoWhiteSpace(true)
 
-	table.AppendBulk(rows)
+	table.AppendBulk(cells)
 	table.Render()
 
 	return nil
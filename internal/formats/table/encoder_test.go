@@ -1,11 +1,14 @@
 package table
 
 import (
+	"bytes"
 	"flag"
 	"testing"
 
 	"github.com/anchore/syft/internal/formats/common/testutils"
 	"github.com/go-test/deep"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var updateTableGoldenFiles = flag.Bool("update-table", false, "update the *.golden files for table format")
@@ -18,6 +21,51 @@ func TestTableEncoder(t *testing.T) {
 	)
 }
 
+func TestTableEncoder_SelectedColumns(t *testing.T) {
+	tests := []struct {
+		name     string
+		columns  []string
+		sortBy   []string
+		expected string
+	}{
+		{
+			name:    "columns in the given order",
+			columns: []string{TypeColumn, NameColumn, PURLColumn, LicensesColumn},
+			expected: "TYPE    NAME       PURL      LICENSES \n" +
+				"deb     package-2  a-purl-2            \n" +
+				"python  package-1  a-purl-2  MIT       \n",
+		},
+		{
+			name:    "sorted by type then name",
+			columns: []string{NameColumn, TypeColumn},
+			sortBy:  []string{TypeColumn, NameColumn},
+			expected: "NAME       TYPE   \n" +
+				"package-2  deb     \n" +
+				"package-1  python  \n",
+		},
+		{
+			name:    "sorted by a column that is not written",
+			columns: []string{NameColumn},
+			sortBy:  []string{FoundByColumn},
+			expected: "NAME      \n" +
+				"package-1  \n" +
+				"package-2  \n",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, FormatWith(test.columns, test.sortBy).Encode(&buf, testutils.DirectoryInput(t)))
+			assert.Equal(t, test.expected, buf.String())
+		})
+	}
+}
+
+func TestValidateColumns(t *testing.T) {
+	assert.NoError(t, ValidateColumns([]string{NameColumn, PURLColumn}))
+	assert.Error(t, ValidateColumns([]string{NameColumn, "cpes"}))
+}
+
 func TestRemoveDuplicateRows(t *testing.T) {
 	data := [][]string{
 		{"1", "2", "3"},
@@ -2,10 +2,20 @@ package table
 
 import "github.com/anchore/syft/syft/format"
 
+// Format returns a format that writes the default columns (name, version and type), sorted by the same columns.
 func Format() format.Format {
+	return FormatWith(DefaultColumns, nil)
+}
+
+// FormatWith returns a format that writes the given columns (the default columns when none are given), with the rows
+// sorted by the given columns first and then by the written columns, in order.
+func FormatWith(columns, sortBy []string) format.Format {
+	if len(columns) == 0 {
+		columns = DefaultColumns
+	}
 	return format.NewFormat(
 		format.TableOption,
-		encoder,
+		newEncoder(columns, sortBy),
 		nil,
 		nil,
 	)
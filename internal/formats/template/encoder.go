@@ -0,0 +1,66 @@
+package template
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/anchore/syft/internal/formats/syftjson"
+	"github.com/anchore/syft/syft/format"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/mitchellh/go-homedir"
+)
+
+// ErrNoTemplate is returned when rendering with the template format without a template path.
+var ErrNoTemplate = errors.New("no template given (use --template or output-template-path to set one)")
+
+// funcs are the functions available to templates besides the text/template builtins.
+var funcs = template.FuncMap{
+	"join":      strings.Join,
+	"lower":     strings.ToLower,
+	"upper":     strings.ToUpper,
+	"trim":      strings.TrimSpace,
+	"replace":   strings.ReplaceAll,
+	"contains":  strings.Contains,
+	"hasPrefix": strings.HasPrefix,
+	"hasSuffix": strings.HasSuffix,
+}
+
+// Parse reads and parses the template at the given path.
+func Parse(templatePath string) (*template.Template, error) {
+	if templatePath == "" {
+		return nil, ErrNoTemplate
+	}
+
+	expandedPath, err := homedir.Expand(templatePath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to expand template path=%q: %w", templatePath, err)
+	}
+
+	contents, err := ioutil.ReadFile(expandedPath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read template: %w", err)
+	}
+
+	tmpl, err := template.New(filepath.Base(expandedPath)).Funcs(funcs).Parse(string(contents))
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// newEncoder renders the syft-json model of the SBOM (artifacts, relationships, source, distro, descriptor...) with the
+// template at the given path, so templates can use the same field names as the JSON output.
+func newEncoder(templatePath string) format.Encoder {
+	return func(output io.Writer, s sbom.SBOM) error {
+		tmpl, err := Parse(templatePath)
+		if err != nil {
+			return err
+		}
+		return tmpl.Execute(output, syftjson.ToFormatModel(s))
+	}
+}
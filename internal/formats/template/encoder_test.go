@@ -0,0 +1,39 @@
+package template
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/anchore/syft/internal/formats/common/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormat_Encode(t *testing.T) {
+	var buf bytes.Buffer
+	err := Format("test-fixtures/csv.template").Encode(&buf, testutils.DirectoryInput(t))
+	require.NoError(t, err)
+
+	assert.Equal(t, `"Package","Version","Type","Licenses"
+"package-1","1.0.1","python","MIT"
+"package-2","2.0.1","deb",""
+source: directory /some/path
+distro: debian 1.2.3
+tool: SYFT v0.42.0-bogus
+`, buf.String())
+}
+
+func TestParse(t *testing.T) {
+	_, err := Parse("")
+	assert.ErrorIs(t, err, ErrNoTemplate)
+
+	_, err = Parse("test-fixtures/does-not-exist.template")
+	assert.Error(t, err)
+
+	malformed := filepath.Join(t.TempDir(), "malformed.template")
+	require.NoError(t, ioutil.WriteFile(malformed, []byte("{{ .Artifacts "), 0644))
+	_, err = Parse(malformed)
+	assert.Error(t, err)
+}
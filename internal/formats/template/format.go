@@ -0,0 +1,15 @@
+package template
+
+import (
+	"github.com/anchore/syft/syft/format"
+)
+
+// Format returns a format that renders SBOMs through the user-supplied Go text/template at the given path.
+func Format(templatePath string) format.Format {
+	return format.NewFormat(
+		format.TemplateOption,
+		newEncoder(templatePath),
+		nil,
+		nil,
+	)
+}
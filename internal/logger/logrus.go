@@ -20,6 +20,15 @@ type LogrusConfig struct {
 	Structured    bool
 	Level         logrus.Level
 	FileLocation  string
+	Syslog        SyslogConfig
+}
+
+// SyslogConfig describes where to forward log entries via syslog (in addition to any console or file output)
+type SyslogConfig struct {
+	Enabled bool
+	Network string // "udp", "tcp", or empty to use the local syslog daemon
+	Address string // the remote syslog address (host:port), or empty to use the local syslog daemon
+	Tag     string // the tag (program name) to attach to each message
 }
 
 // LogrusLogger contains all runtime values for using Logrus with the configured output target and input configuration values.
@@ -61,6 +70,14 @@ func NewLogrusLogger(cfg LogrusConfig) *LogrusLogger {
 	appLogger.SetOutput(output)
 	appLogger.SetLevel(cfg.Level)
 
+	if cfg.Syslog.Enabled {
+		hook, err := newSyslogHook(cfg.Syslog)
+		if err != nil {
+			panic(fmt.Errorf("unable to setup syslog: %w", err))
+		}
+		appLogger.AddHook(hook)
+	}
+
 	if cfg.Structured {
 		appLogger.SetFormatter(&logrus.JSONFormatter{
 			TimestampFormat:   "2006-01-02 15:04:05",
@@ -0,0 +1,60 @@
+//go:build !windows
+// +build !windows
+
+package logger
+
+import (
+	"fmt"
+	"log/syslog"
+
+	"github.com/sirupsen/logrus"
+)
+
+// syslogHook forwards log entries to a local or remote syslog daemon, mapping logrus levels to syslog severities.
+type syslogHook struct {
+	writer    *syslog.Writer
+	formatter logrus.Formatter
+}
+
+func newSyslogHook(cfg SyslogConfig) (logrus.Hook, error) {
+	writer, err := syslog.Dial(cfg.Network, cfg.Address, syslog.LOG_INFO|syslog.LOG_USER, cfg.Tag)
+	if err != nil {
+		return nil, err
+	}
+
+	return &syslogHook{
+		writer: writer,
+		// syslog records its own timestamp, and color codes have no place in a syslog message
+		formatter: &logrus.TextFormatter{
+			DisableColors:    true,
+			DisableTimestamp: true,
+		},
+	}, nil
+}
+
+func (h *syslogHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (h *syslogHook) Fire(entry *logrus.Entry) error {
+	contents, err := h.formatter.Format(entry)
+	if err != nil {
+		return fmt.Errorf("unable to format syslog entry: %w", err)
+	}
+	line := string(contents)
+
+	switch entry.Level {
+	case logrus.PanicLevel:
+		return h.writer.Crit(line)
+	case logrus.FatalLevel:
+		return h.writer.Crit(line)
+	case logrus.ErrorLevel:
+		return h.writer.Err(line)
+	case logrus.WarnLevel:
+		return h.writer.Warning(line)
+	case logrus.InfoLevel:
+		return h.writer.Info(line)
+	default:
+		return h.writer.Debug(line)
+	}
+}
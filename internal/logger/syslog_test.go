@@ -0,0 +1,47 @@
+//go:build !windows
+// +build !windows
+
+package logger
+
+import (
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyslogHook(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	hook, err := newSyslogHook(SyslogConfig{
+		Enabled: true,
+		Network: "udp",
+		Address: conn.LocalAddr().String(),
+		Tag:     "syft",
+	})
+	require.NoError(t, err)
+
+	logger := logrus.New()
+	logger.SetOutput(&strings.Builder{})
+	logger.AddHook(hook)
+	logger.WithField("from-lib", "stereoscope").Warn("something happened")
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	buf := make([]byte, 4096)
+	n, _, err := conn.ReadFrom(buf)
+	require.NoError(t, err)
+
+	message := string(buf[:n])
+	// <12> is the "user" facility (1) with the "warning" severity (4)
+	assert.True(t, strings.HasPrefix(message, "<12>"), "unexpected priority: %q", message)
+	assert.Contains(t, message, "syft[")
+	assert.Contains(t, message, `msg="something happened"`)
+	assert.Contains(t, message, "from-lib=stereoscope")
+	assert.NotContains(t, message, "\x1b[", "syslog messages should not contain color codes")
+}
@@ -0,0 +1,11 @@
+package logger
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+)
+
+func newSyslogHook(SyslogConfig) (logrus.Hook, error) {
+	return nil, fmt.Errorf("syslog is not supported on windows")
+}
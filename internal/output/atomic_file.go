@@ -0,0 +1,52 @@
+package output
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// atomicFile is a report file that is written to a temporary file next to its destination, which replaces the
+// destination when committed. This way the destination never holds a partially written report (e.g. when read while
+// the scan is still running, or when the scan fails).
+type atomicFile struct {
+	*os.File
+	path string
+}
+
+func newAtomicFile(path string) (*atomicFile, error) {
+	f, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return nil, err
+	}
+	// temporary files are only readable by the owner, while reports are not
+	if err := f.Chmod(0644); err != nil {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+		return nil, err
+	}
+	return &atomicFile{File: f, path: path}, nil
+}
+
+// commit replaces the destination with the written contents.
+func (f *atomicFile) commit() error {
+	if err := f.Close(); err != nil {
+		_ = os.Remove(f.Name())
+		return err
+	}
+	if err := os.Rename(f.Name(), f.path); err != nil {
+		_ = os.Remove(f.Name())
+		return fmt.Errorf("unable to write report file: %w", err)
+	}
+	return nil
+}
+
+// discard removes the written contents, leaving the destination untouched.
+func (f *atomicFile) discard() error {
+	err := f.Close()
+	if removeErr := os.Remove(f.Name()); removeErr != nil && err == nil {
+		err = removeErr
+	}
+	return err
+}
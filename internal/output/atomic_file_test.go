@@ -0,0 +1,62 @@
+package output
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/anchore/syft/syft/format"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMakeWriter_ReplacesFilesAtomically(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "sbom.txt")
+	require.NoError(t, ioutil.WriteFile(path, []byte("previous"), 0644))
+
+	assertContents := func(expected string) {
+		t.Helper()
+		contents, err := ioutil.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, expected, string(contents))
+	}
+
+	failing := format.NewFormat("test", func(w io.Writer, _ sbom.SBOM) error {
+		_, _ = io.WriteString(w, "partial")
+		return errors.New("failed")
+	}, nil, nil)
+	succeeding := format.NewFormat("test", func(w io.Writer, _ sbom.SBOM) error {
+		_, err := io.WriteString(w, "current")
+		return err
+	}, nil, nil)
+
+	// a failed write leaves the previous report in place
+	writer, err := MakeWriter(WriterOption{Format: failing, Path: path})
+	require.NoError(t, err)
+	assert.Error(t, writer.Write(sbom.SBOM{}))
+	require.NoError(t, writer.Close())
+	assertContents("previous")
+
+	// a scan that fails before anything is written leaves the previous report in place
+	writer, err = MakeWriter(WriterOption{Format: succeeding, Path: path})
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+	assertContents("previous")
+
+	// the report is only replaced once the writer is closed
+	writer, err = MakeWriter(WriterOption{Format: succeeding, Path: path})
+	require.NoError(t, err)
+	require.NoError(t, writer.Write(sbom.SBOM{}))
+	assertContents("previous")
+	require.NoError(t, writer.Close())
+	assertContents("current")
+
+	// no temporary files are left behind
+	entries, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
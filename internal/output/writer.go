@@ -6,25 +6,43 @@ import (
 	"os"
 	"path"
 
+	"github.com/anchore/syft/internal/log"
 	"github.com/anchore/syft/syft/format"
 	"github.com/anchore/syft/syft/sbom"
 	"github.com/hashicorp/go-multierror"
 )
 
 // streamWriter implements sbom.Writer for a given format and io.Writer, also providing a close function for cleanup
+// and a discard function to clean up instead when nothing (or only part of an SBOM) was written
 type streamWriter struct {
-	format format.Format
-	out    io.Writer
-	close  func() error
+	format  format.Format
+	out     io.Writer
+	close   func() error
+	discard func() error
+	written bool // an SBOM was written
+	failed  bool // writing an SBOM failed
 }
 
 // Write the provided SBOM to the data stream
 func (w *streamWriter) Write(s sbom.SBOM) error {
-	return w.format.Encode(w.out, s)
+	if len(s.Nested) > 0 && !w.format.Option.SupportsNestedSBOMs() {
+		log.Warnf("the %s format does not describe the %d container image(s) found within the source, use the json or cyclonedx formats to include them", w.format.Option, len(s.Nested))
+	}
+	err := w.format.Encode(w.out, s)
+	if err != nil {
+		w.failed = true
+		return err
+	}
+	w.written = true
+	return nil
 }
 
-// Close any resources, such as open files
+// Close any resources, such as open files. Anything but a successfully written SBOM (e.g. when the scan failed before
+// anything was written) is discarded, leaving any previous report in place.
 func (w *streamWriter) Close() error {
+	if (!w.written || w.failed) && w.discard != nil {
+		return w.discard()
+	}
 	if w.close != nil {
 		return w.close()
 	}
@@ -36,6 +54,11 @@ type multiWriter struct {
 	writers []sbom.Writer
 }
 
+// NewMultiWriter combines the given writers into a single sbom.Writer
+func NewMultiWriter(writers ...sbom.Writer) sbom.Writer {
+	return &multiWriter{writers: writers}
+}
+
 // Write writes the SBOM to all writers
 func (m *multiWriter) Write(s sbom.SBOM) (errs error) {
 	for _, w := range m.writers {
@@ -64,7 +87,8 @@ type WriterOption struct {
 	Path   string
 }
 
-// MakeWriter create all report writers from input options; if a file is not specified, os.Stdout is used
+// MakeWriter create all report writers from input options; if a file is not specified, os.Stdout is used. Files are
+// replaced only once the writer is closed after a successful write, so that they never hold a partial report.
 func MakeWriter(options ...WriterOption) (_ sbom.Writer, errs error) {
 	if len(options) == 0 {
 		return nil, fmt.Errorf("no output options provided")
@@ -74,7 +98,7 @@ func MakeWriter(options ...WriterOption) (_ sbom.Writer, errs error) {
 
 	defer func() {
 		if errs != nil {
-			// close any previously opened files; we can't really recover from any errors
+			// discard any previously opened files (nothing has been written to them); we can't really recover from any errors
 			_ = out.Close()
 		}
 	}()
@@ -100,14 +124,15 @@ func MakeWriter(options ...WriterOption) (_ sbom.Writer, errs error) {
 					return nil, fmt.Errorf("output path does not contain a valid directory: %s", option.Path)
 				}
 			}
-			fileOut, err := os.OpenFile(option.Path, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+			fileOut, err := newAtomicFile(option.Path)
 			if err != nil {
 				return nil, fmt.Errorf("unable to create report file: %w", err)
 			}
 			out.writers = append(out.writers, &streamWriter{
-				format: option.Format,
-				out:    fileOut,
-				close:  fileOut.Close,
+				format:  option.Format,
+				out:     fileOut,
+				close:   fileOut.commit,
+				discard: fileOut.discard,
 			})
 		}
 	}
@@ -146,6 +146,9 @@ func TestOutputWriter(t *testing.T) {
 
 			assert.Len(t, mw.writers, len(test.expected))
 
+			// files are written when the writer is closed
+			assert.NoError(t, writer.Close())
+
 			for i, e := range test.expected {
 				w := mw.writers[i].(*streamWriter)
 
@@ -0,0 +1,60 @@
+package publish
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/segmentio/kafka-go"
+)
+
+type KafkaConfig struct {
+	Brokers         []string
+	Topic           string
+	MaxMessageBytes int64 // must not exceed the broker (or topic) max.message.bytes setting
+}
+
+// kafkaWriter is the subset of the kafka.Writer used for publishing.
+type kafkaWriter interface {
+	WriteMessages(ctx context.Context, msgs ...kafka.Message) error
+	Close() error
+}
+
+type kafkaPublisher struct {
+	writer kafkaWriter
+}
+
+// NewKafkaPublisher creates a publisher that writes to the given kafka topic. Messages are partitioned by key (the
+// cataloged source) and are acknowledged by all in-sync replicas before the write is considered successful.
+func NewKafkaPublisher(cfg KafkaConfig) (Publisher, error) {
+	if len(cfg.Brokers) == 0 {
+		return nil, fmt.Errorf("no kafka brokers provided")
+	}
+	if cfg.Topic == "" {
+		return nil, fmt.Errorf("no kafka topic provided")
+	}
+
+	return &kafkaPublisher{
+		writer: &kafka.Writer{
+			Addr:         kafka.TCP(cfg.Brokers...),
+			Topic:        cfg.Topic,
+			Balancer:     &kafka.Hash{},
+			RequiredAcks: kafka.RequireAll,
+			BatchBytes:   cfg.MaxMessageBytes,
+		},
+	}, nil
+}
+
+func (p *kafkaPublisher) Publish(ctx context.Context, messages ...Message) error {
+	var kafkaMessages = make([]kafka.Message, len(messages))
+	for i, m := range messages {
+		kafkaMessages[i] = kafka.Message{
+			Key:   m.Key,
+			Value: m.Value,
+		}
+	}
+	return p.writer.WriteMessages(ctx, kafkaMessages...)
+}
+
+func (p *kafkaPublisher) Close() error {
+	return p.writer.Close()
+}
@@ -0,0 +1,87 @@
+package publish
+
+import (
+	"context"
+	"testing"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeKafkaWriter struct {
+	messages []kafka.Message
+	closed   bool
+}
+
+func (w *fakeKafkaWriter) WriteMessages(_ context.Context, msgs ...kafka.Message) error {
+	w.messages = append(w.messages, msgs...)
+	return nil
+}
+
+func (w *fakeKafkaWriter) Close() error {
+	w.closed = true
+	return nil
+}
+
+func TestNewKafkaPublisher(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     KafkaConfig
+		wantErr bool
+	}{
+		{
+			name:    "no brokers",
+			cfg:     KafkaConfig{Topic: "sboms"},
+			wantErr: true,
+		},
+		{
+			name:    "no topic",
+			cfg:     KafkaConfig{Brokers: []string{"localhost:9092"}},
+			wantErr: true,
+		},
+		{
+			name: "valid",
+			cfg: KafkaConfig{
+				Brokers:         []string{"localhost:9092", "localhost:9093"},
+				Topic:           "sboms",
+				MaxMessageBytes: 2048,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p, err := NewKafkaPublisher(test.cfg)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			w, ok := p.(*kafkaPublisher).writer.(*kafka.Writer)
+			require.True(t, ok)
+			assert.Equal(t, test.cfg.Topic, w.Topic)
+			assert.Equal(t, kafka.RequireAll, w.RequiredAcks)
+			assert.Equal(t, test.cfg.MaxMessageBytes, w.BatchBytes)
+			assert.IsType(t, &kafka.Hash{}, w.Balancer)
+		})
+	}
+}
+
+func TestKafkaPublisher(t *testing.T) {
+	w := &fakeKafkaWriter{}
+	p := &kafkaPublisher{writer: w}
+
+	require.NoError(t, p.Publish(context.Background(),
+		Message{Key: []byte("alpine:latest"), Value: []byte(`{"name":"musl"}`)},
+		Message{Key: []byte("alpine:latest"), Value: []byte(`{"name":"busybox"}`)},
+	))
+	require.NoError(t, p.Close())
+
+	require.Len(t, w.messages, 2)
+	assert.Equal(t, "alpine:latest", string(w.messages[0].Key))
+	assert.Equal(t, `{"name":"musl"}`, string(w.messages[0].Value))
+	assert.Equal(t, `{"name":"busybox"}`, string(w.messages[1].Value))
+	assert.True(t, w.closed)
+}
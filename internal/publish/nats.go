@@ -0,0 +1,63 @@
+package publish
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/anchore/syft/internal"
+	"github.com/nats-io/nats.go"
+)
+
+type NATSConfig struct {
+	URL     string
+	Subject string
+}
+
+type natsPublisher struct {
+	conn    *nats.Conn
+	subject string
+}
+
+// NewNATSPublisher connects to the given NATS server and creates a publisher for the given subject.
+func NewNATSPublisher(cfg NATSConfig) (Publisher, error) {
+	if cfg.Subject == "" {
+		return nil, fmt.Errorf("no NATS subject provided")
+	}
+
+	conn, err := nats.Connect(cfg.URL, nats.Name(internal.ApplicationName))
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to NATS server=%q: %w", cfg.URL, err)
+	}
+
+	return &natsPublisher{
+		conn:    conn,
+		subject: cfg.Subject,
+	}, nil
+}
+
+func (p *natsPublisher) Publish(ctx context.Context, messages ...Message) error {
+	for _, m := range messages {
+		if int64(len(m.Value)) > p.conn.MaxPayload() {
+			return fmt.Errorf("message size (%d bytes) exceeds the NATS server max payload (%d bytes)", len(m.Value), p.conn.MaxPayload())
+		}
+
+		msg := nats.NewMsg(p.subject)
+		msg.Data = m.Value
+		if p.conn.HeadersSupported() {
+			msg.Header.Set("Syft-Source", string(m.Key))
+		}
+		if err := p.conn.PublishMsg(msg); err != nil {
+			return err
+		}
+	}
+	// ensure all messages have been received by the server before returning
+	if _, ok := ctx.Deadline(); ok {
+		return p.conn.FlushWithContext(ctx)
+	}
+	return p.conn.Flush()
+}
+
+func (p *natsPublisher) Close() error {
+	p.conn.Close()
+	return nil
+}
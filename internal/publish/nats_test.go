@@ -0,0 +1,113 @@
+package publish
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type natsMessage struct {
+	subject string
+	headers string
+	payload string
+}
+
+// fakeNATSServer implements just enough of the NATS client protocol to accept a connection and record published
+// messages (with or without headers).
+func fakeNATSServer(t *testing.T) (string, <-chan natsMessage) {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	messages := make(chan natsMessage, 10)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		fmt.Fprintf(conn, "INFO {\"server_id\":\"test\",\"version\":\"2.2.0\",\"proto\":1,\"headers\":true,\"max_payload\":1048576}\r\n")
+
+		reader := bufio.NewReader(conn)
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+			fields := strings.Fields(line)
+			if len(fields) == 0 {
+				continue
+			}
+			switch fields[0] {
+			case "PING":
+				fmt.Fprintf(conn, "PONG\r\n")
+			case "PUB", "HPUB":
+				var headerLen int
+				total, _ := strconv.Atoi(fields[len(fields)-1])
+				if fields[0] == "HPUB" {
+					headerLen, _ = strconv.Atoi(fields[len(fields)-2])
+				}
+				body := make([]byte, total+2) // trailing CRLF
+				if _, err := io.ReadFull(reader, body); err != nil {
+					return
+				}
+				messages <- natsMessage{
+					subject: fields[1],
+					headers: string(body[:headerLen]),
+					payload: string(body[headerLen:total]),
+				}
+			}
+		}
+	}()
+
+	return "nats://" + listener.Addr().String(), messages
+}
+
+func TestNATSPublisher(t *testing.T) {
+	url, messages := fakeNATSServer(t)
+
+	p, err := NewNATSPublisher(NATSConfig{URL: url, Subject: "syft.sboms"})
+	require.NoError(t, err)
+	defer p.Close()
+
+	require.NoError(t, p.Publish(context.Background(),
+		Message{Key: []byte("sha256:digest"), Value: []byte(`{"first": true}`)},
+		Message{Key: []byte("sha256:digest"), Value: []byte(`{"second": true}`)},
+	))
+
+	first := <-messages
+	assert.Equal(t, "syft.sboms", first.subject)
+	assert.Contains(t, first.headers, "Syft-Source: sha256:digest")
+	assert.Equal(t, `{"first": true}`, first.payload)
+
+	second := <-messages
+	assert.Equal(t, `{"second": true}`, second.payload)
+}
+
+func TestNewNATSPublisher_RequiresSubject(t *testing.T) {
+	_, err := NewNATSPublisher(NATSConfig{URL: "nats://127.0.0.1:4222"})
+	assert.Error(t, err)
+}
+
+func TestNewKafkaPublisher_Validation(t *testing.T) {
+	_, err := NewKafkaPublisher(KafkaConfig{Topic: "syft-sboms"})
+	assert.Error(t, err)
+
+	_, err = NewKafkaPublisher(KafkaConfig{Brokers: []string{"localhost:9092"}})
+	assert.Error(t, err)
+
+	p, err := NewKafkaPublisher(KafkaConfig{Brokers: []string{"localhost:9092"}, Topic: "syft-sboms"})
+	require.NoError(t, err)
+	assert.NoError(t, p.Close())
+}
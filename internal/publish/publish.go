@@ -0,0 +1,139 @@
+/*
+Package publish provides sbom.Writer implementations that emit completed SBOMs (or individual package events) to
+message brokers, such as Kafka or NATS.
+*/
+package publish
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/anchore/syft/internal/formats/syftjson"
+	"github.com/anchore/syft/internal/formats/syftjson/model"
+	"github.com/anchore/syft/syft/format"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/anchore/syft/syft/source"
+)
+
+// Mode describes the shape of the published messages.
+type Mode string
+
+const (
+	// SBOMMode publishes a single message with the entire SBOM, encoded with the configured format.
+	SBOMMode Mode = "sbom"
+	// PackageMode publishes one message for each package discovered (always syft-json encoded).
+	PackageMode Mode = "package"
+)
+
+var AllModes = []Mode{SBOMMode, PackageMode}
+
+// Publisher sends messages to a topic or subject on a message broker.
+type Publisher interface {
+	Publish(ctx context.Context, messages ...Message) error
+	Close() error
+}
+
+// Message is a single payload to publish. The key identifies the source that was cataloged, so that brokers that
+// partition by key (e.g. Kafka) keep all messages about the same source in order.
+type Message struct {
+	Key   []byte
+	Value []byte
+}
+
+// PackageEvent is the payload published for each package in PackageMode.
+type PackageEvent struct {
+	Source  model.Source  `json:"source"`
+	Package model.Package `json:"package"`
+}
+
+// Writer implements sbom.Writer by publishing the SBOM with the given publisher.
+type Writer struct {
+	publisher Publisher
+	format    format.Format
+	mode      Mode
+}
+
+func NewWriter(publisher Publisher, f format.Format, mode Mode) *Writer {
+	return &Writer{
+		publisher: publisher,
+		format:    f,
+		mode:      mode,
+	}
+}
+
+// Write publishes the SBOM (or one event per package) to the configured broker.
+func (w *Writer) Write(s sbom.SBOM) error {
+	var messages []Message
+	var err error
+	switch w.mode {
+	case SBOMMode:
+		messages, err = w.sbomMessages(s)
+	case PackageMode:
+		messages, err = packageMessages(s)
+	default:
+		err = fmt.Errorf("unsupported publish mode: %q", w.mode)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := w.publisher.Publish(context.Background(), messages...); err != nil {
+		return fmt.Errorf("unable to publish SBOM: %w", err)
+	}
+	return nil
+}
+
+// Close releases the connection to the message broker.
+func (w *Writer) Close() error {
+	return w.publisher.Close()
+}
+
+func (w *Writer) sbomMessages(s sbom.SBOM) ([]Message, error) {
+	var buf bytes.Buffer
+	if err := w.format.Encode(&buf, s); err != nil {
+		return nil, fmt.Errorf("unable to encode SBOM: %w", err)
+	}
+	return []Message{{Key: sourceKey(s.Source), Value: buf.Bytes()}}, nil
+}
+
+func packageMessages(s sbom.SBOM) ([]Message, error) {
+	// the syft-json document model is the stable, schema-versioned representation of packages
+	var buf bytes.Buffer
+	if err := syftjson.Format().Encode(&buf, s); err != nil {
+		return nil, fmt.Errorf("unable to encode SBOM: %w", err)
+	}
+
+	var doc model.Document
+	if err := json.Unmarshal(buf.Bytes(), &doc); err != nil {
+		return nil, fmt.Errorf("unable to decode SBOM: %w", err)
+	}
+
+	key := sourceKey(s.Source)
+	var messages []Message
+	for _, p := range doc.Artifacts {
+		value, err := json.Marshal(PackageEvent{
+			Source:  doc.Source,
+			Package: p,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode package event: %w", err)
+		}
+		messages = append(messages, Message{Key: key, Value: value})
+	}
+	return messages, nil
+}
+
+// sourceKey returns a stable identifier for the cataloged source.
+func sourceKey(src source.Metadata) []byte {
+	switch src.Scheme {
+	case source.ImageScheme:
+		if src.ImageMetadata.ManifestDigest != "" {
+			return []byte(src.ImageMetadata.ManifestDigest)
+		}
+		return []byte(src.ImageMetadata.UserInput)
+	default:
+		return []byte(src.Path)
+	}
+}
@@ -0,0 +1,88 @@
+package publish
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/anchore/syft/internal/formats/syftjson"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/anchore/syft/syft/source"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockPublisher struct {
+	messages []Message
+	closed   bool
+}
+
+func (m *mockPublisher) Publish(_ context.Context, messages ...Message) error {
+	m.messages = append(m.messages, messages...)
+	return nil
+}
+
+func (m *mockPublisher) Close() error {
+	m.closed = true
+	return nil
+}
+
+func testSBOM() sbom.SBOM {
+	catalog := pkg.NewCatalog()
+	catalog.Add(pkg.Package{Name: "musl", Version: "1.2.2-r7", Type: pkg.ApkPkg})
+	catalog.Add(pkg.Package{Name: "busybox", Version: "1.33.1-r3", Type: pkg.ApkPkg})
+
+	return sbom.SBOM{
+		Artifacts: sbom.Artifacts{PackageCatalog: catalog},
+		Source: source.Metadata{
+			Scheme: source.ImageScheme,
+			ImageMetadata: source.ImageMetadata{
+				UserInput:      "alpine:latest",
+				ManifestDigest: "sha256:digest",
+			},
+		},
+	}
+}
+
+func TestWriter_SBOMMode(t *testing.T) {
+	publisher := &mockPublisher{}
+	w := NewWriter(publisher, syftjson.Format(), SBOMMode)
+
+	require.NoError(t, w.Write(testSBOM()))
+	require.NoError(t, w.Close())
+
+	assert.True(t, publisher.closed)
+	require.Len(t, publisher.messages, 1)
+	assert.Equal(t, "sha256:digest", string(publisher.messages[0].Key))
+
+	decoded, err := syftjson.Format().Decode(bytes.NewReader(publisher.messages[0].Value))
+	require.NoError(t, err)
+	assert.Equal(t, 2, decoded.Artifacts.PackageCatalog.PackageCount())
+}
+
+func TestWriter_PackageMode(t *testing.T) {
+	publisher := &mockPublisher{}
+	w := NewWriter(publisher, syftjson.Format(), PackageMode)
+
+	require.NoError(t, w.Write(testSBOM()))
+
+	require.Len(t, publisher.messages, 2)
+
+	var names []string
+	for _, m := range publisher.messages {
+		assert.Equal(t, "sha256:digest", string(m.Key))
+
+		var event PackageEvent
+		require.NoError(t, json.Unmarshal(m.Value, &event))
+		assert.Equal(t, "image", event.Source.Type)
+		names = append(names, event.Package.Name)
+	}
+	assert.ElementsMatch(t, []string{"musl", "busybox"}, names)
+}
+
+func TestSourceKey(t *testing.T) {
+	assert.Equal(t, "/some/path", string(sourceKey(source.Metadata{Scheme: source.DirectoryScheme, Path: "/some/path"})))
+	assert.Equal(t, "alpine:latest", string(sourceKey(source.Metadata{Scheme: source.ImageScheme, ImageMetadata: source.ImageMetadata{UserInput: "alpine:latest"}})))
+}
@@ -0,0 +1,48 @@
+/*
+Package registry provides helpers for making requests directly against OCI registries using the same registry
+configuration (credentials and TLS options) that stereoscope uses when pulling images.
+*/
+package registry
+
+import (
+	"crypto/tls"
+	"net/http"
+
+	"github.com/anchore/stereoscope/pkg/image"
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+)
+
+// ReferenceOptions returns the options for parsing registry, repository, and image references.
+func ReferenceOptions(registryOptions *image.RegistryOptions) []name.Option {
+	var opts []name.Option
+	if registryOptions != nil && registryOptions.InsecureUseHTTP {
+		opts = append(opts, name.Insecure)
+	}
+	return opts
+}
+
+// RemoteOptions returns the options for making requests against the given registry. Explicitly configured
+// credentials are preferred, falling back to the docker config keychain.
+func RemoteOptions(reg name.Registry, registryOptions *image.RegistryOptions) []remote.Option {
+	if registryOptions == nil {
+		registryOptions = &image.RegistryOptions{}
+	}
+
+	var opts []remote.Option
+	if registryOptions.InsecureSkipTLSVerify {
+		opts = append(opts, remote.WithTransport(&http.Transport{
+			// nolint: gosec
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		}))
+	}
+
+	// note: the authn.Authenticator and authn.Keychain options are mutually exclusive, only one may be provided.
+	if authenticator := registryOptions.Authenticator(reg.RegistryStr()); authenticator != nil {
+		opts = append(opts, remote.WithAuth(authenticator))
+	} else {
+		opts = append(opts, remote.WithAuthFromKeychain(authn.DefaultKeychain))
+	}
+	return opts
+}
@@ -0,0 +1,118 @@
+/*
+Package resultcache keeps the SBOMs of previously cataloged immutable sources (images by digest) on disk, such that
+rescanning the same image with the same version and configuration of the application returns the previous results.
+*/
+package resultcache
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/anchore/syft/internal/formats/syftjson"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/sbom"
+)
+
+// Cache keeps syft-json encoded SBOMs within a directory, one file per key.
+type Cache struct {
+	dir string
+	ttl time.Duration
+}
+
+// New creates a cache within the given directory, where entries older than the given TTL are ignored (0 = forever).
+func New(dir string, ttl time.Duration) *Cache {
+	return &Cache{
+		dir: dir,
+		ttl: ttl,
+	}
+}
+
+// Key identifies the results of cataloging the source with the given digest with the given application version and
+// configuration (any value that can be encoded as JSON, limited to the options that affect the results).
+func Key(digest, version string, config interface{}) (string, error) {
+	if digest == "" {
+		return "", fmt.Errorf("no source digest to key cached results by")
+	}
+	configJSON, err := json.Marshal(config)
+	if err != nil {
+		return "", fmt.Errorf("unable to encode configuration for the result cache key: %w", err)
+	}
+
+	hasher := sha256.New()
+	for _, part := range [][]byte{[]byte(digest), []byte(version), configJSON} {
+		// delimit every part so different parts never result in the same key
+		hasher.Write([]byte(fmt.Sprintf("%d:", len(part))))
+		hasher.Write(part)
+	}
+	return fmt.Sprintf("%x", hasher.Sum(nil)), nil
+}
+
+// Get returns the SBOM kept for the given key, if there is one (and it has not expired).
+func (c *Cache) Get(key string) (*sbom.SBOM, bool) {
+	path := c.path(key)
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, false
+	}
+	if c.ttl > 0 && time.Since(info.ModTime()) > c.ttl {
+		log.Debugf("result cache entry=%q has expired", path)
+		return nil, false
+	}
+
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		log.Debugf("unable to read result cache entry=%q: %+v", path, err)
+		return nil, false
+	}
+	s, err := syftjson.Format().Decode(bytes.NewReader(contents))
+	if err != nil {
+		log.Debugf("unable to decode result cache entry=%q: %+v", path, err)
+		return nil, false
+	}
+	return s, true
+}
+
+// Set keeps the given SBOM for the given key, replacing any previous entry.
+func (c *Cache) Set(key string, s sbom.SBOM) error {
+	var buf bytes.Buffer
+	if err := syftjson.Format().Encode(&buf, s); err != nil {
+		return fmt.Errorf("unable to encode result cache entry: %w", err)
+	}
+	if err := os.MkdirAll(c.dir, 0755); err != nil {
+		return fmt.Errorf("unable to create result cache directory: %w", err)
+	}
+
+	// write to a temporary file first so a concurrent scan never reads a partially written entry (each scan writes to
+	// its own temporary file, so concurrent scans of the same image do not interfere either)
+	temp, err := ioutil.TempFile(c.dir, key+"-*.tmp")
+	if err != nil {
+		return fmt.Errorf("unable to create result cache entry: %w", err)
+	}
+	defer os.Remove(temp.Name())
+
+	if err := temp.Chmod(0644); err != nil {
+		temp.Close()
+		return fmt.Errorf("unable to write result cache entry: %w", err)
+	}
+	if _, err := temp.Write(buf.Bytes()); err != nil {
+		temp.Close()
+		return fmt.Errorf("unable to write result cache entry: %w", err)
+	}
+	if err := temp.Close(); err != nil {
+		return fmt.Errorf("unable to write result cache entry: %w", err)
+	}
+	if err := os.Rename(temp.Name(), c.path(key)); err != nil {
+		return fmt.Errorf("unable to write result cache entry: %w", err)
+	}
+	return nil
+}
+
+func (c *Cache) path(key string) string {
+	return filepath.Join(c.dir, key+".json")
+}
@@ -0,0 +1,101 @@
+package resultcache
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/anchore/syft/syft/source"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKey(t *testing.T) {
+	config := map[string]interface{}{"scope": "squashed"}
+
+	key, err := Key("sha256:abc", "v0.35.0", config)
+	require.NoError(t, err)
+
+	same, err := Key("sha256:abc", "v0.35.0", map[string]interface{}{"scope": "squashed"})
+	require.NoError(t, err)
+	assert.Equal(t, key, same)
+
+	for _, other := range []struct {
+		digest  string
+		version string
+		config  interface{}
+	}{
+		{digest: "sha256:abd", version: "v0.35.0", config: config},
+		{digest: "sha256:abc", version: "v0.35.1", config: config},
+		{digest: "sha256:abc", version: "v0.35.0", config: map[string]interface{}{"scope": "all-layers"}},
+		// the parts are delimited, so moving characters between parts results in another key
+		{digest: "sha256:abcv", version: "0.35.0", config: config},
+	} {
+		otherKey, err := Key(other.digest, other.version, other.config)
+		require.NoError(t, err)
+		assert.NotEqual(t, key, otherKey)
+	}
+
+	_, err = Key("", "v0.35.0", config)
+	assert.Error(t, err)
+}
+
+func TestCache(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "results")
+	c := New(dir, time.Hour)
+
+	p := pkg.Package{
+		Name:    "musl",
+		Version: "1.2.2-r7",
+		Type:    pkg.ApkPkg,
+	}
+	p.SetID()
+
+	binary := source.Coordinates{RealPath: "/lib/ld-musl-x86_64.so.1"}
+	s := sbom.SBOM{
+		Artifacts: sbom.Artifacts{
+			PackageCatalog: pkg.NewCatalog(p),
+			FileDigests: map[source.Coordinates][]file.Digest{
+				binary: {{Algorithm: "sha256", Value: "abc"}},
+			},
+		},
+		Source: source.Metadata{
+			Scheme: source.ImageScheme,
+			ImageMetadata: source.ImageMetadata{
+				UserInput:      "alpine:3.15",
+				ManifestDigest: "sha256:abc",
+			},
+		},
+	}
+
+	_, ok := c.Get("key")
+	assert.False(t, ok)
+
+	require.NoError(t, c.Set("key", s))
+
+	actual, ok := c.Get("key")
+	require.True(t, ok)
+	assert.Equal(t, "sha256:abc", actual.Source.ImageMetadata.ManifestDigest)
+	packages := actual.Artifacts.PackageCatalog.Sorted()
+	require.Len(t, packages, 1)
+	assert.Equal(t, "musl", packages[0].Name)
+	// the findings of file catalogers are kept as well
+	assert.Equal(t, s.Artifacts.FileDigests, actual.Artifacts.FileDigests)
+
+	// only the entry itself remains (and no temporary files)
+	entries, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "key.json", entries[0].Name())
+
+	// expired entries are ignored
+	old := time.Now().Add(-2 * time.Hour)
+	require.NoError(t, os.Chtimes(filepath.Join(dir, "key.json"), old, old))
+	_, ok = c.Get("key")
+	assert.False(t, ok)
+}
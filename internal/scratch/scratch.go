@@ -0,0 +1,128 @@
+// Package scratch manages the directory that image layers and archives are extracted to while cataloging.
+package scratch
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+)
+
+// ErrLimitExceeded is returned when the scratch space grows past its configured size.
+var ErrLimitExceeded = errors.New("scratch space limit exceeded")
+
+// defaultPollInterval is how often the size of the scratch space is checked against its limit.
+const defaultPollInterval = time.Second
+
+// Space is a directory created for a single syft invocation that all temporary files are written within, so that they
+// can all be removed at once (even those left behind by a cancelled scan).
+type Space struct {
+	dir          string
+	maxSize      int64
+	pollInterval time.Duration
+}
+
+// New creates the scratch space within the given parent directory (the platform temp dir when empty). A non-positive
+// max size (in bytes) means there is no limit.
+func New(parent string, maxSize int64) (*Space, error) {
+	if parent != "" {
+		if err := os.MkdirAll(parent, 0700); err != nil {
+			return nil, fmt.Errorf("unable to create scratch directory parent=%q: %w", parent, err)
+		}
+	}
+	dir, err := ioutil.TempDir(parent, internal.ApplicationName+"-scratch-")
+	if err != nil {
+		return nil, fmt.Errorf("unable to create scratch directory: %w", err)
+	}
+	return &Space{
+		dir:          dir,
+		maxSize:      maxSize,
+		pollInterval: defaultPollInterval,
+	}, nil
+}
+
+// Dir is the path of the scratch space.
+func (s *Space) Dir() string {
+	if s == nil {
+		return ""
+	}
+	return s.dir
+}
+
+// Usage returns the number of bytes currently stored within the scratch space.
+func (s *Space) Usage() (int64, error) {
+	var total int64
+	err := filepath.Walk(s.dir, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			// files may be removed while walking (e.g. by a cataloger cleaning up after itself)
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if info.Mode().IsRegular() {
+			total += info.Size()
+		}
+		return nil
+	})
+	return total, err
+}
+
+// Guard forwards the given worker errors, adding ErrLimitExceeded (and closing the returned channel without waiting
+// for the worker) when the scratch space grows past its limit. Without a limit the worker errors are returned as-is.
+func (s *Space) Guard(workerErrs <-chan error) <-chan error {
+	if s == nil || s.maxSize <= 0 {
+		return workerErrs
+	}
+
+	errs := make(chan error)
+	go func() {
+		defer close(errs)
+		ticker := time.NewTicker(s.pollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case err, isOpen := <-workerErrs:
+				if !isOpen {
+					return
+				}
+				errs <- err
+			case <-ticker.C:
+				usage, err := s.Usage()
+				if err != nil {
+					log.Debugf("unable to determine scratch space usage: %+v", err)
+					continue
+				}
+				if usage > s.maxSize {
+					errs <- fmt.Errorf("%w: %d bytes used of %d allowed in %q", ErrLimitExceeded, usage, s.maxSize, s.dir)
+					// the worker is abandoned, but must not block on reporting errors nobody is listening to
+					go drain(workerErrs)
+					return
+				}
+			}
+		}
+	}()
+	return errs
+}
+
+// Cleanup removes the scratch space and everything within it.
+func (s *Space) Cleanup() {
+	if s == nil {
+		return
+	}
+	if err := os.RemoveAll(s.dir); err != nil {
+		log.Warnf("unable to remove scratch directory=%q: %+v", s.dir, err)
+	}
+}
+
+func drain(errs <-chan error) {
+	for range errs {
+		// discard: the scan has already been abandoned
+	}
+}
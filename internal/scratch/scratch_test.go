@@ -0,0 +1,59 @@
+package scratch
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpace_Cleanup(t *testing.T) {
+	parent := filepath.Join(t.TempDir(), "not", "yet", "created")
+	s, err := New(parent, 0)
+	require.NoError(t, err)
+	assert.Equal(t, parent, filepath.Dir(s.Dir()))
+
+	require.NoError(t, os.MkdirAll(filepath.Join(s.Dir(), "layer"), 0700))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(s.Dir(), "layer", "file"), []byte("contents"), 0600))
+
+	usage, err := s.Usage()
+	require.NoError(t, err)
+	assert.Equal(t, int64(len("contents")), usage)
+
+	s.Cleanup()
+	_, err = os.Stat(s.Dir())
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestSpace_Guard(t *testing.T) {
+	s, err := New(t.TempDir(), 4)
+	require.NoError(t, err)
+	defer s.Cleanup()
+	s.pollInterval = 10 * time.Millisecond
+
+	worker := make(chan error)
+	defer close(worker)
+	errs := s.Guard(worker)
+
+	worker <- errors.New("from the worker")
+	assert.EqualError(t, <-errs, "from the worker")
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(s.Dir(), "layer.tar"), []byte("too large"), 0600))
+	assert.ErrorIs(t, <-errs, ErrLimitExceeded)
+	_, isOpen := <-errs
+	assert.False(t, isOpen)
+}
+
+func TestSpace_GuardWithoutLimit(t *testing.T) {
+	s, err := New(t.TempDir(), 0)
+	require.NoError(t, err)
+	defer s.Cleanup()
+
+	worker := make(chan error)
+	assert.Equal(t, (<-chan error)(worker), s.Guard(worker))
+}
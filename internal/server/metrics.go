@@ -0,0 +1,207 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+const (
+	successStatus = "success"
+	failureStatus = "failure"
+
+	// catalogerSpanName and catalogerAttribute identify the spans started for each package cataloger (see
+	// pkg/cataloger.Catalog), which are used to time the catalogers.
+	catalogerSpanName  = "cataloger"
+	catalogerAttribute = "syft.cataloger"
+)
+
+// scanDurationBuckets are the upper bounds (in seconds) of the scan duration histogram buckets.
+var scanDurationBuckets = []float64{1, 5, 10, 30, 60, 120, 300, 600}
+
+type timing struct {
+	count int
+	sum   float64
+}
+
+// Metrics records the scans handled by the server, exposed in the Prometheus text format. Per-cataloger timings are
+// recorded from the cataloger tracing spans, so Metrics must be registered as a span processor with the tracer
+// provider for these to be captured.
+type Metrics struct {
+	lock         sync.Mutex
+	scans        map[string]int
+	bucketCounts []int
+	duration     timing
+	queued       int
+	inProgress   int
+	catalogers   map[string]*timing
+	rescans      map[string]int
+}
+
+var _ sdktrace.SpanProcessor = (*Metrics)(nil)
+
+func NewMetrics() *Metrics {
+	return &Metrics{
+		scans:        make(map[string]int),
+		bucketCounts: make([]int, len(scanDurationBuckets)),
+		catalogers:   make(map[string]*timing),
+		rescans:      make(map[string]int),
+	}
+}
+
+// ScanQueued records a scan that is waiting for a worker.
+func (m *Metrics) ScanQueued() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.queued++
+}
+
+// ScanDequeued records a queued scan that was abandoned before it was started (e.g. the client went away).
+func (m *Metrics) ScanDequeued() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.queued--
+}
+
+// ScanStarted records a queued scan that has been picked up by a worker.
+func (m *Metrics) ScanStarted() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.queued--
+	m.inProgress++
+}
+
+// ScanFinished records the outcome and duration of a started scan.
+func (m *Metrics) ScanFinished(duration time.Duration, err error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.inProgress--
+
+	status := successStatus
+	if err != nil {
+		status = failureStatus
+	}
+	m.scans[status]++
+
+	seconds := duration.Seconds()
+	m.duration.count++
+	m.duration.sum += seconds
+	for i, bound := range scanDurationBuckets {
+		if seconds <= bound {
+			m.bucketCounts[i]++
+		}
+	}
+}
+
+// Rescanned records the result of a scheduled rescan (changed, unchanged, or failure).
+func (m *Metrics) Rescanned(result string) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.rescans[result]++
+}
+
+func (m *Metrics) observeCataloger(name string, duration time.Duration) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	t, ok := m.catalogers[name]
+	if !ok {
+		t = &timing{}
+		m.catalogers[name] = t
+	}
+	t.count++
+	t.sum += duration.Seconds()
+}
+
+func (m *Metrics) OnStart(context.Context, sdktrace.ReadWriteSpan) {}
+
+// OnEnd records the duration of cataloger spans (all other spans are ignored).
+func (m *Metrics) OnEnd(s sdktrace.ReadOnlySpan) {
+	if s.Name() != catalogerSpanName {
+		return
+	}
+	for _, kv := range s.Attributes() {
+		if string(kv.Key) == catalogerAttribute {
+			m.observeCataloger(kv.Value.AsString(), s.EndTime().Sub(s.StartTime()))
+			return
+		}
+	}
+}
+
+func (m *Metrics) Shutdown(context.Context) error { return nil }
+
+func (m *Metrics) ForceFlush(context.Context) error { return nil }
+
+// WriteTo writes all metrics in the Prometheus text exposition format (version 0.0.4).
+func (m *Metrics) WriteTo(w io.Writer) (int64, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	out := &countingWriter{w: w}
+
+	out.printf("# HELP syft_scans_total The number of completed scans by status.\n")
+	out.printf("# TYPE syft_scans_total counter\n")
+	for _, status := range []string{successStatus, failureStatus} {
+		out.printf("syft_scans_total{status=%q} %d\n", status, m.scans[status])
+	}
+
+	out.printf("# HELP syft_scan_duration_seconds The time taken to complete a scan.\n")
+	out.printf("# TYPE syft_scan_duration_seconds histogram\n")
+	for i, bound := range scanDurationBuckets {
+		out.printf("syft_scan_duration_seconds_bucket{le=\"%g\"} %d\n", bound, m.bucketCounts[i])
+	}
+	out.printf("syft_scan_duration_seconds_bucket{le=\"+Inf\"} %d\n", m.duration.count)
+	out.printf("syft_scan_duration_seconds_sum %g\n", m.duration.sum)
+	out.printf("syft_scan_duration_seconds_count %d\n", m.duration.count)
+
+	out.printf("# HELP syft_scan_queue_depth The number of scans waiting for a worker.\n")
+	out.printf("# TYPE syft_scan_queue_depth gauge\n")
+	out.printf("syft_scan_queue_depth %d\n", m.queued)
+
+	out.printf("# HELP syft_scans_in_progress The number of scans being cataloged.\n")
+	out.printf("# TYPE syft_scans_in_progress gauge\n")
+	out.printf("syft_scans_in_progress %d\n", m.inProgress)
+
+	out.printf("# HELP syft_rescans_total The number of scheduled rescans by result.\n")
+	out.printf("# TYPE syft_rescans_total counter\n")
+	for _, result := range []string{changedRescan, unchangedRescan, failedRescan} {
+		out.printf("syft_rescans_total{result=%q} %d\n", result, m.rescans[result])
+	}
+
+	names := make([]string, 0, len(m.catalogers))
+	for name := range m.catalogers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	out.printf("# HELP syft_cataloger_duration_seconds The time taken by each package cataloger.\n")
+	out.printf("# TYPE syft_cataloger_duration_seconds summary\n")
+	for _, name := range names {
+		t := m.catalogers[name]
+		out.printf("syft_cataloger_duration_seconds_sum{cataloger=%q} %g\n", name, t.sum)
+		out.printf("syft_cataloger_duration_seconds_count{cataloger=%q} %d\n", name, t.count)
+	}
+
+	return out.n, out.err
+}
+
+// countingWriter keeps the first write error (skipping all further writes) and the number of bytes written.
+type countingWriter struct {
+	w   io.Writer
+	n   int64
+	err error
+}
+
+func (c *countingWriter) printf(format string, args ...interface{}) {
+	if c.err != nil {
+		return
+	}
+	n, err := fmt.Fprintf(c.w, format, args...)
+	c.n += int64(n)
+	c.err = err
+}
@@ -0,0 +1,66 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestMetrics(t *testing.T) {
+	m := NewMetrics()
+
+	m.ScanQueued()
+	m.ScanStarted()
+	m.ScanFinished(3*time.Second, nil)
+
+	m.ScanQueued()
+	m.ScanStarted()
+	m.ScanFinished(90*time.Second, errors.New("failed"))
+
+	m.ScanQueued()
+	m.ScanQueued()
+	m.ScanDequeued()
+	m.ScanQueued()
+	m.ScanStarted()
+
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(m))
+	tracer := provider.Tracer("test")
+	for _, name := range []string{"dpkgdb-cataloger", "apkdb-cataloger", "dpkgdb-cataloger"} {
+		_, span := tracer.Start(context.Background(), catalogerSpanName)
+		span.SetAttributes(attribute.String(catalogerAttribute, name))
+		span.End()
+	}
+	// other spans are ignored
+	_, span := tracer.Start(context.Background(), "packages")
+	span.End()
+
+	var buf bytes.Buffer
+	_, err := m.WriteTo(&buf)
+	require.NoError(t, err)
+
+	actual := buf.String()
+	for _, line := range []string{
+		`syft_scans_total{status="success"} 1`,
+		`syft_scans_total{status="failure"} 1`,
+		`syft_scan_duration_seconds_bucket{le="1"} 0`,
+		`syft_scan_duration_seconds_bucket{le="5"} 1`,
+		`syft_scan_duration_seconds_bucket{le="120"} 2`,
+		`syft_scan_duration_seconds_bucket{le="+Inf"} 2`,
+		`syft_scan_duration_seconds_sum 93`,
+		`syft_scan_duration_seconds_count 2`,
+		`syft_scan_queue_depth 1`,
+		`syft_scans_in_progress 1`,
+		`syft_cataloger_duration_seconds_count{cataloger="apkdb-cataloger"} 1`,
+		`syft_cataloger_duration_seconds_count{cataloger="dpkgdb-cataloger"} 2`,
+	} {
+		assert.Contains(t, actual, line+"\n")
+	}
+	assert.NotContains(t, actual, "packages")
+}
@@ -0,0 +1,142 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/sbom"
+)
+
+const (
+	changedRescan   = "changed"
+	unchangedRescan = "unchanged"
+	failedRescan    = "failure"
+)
+
+// ScheduledTarget is a source that is rescanned on a schedule.
+type ScheduledTarget struct {
+	Name     string
+	Input    string // any input accepted by the packages command
+	Schedule Schedule
+}
+
+// PublishFunc emits the SBOM of a scheduled target that changed since the previous rescan.
+type PublishFunc func(target ScheduledTarget, s sbom.SBOM) error
+
+// rescanner rescans scheduled targets, only publishing results that differ from the previous rescan of the target.
+type rescanner struct {
+	server  *Server
+	publish PublishFunc
+	lock    sync.Mutex
+	digests map[string]string // the digest of the last published SBOM, by target name
+}
+
+// RunScheduled rescans each of the given targets on its schedule until the context is done. Scheduled scans share the
+// workers (and metrics) of the HTTP API. The first successful rescan of every target is always published, since the
+// last published SBOM is only kept in memory.
+func (s *Server) RunScheduled(ctx context.Context, targets []ScheduledTarget, publish PublishFunc) {
+	r := &rescanner{
+		server:  s,
+		publish: publish,
+		digests: make(map[string]string),
+	}
+
+	var wg sync.WaitGroup
+	for _, target := range targets {
+		wg.Add(1)
+		go func(target ScheduledTarget) {
+			defer wg.Done()
+			r.schedule(ctx, target)
+		}(target)
+	}
+	wg.Wait()
+}
+
+func (r *rescanner) schedule(ctx context.Context, target ScheduledTarget) {
+	for {
+		next := target.Schedule.Next(time.Now())
+		if next.IsZero() {
+			log.Warnf("schedule of target %q never activates", target.Name)
+			return
+		}
+
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		if err := r.rescan(ctx, target); err != nil {
+			log.Warnf("rescan of target %q failed: %+v", target.Name, err)
+		}
+	}
+}
+
+// rescan catalogs the target, publishing the SBOM if it changed since the previous rescan.
+func (r *rescanner) rescan(ctx context.Context, target ScheduledTarget) error {
+	result, err := r.server.run(ctx, target.Input)
+	if err != nil {
+		r.server.metrics.Rescanned(failedRescan)
+		return err
+	}
+
+	digest := Digest(*result)
+
+	r.lock.Lock()
+	unchanged := r.digests[target.Name] == digest
+	r.lock.Unlock()
+
+	if unchanged {
+		log.Infof("target %q is unchanged since the previous rescan", target.Name)
+		r.server.metrics.Rescanned(unchangedRescan)
+		return nil
+	}
+
+	if err := r.publish(target, *result); err != nil {
+		r.server.metrics.Rescanned(failedRescan)
+		return fmt.Errorf("unable to publish: %w", err)
+	}
+
+	// only a published SBOM is compared against, so a failure to publish is retried on the next rescan
+	r.lock.Lock()
+	r.digests[target.Name] = digest
+	r.lock.Unlock()
+
+	log.Infof("published the changed SBOM of target %q", target.Name)
+	r.server.metrics.Rescanned(changedRescan)
+	return nil
+}
+
+// Digest summarizes the findings of the given SBOM, ignoring anything that differs between two scans of an unchanged
+// source (such as the document timestamp or UUID).
+func Digest(s sbom.SBOM) string {
+	var lines []string
+	if s.Artifacts.PackageCatalog != nil {
+		for _, p := range s.Artifacts.PackageCatalog.Sorted() {
+			lines = append(lines, fmt.Sprintf("package %s", p.ID()))
+		}
+	}
+	for _, r := range s.Relationships {
+		lines = append(lines, fmt.Sprintf("relationship %s %s %s", r.From.ID(), r.To.ID(), r.Type))
+	}
+	if s.Artifacts.Distro != nil {
+		lines = append(lines, fmt.Sprintf("distro %s %s", s.Artifacts.Distro.Type, s.Artifacts.Distro.RawVersion))
+	}
+	for _, n := range s.Nested {
+		lines = append(lines, fmt.Sprintf("nested %s %s", n.Location.ID(), Digest(n.SBOM)))
+	}
+	sort.Strings(lines)
+
+	hasher := sha256.New()
+	for _, line := range lines {
+		hasher.Write([]byte(line + "\n"))
+	}
+	return fmt.Sprintf("%x", hasher.Sum(nil))
+}
@@ -0,0 +1,73 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDigest(t *testing.T) {
+	// the document timestamp and source do not contribute to the digest
+	a := newTestSBOM("/a", "musl", "busybox")
+	a.Descriptor.UUID = "a"
+	b := newTestSBOM("/b", "busybox", "musl")
+	b.Descriptor.UUID = "b"
+	assert.Equal(t, Digest(*a), Digest(*b))
+
+	assert.NotEqual(t, Digest(*a), Digest(*newTestSBOM("/a", "musl")))
+}
+
+func TestRescanner_Rescan(t *testing.T) {
+	results := map[string]*sbom.SBOM{
+		"/target": newTestSBOM("/target", "musl"),
+	}
+	scan := func(_ context.Context, userInput string) (*sbom.SBOM, error) {
+		if s, ok := results[userInput]; ok {
+			return s, nil
+		}
+		return nil, errors.New("unable to scan")
+	}
+
+	var published []string
+	var publishErr error
+	r := &rescanner{
+		server: New(scan, Config{Workers: 1}, NewMetrics()),
+		publish: func(target ScheduledTarget, s sbom.SBOM) error {
+			if publishErr != nil {
+				return publishErr
+			}
+			published = append(published, target.Name)
+			return nil
+		},
+		digests: make(map[string]string),
+	}
+	target := ScheduledTarget{Name: "target", Input: "/target"}
+
+	// the first rescan is always published
+	require.NoError(t, r.rescan(context.Background(), target))
+	assert.Equal(t, []string{"target"}, published)
+
+	// unchanged results are not published again
+	require.NoError(t, r.rescan(context.Background(), target))
+	assert.Equal(t, []string{"target"}, published)
+
+	// a failure to publish is retried on the next rescan
+	results["/target"] = newTestSBOM("/target", "musl", "busybox")
+	publishErr = errors.New("broker unavailable")
+	require.Error(t, r.rescan(context.Background(), target))
+	publishErr = nil
+	require.NoError(t, r.rescan(context.Background(), target))
+	assert.Equal(t, []string{"target", "target"}, published)
+
+	require.Error(t, r.rescan(context.Background(), ScheduledTarget{Name: "missing", Input: "/missing"}))
+
+	assert.Equal(t, map[string]int{
+		changedRescan:   2,
+		unchangedRescan: 1,
+		failedRescan:    2,
+	}, r.server.metrics.rescans)
+}
@@ -0,0 +1,29 @@
+package server
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// Schedule determines when a scheduled target is rescanned.
+type Schedule interface {
+	// Next returns the first activation time after the given time (or the zero time if the schedule never activates).
+	Next(time.Time) time.Time
+}
+
+// ParseSchedule parses a standard 5-field cron expression (e.g. "30 2 * * 1-5", see github.com/robfig/cron for the
+// syntax), one of the "@hourly", "@daily", "@weekly", "@monthly", or "@yearly" shorthands, or "@every <duration>"
+// (e.g. "@every 6h").
+func ParseSchedule(expr string) (Schedule, error) {
+	schedule, err := cron.ParseStandard(strings.TrimSpace(expr))
+	if err != nil {
+		return nil, fmt.Errorf("bad schedule %q: %w", expr, err)
+	}
+	if every, ok := schedule.(cron.ConstantDelaySchedule); ok && every.Delay < time.Minute {
+		return nil, fmt.Errorf("bad schedule %q: the interval must be at least one minute", expr)
+	}
+	return schedule, nil
+}
@@ -0,0 +1,154 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSchedule(t *testing.T) {
+	// a wednesday
+	now := time.Date(2022, time.January, 5, 10, 17, 30, 0, time.UTC)
+
+	tests := []struct {
+		expr     string
+		expected time.Time
+		wantErr  require.ErrorAssertionFunc
+	}{
+		{
+			expr:     "@every 6h",
+			expected: now.Add(6 * time.Hour),
+		},
+		{
+			expr:     "@hourly",
+			expected: time.Date(2022, time.January, 5, 11, 0, 0, 0, time.UTC),
+		},
+		{
+			expr:     "@daily",
+			expected: time.Date(2022, time.January, 6, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			expr:     "@weekly",
+			expected: time.Date(2022, time.January, 9, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			expr:     "@monthly",
+			expected: time.Date(2022, time.February, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			expr:     "*/15 * * * *",
+			expected: time.Date(2022, time.January, 5, 10, 30, 0, 0, time.UTC),
+		},
+		{
+			expr:     "30 2 * * 1-5",
+			expected: time.Date(2022, time.January, 6, 2, 30, 0, 0, time.UTC),
+		},
+		{
+			expr:     "0 0 * * SUN",
+			expected: time.Date(2022, time.January, 9, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			// the 10th, or any friday
+			expr:     "0 12 10 * 5",
+			expected: time.Date(2022, time.January, 7, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			// the 5th (today, but already past), or any monday
+			expr:     "0 9 5 * 1",
+			expected: time.Date(2022, time.January, 10, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			// an unrestricted day-of-week does not widen the day-of-month match
+			expr:     "0 0 1 * *",
+			expected: time.Date(2022, time.February, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			// a stepped day-of-month is restricted, so a day matching either day field activates (the 1st, 11th, 21st,
+			// 31st, or any monday)
+			expr:     "0 0 */10 * 1",
+			expected: time.Date(2022, time.January, 10, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			// a step over a range
+			expr:     "10-40/20 * * * *",
+			expected: time.Date(2022, time.January, 5, 10, 30, 0, 0, time.UTC),
+		},
+		{
+			// a step from a starting value
+			expr:     "7/20 * * * *",
+			expected: time.Date(2022, time.January, 5, 10, 27, 0, 0, time.UTC),
+		},
+		{
+			// the range wraps to the next day
+			expr:     "0 1-3 * * *",
+			expected: time.Date(2022, time.January, 6, 1, 0, 0, 0, time.UTC),
+		},
+		{
+			// the last day of a month that has it
+			expr:     "0 0 31 * *",
+			expected: time.Date(2022, time.January, 31, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			expr:     "0 0 29 2 *",
+			expected: time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			expr:     "@yearly",
+			expected: time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			expr:     "0 0 1,15 3 *",
+			expected: time.Date(2022, time.March, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			expr:    "@every 10s",
+			wantErr: require.Error,
+		},
+		{
+			expr:    "@every daily",
+			wantErr: require.Error,
+		},
+		{
+			expr:    "0 0 * *",
+			wantErr: require.Error,
+		},
+		{
+			expr:    "60 * * * *",
+			wantErr: require.Error,
+		},
+		{
+			expr:    "*/0 * * * *",
+			wantErr: require.Error,
+		},
+		{
+			expr:    "5-1 * * * *",
+			wantErr: require.Error,
+		},
+		{
+			// sunday is 0 (or SUN)
+			expr:    "0 0 * * 7",
+			wantErr: require.Error,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.expr, func(t *testing.T) {
+			if test.wantErr == nil {
+				test.wantErr = require.NoError
+			}
+			schedule, err := ParseSchedule(test.expr)
+			test.wantErr(t, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, test.expected, schedule.Next(now))
+		})
+	}
+}
+
+func TestParseSchedule_NeverActivates(t *testing.T) {
+	schedule, err := ParseSchedule("0 0 30 2 *")
+	require.NoError(t, err)
+	assert.True(t, schedule.Next(time.Now()).IsZero())
+}
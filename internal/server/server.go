@@ -0,0 +1,178 @@
+/*
+Package server provides the HTTP API of "syft serve": on-demand scans of any source accepted by the packages command,
+scheduled rescans of configured targets, and Prometheus metrics about all scans for capacity planning.
+*/
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/anchore/syft/internal/formats"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/format"
+	"github.com/anchore/syft/syft/sbom"
+)
+
+// ScanFunc catalogs the given user input, giving up once the context is done (e.g. when the client disconnected).
+type ScanFunc func(ctx context.Context, userInput string) (*sbom.SBOM, error)
+
+// Config describes how many scans run at once, and who may request scans of which inputs.
+type Config struct {
+	Workers    int                          // the max number of scans to run at once (further requests are queued)
+	Token      string                       // the bearer token required to request scans (empty = no authentication)
+	AllowInput func(userInput string) error // rejects inputs that may not be scanned on request (nil = any input)
+}
+
+// ScanRequest is the body of a request to scan a source.
+type ScanRequest struct {
+	Input  string `json:"input"`            // any input accepted by the packages command (e.g. "alpine:3.15")
+	Output string `json:"output,omitempty"` // the SBOM format to respond with (default is syft-json)
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// Server handles scan requests, running at most a fixed number of scans at once (further requests are queued).
+type Server struct {
+	scan       ScanFunc
+	token      string
+	allowInput func(string) error
+	workers    chan struct{}
+	metrics    *Metrics
+}
+
+func New(scan ScanFunc, cfg Config, metrics *Metrics) *Server {
+	workers := cfg.Workers
+	if workers < 1 {
+		workers = 1
+	}
+	return &Server{
+		scan:       scan,
+		token:      cfg.Token,
+		allowInput: cfg.AllowInput,
+		workers:    make(chan struct{}, workers),
+		metrics:    metrics,
+	}
+}
+
+// Handler returns the HTTP handler for all server endpoints.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/scan", s.handleScan)
+	mux.HandleFunc("/metrics", s.handleMetrics)
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	return mux
+}
+
+func (s *Server) handleScan(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+		return
+	}
+	if !s.authorized(r) {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		writeError(w, http.StatusUnauthorized, fmt.Errorf("a valid bearer token is required"))
+		return
+	}
+
+	var request ScanRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("unable to parse scan request: %w", err))
+		return
+	}
+	if request.Input == "" {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("no input given to scan"))
+		return
+	}
+	if s.allowInput != nil {
+		if err := s.allowInput(request.Input); err != nil {
+			writeError(w, http.StatusForbidden, err)
+			return
+		}
+	}
+
+	option := format.JSONOption
+	if request.Output != "" {
+		option = format.ParseOption(request.Output)
+	}
+	f := formats.ByOption(option)
+	if f == nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("bad output format: %q", request.Output))
+		return
+	}
+
+	result, err := s.run(r.Context(), request.Input)
+	if err != nil {
+		log.Warnf("scan of %q failed: %+v", request.Input, err)
+		writeError(w, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := f.Encode(&buf, *result); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	if _, err := buf.WriteTo(w); err != nil {
+		log.Warnf("unable to write scan response: %+v", err)
+	}
+}
+
+// authorized indicates if the request carries the bearer token of the server (when one is required).
+func (s *Server) authorized(r *http.Request) bool {
+	if s.token == "" {
+		return true
+	}
+	header := r.Header.Get("Authorization")
+	if !strings.HasPrefix(header, "Bearer ") {
+		return false
+	}
+	token := strings.TrimPrefix(header, "Bearer ")
+	return subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) == 1
+}
+
+// run scans the given user input once a worker is available (or until the context is done), recording the scan in
+// the metrics. The scan itself gives up once the context is done (e.g. when the client of a scan request disconnected).
+func (s *Server) run(ctx context.Context, userInput string) (*sbom.SBOM, error) {
+	s.metrics.ScanQueued()
+	select {
+	case s.workers <- struct{}{}:
+	case <-ctx.Done():
+		s.metrics.ScanDequeued()
+		return nil, ctx.Err()
+	}
+	defer func() { <-s.workers }()
+	s.metrics.ScanStarted()
+
+	log.Infof("scanning %q", userInput)
+	start := time.Now()
+	result, err := s.scan(ctx, userInput)
+	s.metrics.ScanFinished(time.Since(start), err)
+	return result, err
+}
+
+func (s *Server) handleMetrics(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	if _, err := s.metrics.WriteTo(w); err != nil {
+		log.Warnf("unable to write metrics: %+v", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(errorResponse{Error: err.Error()}); err != nil {
+		log.Warnf("unable to write error response: %+v", err)
+	}
+}
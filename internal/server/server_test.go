@@ -0,0 +1,193 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/anchore/syft/syft/source"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSBOM(path string, packageNames ...string) *sbom.SBOM {
+	catalog := pkg.NewCatalog()
+	for _, name := range packageNames {
+		p := pkg.Package{Name: name, Version: "1.0"}
+		p.SetID()
+		catalog.Add(p)
+	}
+	return &sbom.SBOM{
+		Artifacts: sbom.Artifacts{PackageCatalog: catalog},
+		Source:    source.Metadata{Scheme: source.DirectoryScheme, Path: path},
+	}
+}
+
+func TestServer_Scan(t *testing.T) {
+	scan := func(_ context.Context, userInput string) (*sbom.SBOM, error) {
+		if userInput == "bad" {
+			return nil, errors.New("unable to scan")
+		}
+		return newTestSBOM(userInput, "musl"), nil
+	}
+	cfg := Config{
+		Workers: 1,
+		Token:   "secret",
+		AllowInput: func(userInput string) error {
+			if strings.HasPrefix(userInput, "host:") {
+				return fmt.Errorf("scanning %q is not allowed", userInput)
+			}
+			return nil
+		},
+	}
+
+	srv := httptest.NewServer(New(scan, cfg, NewMetrics()).Handler())
+	defer srv.Close()
+
+	tests := []struct {
+		name           string
+		method         string
+		token          *string // the token to authenticate with (default is the server token)
+		body           string
+		expectedStatus int
+		expectedBody   string // a substring of the response
+	}{
+		{
+			name:           "missing token",
+			method:         http.MethodPost,
+			token:          strRef(""),
+			body:           `{"input": "/some/path"}`,
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "wrong token",
+			method:         http.MethodPost,
+			token:          strRef("guess"),
+			body:           `{"input": "/some/path"}`,
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "disallowed input",
+			method:         http.MethodPost,
+			body:           `{"input": "host:"}`,
+			expectedStatus: http.StatusForbidden,
+			expectedBody:   `not allowed`,
+		},
+		{
+			name:           "default format",
+			method:         http.MethodPost,
+			body:           `{"input": "/some/path"}`,
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"artifactRelationships"`,
+		},
+		{
+			name:           "requested format",
+			method:         http.MethodPost,
+			body:           `{"input": "/some/path", "output": "spdx-json"}`,
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"spdxVersion"`,
+		},
+		{
+			name:           "scan failure",
+			method:         http.MethodPost,
+			body:           `{"input": "bad"}`,
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedBody:   `{"error":"unable to scan"}`,
+		},
+		{
+			name:           "missing input",
+			method:         http.MethodPost,
+			body:           `{}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "bad format",
+			method:         http.MethodPost,
+			body:           `{"input": "alpine:3.15", "output": "nope"}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "wrong method",
+			method:         http.MethodGet,
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req, err := http.NewRequest(test.method, srv.URL+"/scan", strings.NewReader(test.body))
+			require.NoError(t, err)
+			token := cfg.Token
+			if test.token != nil {
+				token = *test.token
+			}
+			if token != "" {
+				req.Header.Set("Authorization", "Bearer "+token)
+			}
+
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			body, err := ioutil.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expectedStatus, resp.StatusCode)
+			if test.expectedBody != "" {
+				assert.Contains(t, string(body), test.expectedBody)
+			}
+		})
+	}
+
+	resp, err := http.Get(srv.URL + "/metrics")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), `syft_scans_total{status="success"} 2`)
+	assert.Contains(t, string(body), `syft_scans_total{status="failure"} 1`)
+}
+
+func TestServer_ScanCancelledWithRequest(t *testing.T) {
+	started := make(chan struct{})
+	cancelled := make(chan struct{})
+	scan := func(ctx context.Context, userInput string) (*sbom.SBOM, error) {
+		close(started)
+		<-ctx.Done()
+		close(cancelled)
+		return nil, ctx.Err()
+	}
+
+	srv := httptest.NewServer(New(scan, Config{Workers: 1}, NewMetrics()).Handler())
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, srv.URL+"/scan", strings.NewReader(`{"input": "/some/path"}`))
+	require.NoError(t, err)
+
+	go func() {
+		<-started
+		// the client disconnects while the scan is running
+		cancel()
+	}()
+	_, err = http.DefaultClient.Do(req)
+	assert.Error(t, err)
+
+	select {
+	case <-cancelled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the scan was not cancelled along with the request")
+	}
+}
+
+func strRef(s string) *string {
+	return &s
+}
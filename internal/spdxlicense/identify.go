@@ -0,0 +1,42 @@
+package spdxlicense
+
+import (
+	"strings"
+)
+
+// licenseTextPhrases are distinctive (lowercase, whitespace-normalized) phrases found in the full text of common
+// permissive licenses. Entries are checked in order, so more specific licenses must come before more general ones.
+var licenseTextPhrases = []struct {
+	id      string
+	phrases []string
+}{
+	{id: "Apache-2.0", phrases: []string{"apache license", "version 2.0"}},
+	{id: "MPL-2.0", phrases: []string{"mozilla public license", "version 2.0"}},
+	{id: "Unlicense", phrases: []string{"this is free and unencumbered software released into the public domain"}},
+	{id: "ISC", phrases: []string{"permission to use, copy, modify, and", "distribute this software for any purpose with or without fee is hereby granted"}},
+	{id: "MIT", phrases: []string{"permission is hereby granted, free of charge, to any person obtaining a copy"}},
+	{id: "BSD-3-Clause", phrases: []string{"redistribution and use in source and binary forms", "neither the name of"}},
+	{id: "BSD-2-Clause", phrases: []string{"redistribution and use in source and binary forms"}},
+}
+
+// IDFromText identifies the SPDX license ID of the given license file contents (e.g. a LICENSE file). Only a small set
+// of common permissive licenses is recognized; texts that are ambiguous (such as the GPL family, where the text does
+// not say whether "or later" applies) are not identified.
+func IDFromText(text string) (string, bool) {
+	normalized := strings.ToLower(strings.Join(strings.Fields(text), " "))
+	for _, candidate := range licenseTextPhrases {
+		if containsAll(normalized, candidate.phrases) {
+			return candidate.id, true
+		}
+	}
+	return "", false
+}
+
+func containsAll(s string, substrs []string) bool {
+	for _, substr := range substrs {
+		if !strings.Contains(s, substr) {
+			return false
+		}
+	}
+	return true
+}
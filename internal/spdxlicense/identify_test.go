@@ -0,0 +1,66 @@
+package spdxlicense
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIDFromText(t *testing.T) {
+	var tests = []struct {
+		name     string
+		text     string
+		expected string
+	}{
+		{
+			name: "apache",
+			text: `
+                                 Apache License
+                           Version 2.0, January 2004
+                        http://www.apache.org/licenses/`,
+			expected: "Apache-2.0",
+		},
+		{
+			name: "mit",
+			text: `MIT License
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software")...`,
+			expected: "MIT",
+		},
+		{
+			name: "bsd 3 clause",
+			text: `Redistribution and use in source and binary forms, with or without
+modification, are permitted provided that the following conditions are met:
+...
+   * Neither the name of Google Inc. nor the names of its
+contributors may be used to endorse or promote products derived from`,
+			expected: "BSD-3-Clause",
+		},
+		{
+			name: "bsd 2 clause",
+			text: `Redistribution and use in source and binary forms, with or without
+modification, are permitted provided that the following conditions are met:`,
+			expected: "BSD-2-Clause",
+		},
+		{
+			name: "isc",
+			text: `Permission to use, copy, modify, and/or distribute this software for any
+purpose with or without fee is hereby granted, provided that the above`,
+			expected: "ISC",
+		},
+		{
+			name: "gpl is ambiguous",
+			text: `GNU GENERAL PUBLIC LICENSE
+Version 3, 29 June 2007`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			id, ok := IDFromText(test.text)
+			assert.Equal(t, test.expected != "", ok)
+			assert.Equal(t, test.expected, id)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package telemetry
+
+import (
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// LogHook is a logrus hook that records log entries as events on the span held by the root context, so that
+// warnings and errors raised during a scan are visible alongside the trace.
+type LogHook struct {
+	levels []logrus.Level
+}
+
+// NewLogHook creates a LogHook that records entries at the given level or more severe.
+func NewLogHook(level logrus.Level) *LogHook {
+	var levels []logrus.Level
+	for _, l := range logrus.AllLevels {
+		if l <= level {
+			levels = append(levels, l)
+		}
+	}
+	return &LogHook{levels: levels}
+}
+
+func (h *LogHook) Levels() []logrus.Level {
+	return h.levels
+}
+
+func (h *LogHook) Fire(entry *logrus.Entry) error {
+	span := trace.SpanFromContext(rootContext)
+	if !span.IsRecording() {
+		return nil
+	}
+
+	attrs := []attribute.KeyValue{
+		attribute.String("log.severity", entry.Level.String()),
+		attribute.String("log.message", entry.Message),
+	}
+	for k, v := range entry.Data {
+		if s, ok := v.(string); ok {
+			attrs = append(attrs, attribute.String("log."+k, s))
+		}
+	}
+
+	span.AddEvent("log", trace.WithAttributes(attrs...), trace.WithTimestamp(entry.Time))
+	return nil
+}
@@ -0,0 +1,62 @@
+package telemetry
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestLogHook(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	ctx, span := provider.Tracer(InstrumentationName).Start(RootContext(), "packages")
+	SetRootContext(ctx)
+	t.Cleanup(func() {
+		SetRootContext(nil)
+	})
+
+	logger := logrus.New()
+	logger.SetOutput(ioutil.Discard)
+	logger.SetLevel(logrus.DebugLevel)
+	logger.AddHook(NewLogHook(logrus.WarnLevel))
+
+	logger.Debug("not recorded")
+	logger.WithField("from-lib", "stereoscope").Warn("recorded warning")
+	logger.Error("recorded error")
+	span.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+
+	var messages []string
+	for _, event := range spans[0].Events() {
+		assert.Equal(t, "log", event.Name)
+		for _, kv := range event.Attributes {
+			if kv.Key == "log.message" {
+				messages = append(messages, kv.Value.AsString())
+			}
+		}
+		if len(messages) == 1 {
+			assert.Contains(t, event.Attributes, attribute.String("log.from-lib", "stereoscope"))
+		}
+	}
+	assert.Equal(t, []string{"recorded warning", "recorded error"}, messages)
+}
+
+func TestLogHook_NoRootSpan(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(ioutil.Discard)
+	logger.AddHook(NewLogHook(logrus.WarnLevel))
+
+	// there is no recording span; this should be a no-op
+	assert.NotPanics(t, func() {
+		logger.Error("ignored")
+	})
+}
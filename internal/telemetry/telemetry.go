@@ -0,0 +1,49 @@
+/*
+Package telemetry provides access to a singleton root tracing context (provided by the calling application). Spans
+started with this package are children of the root context; when the application has not configured an OpenTelemetry
+tracer provider all spans are no-ops, so the library behaves no differently than if tracing had been enabled.
+*/
+package telemetry
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// InstrumentationName identifies syft as the source of all emitted spans.
+const InstrumentationName = "github.com/anchore/syft"
+
+var rootContext = context.Background()
+
+// SetRootContext sets the context that all spans are parented to (typically one that holds a span for the entire
+// command invocation).
+func SetRootContext(ctx context.Context) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	rootContext = ctx
+}
+
+// RootContext returns the context that all spans are parented to.
+func RootContext() context.Context {
+	return rootContext
+}
+
+// StartSpan starts a new span as a child of the root context. The caller must call End() on the returned span.
+func StartSpan(name string, attrs ...attribute.KeyValue) trace.Span {
+	_, span := otel.Tracer(InstrumentationName).Start(rootContext, name, trace.WithAttributes(attrs...))
+	return span
+}
+
+// EndSpan ends the given span, recording the error (if any) as the span status.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
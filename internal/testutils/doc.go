@@ -0,0 +1,4 @@
+/*
+Package testutils provides helpers shared by the tests of several packages (fixtures and keys).
+*/
+package testutils
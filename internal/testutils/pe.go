@@ -0,0 +1,86 @@
+package testutils
+
+import (
+	"bytes"
+	"debug/pe"
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// WritePEFixture writes a minimal PE32+ binary with an import table that references one symbol from each given DLL.
+func WritePEFixture(t *testing.T, path string, dlls ...string) {
+	t.Helper()
+
+	const (
+		fileAlignment = 0x200
+		sectionRVA    = 0x1000
+		thunkSize     = 16 // one 8-byte lookup entry + 8-byte terminator
+		hintNameSize  = 32
+		dllNameSize   = 64
+	)
+
+	// lay out the .idata section: descriptors, lookup tables, hint/name entries, then DLL names
+	n := len(dlls)
+	descriptorsSize := (n + 1) * 20
+	thunkOffset := func(i int) int { return descriptorsSize + i*thunkSize }
+	hintNameOffset := func(i int) int { return descriptorsSize + n*thunkSize + i*hintNameSize }
+	dllNameOffset := func(i int) int { return descriptorsSize + n*(thunkSize+hintNameSize) + i*dllNameSize }
+
+	section := make([]byte, fileAlignment)
+	for i, dll := range dlls {
+		descriptor := section[i*20:]
+		binary.LittleEndian.PutUint32(descriptor[0:], uint32(sectionRVA+thunkOffset(i)))
+		binary.LittleEndian.PutUint32(descriptor[12:], uint32(sectionRVA+dllNameOffset(i)))
+		binary.LittleEndian.PutUint32(descriptor[16:], uint32(sectionRVA+thunkOffset(i)))
+		binary.LittleEndian.PutUint64(section[thunkOffset(i):], uint64(sectionRVA+hintNameOffset(i)))
+		copy(section[hintNameOffset(i)+2:], "Func")
+		copy(section[dllNameOffset(i):], dll)
+	}
+
+	var importDir pe.DataDirectory
+	if n > 0 {
+		importDir = pe.DataDirectory{VirtualAddress: sectionRVA, Size: uint32(descriptorsSize)}
+	}
+
+	optionalHeader := pe.OptionalHeader64{
+		Magic:               0x20b,
+		SectionAlignment:    0x1000,
+		FileAlignment:       fileAlignment,
+		SizeOfImage:         0x2000,
+		SizeOfHeaders:       fileAlignment,
+		NumberOfRvaAndSizes: 16,
+	}
+	optionalHeader.DataDirectory[pe.IMAGE_DIRECTORY_ENTRY_IMPORT] = importDir
+
+	sectionHeader := pe.SectionHeader32{
+		VirtualSize:      fileAlignment,
+		VirtualAddress:   sectionRVA,
+		SizeOfRawData:    fileAlignment,
+		PointerToRawData: fileAlignment,
+	}
+	copy(sectionHeader.Name[:], ".idata")
+
+	buf := &bytes.Buffer{}
+	dosHeader := make([]byte, 0x40)
+	copy(dosHeader, "MZ")
+	binary.LittleEndian.PutUint32(dosHeader[0x3c:], 0x40)
+	buf.Write(dosHeader)
+	buf.WriteString("PE\x00\x00")
+	require.NoError(t, binary.Write(buf, binary.LittleEndian, pe.FileHeader{
+		Machine:              pe.IMAGE_FILE_MACHINE_AMD64,
+		NumberOfSections:     1,
+		SizeOfOptionalHeader: uint16(binary.Size(optionalHeader)),
+	}))
+	require.NoError(t, binary.Write(buf, binary.LittleEndian, optionalHeader))
+	require.NoError(t, binary.Write(buf, binary.LittleEndian, sectionHeader))
+	buf.Write(make([]byte, fileAlignment-buf.Len()))
+	buf.Write(section)
+
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(t, ioutil.WriteFile(path, buf.Bytes(), 0644))
+}
@@ -0,0 +1,42 @@
+package ui
+
+import (
+	"os"
+	"strings"
+)
+
+// ciEnvVars are set by CI services, which capture stderr to a log file (even when allocating a pseudo-terminal), where
+// the cursor movements of the ETUI would show up as noise.
+var ciEnvVars = []string{
+	"CI",
+	"CONTINUOUS_INTEGRATION",
+	"BUILD_NUMBER",
+	"GITHUB_ACTIONS",
+	"GITLAB_CI",
+	"JENKINS_URL",
+	"TF_BUILD",
+	"BUILDKITE",
+	"CIRCLECI",
+	"TEAMCITY_VERSION",
+}
+
+// isCI indicates if the application is running within a CI environment.
+func isCI(getenv func(string) string) bool {
+	for _, name := range ciEnvVars {
+		value := strings.ToLower(strings.TrimSpace(getenv(name)))
+		if value != "" && value != "false" && value != "0" {
+			return true
+		}
+	}
+	return false
+}
+
+// isDumbTerminal indicates if the terminal is unable to interpret the cursor movements used by the ETUI.
+func isDumbTerminal(getenv func(string) string) bool {
+	return getenv("TERM") == "dumb"
+}
+
+// supportsProgress indicates if the interactive progress display (ETUI) may be used in the current environment.
+func supportsProgress() bool {
+	return !isCI(os.Getenv) && !isDumbTerminal(os.Getenv)
+}
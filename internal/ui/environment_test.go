@@ -0,0 +1,45 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsCI(t *testing.T) {
+	tests := []struct {
+		name     string
+		env      map[string]string
+		expected bool
+	}{
+		{
+			name: "no CI",
+			env:  map[string]string{"TERM": "xterm-256color"},
+		},
+		{
+			name:     "generic CI",
+			env:      map[string]string{"CI": "true"},
+			expected: true,
+		},
+		{
+			name:     "jenkins",
+			env:      map[string]string{"JENKINS_URL": "https://jenkins.example.com/"},
+			expected: true,
+		},
+		{
+			name: "explicitly disabled",
+			env:  map[string]string{"CI": "false"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			getenv := func(name string) string { return test.env[name] }
+			assert.Equal(t, test.expected, isCI(getenv))
+		})
+	}
+}
+
+func TestIsDumbTerminal(t *testing.T) {
+	assert.True(t, isDumbTerminal(func(string) string { return "dumb" }))
+	assert.False(t, isDumbTerminal(func(string) string { return "xterm" }))
+}
@@ -55,6 +55,11 @@ func NewEphemeralTerminalUI() UI {
 
 func (h *ephemeralTerminalUI) Setup(unsubscribe func() error) error {
 	h.unsubscribe = unsubscribe
+
+	// the screen is opened before anything is written to the terminal, so that a fallback UI is left with a clean state
+	if err := h.openScreen(); err != nil {
+		return err
+	}
 	hideCursor(h.uiOutput)
 
 	// prep the logger to not clobber the screen from now on (logrus only)
@@ -64,7 +69,7 @@ func (h *ephemeralTerminalUI) Setup(unsubscribe func() error) error {
 		logWrapper.Logger.SetOutput(h.logBuffer)
 	}
 
-	return h.openScreen()
+	return nil
 }
 
 func (h *ephemeralTerminalUI) Handle(event partybus.Event) error {
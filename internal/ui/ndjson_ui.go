@@ -0,0 +1,166 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/anchore/syft/internal/log"
+	syftEvent "github.com/anchore/syft/syft/event"
+	syftEventParsers "github.com/anchore/syft/syft/event/parsers"
+	"github.com/wagoodman/go-partybus"
+)
+
+// StderrEvents is the events destination that streams events to stderr (instead of a file).
+const StderrEvents = "-"
+
+// streamedEvent is a single line of the NDJSON event stream.
+type streamedEvent struct {
+	Type          string            `json:"type"`
+	Cataloger     string            `json:"cataloger,omitempty"`
+	Package       *streamedPackage  `json:"package,omitempty"`
+	Path          string            `json:"path,omitempty"`
+	FileSystemID  string            `json:"layerID,omitempty"`
+	Class         string            `json:"class,omitempty"`
+	Metadata      map[string]string `json:"metadata,omitempty"`
+	Packages      *int              `json:"packages,omitempty"`
+	Relationships *int              `json:"relationships,omitempty"`
+	Error         string            `json:"error,omitempty"`
+}
+
+type streamedPackage struct {
+	ID        string   `json:"id"`
+	Name      string   `json:"name"`
+	Version   string   `json:"version"`
+	Type      string   `json:"type"`
+	PURL      string   `json:"purl,omitempty"`
+	Locations []string `json:"locations"`
+}
+
+type ndjsonUI struct {
+	destination string
+	out         io.Writer
+	closer      io.Closer
+	enc         *json.Encoder
+	unsubscribe func() error
+}
+
+// NewNDJSONUI streams the cataloging progress (packages discovered, files classified, and catalogers finished) as
+// newline-delimited JSON events to the given file (or to stderr, see StderrEvents), and writes the final report like
+// the logger UI.
+func NewNDJSONUI(destination string) UI {
+	return &ndjsonUI{
+		destination: destination,
+	}
+}
+
+func (u *ndjsonUI) Setup(unsubscribe func() error) error {
+	u.unsubscribe = unsubscribe
+
+	if u.destination == StderrEvents {
+		u.out = os.Stderr
+	} else {
+		f, err := os.Create(u.destination)
+		if err != nil {
+			return fmt.Errorf("unable to create events file: %w", err)
+		}
+		u.out = f
+		u.closer = f
+	}
+
+	u.enc = json.NewEncoder(u.out)
+	u.enc.SetEscapeHTML(false)
+	return nil
+}
+
+func (u *ndjsonUI) Handle(event partybus.Event) error {
+	e, err := toStreamedEvent(event)
+	if err != nil {
+		log.Warnf("unable to stream event: %+v", err)
+		return nil
+	}
+	if e != nil {
+		if err := u.enc.Encode(e); err != nil {
+			return fmt.Errorf("unable to write event: %w", err)
+		}
+	}
+
+	if event.Type != syftEvent.Exit {
+		return nil
+	}
+
+	if err := handleExit(event); err != nil {
+		log.Warnf("unable to show catalog image finished event: %+v", err)
+	}
+
+	// this is the last expected event, stop listening to events
+	return u.unsubscribe()
+}
+
+func (u *ndjsonUI) Teardown(_ bool) error {
+	if u.closer != nil {
+		return u.closer.Close()
+	}
+	return nil
+}
+
+// toStreamedEvent returns the line to stream for the given event, or nil when the event is not streamed.
+func toStreamedEvent(event partybus.Event) (*streamedEvent, error) {
+	switch event.Type {
+	case syftEvent.PackageDiscovered:
+		catalogerName, p, err := syftEventParsers.ParsePackageDiscovered(event)
+		if err != nil {
+			return nil, err
+		}
+		var locations []string
+		for _, l := range p.Locations {
+			locations = append(locations, l.RealPath)
+		}
+		return &streamedEvent{
+			Type:      "package-discovered",
+			Cataloger: catalogerName,
+			Package: &streamedPackage{
+				ID:        string(p.ID()),
+				Name:      p.Name,
+				Version:   p.Version,
+				Type:      string(p.Type),
+				PURL:      p.PURL,
+				Locations: locations,
+			},
+		}, nil
+
+	case syftEvent.FileClassified:
+		coordinates, classification, err := syftEventParsers.ParseFileClassified(event)
+		if err != nil {
+			return nil, err
+		}
+		return &streamedEvent{
+			Type:         "file-classified",
+			Path:         coordinates.RealPath,
+			FileSystemID: coordinates.FileSystemID,
+			Class:        classification.Class,
+			Metadata:     classification.Metadata,
+		}, nil
+
+	case syftEvent.CatalogerFinished:
+		result, err := syftEventParsers.ParseCatalogerFinished(event)
+		if err != nil {
+			return nil, err
+		}
+		e := &streamedEvent{
+			Type:          "cataloger-finished",
+			Cataloger:     result.Cataloger,
+			Packages:      &result.Packages,
+			Relationships: &result.Relationships,
+		}
+		if result.Err != nil {
+			e.Error = result.Err.Error()
+		}
+		return e, nil
+
+	case syftEvent.Exit:
+		return &streamedEvent{Type: "finished"}, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,75 @@
+package ui
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	syftEvent "github.com/anchore/syft/syft/event"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger"
+	"github.com/anchore/syft/syft/source"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wagoodman/go-partybus"
+)
+
+func TestNDJSONUI(t *testing.T) {
+	p := pkg.Package{
+		Name:      "musl",
+		Version:   "1.2.2-r7",
+		Type:      pkg.ApkPkg,
+		PURL:      "pkg:alpine/musl@1.2.2-r7",
+		Locations: []source.Location{source.NewLocation("/lib/apk/db/installed")},
+	}
+	p.SetID()
+
+	events := []partybus.Event{
+		{Type: syftEvent.PackageCatalogerStarted},
+		{Type: syftEvent.PackageDiscovered, Source: "apkdb-cataloger", Value: p},
+		{Type: syftEvent.CatalogerFinished, Source: "apkdb-cataloger", Value: cataloger.Result{Cataloger: "apkdb-cataloger", Packages: 1}},
+		{Type: syftEvent.CatalogerFinished, Source: "go-module-binary-cataloger", Value: cataloger.Result{Cataloger: "go-module-binary-cataloger", Err: errors.New("bad binary")}},
+		{
+			Type:   syftEvent.FileClassified,
+			Source: source.Coordinates{RealPath: "/usr/bin/python3.9", FileSystemID: "sha256:abc"},
+			Value:  file.Classification{Class: "python-binary", Metadata: map[string]string{"version": "3.9.2"}},
+		},
+	}
+
+	destination := filepath.Join(t.TempDir(), "events.ndjson")
+	var reported bool
+	var unsubscribed bool
+
+	u := NewNDJSONUI(destination)
+	require.NoError(t, u.Setup(func() error {
+		unsubscribed = true
+		return nil
+	}))
+	for _, e := range events {
+		require.NoError(t, u.Handle(e))
+	}
+	require.NoError(t, u.Handle(partybus.Event{
+		Type: syftEvent.Exit,
+		Value: func() error {
+			reported = true
+			return nil
+		},
+	}))
+	require.NoError(t, u.Teardown(false))
+
+	assert.True(t, reported)
+	assert.True(t, unsubscribed)
+
+	contents, err := ioutil.ReadFile(destination)
+	require.NoError(t, err)
+
+	expected := `{"type":"package-discovered","cataloger":"apkdb-cataloger","package":{"id":"` + string(p.ID()) + `","name":"musl","version":"1.2.2-r7","type":"apk","purl":"pkg:alpine/musl@1.2.2-r7","locations":["/lib/apk/db/installed"]}}
+{"type":"cataloger-finished","cataloger":"apkdb-cataloger","packages":1,"relationships":0}
+{"type":"cataloger-finished","cataloger":"go-module-binary-cataloger","packages":0,"relationships":0,"error":"bad binary"}
+{"type":"file-classified","path":"/usr/bin/python3.9","layerID":"sha256:abc","class":"python-binary","metadata":{"version":"3.9.2"}}
+{"type":"finished"}
+`
+	assert.Equal(t, expected, string(contents))
+}
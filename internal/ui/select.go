@@ -15,16 +15,18 @@ import (
 // is intended to be used and the UIs that follow are meant to be attempted only in a fallback posture when there
 // are environmental problems (e.g. cannot write to the terminal). A writer is provided to capture the output of
 // the final SBOM report.
-func Select(verbose, quiet bool) (uis []UI) {
-	isStdoutATty := term.IsTerminal(int(os.Stdout.Fd()))
+//
+// The ETUI is only drawn on a terminal attached to stderr (the report is always written to stdout, which may be
+// redirected), and never within CI environments, on dumb terminals, or when progress has been disabled by the user.
+func Select(verbose, quiet, noProgress bool) (uis []UI) {
 	isStderrATty := term.IsTerminal(int(os.Stderr.Fd()))
-	notATerminal := !isStderrATty && !isStdoutATty
 
 	switch {
-	case runtime.GOOS == "windows" || verbose || quiet || notATerminal || !isStderrATty:
+	case runtime.GOOS == "windows" || verbose || quiet || noProgress || !isStderrATty || !supportsProgress():
 		uis = append(uis, NewLoggerUI())
 	default:
-		uis = append(uis, NewEphemeralTerminalUI())
+		// fall back to plain logging if the terminal cannot be set up for the ETUI
+		uis = append(uis, NewEphemeralTerminalUI(), NewLoggerUI())
 	}
 
 	return uis
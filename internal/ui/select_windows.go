@@ -8,6 +8,6 @@ package ui
 // is intended to be used and the UIs that follow are meant to be attempted only in a fallback posture when there
 // are environmental problems (e.g. cannot write to the terminal). A writer is provided to capture the output of
 // the final SBOM report.
-func Select(verbose, quiet bool) (uis []UI) {
+func Select(verbose, quiet, noProgress bool) (uis []UI) {
 	return append(uis, NewLoggerUI())
 }
@@ -0,0 +1,314 @@
+/*
+Package verify checks the cosign signatures and attestations of container images in a registry against a set of
+trusted public keys. This is performed without contacting a transparency log or certificate authority, so only
+key-based signatures (not keyless signatures) can be verified.
+*/
+package verify
+
+import (
+	"context"
+	"crypto"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/anchore/stereoscope/pkg/image"
+	"github.com/anchore/syft/internal/attest"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/internal/registry"
+	"github.com/anchore/syft/syft/source"
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+)
+
+const (
+	// SignatureAnnotation is the layer annotation holding the (base64 encoded) signature of a cosign signature layer.
+	SignatureAnnotation = "dev.cosignproject.cosign/signature"
+	// SignatureType is the type of the cosign "simple signing" payload.
+	SignatureType = "cosign container image signature"
+)
+
+// ErrNotAnImage is returned when the user input does not refer to an image that can be found in a registry.
+var ErrNotAnImage = errors.New("only images in a registry can be verified")
+
+// Policy describes what must be true about the signatures of an image for it to be trusted.
+type Policy struct {
+	Keys             []crypto.PublicKey // an image is trusted when it has a valid signature from any of these keys
+	AttestationTypes []string           // predicate types that must each have a valid attestation from any of the keys
+}
+
+// NewPolicy creates a policy trusting the public keys at the given paths (e.g. the cosign.pub file created by
+// "cosign generate-key-pair") or of the given KMS keys.
+func NewPolicy(keyPaths []string, attestationTypes []string) (Policy, error) {
+	var keys []crypto.PublicKey
+	for _, path := range keyPaths {
+		key, err := attest.LoadPublicKey(context.Background(), path)
+		if err != nil {
+			return Policy{}, fmt.Errorf("unable to load verification key %q: %w", path, err)
+		}
+		keys = append(keys, key)
+	}
+
+	return Policy{
+		Keys:             keys,
+		AttestationTypes: attestationTypes,
+	}, nil
+}
+
+// simpleSigning is the payload signed by "cosign sign".
+type simpleSigning struct {
+	Critical struct {
+		Identity struct {
+			DockerReference string `json:"docker-reference"`
+		} `json:"identity"`
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+		Type string `json:"type"`
+	} `json:"critical"`
+}
+
+// Reference returns the registry reference for the given user input (e.g. "registry:alpine:3.15" -> "alpine:3.15").
+func Reference(userInput string, registryOptions *image.RegistryOptions) (name.Reference, error) {
+	scheme, imageSource, location, err := source.DetectScheme(userInput)
+	if err != nil {
+		return nil, err
+	}
+
+	if scheme != source.ImageScheme {
+		return nil, fmt.Errorf("unable to verify %q: %w", userInput, ErrNotAnImage)
+	}
+
+	switch imageSource {
+	case image.OciRegistrySource, image.DockerDaemonSource:
+	default:
+		return nil, fmt.Errorf("unable to verify %q: %w", userInput, ErrNotAnImage)
+	}
+
+	ref, err := name.ParseReference(location, registry.ReferenceOptions(registryOptions)...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to verify %q: %w", userInput, ErrNotAnImage)
+	}
+	return ref, nil
+}
+
+// Verify checks the image in the registry against the given policy, returning a description of the signatures
+// found. An error is returned when the image does not satisfy the policy.
+func Verify(ctx context.Context, ref name.Reference, policy Policy, registryOptions *image.RegistryOptions) (*source.ImageVerification, error) {
+	if len(policy.Keys) == 0 {
+		return nil, fmt.Errorf("no keys configured to verify signatures with")
+	}
+
+	opts := append(registry.RemoteOptions(ref.Context().Registry, registryOptions), remote.WithContext(ctx))
+
+	desc, err := remote.Head(ref, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve image digest for %q: %w", ref.String(), err)
+	}
+	digest := desc.Digest
+
+	result := &source.ImageVerification{
+		Digest: digest.String(),
+	}
+
+	result.Signatures, err = verifySignatures(ref.Context(), digest, policy.Keys, opts)
+	if err != nil {
+		return result, err
+	}
+	if result.Signatures == 0 {
+		return result, fmt.Errorf("no valid signatures found for %q (digest %s)", ref.String(), digest)
+	}
+
+	result.AttestationTypes, err = verifyAttestations(ref.Context(), digest, policy.Keys, opts)
+	if err != nil {
+		return result, err
+	}
+
+	for _, required := range policy.AttestationTypes {
+		if !contains(result.AttestationTypes, required) {
+			return result, fmt.Errorf("no valid %q attestation found for %q (digest %s)", required, ref.String(), digest)
+		}
+	}
+
+	result.Verified = true
+	return result, nil
+}
+
+// verifySignatures returns the number of valid signatures (from "cosign sign") for the given image digest.
+func verifySignatures(repo name.Repository, digest v1.Hash, keys []crypto.PublicKey, opts []remote.Option) (int, error) {
+	layers, err := fetchLayers(repo.Tag(cosignTag(digest, "sig")), opts)
+	if err != nil {
+		return 0, err
+	}
+
+	var count int
+	for _, l := range layers {
+		sig, err := base64.StdEncoding.DecodeString(l.annotations[SignatureAnnotation])
+		if err != nil || len(sig) == 0 {
+			log.Debugf("skipping cosign signature layer without a signature (digest %s)", l.digest)
+			continue
+		}
+
+		if !verifiedByAny(keys, func(key crypto.PublicKey) error {
+			return attest.VerifySignature(key, l.contents, sig)
+		}) {
+			log.Debugf("skipping cosign signature not signed by a trusted key (digest %s)", l.digest)
+			continue
+		}
+
+		// the signature is only valid if it is about this image (and not replayed from another image)
+		var payload simpleSigning
+		if err := json.Unmarshal(l.contents, &payload); err != nil {
+			log.Debugf("skipping cosign signature with an unreadable payload (digest %s): %+v", l.digest, err)
+			continue
+		}
+		if payload.Critical.Type != SignatureType || payload.Critical.Image.DockerManifestDigest != digest.String() {
+			log.Debugf("skipping cosign signature for a different image (digest %s)", l.digest)
+			continue
+		}
+		count++
+	}
+	return count, nil
+}
+
+// verifyAttestations returns the predicate types of all valid attestations (from "cosign attest") for the given
+// image digest.
+func verifyAttestations(repo name.Repository, digest v1.Hash, keys []crypto.PublicKey, opts []remote.Option) ([]string, error) {
+	layers, err := fetchLayers(repo.Tag(cosignTag(digest, "att")), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var types []string
+	for _, l := range layers {
+		var envelope attest.Envelope
+		if err := json.Unmarshal(l.contents, &envelope); err != nil {
+			log.Debugf("skipping unreadable attestation (digest %s): %+v", l.digest, err)
+			continue
+		}
+
+		if envelope.PayloadType != attest.InTotoPayloadType || !verifiedByAny(keys, envelope.Verify) {
+			log.Debugf("skipping attestation not signed by a trusted key (digest %s)", l.digest)
+			continue
+		}
+
+		var statement attest.Statement
+		if err := json.Unmarshal(envelope.Payload, &statement); err != nil {
+			log.Debugf("skipping attestation with an unreadable statement (digest %s): %+v", l.digest, err)
+			continue
+		}
+		if !aboutDigest(statement, digest) {
+			log.Debugf("skipping attestation for a different image (digest %s)", l.digest)
+			continue
+		}
+		if !contains(types, statement.PredicateType) {
+			types = append(types, statement.PredicateType)
+		}
+	}
+
+	sort.Strings(types)
+	return types, nil
+}
+
+func aboutDigest(statement attest.Statement, digest v1.Hash) bool {
+	for _, subject := range statement.Subject {
+		if subject.Digest[digest.Algorithm] == digest.Hex {
+			return true
+		}
+	}
+	return false
+}
+
+type cosignLayer struct {
+	digest      v1.Hash
+	annotations map[string]string
+	contents    []byte
+}
+
+// fetchLayers returns all layers of the given cosign signature (or attestation) image. An image that has not been
+// signed has no such image, which is not an error.
+func fetchLayers(tag name.Tag, opts []remote.Option) ([]cosignLayer, error) {
+	img, err := remote.Image(tag, opts...)
+	if err != nil {
+		var terr *transport.Error
+		if errors.As(err, &terr) && terr.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to fetch %q: %w", tag.String(), err)
+	}
+
+	manifest, err := img.Manifest()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read manifest of %q: %w", tag.String(), err)
+	}
+
+	var layers []cosignLayer
+	for _, desc := range manifest.Layers {
+		layer, err := img.LayerByDigest(desc.Digest)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch layer %s of %q: %w", desc.Digest, tag.String(), err)
+		}
+
+		// cosign layers are stored as-is (not compressed), so the "compressed" contents are the payload itself
+		reader, err := layer.Compressed()
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch layer %s of %q: %w", desc.Digest, tag.String(), err)
+		}
+		contents, err := ioutil.ReadAll(reader)
+		_ = reader.Close()
+		if err != nil {
+			return nil, fmt.Errorf("unable to read layer %s of %q: %w", desc.Digest, tag.String(), err)
+		}
+
+		layers = append(layers, cosignLayer{
+			digest:      desc.Digest,
+			annotations: desc.Annotations,
+			contents:    contents,
+		})
+	}
+	return layers, nil
+}
+
+// cosignTag is the tag that cosign stores signatures ("sig") and attestations ("att") under for an image digest.
+func cosignTag(digest v1.Hash, suffix string) string {
+	return fmt.Sprintf("%s-%s.%s", digest.Algorithm, digest.Hex, suffix)
+}
+
+func verifiedByAny(keys []crypto.PublicKey, verify func(crypto.PublicKey) error) bool {
+	for _, key := range keys {
+		if err := verify(key); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// Matches indicates if the cataloged image is the same image that was verified in the registry (e.g. an image read
+// from the docker daemon could differ from the one in the registry with the same tag).
+func Matches(metadata source.ImageMetadata, verification source.ImageVerification) bool {
+	if metadata.ManifestDigest == verification.Digest {
+		return true
+	}
+	for _, repoDigest := range metadata.RepoDigests {
+		if strings.HasSuffix(repoDigest, "@"+verification.Digest) {
+			return true
+		}
+	}
+	return false
+}
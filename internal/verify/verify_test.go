@@ -0,0 +1,267 @@
+package verify
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/anchore/stereoscope/pkg/image"
+	"github.com/anchore/syft/internal/attest"
+	"github.com/anchore/syft/internal/testutils"
+	"github.com/anchore/syft/syft/source"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/sigstore/sigstore/pkg/signature"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testRegistry struct {
+	t    *testing.T
+	host string
+}
+
+func newTestRegistry(t *testing.T) testRegistry {
+	server := httptest.NewServer(registry.New(registry.Logger(log.New(ioutil.Discard, "", 0))))
+	t.Cleanup(server.Close)
+	return testRegistry{t: t, host: strings.TrimPrefix(server.URL, "http://")}
+}
+
+// push writes a random image to the given repository:tag, returning the image digest.
+func (r testRegistry) push(ref string) (name.Tag, v1.Hash) {
+	tag, err := name.NewTag(r.host+"/"+ref, name.Insecure)
+	require.NoError(r.t, err)
+
+	img, err := random.Image(512, 1)
+	require.NoError(r.t, err)
+	require.NoError(r.t, remote.Write(tag, img))
+
+	digest, err := img.Digest()
+	require.NoError(r.t, err)
+	return tag, digest
+}
+
+// sign writes a cosign signature for the given digest in the same layout as "cosign sign".
+func (r testRegistry) sign(tag name.Tag, digest v1.Hash, signedDigest v1.Hash, key crypto.Signer) {
+	payload := []byte(fmt.Sprintf(`{"critical":{"identity":{"docker-reference":%q},"image":{"docker-manifest-digest":%q},"type":%q},"optional":null}`,
+		tag.Context().String(), signedDigest.String(), SignatureType))
+
+	h := sha256.Sum256(payload)
+	sig, err := key.Sign(rand.Reader, h[:], crypto.SHA256)
+	require.NoError(r.t, err)
+
+	r.appendCosignLayer(tag, digest, "sig", payload, "application/vnd.dev.cosign.simplesigning.v1+json", map[string]string{
+		SignatureAnnotation: base64.StdEncoding.EncodeToString(sig),
+	})
+}
+
+// attest writes a cosign attestation for the given digest in the same layout as "cosign attest".
+func (r testRegistry) attest(tag name.Tag, digest v1.Hash, predicateType string, key crypto.Signer) {
+	statement, err := json.Marshal(attest.Statement{
+		Type:          attest.StatementType,
+		PredicateType: predicateType,
+		Subject: []attest.Subject{
+			{Name: tag.Context().String(), Digest: map[string]string{digest.Algorithm: digest.Hex}},
+		},
+		Predicate: json.RawMessage(`{}`),
+	})
+	require.NoError(r.t, err)
+
+	signer, err := signature.LoadSigner(key, crypto.SHA256)
+	require.NoError(r.t, err)
+	envelope, err := attest.Sign(attest.InTotoPayloadType, statement, signer)
+	require.NoError(r.t, err)
+	contents, err := json.Marshal(envelope)
+	require.NoError(r.t, err)
+
+	r.appendCosignLayer(tag, digest, "att", contents, "application/vnd.dsse.envelope.v1+json", map[string]string{
+		SignatureAnnotation: "",
+	})
+}
+
+func (r testRegistry) appendCosignLayer(tag name.Tag, digest v1.Hash, suffix string, contents []byte, mediaType types.MediaType, annotations map[string]string) {
+	cosignTag := tag.Context().Tag(cosignTag(digest, suffix))
+
+	base, err := remote.Image(cosignTag)
+	if err != nil {
+		base = empty.Image
+	}
+
+	img, err := mutate.Append(base, mutate.Addendum{
+		Layer:       static.NewLayer(contents, mediaType),
+		Annotations: annotations,
+	})
+	require.NoError(r.t, err)
+	require.NoError(r.t, remote.Write(cosignTag, img))
+}
+
+func TestVerify(t *testing.T) {
+	reg := newTestRegistry(t)
+	trusted := testutils.NewECDSAKey(t)
+	untrusted := testutils.NewECDSAKey(t)
+	other := testutils.NewECDSAKey(t)
+
+	signedTag, signedDigest := reg.push("signed:latest")
+	reg.sign(signedTag, signedDigest, signedDigest, trusted)
+	reg.attest(signedTag, signedDigest, attest.SyftPredicateType, trusted)
+	reg.attest(signedTag, signedDigest, "https://slsa.dev/provenance/v0.2", untrusted)
+
+	unsignedTag, _ := reg.push("unsigned:latest")
+
+	untrustedTag, untrustedDigest := reg.push("untrusted:latest")
+	reg.sign(untrustedTag, untrustedDigest, untrustedDigest, untrusted)
+
+	// a valid signature of a different image, copied alongside this image
+	replayedTag, replayedDigest := reg.push("replayed:latest")
+	reg.sign(replayedTag, replayedDigest, signedDigest, trusted)
+
+	opts := &image.RegistryOptions{InsecureUseHTTP: true}
+
+	tests := []struct {
+		name     string
+		tag      name.Tag
+		policy   Policy
+		expected *source.ImageVerification
+		wantErr  string
+	}{
+		{
+			name:   "signed by a trusted key",
+			tag:    signedTag,
+			policy: Policy{Keys: []crypto.PublicKey{other.Public(), trusted.Public()}},
+			expected: &source.ImageVerification{
+				Verified:         true,
+				Digest:           signedDigest.String(),
+				Signatures:       1,
+				AttestationTypes: []string{attest.SyftPredicateType},
+			},
+		},
+		{
+			name: "required attestation present",
+			tag:  signedTag,
+			policy: Policy{
+				Keys:             []crypto.PublicKey{trusted.Public()},
+				AttestationTypes: []string{attest.SyftPredicateType},
+			},
+			expected: &source.ImageVerification{
+				Verified:         true,
+				Digest:           signedDigest.String(),
+				Signatures:       1,
+				AttestationTypes: []string{attest.SyftPredicateType},
+			},
+		},
+		{
+			name: "required attestation only signed by an untrusted key",
+			tag:  signedTag,
+			policy: Policy{
+				Keys:             []crypto.PublicKey{trusted.Public()},
+				AttestationTypes: []string{"https://slsa.dev/provenance/v0.2"},
+			},
+			wantErr: "no valid \"https://slsa.dev/provenance/v0.2\" attestation",
+		},
+		{
+			name:    "not signed",
+			tag:     unsignedTag,
+			policy:  Policy{Keys: []crypto.PublicKey{trusted.Public()}},
+			wantErr: "no valid signatures",
+		},
+		{
+			name:    "signed by an untrusted key",
+			tag:     untrustedTag,
+			policy:  Policy{Keys: []crypto.PublicKey{trusted.Public()}},
+			wantErr: "no valid signatures",
+		},
+		{
+			name:    "signature of another image",
+			tag:     replayedTag,
+			policy:  Policy{Keys: []crypto.PublicKey{trusted.Public()}},
+			wantErr: "no valid signatures",
+		},
+		{
+			name:    "no keys",
+			tag:     signedTag,
+			wantErr: "no keys",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := Verify(context.Background(), test.tag, test.policy, opts)
+			if test.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestReference(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		wantErr  bool
+	}{
+		{input: "registry:alpine:3.15", expected: "alpine:3.15"},
+		{input: "registry:ghcr.io/anchore/syft:v0.34.0", expected: "ghcr.io/anchore/syft:v0.34.0"},
+		{input: "docker-archive:image.tar", wantErr: true},
+		{input: "oci-dir:path/to/layout", wantErr: true},
+		{input: "dir:.", wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			ref, err := Reference(test.input, nil)
+			if test.wantErr {
+				assert.ErrorIs(t, err, ErrNotAnImage)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, ref.String())
+		})
+	}
+}
+
+func TestMatches(t *testing.T) {
+	verification := source.ImageVerification{Digest: "sha256:abc"}
+
+	assert.True(t, Matches(source.ImageMetadata{ManifestDigest: "sha256:abc"}, verification))
+	assert.True(t, Matches(source.ImageMetadata{ManifestDigest: "sha256:def", RepoDigests: []string{"docker.io/library/alpine@sha256:abc"}}, verification))
+	assert.False(t, Matches(source.ImageMetadata{ManifestDigest: "sha256:def", RepoDigests: []string{"docker.io/library/alpine@sha256:def"}}, verification))
+}
+
+func TestNewPolicy(t *testing.T) {
+	key := testutils.NewECDSAKey(t)
+	der, err := x509.MarshalPKIXPublicKey(key.Public())
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "cosign.pub")
+	require.NoError(t, ioutil.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0600))
+
+	policy, err := NewPolicy([]string{path}, []string{attest.SyftPredicateType})
+	require.NoError(t, err)
+	assert.Equal(t, []crypto.PublicKey{key.Public()}, policy.Keys)
+	assert.Equal(t, []string{attest.SyftPredicateType}, policy.AttestationTypes)
+
+	_, err = NewPolicy([]string{filepath.Join(t.TempDir(), "missing.pub")}, nil)
+	assert.Error(t, err)
+}
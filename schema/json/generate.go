@@ -1,3 +1,6 @@
+//go:build ignore
+// +build ignore
+
 package main
 
 import (
@@ -27,15 +30,25 @@ can be extended to include specific package metadata struct shapes in the future
 // When a new package metadata definition is created it will need to be manually added here. The variable name does
 // not matter as long as it is exported.
 type artifactMetadataContainer struct {
-	Apk    pkg.ApkMetadata
-	Dpkg   pkg.DpkgMetadata
-	Gem    pkg.GemMetadata
-	Java   pkg.JavaMetadata
-	Npm    pkg.NpmPackageJSONMetadata
-	Python pkg.PythonPackageMetadata
-	Rpm    pkg.RpmdbMetadata
-	Cargo  pkg.CargoPackageMetadata
-	Go     pkg.GolangBinMetadata
+	Apk       pkg.ApkMetadata
+	Dpkg      pkg.DpkgMetadata
+	DpkgBuild pkg.DpkgBuildDependencyMetadata
+	Changes   pkg.DpkgUploadMetadata
+	Gem       pkg.GemMetadata
+	Java      pkg.JavaMetadata
+	Npm       pkg.NpmPackageJSONMetadata
+	Python    pkg.PythonPackageMetadata
+	Rpm       pkg.RpmdbMetadata
+	Cargo     pkg.CargoPackageMetadata
+	Go        pkg.GolangBinMetadata
+	GoMod     pkg.GolangModMetadata
+	Buildroot pkg.BuildrootMetadata
+	Yocto     pkg.YoctoMetadata
+	Opkg      pkg.OpkgMetadata
+	Runtime   pkg.RuntimeMetadata
+	StaticLib pkg.StaticLibraryMetadata
+	PhpPecl   pkg.PhpPeclMetadata
+	WebServer pkg.WebServerModuleMetadata
 }
 
 func main() {
@@ -0,0 +1,73 @@
+/*
+Package schema provides the JSON schema of every published version of the syft JSON format, so that documents can be
+validated against the schema of the version they declare (even when written by older releases).
+*/
+package schema
+
+import (
+	"embed"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// note: the schemas are generated by generate.go (which is excluded from the package by a build tag)
+
+//go:embed schema-*.json
+var schemas embed.FS
+
+// Get returns the JSON schema of the given version of the syft JSON format.
+func Get(version string) ([]byte, error) {
+	contents, err := schemas.ReadFile(filename(version))
+	if err != nil {
+		return nil, fmt.Errorf("no JSON schema for syft JSON version %q (known versions: %s)", version, strings.Join(Versions(), ", "))
+	}
+	return contents, nil
+}
+
+// Versions returns all versions of the syft JSON format with a schema, in order.
+func Versions() []string {
+	matches, err := schemas.ReadDir(".")
+	if err != nil {
+		return nil
+	}
+
+	var versions []string
+	for _, entry := range matches {
+		name := entry.Name()
+		if path.Ext(name) != ".json" {
+			continue
+		}
+		versions = append(versions, strings.TrimSuffix(strings.TrimPrefix(name, "schema-"), ".json"))
+	}
+
+	sort.SliceStable(versions, func(i, j int) bool {
+		return lessVersion(versions[i], versions[j])
+	})
+	return versions
+}
+
+func filename(version string) string {
+	return fmt.Sprintf("schema-%s.json", version)
+}
+
+// lessVersion compares MODEL.REVISION.ADDITION versions field by field (numerically).
+func lessVersion(a, b string) bool {
+	aFields, bFields := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(aFields) && i < len(bFields); i++ {
+		aValue, aErr := strconv.Atoi(aFields[i])
+		bValue, bErr := strconv.Atoi(bFields[i])
+		if aErr != nil || bErr != nil {
+			if aFields[i] != bFields[i] {
+				return aFields[i] < bFields[i]
+			}
+			continue
+		}
+		if aValue != bValue {
+			return aValue < bValue
+		}
+	}
+	return len(aFields) < len(bFields)
+}
@@ -0,0 +1,35 @@
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/anchore/syft/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGet(t *testing.T) {
+	contents, err := Get(internal.JSONSchemaVersion)
+	require.NoError(t, err)
+	assert.True(t, json.Valid(contents))
+
+	_, err = Get("0.0.1")
+	assert.Error(t, err)
+}
+
+func TestVersions(t *testing.T) {
+	versions := Versions()
+	require.NotEmpty(t, versions)
+	assert.Equal(t, "1.0.0", versions[0])
+	assert.Equal(t, internal.JSONSchemaVersion, versions[len(versions)-1])
+	assert.Contains(t, versions, "1.0.5")
+}
+
+func Test_lessVersion(t *testing.T) {
+	assert.True(t, lessVersion("1.0.5", "1.1.0"))
+	assert.True(t, lessVersion("1.9.0", "1.10.0"))
+	assert.True(t, lessVersion("1.1", "1.1.0"))
+	assert.False(t, lessVersion("2.0.0", "1.10.0"))
+	assert.False(t, lessVersion("2.0.0", "2.0.0"))
+}
@@ -7,8 +7,20 @@ const (
 	// has been completed.
 	OwnershipByFileOverlapRelationship RelationshipType = "ownership-by-file-overlap"
 
+	// DependencyOfRelationship (supports package-to-package linkages) indicates that the parent package is required by
+	// the child package. This is a proxy for the SPDX 2.2 DEPENDENCY_OF relationship.
+	DependencyOfRelationship RelationshipType = "dependency-of"
+
 	// ContainsRelationship (supports any-to-any linkages) is a proxy for the SPDX 2.2 CONTAINS relationship.
 	ContainsRelationship RelationshipType = "contains"
+
+	// DynamicLinkRelationship (supports file-to-file linkages) indicates that the parent binary loads the child
+	// shared library at runtime (e.g. a PE import table entry). This is a proxy for the SPDX 2.2 DYNAMIC_LINK relationship.
+	DynamicLinkRelationship RelationshipType = "dynamic-link"
+
+	// DebugSymbolsOfRelationship (supports file-to-file linkages) indicates that the parent file holds the split debug
+	// symbols (e.g. under /usr/lib/debug) for the child binary, as matched by the GNU build-id of both files.
+	DebugSymbolsOfRelationship RelationshipType = "debug-symbols-of"
 )
 
 type RelationshipType string
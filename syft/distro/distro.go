@@ -3,6 +3,7 @@ package distro
 import (
 	"fmt"
 
+	"github.com/anchore/syft/syft/eol"
 	hashiVer "github.com/hashicorp/go-version"
 )
 
@@ -12,6 +13,7 @@ type Distro struct {
 	Version    *hashiVer.Version
 	RawVersion string
 	IDLike     string
+	EOL        *eol.Status // the end-of-life status of the release, if known
 }
 
 // NewDistro creates a new Distro object populated with the given values.
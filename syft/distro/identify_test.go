@@ -132,6 +132,16 @@ func TestIdentifyDistro(t *testing.T) {
 			Type:    AlmaLinux,
 			Version: "8.4.0",
 		},
+		{
+			fixture: "test-fixtures/os/wolfi",
+			Type:    Wolfi,
+			Version: "20230201.0.0",
+		},
+		{
+			fixture: "test-fixtures/os/chainguard",
+			Type:    Chainguard,
+			Version: "20230214.0.0",
+		},
 	}
 
 	observedDistros := internal.NewStringSet()
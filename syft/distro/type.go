@@ -23,6 +23,8 @@ const (
 	Mariner           Type = "mariner"
 	RockyLinux        Type = "rockylinux"
 	AlmaLinux         Type = "almalinux"
+	Wolfi             Type = "wolfi"
+	Chainguard        Type = "chainguard"
 )
 
 // All contains all Linux distribution options
@@ -44,6 +46,8 @@ var All = []Type{
 	Mariner,
 	RockyLinux,
 	AlmaLinux,
+	Wolfi,
+	Chainguard,
 }
 
 // IDMapping connects a distro ID like "ubuntu" to a Distro type
@@ -65,6 +69,8 @@ var IDMapping = map[string]Type{
 	"mariner":       Mariner,
 	"rocky":         RockyLinux,
 	"almalinux":     AlmaLinux,
+	"wolfi":         Wolfi,
+	"chainguard":    Chainguard,
 }
 
 // String returns the string representation of the given Linux distribution.
@@ -32,7 +32,25 @@ func Decode(reader io.Reader) (*sbom.SBOM, format.Option, error) {
 	if err != nil {
 		return nil, format.UnknownFormatOption, fmt.Errorf("unable to read sbom: %w", err)
 	}
+	return decode(by)
+}
+
+// DecodeWithOrigin decodes an SBOM like Decode, additionally describing the decoded document so that SBOMs derived
+// from it (e.g. by converting or merging documents) can record it within their descriptor.
+func DecodeWithOrigin(reader io.Reader) (*sbom.SBOM, *sbom.DocumentOrigin, error) {
+	by, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to read sbom: %w", err)
+	}
+	s, option, err := decode(by)
+	if err != nil {
+		return nil, nil, err
+	}
+	origin := sbom.NewDocumentOrigin(*s, string(option), by)
+	return s, &origin, nil
+}
 
+func decode(by []byte) (*sbom.SBOM, format.Option, error) {
 	f, err := formats.Identify(by)
 	if err != nil {
 		return nil, format.UnknownFormatOption, fmt.Errorf("unable to detect format: %w", err)
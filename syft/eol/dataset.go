@@ -0,0 +1,189 @@
+/*
+Package eol provides the end-of-life dates of language runtimes and Linux distributions, so that unsupported platforms
+can be flagged while cataloging.
+*/
+package eol
+
+import (
+	"bytes"
+	// embed the default end-of-life dataset
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// DatasetSchemaVersion is the version of the dataset file format understood by this version of syft. Datasets written
+// for any other schema version are rejected.
+const DatasetSchemaVersion = 1
+
+const dateLayout = "2006-01-02"
+
+//go:embed dataset.json
+var defaultDataset []byte
+
+// Dataset is the set of products (runtimes and distributions) with known end-of-life dates.
+type Dataset struct {
+	Products []Product
+}
+
+// Product is a runtime or distribution with a number of release cycles, each with its own end-of-life date.
+type Product struct {
+	Name     string
+	Packages []PackageMatcher // the packages that install the product (e.g. a "python3.9" deb package)
+	Distros  []string         // the distro types that are releases of the product (e.g. "debian")
+	Cycles   []Cycle
+}
+
+// PackageMatcher matches the packages of the given type with a name matching the given pattern.
+type PackageMatcher struct {
+	Type string
+	Name *regexp.Regexp
+}
+
+// Cycle is a release line (e.g. "3.9" for python or "20.04" for ubuntu) and the date support for it ends.
+type Cycle struct {
+	Cycle string
+	EOL   time.Time
+}
+
+type datasetDocument struct {
+	SchemaVersion int               `json:"schemaVersion"`
+	Products      []productDocument `json:"products"`
+}
+
+type productDocument struct {
+	Name     string `json:"name"`
+	Packages []struct {
+		Type string `json:"type"`
+		Name string `json:"name"`
+	} `json:"packages"`
+	Distros []string `json:"distros"`
+	Cycles  []struct {
+		Cycle string `json:"cycle"`
+		EOL   string `json:"eol"`
+	} `json:"cycles"`
+}
+
+// DefaultDataset returns the end-of-life dataset built into syft.
+func DefaultDataset() *Dataset {
+	dataset, err := ParseDataset(bytes.NewReader(defaultDataset))
+	if err != nil {
+		panic(err)
+	}
+	return dataset
+}
+
+// ParseDataset reads an end-of-life dataset (JSON), ensuring that it is of a supported schema version and that all
+// package name patterns and dates are valid.
+func ParseDataset(reader io.Reader) (*Dataset, error) {
+	var doc datasetDocument
+	if err := json.NewDecoder(reader).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("unable to decode end-of-life dataset: %w", err)
+	}
+
+	if doc.SchemaVersion != DatasetSchemaVersion {
+		return nil, fmt.Errorf("unsupported end-of-life dataset schema version=%d (supported version=%d)", doc.SchemaVersion, DatasetSchemaVersion)
+	}
+
+	var dataset Dataset
+	for _, entry := range doc.Products {
+		if entry.Name == "" {
+			return nil, fmt.Errorf("end-of-life dataset has a product without a name")
+		}
+
+		product := Product{
+			Name:    entry.Name,
+			Distros: entry.Distros,
+		}
+		for _, p := range entry.Packages {
+			pattern, err := regexp.Compile(p.Name)
+			if err != nil {
+				return nil, fmt.Errorf("unable to compile package name pattern=%q for product=%q: %w", p.Name, entry.Name, err)
+			}
+			product.Packages = append(product.Packages, PackageMatcher{Type: p.Type, Name: pattern})
+		}
+		for _, c := range entry.Cycles {
+			date, err := time.Parse(dateLayout, c.EOL)
+			if err != nil {
+				return nil, fmt.Errorf("invalid end-of-life date=%q of cycle=%q for product=%q: %w", c.EOL, c.Cycle, entry.Name, err)
+			}
+			product.Cycles = append(product.Cycles, Cycle{Cycle: c.Cycle, EOL: date})
+		}
+
+		dataset.Products = append(dataset.Products, product)
+	}
+
+	return &dataset, nil
+}
+
+// PackageStatus returns the end-of-life status (as of the given time) of the runtime installed by the package of the
+// given type, name, and version, or nil if the package is not a known runtime (or of a known release cycle).
+func (d *Dataset) PackageStatus(pkgType, name, version string, at time.Time) *Status {
+	for _, product := range d.Products {
+		for _, m := range product.Packages {
+			if m.Type == pkgType && m.Name.MatchString(name) {
+				return product.status(version, at)
+			}
+		}
+	}
+	return nil
+}
+
+// DistroStatus returns the end-of-life status (as of the given time) of the given distro release, or nil if the
+// distro (or the release cycle) is not known.
+func (d *Dataset) DistroStatus(distroType, version string, at time.Time) *Status {
+	for _, product := range d.Products {
+		for _, t := range product.Distros {
+			if t == distroType {
+				return product.status(version, at)
+			}
+		}
+	}
+	return nil
+}
+
+func (p Product) status(version string, at time.Time) *Status {
+	c := p.cycle(version)
+	if c == nil {
+		return nil
+	}
+	return &Status{
+		Product: p.Name,
+		Cycle:   c.Cycle,
+		EOL:     c.EOL.Format(dateLayout),
+		Expired: !at.Before(c.EOL),
+	}
+}
+
+// cycle returns the release cycle the given version belongs to: the most specific cycle that equals the version or is
+// a prefix of it (e.g. "3.9.7-1" belongs to "3.9", but "3.10.1" does not).
+func (p Product) cycle(version string) *Cycle {
+	if idx := strings.Index(version, ":"); idx >= 0 {
+		// drop the epoch of OS package versions (e.g. "1:3.9.2-1")
+		version = version[idx+1:]
+	}
+	version = strings.TrimPrefix(version, "v")
+
+	var best *Cycle
+	for i, c := range p.Cycles {
+		if !withinCycle(version, c.Cycle) {
+			continue
+		}
+		if best == nil || len(c.Cycle) > len(best.Cycle) {
+			best = &p.Cycles[i]
+		}
+	}
+	return best
+}
+
+func withinCycle(version, cycle string) bool {
+	if !strings.HasPrefix(version, cycle) {
+		return false
+	}
+	rest := version[len(cycle):]
+	return rest == "" || strings.ContainsAny(rest[:1], ".-_+~")
+}
@@ -0,0 +1,129 @@
+package eol
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultDataset(t *testing.T) {
+	dataset := DefaultDataset()
+	assert.NotEmpty(t, dataset.Products)
+}
+
+func TestParseDataset(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents string
+		wantErr  require.ErrorAssertionFunc
+	}{
+		{
+			name:     "valid",
+			contents: `{"schemaVersion": 1, "products": [{"name": "python", "packages": [{"type": "deb", "name": "^python3$"}], "cycles": [{"cycle": "3.9", "eol": "2025-10-31"}]}]}`,
+			wantErr:  require.NoError,
+		},
+		{
+			name:     "unsupported schema version",
+			contents: `{"schemaVersion": 2, "products": []}`,
+			wantErr:  require.Error,
+		},
+		{
+			name:     "missing name",
+			contents: `{"schemaVersion": 1, "products": [{"distros": ["debian"]}]}`,
+			wantErr:  require.Error,
+		},
+		{
+			name:     "invalid package name pattern",
+			contents: `{"schemaVersion": 1, "products": [{"name": "python", "packages": [{"type": "deb", "name": "python("}]}]}`,
+			wantErr:  require.Error,
+		},
+		{
+			name:     "invalid date",
+			contents: `{"schemaVersion": 1, "products": [{"name": "python", "cycles": [{"cycle": "3.9", "eol": "October 2025"}]}]}`,
+			wantErr:  require.Error,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParseDataset(strings.NewReader(test.contents))
+			test.wantErr(t, err)
+		})
+	}
+}
+
+func TestDataset_PackageStatus(t *testing.T) {
+	at := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		pkgType  string
+		pkgName  string
+		version  string
+		expected *Status
+	}{
+		{
+			name:     "expired runtime",
+			pkgType:  "deb",
+			pkgName:  "python3.6",
+			version:  "3.6.9-1~18.04ubuntu1.7",
+			expected: &Status{Product: "python", Cycle: "3.6", EOL: "2021-12-23", Expired: true},
+		},
+		{
+			name:     "supported runtime",
+			pkgType:  "runtime",
+			pkgName:  "node",
+			version:  "16.13.0",
+			expected: &Status{Product: "nodejs", Cycle: "16", EOL: "2023-09-11", Expired: false},
+		},
+		{
+			name:     "epoch is ignored",
+			pkgType:  "rpm",
+			pkgName:  "golang",
+			version:  "1:1.17.5-1.el8",
+			expected: &Status{Product: "go", Cycle: "1.17", EOL: "2022-08-02", Expired: false},
+		},
+		{
+			name:     "cycle is not a prefix of a longer minor version",
+			pkgType:  "go-module",
+			pkgName:  "stdlib",
+			version:  "1.150.0",
+			expected: nil,
+		},
+		{
+			name:     "most specific cycle wins",
+			pkgType:  "apk",
+			pkgName:  "python3",
+			version:  "3.10.4-r0",
+			expected: &Status{Product: "python", Cycle: "3.10", EOL: "2026-10-31", Expired: false},
+		},
+		{
+			name:     "not a runtime",
+			pkgType:  "npm",
+			pkgName:  "node",
+			version:  "16.13.0",
+			expected: nil,
+		},
+	}
+
+	dataset := DefaultDataset()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, dataset.PackageStatus(test.pkgType, test.pkgName, test.version, at))
+		})
+	}
+}
+
+func TestDataset_DistroStatus(t *testing.T) {
+	at := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+	dataset := DefaultDataset()
+
+	assert.Equal(t, &Status{Product: "debian", Cycle: "9", EOL: "2020-07-06", Expired: true}, dataset.DistroStatus("debian", "9", at))
+	assert.Equal(t, &Status{Product: "ubuntu", Cycle: "20.04", EOL: "2025-05-31", Expired: false}, dataset.DistroStatus("ubuntu", "20.04", at))
+	assert.Equal(t, &Status{Product: "alpine", Cycle: "3.12", EOL: "2022-05-01", Expired: true}, dataset.DistroStatus("alpine", "3.12.9", at))
+	assert.Nil(t, dataset.DistroStatus("alpine", "3.2.0", at))
+	assert.Nil(t, dataset.DistroStatus("busybox", "1.34.1", at))
+}
@@ -0,0 +1,9 @@
+package eol
+
+// Status describes the release cycle of a runtime or distribution and when its support ends.
+type Status struct {
+	Product string `json:"product"` // the runtime or distribution (e.g. "python" or "debian")
+	Cycle   string `json:"cycle"`   // the release cycle the version belongs to (e.g. "3.9" or "11")
+	EOL     string `json:"eol"`     // the date support for the release cycle ends (YYYY-MM-DD)
+	Expired bool   `json:"expired"` // whether the end-of-life date had been reached at the time of the scan
+}
@@ -23,6 +23,16 @@ const (
 	// FileDigestsCatalogerStarted is a partybus event that occurs when the file digests cataloging has begun
 	FileDigestsCatalogerStarted partybus.EventType = "syft-file-digests-cataloger-started-event"
 
+	// PackageDiscovered is a partybus event that occurs when a package cataloger has discovered a package (after the
+	// package was added to the catalog)
+	PackageDiscovered partybus.EventType = "syft-package-discovered-event"
+
+	// CatalogerFinished is a partybus event that occurs when a single package cataloger has finished (successfully or not)
+	CatalogerFinished partybus.EventType = "syft-cataloger-finished-event"
+
+	// FileClassified is a partybus event that occurs when the file classification cataloger has classified a file
+	FileClassified partybus.EventType = "syft-file-classified-event"
+
 	// FileIndexingStarted is a partybus event that occurs when the directory resolver begins indexing a filesystem
 	FileIndexingStarted partybus.EventType = "syft-file-indexing-started-event"
 
@@ -8,7 +8,9 @@ import (
 
 	"github.com/anchore/syft/syft/event"
 	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
 	"github.com/anchore/syft/syft/pkg/cataloger"
+	"github.com/anchore/syft/syft/source"
 	"github.com/wagoodman/go-partybus"
 	"github.com/wagoodman/go-progress"
 )
@@ -51,6 +53,55 @@ func ParsePackageCatalogerStarted(e partybus.Event) (*cataloger.Monitor, error)
 	return &monitor, nil
 }
 
+func ParsePackageDiscovered(e partybus.Event) (string, *pkg.Package, error) {
+	if err := checkEventType(e.Type, event.PackageDiscovered); err != nil {
+		return "", nil, err
+	}
+
+	catalogerName, ok := e.Source.(string)
+	if !ok {
+		return "", nil, newPayloadErr(e.Type, "Source", e.Source)
+	}
+
+	p, ok := e.Value.(pkg.Package)
+	if !ok {
+		return "", nil, newPayloadErr(e.Type, "Value", e.Value)
+	}
+
+	return catalogerName, &p, nil
+}
+
+func ParseCatalogerFinished(e partybus.Event) (*cataloger.Result, error) {
+	if err := checkEventType(e.Type, event.CatalogerFinished); err != nil {
+		return nil, err
+	}
+
+	result, ok := e.Value.(cataloger.Result)
+	if !ok {
+		return nil, newPayloadErr(e.Type, "Value", e.Value)
+	}
+
+	return &result, nil
+}
+
+func ParseFileClassified(e partybus.Event) (source.Coordinates, *file.Classification, error) {
+	if err := checkEventType(e.Type, event.FileClassified); err != nil {
+		return source.Coordinates{}, nil, err
+	}
+
+	coordinates, ok := e.Source.(source.Coordinates)
+	if !ok {
+		return source.Coordinates{}, nil, newPayloadErr(e.Type, "Source", e.Source)
+	}
+
+	classification, ok := e.Value.(file.Classification)
+	if !ok {
+		return source.Coordinates{}, nil, newPayloadErr(e.Type, "Value", e.Value)
+	}
+
+	return coordinates, &classification, nil
+}
+
 func ParseSecretsCatalogingStarted(e partybus.Event) (*file.SecretsMonitor, error) {
 	if err := checkEventType(e.Type, event.SecretsCatalogerStarted); err != nil {
 		return nil, err
@@ -0,0 +1,167 @@
+package file
+
+import (
+	"bytes"
+	"debug/pe"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/source"
+)
+
+// maxPESize limits how much of a PE binary is buffered in memory when its contents cannot be read at random offsets.
+const maxPESize = 256 * 1024 * 1024
+
+// errFileTooLarge indicates that a file was not read since it exceeds the size that may be buffered in memory.
+var errFileTooLarge = errors.New("file is too large to read")
+
+// windowsSystemDirs are the directories searched for DLLs that are not found alongside the importing binary.
+var windowsSystemDirs = []string{
+	"/windows/system32/",
+	"/windows/syswow64/",
+	"/windows/",
+}
+
+// BinaryLinksCataloger discovers the shared libraries that binaries load at runtime, recording the imported library names
+// of each binary and relating it to the cataloged library files that satisfy its imports (imports that are not found in
+// the source, such as DLLs provided by the OS, are only recorded by name). Currently only PE (Windows) import tables are
+// supported.
+type BinaryLinksCataloger struct {
+}
+
+func NewBinaryLinksCataloger() (*BinaryLinksCataloger, error) {
+	return &BinaryLinksCataloger{}, nil
+}
+
+func (i *BinaryLinksCataloger) Catalog(resolver source.FileResolver) (map[source.Coordinates][]string, []artifact.Relationship, error) {
+	locations, err := resolver.FilesByMIMEType("application/vnd.microsoft.portable-executable")
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to find PE binaries: %w", err)
+	}
+
+	// DLL names are case-insensitive on windows
+	locationsByName := make(map[string][]source.Location)
+	for _, location := range locations {
+		name := strings.ToLower(path.Base(location.RealPath))
+		locationsByName[name] = append(locationsByName[name], location)
+	}
+
+	results := make(map[source.Coordinates][]string)
+	var relationships []artifact.Relationship
+	for _, location := range locations {
+		imports, err := peImportedLibraries(resolver, location)
+		if err != nil {
+			log.Debugf("unable to read PE imports from %q: %+v", location.RealPath, err)
+			continue
+		}
+		if len(imports) > 0 {
+			results[location.Coordinates] = imports
+		}
+
+		for _, library := range imports {
+			for _, target := range resolveLibrary(location, locationsByName[strings.ToLower(library)]) {
+				relationships = append(relationships, artifact.Relationship{
+					From: location.Coordinates,
+					To:   target.Coordinates,
+					Type: artifact.DynamicLinkRelationship,
+				})
+			}
+		}
+	}
+	log.Debugf("binary links cataloger discovered %d links", len(relationships))
+
+	return results, relationships, nil
+}
+
+// peImportedLibraries returns the names of the DLLs within the import table of the PE binary at the given location.
+func peImportedLibraries(resolver source.FileResolver, location source.Location) ([]string, error) {
+	contentReader, err := resolver.FileContentsByLocation(location)
+	if err != nil {
+		return nil, err
+	}
+	defer internal.CloseAndLogError(contentReader, location.VirtualPath)
+
+	reader, err := readerAt(contentReader, maxPESize)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := pe.NewFile(reader)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	// note: pe.File.ImportedLibraries is not implemented by the standard library, however, every imported symbol
+	// is reported as "symbol:library"
+	symbols, err := f.ImportedSymbols()
+	if err != nil {
+		return nil, err
+	}
+
+	libraries := internal.NewStringSet()
+	for _, symbol := range symbols {
+		if idx := strings.LastIndex(symbol, ":"); idx >= 0 && idx < len(symbol)-1 {
+			libraries.Add(symbol[idx+1:])
+		}
+	}
+
+	result := libraries.ToSlice()
+	sort.Strings(result)
+	return result, nil
+}
+
+// readerAt returns random access to the given contents, buffering at most maxSize bytes when the reader does not
+// support it natively.
+func readerAt(reader io.Reader, maxSize int64) (io.ReaderAt, error) {
+	if r, ok := reader.(io.ReaderAt); ok {
+		return r, nil
+	}
+
+	// read one byte past the limit to distinguish between a file that is exactly at the limit and one that is over
+	contents, err := ioutil.ReadAll(io.LimitReader(reader, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(contents)) > maxSize {
+		return nil, fmt.Errorf("%w: exceeds the maximum size of %d bytes", errFileTooLarge, maxSize)
+	}
+	return bytes.NewReader(contents), nil
+}
+
+// resolveLibrary selects the candidates that would satisfy an import for the given binary, following the windows DLL
+// search order: the directory of the binary first, then the system directories. When neither applies all
+// candidates are returned, since the binary may be run with a PATH that includes any of them.
+func resolveLibrary(binary source.Location, candidates []source.Location) []source.Location {
+	binaryDir := strings.ToLower(path.Dir(binary.RealPath))
+	for _, candidate := range candidates {
+		if strings.ToLower(path.Dir(candidate.RealPath)) == binaryDir && candidate.RealPath != binary.RealPath {
+			return []source.Location{candidate}
+		}
+	}
+
+	for _, systemDir := range windowsSystemDirs {
+		for _, candidate := range candidates {
+			candidateDir := "/" + strings.TrimPrefix(strings.ToLower(path.Dir(candidate.RealPath)), "/") + "/"
+			if strings.HasSuffix(candidateDir, systemDir) {
+				return []source.Location{candidate}
+			}
+		}
+	}
+
+	var result []source.Location
+	for _, candidate := range candidates {
+		if candidate.RealPath != binary.RealPath {
+			result = append(result, candidate)
+		}
+	}
+	return result
+}
@@ -0,0 +1,75 @@
+package file
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/anchore/syft/internal/testutils"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/source"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBinaryLinksCataloger(t *testing.T) {
+	root := t.TempDir()
+	testutils.WritePEFixture(t, filepath.Join(root, "app", "app.exe"), "KERNEL32.dll", "helper.dll", "missing.dll")
+	testutils.WritePEFixture(t, filepath.Join(root, "app", "helper.dll"), "kernel32.dll")
+	testutils.WritePEFixture(t, filepath.Join(root, "plugins", "helper.dll"))
+	testutils.WritePEFixture(t, filepath.Join(root, "Windows", "System32", "kernel32.dll"))
+	testutils.WritePEFixture(t, filepath.Join(root, "Program Files", "kernel32.dll"))
+
+	src, err := source.NewFromDirectory(root)
+	require.NoError(t, err)
+
+	resolver, err := src.FileResolver(source.SquashedScope)
+	require.NoError(t, err)
+
+	c, err := NewBinaryLinksCataloger()
+	require.NoError(t, err)
+
+	imports, relationships, err := c.Catalog(resolver)
+	require.NoError(t, err)
+
+	actualImports := make(map[string][]string)
+	for coordinates, libraries := range imports {
+		actualImports[strings.TrimPrefix(coordinates.RealPath, "/")] = libraries
+	}
+	assert.Equal(t, map[string][]string{
+		// imports that are not found in the source are still recorded
+		"app/app.exe":    {"KERNEL32.dll", "helper.dll", "missing.dll"},
+		"app/helper.dll": {"kernel32.dll"},
+	}, actualImports)
+
+	var actual [][2]string
+	for _, r := range relationships {
+		assert.Equal(t, artifact.DynamicLinkRelationship, r.Type)
+		actual = append(actual, [2]string{
+			strings.TrimPrefix(r.From.(source.Coordinates).RealPath, "/"),
+			strings.TrimPrefix(r.To.(source.Coordinates).RealPath, "/"),
+		})
+	}
+
+	assert.ElementsMatch(t, [][2]string{
+		// the DLL within the same directory wins
+		{"app/app.exe", "app/helper.dll"},
+		// followed by the system directory
+		{"app/app.exe", "Windows/System32/kernel32.dll"},
+		{"app/helper.dll", "Windows/System32/kernel32.dll"},
+	}, actual)
+}
+
+func TestReaderAt(t *testing.T) {
+	r, err := readerAt(strings.NewReader("contents"), 8)
+	require.NoError(t, err)
+
+	b := make([]byte, 4)
+	_, err = r.ReadAt(b, 4)
+	require.NoError(t, err)
+	assert.Equal(t, "ents", string(b))
+
+	_, err = readerAt(ioutil.NopCloser(strings.NewReader("contents")), 7)
+	assert.ErrorIs(t, err, errFileTooLarge)
+}
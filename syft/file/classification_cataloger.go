@@ -1,8 +1,11 @@
 package file
 
 import (
+	"github.com/anchore/syft/internal/bus"
 	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/event"
 	"github.com/anchore/syft/syft/source"
+	"github.com/wagoodman/go-partybus"
 )
 
 type ClassificationCataloger struct {
@@ -20,6 +23,9 @@ func (i *ClassificationCataloger) Catalog(resolver source.FileResolver) (map[sou
 
 	numResults := 0
 	for location := range resolver.AllLocations() {
+		if isSpecialFile(resolver, location) {
+			continue
+		}
 		for _, classifier := range i.classifiers {
 			result, err := classifier.Classify(resolver, location)
 			if err != nil {
@@ -28,6 +34,12 @@ func (i *ClassificationCataloger) Catalog(resolver source.FileResolver) (map[sou
 			if result != nil {
 				results[location.Coordinates] = append(results[location.Coordinates], *result)
 				numResults++
+
+				bus.Publish(partybus.Event{
+					Type:   event.FileClassified,
+					Source: location.Coordinates,
+					Value:  *result,
+				})
 			}
 		}
 	}
@@ -99,6 +99,90 @@ func TestClassifierCataloger_DefaultClassifiers_PositiveCases(t *testing.T) {
 			},
 			expectedErr: assert.NoError,
 		},
+		{
+			name:       "positive-busybox.nosuid",
+			fixtureDir: "test-fixtures/classifiers/positive",
+			location:   "busybox.nosuid",
+			expected: []Classification{
+				{
+					Class: "busybox-binary",
+					Metadata: map[string]string{
+						"version": "1.35.0",
+					},
+				},
+			},
+			expectedErr: assert.NoError,
+		},
+		{
+			name:       "positive-u-boot.bin",
+			fixtureDir: "test-fixtures/classifiers/positive",
+			location:   "u-boot.bin",
+			expected: []Classification{
+				{
+					Class: "u-boot-binary",
+					Metadata: map[string]string{
+						"version": "2022.04",
+					},
+				},
+			},
+			expectedErr: assert.NoError,
+		},
+		{
+			name:       "positive-dropbear",
+			fixtureDir: "test-fixtures/classifiers/positive",
+			location:   "dropbear",
+			expected: []Classification{
+				{
+					Class: "dropbear-binary",
+					Metadata: map[string]string{
+						"version": "2022.82",
+					},
+				},
+			},
+			expectedErr: assert.NoError,
+		},
+		{
+			name:       "positive-libcrypto.so.1.1",
+			fixtureDir: "test-fixtures/classifiers/positive",
+			location:   "libcrypto.so.1.1",
+			expected: []Classification{
+				{
+					Class: "openssl-binary",
+					Metadata: map[string]string{
+						"version": "1.1.1k",
+					},
+				},
+			},
+			expectedErr: assert.NoError,
+		},
+		{
+			name:       "positive-dnsmasq",
+			fixtureDir: "test-fixtures/classifiers/positive",
+			location:   "dnsmasq",
+			expected: []Classification{
+				{
+					Class: "dnsmasq-binary",
+					Metadata: map[string]string{
+						"version": "2.86",
+					},
+				},
+			},
+			expectedErr: assert.NoError,
+		},
+		{
+			name:       "positive-vmlinux",
+			fixtureDir: "test-fixtures/classifiers/positive",
+			location:   "vmlinux",
+			expected: []Classification{
+				{
+					Class: "linux-kernel-binary",
+					Metadata: map[string]string{
+						"version": "5.15.72-yocto-standard",
+					},
+				},
+			},
+			expectedErr: assert.NoError,
+		},
 	}
 
 	for _, test := range tests {
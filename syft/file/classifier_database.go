@@ -0,0 +1,97 @@
+package file
+
+import (
+	"bytes"
+	// embed the default classifier database
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"text/template"
+)
+
+// ClassifierDatabaseSchemaVersion is the version of the classifier database file format understood by this version
+// of syft. Databases written for any other schema version are rejected.
+const ClassifierDatabaseSchemaVersion = 1
+
+//go:embed classifiers.json
+var defaultClassifierDatabase []byte
+
+// ClassifierDatabase is a versioned set of classifiers, which may be refreshed independently of syft releases.
+type ClassifierDatabase struct {
+	SchemaVersion int
+	// Version increases with every change to the classifiers within the database
+	Version     int
+	Classifiers []Classifier
+}
+
+type classifierDatabaseDocument struct {
+	SchemaVersion int                       `json:"schemaVersion"`
+	Version       int                       `json:"version"`
+	Classifiers   []classifierDocumentEntry `json:"classifiers"`
+}
+
+type classifierDocumentEntry struct {
+	Class                    string   `json:"class"`
+	FilepathPatterns         []string `json:"filepathPatterns"`
+	EvidencePatternTemplates []string `json:"evidencePatternTemplates"`
+}
+
+// DefaultClassifierDatabase returns the classifier database built into syft.
+func DefaultClassifierDatabase() *ClassifierDatabase {
+	return MustParseClassifierDatabase(defaultClassifierDatabase)
+}
+
+// MustParseClassifierDatabase parses the given classifier database, panicking if it is invalid.
+func MustParseClassifierDatabase(contents []byte) *ClassifierDatabase {
+	db, err := ParseClassifierDatabase(bytes.NewReader(contents))
+	if err != nil {
+		panic(err)
+	}
+	return db
+}
+
+// ParseClassifierDatabase reads a classifier database (JSON), ensuring that it is of a supported schema version and
+// that all patterns and templates of every classifier are valid.
+func ParseClassifierDatabase(reader io.Reader) (*ClassifierDatabase, error) {
+	var doc classifierDatabaseDocument
+	if err := json.NewDecoder(reader).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("unable to decode classifier database: %w", err)
+	}
+
+	if doc.SchemaVersion != ClassifierDatabaseSchemaVersion {
+		return nil, fmt.Errorf("unsupported classifier database schema version=%d (supported version=%d)", doc.SchemaVersion, ClassifierDatabaseSchemaVersion)
+	}
+
+	db := ClassifierDatabase{
+		SchemaVersion: doc.SchemaVersion,
+		Version:       doc.Version,
+	}
+	for _, entry := range doc.Classifiers {
+		if entry.Class == "" {
+			return nil, fmt.Errorf("classifier database has a classifier without a class")
+		}
+
+		classifier := Classifier{
+			Class:                    entry.Class,
+			EvidencePatternTemplates: entry.EvidencePatternTemplates,
+		}
+		for _, pattern := range entry.FilepathPatterns {
+			compiled, err := regexp.Compile(pattern)
+			if err != nil {
+				return nil, fmt.Errorf("unable to compile filepath pattern=%q for classifier=%q: %w", pattern, entry.Class, err)
+			}
+			classifier.FilepathPatterns = append(classifier.FilepathPatterns, compiled)
+		}
+		for _, patternTemplate := range entry.EvidencePatternTemplates {
+			if _, err := template.New("").Parse(patternTemplate); err != nil {
+				return nil, fmt.Errorf("unable to parse evidence template=%q for classifier=%q: %w", patternTemplate, entry.Class, err)
+			}
+		}
+
+		db.Classifiers = append(db.Classifiers, classifier)
+	}
+
+	return &db, nil
+}
@@ -0,0 +1,57 @@
+package file
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultClassifierDatabase(t *testing.T) {
+	db := DefaultClassifierDatabase()
+	assert.Equal(t, ClassifierDatabaseSchemaVersion, db.SchemaVersion)
+	assert.Greater(t, db.Version, 0)
+	assert.Equal(t, DefaultClassifiers, db.Classifiers)
+}
+
+func TestParseClassifierDatabase(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents string
+		wantErr  require.ErrorAssertionFunc
+	}{
+		{
+			name:     "valid",
+			contents: `{"schemaVersion": 1, "version": 2, "classifiers": [{"class": "example-binary", "filepathPatterns": ["(.*/|^)example$"], "evidencePatternTemplates": ["(?m)example-(?P<version>{{ .version }})"]}]}`,
+			wantErr:  require.NoError,
+		},
+		{
+			name:     "unsupported schema version",
+			contents: `{"schemaVersion": 2, "version": 2, "classifiers": []}`,
+			wantErr:  require.Error,
+		},
+		{
+			name:     "missing class",
+			contents: `{"schemaVersion": 1, "version": 2, "classifiers": [{"filepathPatterns": ["example$"]}]}`,
+			wantErr:  require.Error,
+		},
+		{
+			name:     "invalid filepath pattern",
+			contents: `{"schemaVersion": 1, "version": 2, "classifiers": [{"class": "bad", "filepathPatterns": ["("]}]}`,
+			wantErr:  require.Error,
+		},
+		{
+			name:     "invalid evidence template",
+			contents: `{"schemaVersion": 1, "version": 2, "classifiers": [{"class": "bad", "evidencePatternTemplates": ["{{ .version"]}]}`,
+			wantErr:  require.Error,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParseClassifierDatabase(strings.NewReader(test.contents))
+			test.wantErr(t, err)
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package file
+
+import (
+	"github.com/anchore/syft/syft/source"
+)
+
+// hasOwnContent indicates whether the content of the file at the given location should be cataloged: hardlinks share
+// the content of their link destination (which is cataloged instead, so the content is not counted twice), and special
+// files (devices, sockets, and FIFOs) have no content to read.
+func hasOwnContent(resolver source.FileResolver, location source.Location) bool {
+	metadata, err := resolver.FileMetadataByLocation(location)
+	if err != nil {
+		// let the content request surface any problem with the file
+		return true
+	}
+	return metadata.Type != source.HardLink && !metadata.Type.IsSpecial()
+}
+
+// isSpecialFile indicates whether the file at the given location is a device, socket, or FIFO.
+func isSpecialFile(resolver source.FileResolver, location source.Location) bool {
+	metadata, err := resolver.FileMetadataByLocation(location)
+	if err != nil {
+		return false
+	}
+	return metadata.Type.IsSpecial()
+}
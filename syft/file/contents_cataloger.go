@@ -23,37 +23,46 @@ func NewContentsCataloger(globs []string, skipFilesAboveSize int64) (*ContentsCa
 	}, nil
 }
 
-func (i *ContentsCataloger) Catalog(resolver source.FileResolver) (map[source.Coordinates]string, error) {
+// Catalog captures the (base64 encoded) contents of all files matching the configured globs, along with warnings for
+// files that were skipped (unreadable or above the configured size limit).
+func (i *ContentsCataloger) Catalog(resolver source.FileResolver) (map[source.Coordinates]string, []source.Warning, error) {
 	results := make(map[source.Coordinates]string)
+	var warnings []source.Warning
 	var locations []source.Location
 
 	locations, err := resolver.FilesByGlob(i.globs...)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	for _, location := range locations {
 		metadata, err := resolver.FileMetadataByLocation(location)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
+		}
+
+		if metadata.Type.IsSpecial() {
+			continue
 		}
 
 		if i.skipFilesAboveSizeInBytes > 0 && metadata.Size > i.skipFilesAboveSizeInBytes {
+			warnings = append(warnings, skippedFileWarning("file contents", location, errFileTooLarge{size: metadata.Size, limit: i.skipFilesAboveSizeInBytes}))
 			continue
 		}
 
 		result, err := i.catalogLocation(resolver, location)
 		if internal.IsErrPathPermission(err) {
 			log.Debugf("file contents cataloger skipping - %+v", err)
+			warnings = append(warnings, skippedFileWarning("file contents", location, err))
 			continue
 		}
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		results[location.Coordinates] = result
 	}
 	log.Debugf("file contents cataloger processed %d files", len(results))
 
-	return results, nil
+	return results, warnings, nil
 }
 
 func (i *ContentsCataloger) catalogLocation(resolver source.FileResolver, location source.Location) (string, error) {
@@ -16,6 +16,7 @@ func TestContentsCataloger(t *testing.T) {
 		maxSize  int64
 		files    []string
 		expected map[source.Coordinates]string
+		skipped  []string
 	}{
 		{
 			name:  "multi-pattern",
@@ -61,6 +62,7 @@ func TestContentsCataloger(t *testing.T) {
 				source.NewLocation("test-fixtures/last/path.txt").Coordinates: "dGVzdC1maXh0dXJlcy9sYXN0L3BhdGgudHh0IGZpbGUgY29udGVudHMh",
 				source.NewLocation("test-fixtures/a-path.txt").Coordinates:    "dGVzdC1maXh0dXJlcy9hLXBhdGgudHh0IGZpbGUgY29udGVudHMh",
 			},
+			skipped: []string{"test-fixtures/another-path.txt"},
 		},
 	}
 
@@ -70,10 +72,16 @@ func TestContentsCataloger(t *testing.T) {
 			assert.NoError(t, err)
 
 			resolver := source.NewMockResolverForPaths(test.files...)
-			actual, err := c.Catalog(resolver)
+			actual, warnings, err := c.Catalog(resolver)
 			assert.NoError(t, err)
 			assert.Equal(t, test.expected, actual, "mismatched contents")
 
+			var skipped []string
+			for _, w := range warnings {
+				skipped = append(skipped, w.Path)
+			}
+			assert.Equal(t, test.skipped, skipped, "mismatched skipped files")
+
 		})
 	}
 }
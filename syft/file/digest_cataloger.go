@@ -38,6 +38,10 @@ func (i *DigestsCataloger) Catalog(resolver source.FileResolver) (map[source.Coo
 	stage, prog := digestsCatalogingProgress(int64(len(locations)))
 	for _, location := range locations {
 		stage.Current = location.RealPath
+		if !hasOwnContent(resolver, location) {
+			prog.N++
+			continue
+		}
 		result, err := i.catalogLocation(resolver, location)
 		if internal.IsErrPathPermission(err) {
 			log.Debugf("file digests cataloger skipping - %+v", err)
@@ -0,0 +1,42 @@
+package file
+
+// ExecutableFormat is the binary format of an executable file (e.g. ELF).
+type ExecutableFormat string
+
+const (
+	ELF   ExecutableFormat = "elf"
+	PE    ExecutableFormat = "pe"
+	MachO ExecutableFormat = "macho"
+)
+
+// RelocationReadOnly describes how much of the relocation table of an ELF binary is remapped read-only after startup.
+type RelocationReadOnly string
+
+const (
+	RelocationReadOnlyNone    RelocationReadOnly = "none"
+	RelocationReadOnlyPartial RelocationReadOnly = "partial"
+	RelocationReadOnlyFull    RelocationReadOnly = "full"
+)
+
+// Executable describes how a binary was built: the toolchains that produced it and the hardening features it was
+// compiled and linked with.
+type Executable struct {
+	Format ExecutableFormat `json:"format"`
+	// BuildID is the GNU build-id of an ELF binary (hex encoded), which is shared with any split debug file for the binary.
+	BuildID    string      `json:"buildID,omitempty"`
+	Toolchains []Toolchain `json:"toolchains,omitempty"`
+	// PositionIndependent indicates the binary can be loaded at a random base address (PIE for ELF, DYNAMIC_BASE for PE, MH_PIE for Mach-O).
+	PositionIndependent bool `json:"positionIndependent"`
+	// RelocationReadOnly is the RELRO level of an ELF binary (not set for other formats).
+	RelocationReadOnly RelocationReadOnly `json:"relocationReadOnly,omitempty"`
+	// StackProtector indicates the binary references the stack smashing protector (e.g. __stack_chk_fail).
+	StackProtector bool `json:"stackProtector"`
+	// NonExecutableStack indicates the stack (or data, for PE) is not executable (NX / NX_COMPAT).
+	NonExecutableStack bool `json:"nonExecutableStack"`
+}
+
+// Toolchain is a compiler (or linker) that contributed to a binary, as recorded within the binary itself.
+type Toolchain struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
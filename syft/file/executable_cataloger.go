@@ -0,0 +1,411 @@
+package file
+
+import (
+	"bytes"
+	"debug/elf"
+	"debug/macho"
+	"debug/pe"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/source"
+)
+
+const (
+	debugFileDir = "/lib/debug/"
+	// noteGNUBuildID is the ELF note type holding the build-id (NT_GNU_BUILD_ID)
+	noteGNUBuildID = 3
+	// maxExecutableSize limits how much of a binary is buffered in memory when its contents cannot be read at random
+	// offsets (larger binaries are skipped)
+	maxExecutableSize = 512 * 1024 * 1024
+)
+
+var (
+	// goBuildInfoMagic is the header of the .go.buildinfo section (see debug/buildinfo in the go stdlib)
+	goBuildInfoMagic = []byte("\xff Go buildinf:")
+	// toolchainCommentPatterns match the compiler and linker identification strings recorded in the ELF .comment section
+	toolchainCommentPatterns = []struct {
+		name    string
+		pattern *regexp.Regexp
+	}{
+		{name: "gcc", pattern: regexp.MustCompile(`^GCC: \(.*\) (?P<version>\d+(\.\d+)*)`)},
+		{name: "clang", pattern: regexp.MustCompile(`clang version (?P<version>\d+(\.\d+)*)`)},
+		{name: "rustc", pattern: regexp.MustCompile(`^rustc version (?P<version>\d+(\.\d+)*)`)},
+		{name: "lld", pattern: regexp.MustCompile(`^Linker: LLD (?P<version>\d+(\.\d+)*)`)},
+	}
+	// debugFileGlob matches split debug files, either by build-id (/usr/lib/debug/.build-id/ab/cdef.debug) or by the path of
+	// the binary (/usr/lib/debug/usr/bin/foo.debug)
+	debugFileGlob         = "**" + debugFileDir + "**"
+	stackProtectorSymbols = []string{"__stack_chk_fail", "__stack_chk_guard", "___stack_chk_fail", "___stack_chk_guard"}
+)
+
+// ExecutableCataloger records the toolchain provenance (compiler versions), the hardening features (PIE, RELRO,
+// stack protector, NX), and the GNU build-id of ELF, PE, and Mach-O binaries. Split debug files are related back to
+// the binaries they hold the symbols for.
+type ExecutableCataloger struct {
+}
+
+func NewExecutableCataloger() (*ExecutableCataloger, error) {
+	return &ExecutableCataloger{}, nil
+}
+
+func (i *ExecutableCataloger) Catalog(resolver source.FileResolver) (map[source.Coordinates]Executable, []artifact.Relationship, error) {
+	locations, err := resolver.FilesByMIMEType(internal.ExecutableMIMETypeSet.List()...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to find executables: %w", err)
+	}
+
+	// debug files do not always have a MIME type that indicates an executable, so these are searched for explicitly
+	debugLocations, err := resolver.FilesByGlob(debugFileGlob)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to find debug files: %w", err)
+	}
+
+	results := make(map[source.Coordinates]Executable)
+	for _, location := range append(locations, debugLocations...) {
+		if _, exists := results[location.Coordinates]; exists {
+			continue
+		}
+		executable, err := catalogExecutable(resolver, location)
+		if errors.Is(err, errFileTooLarge) {
+			log.Warnf("skipping executable %q: %+v", location.RealPath, err)
+			continue
+		}
+		if err != nil {
+			log.Debugf("unable to read executable %q: %+v", location.RealPath, err)
+			continue
+		}
+		if executable != nil {
+			results[location.Coordinates] = *executable
+		}
+	}
+	log.Debugf("executable cataloger processed %d binaries", len(results))
+
+	return results, debugSymbolsRelationships(results), nil
+}
+
+// debugSymbolsRelationships relates each split debug file to the binaries that share the same GNU build-id.
+func debugSymbolsRelationships(executables map[source.Coordinates]Executable) []artifact.Relationship {
+	binariesByBuildID := make(map[string][]source.Coordinates)
+	var debugFiles []source.Coordinates
+	for coordinates, executable := range executables {
+		if executable.BuildID == "" {
+			continue
+		}
+		if isDebugFile(coordinates.RealPath) {
+			debugFiles = append(debugFiles, coordinates)
+			continue
+		}
+		binariesByBuildID[executable.BuildID] = append(binariesByBuildID[executable.BuildID], coordinates)
+	}
+
+	// keep the results stable across runs
+	sort.Slice(debugFiles, func(i, j int) bool {
+		return debugFiles[i].RealPath < debugFiles[j].RealPath
+	})
+
+	var relationships []artifact.Relationship
+	for _, debugFile := range debugFiles {
+		binaries := binariesByBuildID[executables[debugFile].BuildID]
+		sort.Slice(binaries, func(i, j int) bool {
+			return binaries[i].RealPath < binaries[j].RealPath
+		})
+		for _, binary := range binaries {
+			relationships = append(relationships, artifact.Relationship{
+				From: debugFile,
+				To:   binary,
+				Type: artifact.DebugSymbolsOfRelationship,
+			})
+		}
+	}
+	return relationships
+}
+
+func isDebugFile(p string) bool {
+	return strings.Contains(p, debugFileDir)
+}
+
+func catalogExecutable(resolver source.FileResolver, location source.Location) (*Executable, error) {
+	contentReader, err := resolver.FileContentsByLocation(location)
+	if err != nil {
+		return nil, err
+	}
+	defer internal.CloseAndLogError(contentReader, location.VirtualPath)
+
+	reader, err := readerAt(contentReader, maxExecutableSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return readExecutable(reader)
+}
+
+// readExecutable determines the format of the given binary and describes it, returning nil for unsupported formats
+// (e.g. scripts or fat Mach-O binaries).
+func readExecutable(reader io.ReaderAt) (*Executable, error) {
+	magic := make([]byte, 4)
+	if _, err := reader.ReadAt(magic, 0); err != nil {
+		return nil, nil
+	}
+
+	switch {
+	case bytes.Equal(magic, []byte(elf.ELFMAG)):
+		f, err := elf.NewFile(reader)
+		if err != nil {
+			return nil, err
+		}
+		return elfExecutable(f), nil
+	case bytes.HasPrefix(magic, []byte("MZ")):
+		f, err := pe.NewFile(reader)
+		if err != nil {
+			return nil, err
+		}
+		return peExecutable(f), nil
+	case isMachO(magic):
+		f, err := macho.NewFile(reader)
+		if err != nil {
+			return nil, err
+		}
+		return machoExecutable(f), nil
+	}
+	return nil, nil
+}
+
+func isMachO(magic []byte) bool {
+	for _, m := range []uint32{macho.Magic32, macho.Magic64} {
+		if binary.LittleEndian.Uint32(magic) == m || binary.BigEndian.Uint32(magic) == m {
+			return true
+		}
+	}
+	return false
+}
+
+func elfExecutable(f *elf.File) *Executable {
+	executable := Executable{
+		Format:              ELF,
+		BuildID:             elfBuildID(f),
+		Toolchains:          elfToolchains(f),
+		PositionIndependent: f.Type == elf.ET_DYN,
+		RelocationReadOnly:  RelocationReadOnlyNone,
+		StackProtector:      hasAnySymbol(elfSymbolNames(f), stackProtectorSymbols),
+	}
+
+	// without a PT_GNU_STACK header the loader falls back to an executable stack
+	for _, prog := range f.Progs {
+		switch prog.Type {
+		case elf.PT_GNU_RELRO:
+			executable.RelocationReadOnly = RelocationReadOnlyPartial
+		case elf.PT_GNU_STACK:
+			executable.NonExecutableStack = prog.Flags&elf.PF_X == 0
+		}
+	}
+
+	// full RELRO additionally requires that all symbols are resolved at load time (so the GOT can be made read-only)
+	if executable.RelocationReadOnly == RelocationReadOnlyPartial {
+		tags := elfDynamicTags(f)
+		_, bindNow := tags[elf.DT_BIND_NOW]
+		if bindNow || tags[elf.DT_FLAGS]&uint64(elf.DF_BIND_NOW) != 0 || tags[elf.DT_FLAGS_1]&uint64(elf.DF_1_NOW) != 0 {
+			executable.RelocationReadOnly = RelocationReadOnlyFull
+		}
+	}
+
+	return &executable
+}
+
+// elfBuildID returns the hex encoded GNU build-id from the .note.gnu.build-id section (if present).
+func elfBuildID(f *elf.File) string {
+	section := f.Section(".note.gnu.build-id")
+	if section == nil {
+		return ""
+	}
+	data, err := section.Data()
+	if err != nil || len(data) < 12 {
+		return ""
+	}
+
+	// a note is a header (name size, description size, type) followed by the name and description (each 4-byte aligned)
+	nameSize := f.ByteOrder.Uint32(data[0:4])
+	descSize := f.ByteOrder.Uint32(data[4:8])
+	noteType := f.ByteOrder.Uint32(data[8:12])
+	descStart := 12 + uint64(nameSize+3)&^3
+	if noteType != noteGNUBuildID || descStart+uint64(descSize) > uint64(len(data)) {
+		return ""
+	}
+	return hex.EncodeToString(data[descStart : descStart+uint64(descSize)])
+}
+
+// elfToolchains reads the compilers recorded in the .comment section as well as the go toolchain recorded in the
+// .go.buildinfo section.
+func elfToolchains(f *elf.File) []Toolchain {
+	var toolchains []Toolchain
+	if section := f.Section(".comment"); section != nil {
+		if data, err := section.Data(); err == nil {
+			toolchains = append(toolchains, parseToolchainComments(data)...)
+		}
+	}
+	if section := f.Section(".go.buildinfo"); section != nil {
+		if data, err := section.Data(); err == nil {
+			toolchains = append(toolchains, goToolchain(data))
+		}
+	}
+	return toolchains
+}
+
+// parseToolchainComments parses the NUL-separated compiler identification strings (e.g. "GCC: (Debian 10.2.1-6) 10.2.1")
+// from an ELF .comment section, keeping only the recognized toolchains (in the order found, without duplicates).
+func parseToolchainComments(data []byte) []Toolchain {
+	var toolchains []Toolchain
+	seen := make(map[Toolchain]struct{})
+	for _, comment := range bytes.Split(data, []byte{0}) {
+		value := strings.TrimSpace(string(comment))
+		for _, candidate := range toolchainCommentPatterns {
+			groups := internal.MatchNamedCaptureGroups(candidate.pattern, value)
+			if len(groups) == 0 {
+				continue
+			}
+			toolchain := Toolchain{Name: candidate.name, Version: groups["version"]}
+			if _, ok := seen[toolchain]; !ok {
+				seen[toolchain] = struct{}{}
+				toolchains = append(toolchains, toolchain)
+			}
+			break
+		}
+	}
+	return toolchains
+}
+
+// goToolchain reads the go version from .go.buildinfo section contents. Only the inline string format (go 1.18+) is
+// read, older binaries refer to the version indirectly so only the toolchain name is reported.
+func goToolchain(data []byte) Toolchain {
+	toolchain := Toolchain{Name: "go"}
+
+	const headerSize = 32
+	if !bytes.HasPrefix(data, goBuildInfoMagic) || len(data) < headerSize {
+		return toolchain
+	}
+
+	// the flags byte follows the magic and the pointer size, where the 0x2 bit indicates inline strings
+	if data[len(goBuildInfoMagic)+1]&0x2 == 0 {
+		return toolchain
+	}
+
+	length, n := binary.Uvarint(data[headerSize:])
+	if n <= 0 || uint64(len(data)-headerSize-n) < length {
+		return toolchain
+	}
+	toolchain.Version = strings.TrimPrefix(string(data[headerSize+n:headerSize+n+int(length)]), "go")
+
+	return toolchain
+}
+
+func elfSymbolNames(f *elf.File) []string {
+	var names []string
+	// note: either symbol table may be missing (e.g. static or stripped binaries)
+	dynamic, _ := f.DynamicSymbols()
+	static, _ := f.Symbols()
+	for _, symbols := range [][]elf.Symbol{dynamic, static} {
+		for _, symbol := range symbols {
+			names = append(names, symbol.Name)
+		}
+	}
+	return names
+}
+
+// elfDynamicTags returns the value of each tag found within the .dynamic section (later entries win).
+func elfDynamicTags(f *elf.File) map[elf.DynTag]uint64 {
+	tags := make(map[elf.DynTag]uint64)
+
+	section := f.Section(".dynamic")
+	if section == nil {
+		return tags
+	}
+	data, err := section.Data()
+	if err != nil {
+		return tags
+	}
+
+	for len(data) > 0 {
+		var tag elf.DynTag
+		var value uint64
+		switch f.Class {
+		case elf.ELFCLASS32:
+			if len(data) < 8 {
+				return tags
+			}
+			tag = elf.DynTag(f.ByteOrder.Uint32(data[0:4]))
+			value = uint64(f.ByteOrder.Uint32(data[4:8]))
+			data = data[8:]
+		case elf.ELFCLASS64:
+			if len(data) < 16 {
+				return tags
+			}
+			tag = elf.DynTag(f.ByteOrder.Uint64(data[0:8]))
+			value = f.ByteOrder.Uint64(data[8:16])
+			data = data[16:]
+		default:
+			return tags
+		}
+		if tag == elf.DT_NULL {
+			break
+		}
+		tags[tag] = value
+	}
+	return tags
+}
+
+func peExecutable(f *pe.File) *Executable {
+	var characteristics uint16
+	switch header := f.OptionalHeader.(type) {
+	case *pe.OptionalHeader32:
+		characteristics = header.DllCharacteristics
+	case *pe.OptionalHeader64:
+		characteristics = header.DllCharacteristics
+	}
+
+	return &Executable{
+		Format:              PE,
+		PositionIndependent: characteristics&pe.IMAGE_DLLCHARACTERISTICS_DYNAMIC_BASE != 0,
+		NonExecutableStack:  characteristics&pe.IMAGE_DLLCHARACTERISTICS_NX_COMPAT != 0,
+	}
+}
+
+func machoExecutable(f *macho.File) *Executable {
+	const (
+		flagPIE                 = 0x200000
+		flagAllowStackExecution = 0x20000
+	)
+
+	var names []string
+	if f.Symtab != nil {
+		for _, symbol := range f.Symtab.Syms {
+			names = append(names, symbol.Name)
+		}
+	}
+
+	return &Executable{
+		Format:              MachO,
+		PositionIndependent: f.Flags&flagPIE != 0,
+		StackProtector:      hasAnySymbol(names, stackProtectorSymbols),
+		NonExecutableStack:  f.Flags&flagAllowStackExecution == 0,
+	}
+}
+
+func hasAnySymbol(names []string, wanted []string) bool {
+	for _, name := range names {
+		for _, w := range wanted {
+			if name == w {
+				return true
+			}
+		}
+	}
+	return false
+}
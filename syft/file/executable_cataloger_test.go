@@ -0,0 +1,162 @@
+package file
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/source"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecutableCataloger(t *testing.T) {
+	src, err := source.NewFromDirectory("test-fixtures/executables")
+	require.NoError(t, err)
+
+	resolver, err := src.FileResolver(source.SquashedScope)
+	require.NoError(t, err)
+
+	c, err := NewExecutableCataloger()
+	require.NoError(t, err)
+
+	actual, relationships, err := c.Catalog(resolver)
+	require.NoError(t, err)
+	assert.Empty(t, relationships)
+
+	gcc := []Toolchain{{Name: "gcc", Version: "12.2.0"}}
+	expected := map[string]Executable{
+		"hardened": {
+			Format:              ELF,
+			BuildID:             "74ff8773fbb58cff4322019a1012d65abed6f36b",
+			Toolchains:          gcc,
+			PositionIndependent: true,
+			RelocationReadOnly:  RelocationReadOnlyFull,
+			StackProtector:      true,
+			NonExecutableStack:  true,
+		},
+		"unhardened": {
+			Format:             ELF,
+			BuildID:            "c41bf7a94fec42acfc154988a02afa21bf8889ef",
+			Toolchains:         gcc,
+			RelocationReadOnly: RelocationReadOnlyNone,
+		},
+	}
+
+	byPath := make(map[string]Executable)
+	for coordinates, executable := range actual {
+		byPath[coordinates.RealPath] = executable
+	}
+	assert.Equal(t, expected, byPath)
+}
+
+func TestExecutableCataloger_DebugSymbols(t *testing.T) {
+	src, err := source.NewFromDirectory("test-fixtures/debug-symbols")
+	require.NoError(t, err)
+
+	resolver, err := src.FileResolver(source.SquashedScope)
+	require.NoError(t, err)
+
+	c, err := NewExecutableCataloger()
+	require.NoError(t, err)
+
+	actual, relationships, err := c.Catalog(resolver)
+	require.NoError(t, err)
+
+	debugFile := "usr/lib/debug/.build-id/74/ff8773fbb58cff4322019a1012d65abed6f36b.debug"
+
+	var buildIDs = make(map[string]string)
+	for coordinates, executable := range actual {
+		buildIDs[coordinates.RealPath] = executable.BuildID
+	}
+	assert.Equal(t, map[string]string{
+		"usr/bin/hello": "74ff8773fbb58cff4322019a1012d65abed6f36b",
+		"usr/bin/other": "c41bf7a94fec42acfc154988a02afa21bf8889ef",
+		debugFile:       "74ff8773fbb58cff4322019a1012d65abed6f36b",
+	}, buildIDs)
+
+	require.Len(t, relationships, 1)
+	assert.Equal(t, artifact.DebugSymbolsOfRelationship, relationships[0].Type)
+	assert.Equal(t, debugFile, relationships[0].From.(source.Coordinates).RealPath)
+	assert.Equal(t, "usr/bin/hello", relationships[0].To.(source.Coordinates).RealPath)
+}
+
+func Test_parseToolchainComments(t *testing.T) {
+	tests := []struct {
+		name     string
+		comments []string
+		expected []Toolchain
+	}{
+		{
+			name:     "gcc",
+			comments: []string{"GCC: (Debian 10.2.1-6) 10.2.1 20210110", "GCC: (Debian 10.2.1-6) 10.2.1 20210110"},
+			expected: []Toolchain{{Name: "gcc", Version: "10.2.1"}},
+		},
+		{
+			name:     "rust linked with gcc",
+			comments: []string{"rustc version 1.56.0 (09c42c458 2021-10-18)", "GCC: (GNU) 11.1.0"},
+			expected: []Toolchain{{Name: "rustc", Version: "1.56.0"}, {Name: "gcc", Version: "11.1.0"}},
+		},
+		{
+			name:     "clang and lld",
+			comments: []string{"Ubuntu clang version 14.0.0-1ubuntu1", "Linker: LLD 14.0.0"},
+			expected: []Toolchain{{Name: "clang", Version: "14.0.0"}, {Name: "lld", Version: "14.0.0"}},
+		},
+		{
+			name:     "unknown",
+			comments: []string{"some other compiler"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var data []byte
+			for _, comment := range test.comments {
+				data = append(data, []byte(comment)...)
+				data = append(data, 0)
+			}
+			assert.Equal(t, test.expected, parseToolchainComments(data))
+		})
+	}
+}
+
+func Test_goToolchain(t *testing.T) {
+	inline := func(version string) []byte {
+		data := make([]byte, 32)
+		copy(data, goBuildInfoMagic)
+		data[len(goBuildInfoMagic)] = 8
+		data[len(goBuildInfoMagic)+1] = 0x2
+		data = append(data, byte(len(version)))
+		return append(data, []byte(version)...)
+	}
+
+	pointers := make([]byte, 32)
+	copy(pointers, goBuildInfoMagic)
+	binary.LittleEndian.PutUint64(pointers[16:], 0x4000)
+
+	tests := []struct {
+		name     string
+		data     []byte
+		expected Toolchain
+	}{
+		{
+			name:     "inline strings",
+			data:     inline("go1.18.3"),
+			expected: Toolchain{Name: "go", Version: "1.18.3"},
+		},
+		{
+			name:     "version pointers",
+			data:     pointers,
+			expected: Toolchain{Name: "go"},
+		},
+		{
+			name:     "truncated",
+			data:     goBuildInfoMagic,
+			expected: Toolchain{Name: "go"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, goToolchain(test.data))
+		})
+	}
+}
@@ -2,6 +2,7 @@ package file
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -40,8 +41,11 @@ func NewSecretsCataloger(patterns map[string]*regexp.Regexp, revealValues bool,
 	}, nil
 }
 
-func (i *SecretsCataloger) Catalog(resolver source.FileResolver) (map[source.Coordinates][]SearchResult, error) {
+// Catalog searches all files in the resolver for secrets, returning the findings by location along with warnings for
+// files that were skipped (unreadable or above the configured size limit).
+func (i *SecretsCataloger) Catalog(resolver source.FileResolver) (map[source.Coordinates][]SearchResult, []source.Warning, error) {
 	results := make(map[source.Coordinates][]SearchResult)
+	var warnings []source.Warning
 	var locations []source.Location
 	for location := range resolver.AllLocations() {
 		locations = append(locations, location)
@@ -49,14 +53,24 @@ func (i *SecretsCataloger) Catalog(resolver source.FileResolver) (map[source.Coo
 	stage, prog, secretsDiscovered := secretsCatalogingProgress(int64(len(locations)))
 	for _, location := range locations {
 		stage.Current = location.RealPath
+		if !hasOwnContent(resolver, location) {
+			prog.N++
+			continue
+		}
 		result, err := i.catalogLocation(resolver, location)
 		if internal.IsErrPathPermission(err) {
 			log.Debugf("secrets cataloger skipping - %+v", err)
+			warnings = append(warnings, skippedFileWarning("secrets", location, err))
+			continue
+		}
+		var tooLarge errFileTooLarge
+		if errors.As(err, &tooLarge) {
+			warnings = append(warnings, skippedFileWarning("secrets", location, tooLarge))
 			continue
 		}
 
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		if len(result) > 0 {
 			secretsDiscovered.N += int64(len(result))
@@ -66,7 +80,7 @@ func (i *SecretsCataloger) Catalog(resolver source.FileResolver) (map[source.Coo
 	}
 	log.Debugf("secrets cataloger discovered %d secrets", secretsDiscovered.N)
 	prog.SetCompleted()
-	return results, nil
+	return results, warnings, nil
 }
 
 func (i *SecretsCataloger) catalogLocation(resolver source.FileResolver, location source.Location) ([]SearchResult, error) {
@@ -76,7 +90,7 @@ func (i *SecretsCataloger) catalogLocation(resolver source.FileResolver, locatio
 	}
 
 	if i.skipFilesAboveSize > 0 && metadata.Size > i.skipFilesAboveSize {
-		return nil, nil
+		return nil, errFileTooLarge{size: metadata.Size, limit: i.skipFilesAboveSize}
 	}
 
 	// TODO: in the future we can swap out search strategies here
@@ -188,7 +188,7 @@ func TestSecretsCataloger(t *testing.T) {
 
 			resolver := source.NewMockResolverForPaths(test.fixture)
 
-			actualResults, err := c.Catalog(resolver)
+			actualResults, _, err := c.Catalog(resolver)
 			if err != nil && !test.catalogErr {
 				t.Fatalf("could not catalog (but should have been able to): %+v", err)
 			} else if err == nil && test.catalogErr {
@@ -426,7 +426,7 @@ j4f668YfhUbKdRF6S6734856
 
 			resolver := source.NewMockResolverForPaths(test.fixture)
 
-			actualResults, err := c.Catalog(resolver)
+			actualResults, _, err := c.Catalog(resolver)
 			if err != nil {
 				t.Fatalf("could not catalog: %+v", err)
 			}
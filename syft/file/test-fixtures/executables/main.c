@@ -0,0 +1,10 @@
+#include <stdio.h>
+#include <string.h>
+
+int main(int argc, char **argv) {
+	char buf[64];
+	strncpy(buf, argc > 1 ? argv[1] : "syft", sizeof(buf) - 1);
+	buf[sizeof(buf) - 1] = 0;
+	printf("hello %s\n", buf);
+	return 0;
+}
@@ -0,0 +1,25 @@
+package file
+
+import (
+	"fmt"
+
+	"github.com/anchore/syft/syft/source"
+)
+
+// errFileTooLarge indicates a file was not cataloged since it is above the configured size limit.
+type errFileTooLarge struct {
+	size  int64
+	limit int64
+}
+
+func (e errFileTooLarge) Error() string {
+	return fmt.Sprintf("file size (%d bytes) is above the limit of %d bytes", e.size, e.limit)
+}
+
+// skippedFileWarning describes a file that the named cataloger did not catalog, and why.
+func skippedFileWarning(cataloger string, location source.Location, err error) source.Warning {
+	return source.Warning{
+		Path:    location.RealPath,
+		Message: fmt.Sprintf("%s cataloger skipped file: %v", cataloger, err),
+	}
+}
@@ -11,6 +11,13 @@ const (
 	CycloneDxJSONOption Option = "cyclonedx-json"
 	SPDXTagValueOption  Option = "spdx-tag-value"
 	SPDXJSONOption      Option = "spdx-json"
+	SPDX23JSONOption    Option = "spdx-2.3-json"
+	TemplateOption      Option = "template"
+	CSVOption           Option = "csv"
+	GitHubOption        Option = "github-json"
+	SARIFOption         Option = "sarif"
+	MarkdownOption      Option = "markdown"
+	HTMLOption          Option = "html"
 )
 
 var AllOptions = []Option{
@@ -21,10 +28,36 @@ var AllOptions = []Option{
 	CycloneDxJSONOption,
 	SPDXTagValueOption,
 	SPDXJSONOption,
+	SPDX23JSONOption,
+	TemplateOption,
+	CSVOption,
+	GitHubOption,
+	SARIFOption,
+	MarkdownOption,
+	HTMLOption,
+}
+
+// nestedSBOMOptions are the formats that describe the container images found within the source (see sbom.NestedSBOM).
+var nestedSBOMOptions = []Option{
+	JSONOption,
+	CycloneDxXMLOption,
+	CycloneDxJSONOption,
+	TemplateOption,
 }
 
 type Option string
 
+// SupportsNestedSBOMs indicates if the format describes the container images found within the source (any other format
+// drops them).
+func (o Option) SupportsNestedSBOMs() bool {
+	for _, option := range nestedSBOMOptions {
+		if o == option {
+			return true
+		}
+	}
+	return false
+}
+
 func ParseOption(userStr string) Option {
 	switch strings.ToLower(userStr) {
 	case string(JSONOption):
@@ -43,6 +76,20 @@ func ParseOption(userStr string) Option {
 		return SPDXTagValueOption
 	case string(SPDXJSONOption), "spdxjson":
 		return SPDXJSONOption
+	case string(SPDX23JSONOption), "spdx23-json", "spdx23json":
+		return SPDX23JSONOption
+	case string(TemplateOption):
+		return TemplateOption
+	case string(CSVOption):
+		return CSVOption
+	case string(GitHubOption), "github":
+		return GitHubOption
+	case string(SARIFOption):
+		return SARIFOption
+	case string(MarkdownOption), "md":
+		return MarkdownOption
+	case string(HTMLOption):
+		return HTMLOption
 	default:
 		return UnknownFormatOption
 	}
@@ -33,11 +33,11 @@ import (
 
 // CatalogPackages takes an inventory of packages from the given image from a particular perspective
 // (e.g. squashed source, all-layers source). Returns the discovered  set of packages, the identified Linux
-// distribution, and the source object used to wrap the data source.
-func CatalogPackages(src *source.Source, cfg cataloger.Config) (*pkg.Catalog, []artifact.Relationship, *distro.Distro, error) {
+// distribution, and any non-fatal problems reported by the catalogers (e.g. unsupported lockfile versions).
+func CatalogPackages(src *source.Source, cfg cataloger.Config) (*pkg.Catalog, []artifact.Relationship, *distro.Distro, []source.Warning, error) {
 	resolver, err := src.FileResolver(cfg.Search.Scope)
 	if err != nil {
-		return nil, nil, nil, fmt.Errorf("unable to determine resolver while cataloging packages: %w", err)
+		return nil, nil, nil, nil, fmt.Errorf("unable to determine resolver while cataloging packages: %w", err)
 	}
 
 	// find the distro
@@ -61,15 +61,20 @@ func CatalogPackages(src *source.Source, cfg cataloger.Config) (*pkg.Catalog, []
 		log.Info("cataloging directory")
 		catalogers = cataloger.DirectoryCatalogers(cfg)
 	default:
-		return nil, nil, nil, fmt.Errorf("unable to determine cataloger set from scheme=%+v", src.Metadata.Scheme)
+		return nil, nil, nil, nil, fmt.Errorf("unable to determine cataloger set from scheme=%+v", src.Metadata.Scheme)
 	}
 
-	catalog, relationships, err := cataloger.Catalog(resolver, theDistro, catalogers...)
+	catalogers = cataloger.LimitSearchDepth(catalogers, cfg.Search.MaxDepthByCataloger)
+
+	catalog, relationships, warnings, err := cataloger.Catalog(resolver, theDistro, catalogers...)
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, nil, nil, nil, err
 	}
+	catalog = cataloger.MapRegistryPackageURLs(catalog, cfg.RegistryMappings)
+	// ownership rules match on canonical package URL namespaces, so they are applied after the registry mapping
+	catalog = cataloger.AttachOwnership(catalog, cfg.OwnershipRules)
 
-	return catalog, relationships, theDistro, nil
+	return catalog, relationships, theDistro, warnings, nil
 }
 
 // SetLogger sets the logger object used for all syft logging calls.
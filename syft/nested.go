@@ -0,0 +1,65 @@
+package syft
+
+import (
+	"fmt"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/pkg/cataloger"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/anchore/syft/syft/source"
+)
+
+// CatalogNestedImages finds container images stored within the given source (OCI layouts and docker-archive
+// tarballs) and catalogs the packages within each of them. Only images directly within the source are considered
+// (images nested within nested images are not). Images that cannot be read are logged and skipped.
+func CatalogNestedImages(src *source.Source, cfg cataloger.Config) ([]sbom.NestedSBOM, error) {
+	resolver, err := src.FileResolver(cfg.Search.Scope)
+	if err != nil {
+		return nil, fmt.Errorf("unable to determine resolver while searching for nested images: %w", err)
+	}
+
+	images, err := source.FindNestedImages(resolver)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []sbom.NestedSBOM
+	for _, img := range images {
+		nested, err := catalogNestedImage(img, resolver, cfg)
+		if err != nil {
+			log.Warnf("unable to catalog nested image (path=%q): %+v", img.Location.RealPath, err)
+			continue
+		}
+		results = append(results, *nested)
+	}
+
+	return results, nil
+}
+
+func catalogNestedImage(img source.NestedImage, resolver source.FileResolver, cfg cataloger.Config) (*sbom.NestedSBOM, error) {
+	log.Infof("cataloging nested image: %s", img.Location.RealPath)
+
+	src, cleanup, err := img.Open(resolver)
+	defer cleanup()
+	if err != nil {
+		return nil, err
+	}
+
+	catalog, relationships, theDistro, warnings, err := CatalogPackages(src, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sbom.NestedSBOM{
+		Location: img.Location.Coordinates,
+		SBOM: sbom.SBOM{
+			Artifacts: sbom.Artifacts{
+				PackageCatalog: catalog,
+				Distro:         theDistro,
+				Warnings:       warnings,
+			},
+			Relationships: relationships,
+			Source:        src.Metadata,
+		},
+	}, nil
+}
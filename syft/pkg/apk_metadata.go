@@ -1,8 +1,10 @@
 package pkg
 
 import (
+	"fmt"
 	"sort"
 
+	"github.com/anchore/syft/syft/distro"
 	"github.com/anchore/syft/syft/file"
 
 	"github.com/anchore/packageurl-go"
@@ -33,6 +35,7 @@ type ApkMetadata struct {
 	PullChecksum     string          `mapstructure:"C" json:"pullChecksum"`
 	GitCommitOfAport string          `mapstructure:"c" json:"gitCommitOfApkPort"`
 	Files            []ApkFileRecord `json:"files"`
+	Sources          []string        `json:"sources,omitempty"`
 }
 
 // ApkFileRecord represents a single file listing and metadata from a APK DB entry (which may have many of these file records).
@@ -44,8 +47,27 @@ type ApkFileRecord struct {
 	Digest      *file.Digest `json:"digest,omitempty"`
 }
 
-// PackageURL returns the PURL for the specific Alpine package (see https://github.com/package-url/purl-spec)
-func (m ApkMetadata) PackageURL() string {
+// PackageURL returns the PURL for the specific Alpine package (see https://github.com/package-url/purl-spec). Packages
+// from the APK based distributions of Wolfi and Chainguard are given the "apk" type, namespaced by the distribution (as
+// written into the melange SBOMs of those packages).
+func (m ApkMetadata) PackageURL(d *distro.Distro) string {
+	qualifiers := packageurl.Qualifiers{
+		{
+			Key:   "arch",
+			Value: m.Architecture,
+		},
+	}
+
+	if d != nil && (d.Type == distro.Wolfi || d.Type == distro.Chainguard) {
+		if d.RawVersion != "" {
+			qualifiers = append(qualifiers, packageurl.Qualifier{
+				Key:   "distro",
+				Value: fmt.Sprintf("%s-%s", d.Type, d.RawVersion),
+			})
+		}
+		return packageurl.NewPackageURL("apk", d.Type.String(), m.Package, m.Version, qualifiers, "").ToString()
+	}
+
 	pURL := packageurl.NewPackageURL(
 		// note: this is currently a candidate and not technically within spec
 		// see https://github.com/package-url/purl-spec#other-candidate-types-to-define
@@ -53,12 +75,7 @@ func (m ApkMetadata) PackageURL() string {
 		"",
 		m.Package,
 		m.Version,
-		packageurl.Qualifiers{
-			{
-				Key:   "arch",
-				Value: m.Architecture,
-			},
-		},
+		qualifiers,
 		"")
 	return pURL.ToString()
 }
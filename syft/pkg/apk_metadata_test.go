@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/syft/distro"
 	"github.com/go-test/deep"
 	"github.com/sergi/go-diff/diffmatchpatch"
 )
@@ -12,6 +13,7 @@ import (
 func TestApkMetadata_pURL(t *testing.T) {
 	tests := []struct {
 		metadata ApkMetadata
+		distro   *distro.Distro
 		expected string
 	}{
 		{
@@ -39,11 +41,45 @@ func TestApkMetadata_pURL(t *testing.T) {
 			},
 			expected: "pkg:alpine/g%20plus%20plus@v84?arch=am86",
 		},
+		{
+			metadata: ApkMetadata{
+				Package:      "p",
+				Version:      "v",
+				Architecture: "a",
+			},
+			distro: &distro.Distro{
+				Type: distro.Alpine,
+			},
+			expected: "pkg:alpine/p@v?arch=a",
+		},
+		{
+			metadata: ApkMetadata{
+				Package:      "busybox",
+				Version:      "1.36.0-r3",
+				Architecture: "x86_64",
+			},
+			distro: &distro.Distro{
+				Type:       distro.Wolfi,
+				RawVersion: "20230201",
+			},
+			expected: "pkg:apk/wolfi/busybox@1.36.0-r3?arch=x86_64&distro=wolfi-20230201",
+		},
+		{
+			metadata: ApkMetadata{
+				Package:      "busybox",
+				Version:      "1.36.0-r3",
+				Architecture: "x86_64",
+			},
+			distro: &distro.Distro{
+				Type: distro.Chainguard,
+			},
+			expected: "pkg:apk/chainguard/busybox@1.36.0-r3?arch=x86_64",
+		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.expected, func(t *testing.T) {
-			actual := test.metadata.PackageURL()
+			actual := test.metadata.PackageURL(test.distro)
 			if actual != test.expected {
 				dmp := diffmatchpatch.New()
 				diffs := dmp.DiffMain(test.expected, actual, true)
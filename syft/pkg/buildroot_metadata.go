@@ -0,0 +1,24 @@
+package pkg
+
+import (
+	"sort"
+)
+
+var _ FileOwner = (*BuildrootMetadata)(nil)
+
+// BuildrootMetadata represents all captured data for a package built into a Buildroot firmware image, as described
+// by the packages-file-list.txt and legal-info/manifest.csv files produced by a Buildroot build.
+type BuildrootMetadata struct {
+	Package       string   `json:"package"`
+	Version       string   `json:"version,omitempty"`
+	License       string   `json:"license,omitempty"`
+	SourceArchive string   `json:"sourceArchive,omitempty"`
+	SourceSite    string   `json:"sourceSite,omitempty"`
+	Files         []string `json:"files"`
+}
+
+func (m BuildrootMetadata) OwnedFiles() (result []string) {
+	result = append(result, m.Files...)
+	sort.Strings(result)
+	return
+}
@@ -1,5 +1,18 @@
 package pkg
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anchore/syft/syft/file"
+)
+
+// cratesIORegistrySources are the Cargo.lock source values that refer to the public crates.io registry.
+var cratesIORegistrySources = []string{
+	"registry+https://github.com/rust-lang/crates.io-index",
+	"sparse+https://index.crates.io/",
+}
+
 type CargoPackageMetadata struct {
 	Name         string   `toml:"name" json:"name"`
 	Version      string   `toml:"version" json:"version"`
@@ -15,7 +28,42 @@ func (p CargoPackageMetadata) Pkg() *Package {
 		Version:      p.Version,
 		Language:     Rust,
 		Type:         RustPkg,
+		OriginURLs:   p.originURLs(),
+		Checksums:    p.Checksums(),
 		MetadataType: RustCargoPackageMetadataType,
 		Metadata:     p,
 	}
 }
+
+// originURLs derives where the crate was fetched from based on the Cargo.lock source.
+func (p CargoPackageMetadata) originURLs() OriginURLs {
+	for _, registry := range cratesIORegistrySources {
+		if p.Source == registry {
+			return OriginURLs{
+				Download: fmt.Sprintf("https://crates.io/api/v1/crates/%s/%s/download", p.Name, p.Version),
+			}
+		}
+	}
+
+	if strings.HasPrefix(p.Source, "git+") {
+		return OriginURLs{
+			Repository: p.Source,
+		}
+	}
+
+	return OriginURLs{}
+}
+
+// Checksums returns the digest of the .crate file the package is installed from, as recorded in Cargo.lock (absent for
+// git and path dependencies).
+func (p CargoPackageMetadata) Checksums() []file.Digest {
+	if p.Checksum == "" {
+		return nil
+	}
+	return []file.Digest{
+		{
+			Algorithm: "sha256",
+			Value:     p.Checksum,
+		},
+	}
+}
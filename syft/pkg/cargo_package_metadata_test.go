@@ -0,0 +1,61 @@
+package pkg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCargoPackageMetadata_originURLs(t *testing.T) {
+	tests := []struct {
+		name     string
+		metadata CargoPackageMetadata
+		expected OriginURLs
+	}{
+		{
+			name: "crates.io registry",
+			metadata: CargoPackageMetadata{
+				Name:    "ansi_term",
+				Version: "0.12.1",
+				Source:  "registry+https://github.com/rust-lang/crates.io-index",
+			},
+			expected: OriginURLs{
+				Download: "https://crates.io/api/v1/crates/ansi_term/0.12.1/download",
+			},
+		},
+		{
+			name: "crates.io sparse registry",
+			metadata: CargoPackageMetadata{
+				Name:    "matches",
+				Version: "0.1.8",
+				Source:  "sparse+https://index.crates.io/",
+			},
+			expected: OriginURLs{
+				Download: "https://crates.io/api/v1/crates/matches/0.1.8/download",
+			},
+		},
+		{
+			name: "git source",
+			metadata: CargoPackageMetadata{
+				Name:    "syft",
+				Version: "0.1.0",
+				Source:  "git+https://github.com/anchore/syft?branch=main#a1b2c3",
+			},
+			expected: OriginURLs{
+				Repository: "git+https://github.com/anchore/syft?branch=main#a1b2c3",
+			},
+		},
+		{
+			name: "local path",
+			metadata: CargoPackageMetadata{
+				Name:    "workspace-member",
+				Version: "0.1.0",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.metadata.originURLs())
+		})
+	}
+}
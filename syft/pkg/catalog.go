@@ -146,6 +146,10 @@ func (c *Catalog) Sorted(types ...Type) (pkgs []Package) {
 		if pkgs[i].Name == pkgs[j].Name {
 			if pkgs[i].Version == pkgs[j].Version {
 				if pkgs[i].Type == pkgs[j].Type && len(pkgs[i].Locations) > 0 && len(pkgs[j].Locations) > 0 {
+					if pkgs[i].Locations[0].String() == pkgs[j].Locations[0].String() {
+						// packages that only differ by their metadata are still ordered the same way on every run
+						return pkgs[i].ID() < pkgs[j].ID()
+					}
 					return pkgs[i].Locations[0].String() < pkgs[j].Locations[0].String()
 				}
 				return pkgs[i].Type < pkgs[j].Type
@@ -1,18 +1,105 @@
 /*
-Package apkdb provides a concrete Cataloger implementation for Alpine DB files.
+Package apkdb provides a concrete Cataloger implementation for Alpine DB files (including those of the APK based
+Wolfi and Chainguard distributions).
 */
 package apkdb
 
 import (
+	"fmt"
+	"path"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
 	"github.com/anchore/syft/syft/pkg"
-	"github.com/anchore/syft/syft/pkg/cataloger/common"
+	"github.com/anchore/syft/syft/source"
 )
 
+// melangeSBOMDir is where packages built with melange (e.g. all Wolfi and Chainguard packages) install their SBOM,
+// relative to the root of the APK database.
+const melangeSBOMDir = "var/lib/db/sbom"
+
+type Cataloger struct{}
+
 // NewApkdbCataloger returns a new Alpine DB cataloger object.
-func NewApkdbCataloger() *common.GenericCataloger {
-	globParsers := map[string]common.ParserFn{
-		pkg.ApkDBGlob: parseApkDB,
+func NewApkdbCataloger() *Cataloger {
+	return &Cataloger{}
+}
+
+// Name returns a string that uniquely describes a cataloger
+func (c *Cataloger) Name() string {
+	return "apkdb-cataloger"
+}
+
+// Catalog is given an object to resolve file references and content, this function returns any discovered Packages after analyzing APK DB files (and any melange SBOMs).
+func (c *Cataloger) Catalog(resolver source.FileResolver) ([]pkg.Package, []artifact.Relationship, error) {
+	dbFileMatches, err := resolver.FilesByGlob(pkg.ApkDBGlob)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find apk db files by glob: %w", err)
+	}
+
+	var allPackages []pkg.Package
+	for _, dbLocation := range dbFileMatches {
+		dbContents, err := resolver.FileContentsByLocation(dbLocation)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		pkgs, _, err := parseApkDB(dbLocation.RealPath, dbContents)
+		internal.CloseAndLogError(dbContents, dbLocation.VirtualPath)
+		if err != nil {
+			log.Warnf("cataloger '%s' failed to parse entries at location=%+v: %+v", c.Name(), dbLocation, err)
+			continue
+		}
+
+		for _, p := range pkgs {
+			p.FoundBy = c.Name()
+			p.Locations = []source.Location{dbLocation}
+
+			// packages built with melange describe where they were built from within their own SBOM
+			addMelangeSBOM(resolver, dbLocation, p)
+
+			p.SetID()
+			allPackages = append(allPackages, *p)
+		}
 	}
+	return allPackages, nil, nil
+}
+
+func addMelangeSBOM(resolver source.FileResolver, dbLocation source.Location, p *pkg.Package) {
+	metadata := p.Metadata.(pkg.ApkMetadata)
+
+	// the database lives at ROOT/lib/apk/db/installed, while the SBOMs live at ROOT/var/lib/db/sbom/NAME-VERSION.spdx.json
+	root := path.Dir(path.Dir(path.Dir(path.Dir(dbLocation.RealPath))))
+	sbomPath := path.Join(root, melangeSBOMDir, fmt.Sprintf("%s-%s.spdx.json", metadata.Package, metadata.Version))
+
+	location := resolver.RelativeFileByPath(dbLocation, sbomPath)
+	if location == nil {
+		return
+	}
+
+	reader, err := resolver.FileContentsByLocation(*location)
+	if err != nil {
+		log.Warnf("failed to fetch melange SBOM contents (package=%s): %+v", p.Name, err)
+		return
+	}
+	defer internal.CloseAndLogError(reader, location.VirtualPath)
+
+	sbom, err := parseMelangeSBOM(reader, metadata.Package)
+	if err != nil {
+		log.Warnf("failed to parse melange SBOM (package=%s): %+v", p.Name, err)
+		return
+	}
+
+	metadata.Sources = sbom.sources
+	if metadata.License == "" && sbom.license != "" {
+		metadata.License = sbom.license
+		p.Licenses = []string{sbom.license}
+	}
+
+	// persist alterations
+	p.Metadata = metadata
 
-	return common.NewGenericCataloger(nil, globParsers, "apkdb-cataloger")
+	// keep a record of the file where this was discovered
+	p.Locations = append(p.Locations, *location)
 }
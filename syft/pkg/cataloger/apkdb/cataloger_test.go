@@ -0,0 +1,43 @@
+package apkdb
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/source"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApkdbCataloger_MelangeSBOM(t *testing.T) {
+	src, err := source.NewFromDirectory("test-fixtures/wolfi")
+	require.NoError(t, err)
+
+	resolver, err := src.FileResolver(source.SquashedScope)
+	require.NoError(t, err)
+
+	pkgs, _, err := NewApkdbCataloger().Catalog(resolver)
+	require.NoError(t, err)
+	require.Len(t, pkgs, 2)
+
+	byName := make(map[string]pkg.Package)
+	for _, p := range pkgs {
+		byName[p.Name] = p
+	}
+
+	// the melange SBOM names the upstream sources of the package
+	busybox := byName["busybox"]
+	assert.Equal(t, "1.36.0-r3", busybox.Version)
+	assert.Equal(t, []string{"GPL-2.0-only"}, busybox.Licenses)
+	assert.Len(t, busybox.Locations, 2)
+	assert.Equal(t, []string{
+		"https://busybox.net/downloads/busybox-1.36.0.tar.bz2",
+		"pkg:github/wolfi-dev/os@6a6b5ab4e8fd9ad2d1d4f2d2b2b41b84c23be9b4",
+	}, busybox.Metadata.(pkg.ApkMetadata).Sources)
+
+	// packages without a melange SBOM are cataloged from the database alone
+	baselayout := byName["wolfi-baselayout"]
+	assert.Equal(t, "20230201-r0", baselayout.Version)
+	assert.Len(t, baselayout.Locations, 1)
+	assert.Empty(t, baselayout.Metadata.(pkg.ApkMetadata).Sources)
+}
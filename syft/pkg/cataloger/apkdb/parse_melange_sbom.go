@@ -0,0 +1,84 @@
+package apkdb
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+const (
+	// spdxNoAssertion is the SPDX value for a field that the SBOM author makes no claim about
+	spdxNoAssertion = "NOASSERTION"
+	// spdxNone is the SPDX value for a field that has no value
+	spdxNone = "NONE"
+)
+
+// melangeSBOM is the information of interest within the SBOM that melange writes into every package it builds.
+type melangeSBOM struct {
+	license string
+	sources []string
+}
+
+type spdxDocument struct {
+	DocumentDescribes []string      `json:"documentDescribes"`
+	Packages          []spdxPackage `json:"packages"`
+}
+
+type spdxPackage struct {
+	SPDXID           string            `json:"SPDXID"`
+	Name             string            `json:"name"`
+	DownloadLocation string            `json:"downloadLocation"`
+	LicenseDeclared  string            `json:"licenseDeclared"`
+	ExternalRefs     []spdxExternalRef `json:"externalRefs"`
+}
+
+type spdxExternalRef struct {
+	ReferenceType    string `json:"referenceType"`
+	ReferenceLocator string `json:"referenceLocator"`
+}
+
+// parseMelangeSBOM reads the SPDX (JSON) document that melange installs alongside a package (e.g.
+// /var/lib/db/sbom/busybox-1.36.0-r3.spdx.json), returning the license declared for the package, and the upstream
+// sources the package was built from (all other packages within the document). The package is the one the document
+// describes, or when not stated, the package of the given name.
+func parseMelangeSBOM(reader io.Reader, name string) (*melangeSBOM, error) {
+	var doc spdxDocument
+	if err := json.NewDecoder(reader).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("unable to decode melange SBOM: %w", err)
+	}
+
+	described := make(map[string]bool)
+	for _, id := range doc.DocumentDescribes {
+		described[id] = true
+	}
+
+	var sbom melangeSBOM
+	for _, p := range doc.Packages {
+		if described[p.SPDXID] || (len(described) == 0 && p.Name == name) {
+			if p.LicenseDeclared != spdxNoAssertion && p.LicenseDeclared != spdxNone {
+				sbom.license = p.LicenseDeclared
+			}
+			continue
+		}
+
+		if source := sourceReference(p); source != "" {
+			sbom.sources = append(sbom.sources, source)
+		}
+	}
+
+	return &sbom, nil
+}
+
+// sourceReference returns the package URL of the given source package, falling back to its download location.
+func sourceReference(p spdxPackage) string {
+	for _, ref := range p.ExternalRefs {
+		if ref.ReferenceType == "purl" && ref.ReferenceLocator != "" {
+			return ref.ReferenceLocator
+		}
+	}
+	switch p.DownloadLocation {
+	case spdxNoAssertion, spdxNone:
+		return ""
+	}
+	return p.DownloadLocation
+}
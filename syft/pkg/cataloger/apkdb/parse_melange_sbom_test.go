@@ -0,0 +1,35 @@
+package apkdb
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMelangeSBOM(t *testing.T) {
+	fixture, err := os.Open("test-fixtures/wolfi/var/lib/db/sbom/busybox-1.36.0-r3.spdx.json")
+	require.NoError(t, err)
+	defer fixture.Close()
+
+	sbom, err := parseMelangeSBOM(fixture, "busybox")
+	require.NoError(t, err)
+
+	assert.Equal(t, "GPL-2.0-only", sbom.license)
+	assert.Equal(t, []string{
+		"https://busybox.net/downloads/busybox-1.36.0.tar.bz2",
+		"pkg:github/wolfi-dev/os@6a6b5ab4e8fd9ad2d1d4f2d2b2b41b84c23be9b4",
+	}, sbom.sources)
+}
+
+func TestParseMelangeSBOM_WithoutDescribes(t *testing.T) {
+	doc := `{"packages": [{"name": "zlib", "licenseDeclared": "Zlib", "downloadLocation": "NOASSERTION"}, {"name": "zlib-src", "downloadLocation": "NONE"}]}`
+
+	sbom, err := parseMelangeSBOM(strings.NewReader(doc), "zlib")
+	require.NoError(t, err)
+
+	assert.Equal(t, "Zlib", sbom.license)
+	assert.Empty(t, sbom.sources)
+}
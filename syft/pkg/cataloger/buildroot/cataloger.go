@@ -0,0 +1,116 @@
+/*
+Package buildroot provides a concrete Cataloger implementation for the package manifests produced by Buildroot builds.
+*/
+package buildroot
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/common"
+	"github.com/anchore/syft/syft/source"
+)
+
+const (
+	packagesFileListGlob  = "**/packages-file-list.txt"
+	legalInfoManifestGlob = "**/legal-info/manifest.csv"
+)
+
+type Cataloger struct{}
+
+// NewBuildrootCataloger returns a new cataloger object for Buildroot package manifests, which describe the packages
+// that were compiled into an embedded firmware image.
+func NewBuildrootCataloger() *Cataloger {
+	return &Cataloger{}
+}
+
+// Name returns a string that uniquely describes a cataloger
+func (c *Cataloger) Name() string {
+	return "buildroot-cataloger"
+}
+
+// Catalog returns the packages described by buildroot legal-info manifests, along with the files each package
+// installed (from the packages-file-list.txt of the same build output). Packages that are only found in a file list
+// (buildroot does not report every package in the legal-info, e.g. virtual or host-only packages) are cataloged without
+// a version.
+func (c *Cataloger) Catalog(resolver source.FileResolver) ([]pkg.Package, []artifact.Relationship, error) {
+	manifestLocations, err := resolver.FilesByGlob(legalInfoManifestGlob)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find buildroot legal-info manifests by glob: %w", err)
+	}
+	fileListLocations, err := resolver.FilesByGlob(packagesFileListGlob)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find buildroot package file lists by glob: %w", err)
+	}
+
+	var packages []*pkg.Package
+
+	// the manifest packages of each build output directory (the parent of the legal-info directory), by name
+	manifestPackages := make(map[string]map[string]*pkg.Package)
+	for _, location := range manifestLocations {
+		pkgs := c.parse(resolver, location, parseLegalInfoManifest)
+		outputDir := path.Dir(path.Dir(location.RealPath))
+		if manifestPackages[outputDir] == nil {
+			manifestPackages[outputDir] = make(map[string]*pkg.Package)
+		}
+		for _, p := range pkgs {
+			manifestPackages[outputDir][p.Name] = p
+		}
+		packages = append(packages, pkgs...)
+	}
+
+	for _, location := range fileListLocations {
+		// buildroot writes the file list to the "build" directory of the output directory
+		dir := path.Dir(location.RealPath)
+		known := manifestPackages[path.Dir(dir)]
+		if known == nil {
+			known = manifestPackages[dir]
+		}
+
+		for _, p := range c.parse(resolver, location, parsePackagesFileList) {
+			existing, ok := known[p.Name]
+			if !ok {
+				packages = append(packages, p)
+				continue
+			}
+			// merge the installed files into the (versioned) package from the manifest
+			metadata := existing.Metadata.(pkg.BuildrootMetadata)
+			metadata.Files = append(metadata.Files, p.Metadata.(pkg.BuildrootMetadata).Files...)
+			existing.Metadata = metadata
+			existing.Locations = append(existing.Locations, p.Locations...)
+		}
+	}
+
+	var results []pkg.Package
+	for _, p := range packages {
+		p.SetID()
+		results = append(results, *p)
+	}
+	return results, nil, nil
+}
+
+// parse reads the packages from the given location with the given parser, logging (and skipping) invalid files.
+func (c *Cataloger) parse(resolver source.FileResolver, location source.Location, parser common.ParserFn) []*pkg.Package {
+	reader, err := resolver.FileContentsByLocation(location)
+	if err != nil {
+		log.Warnf("cataloger '%s' unable to fetch contents at location=%+v: %+v", c.Name(), location, err)
+		return nil
+	}
+	defer internal.CloseAndLogError(reader, location.VirtualPath)
+
+	pkgs, _, err := parser(location.RealPath, reader)
+	if err != nil {
+		log.Warnf("cataloger '%s' failed to parse entries at location=%+v: %+v", c.Name(), location, err)
+		return nil
+	}
+
+	for _, p := range pkgs {
+		p.FoundBy = c.Name()
+		p.Locations = append(p.Locations, location)
+	}
+	return pkgs
+}
@@ -0,0 +1,44 @@
+package buildroot
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/source"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildrootCataloger_MergesFileList(t *testing.T) {
+	src, err := source.NewFromDirectory("test-fixtures")
+	require.NoError(t, err)
+
+	resolver, err := src.FileResolver(source.SquashedScope)
+	require.NoError(t, err)
+
+	pkgs, _, err := NewBuildrootCataloger().Catalog(resolver)
+	require.NoError(t, err)
+
+	byName := make(map[string]pkg.Package)
+	for _, p := range pkgs {
+		byName[p.Name] = p
+	}
+	// packages from the file list are never duplicated when the manifest describes them
+	require.Len(t, pkgs, 4)
+
+	busybox := byName["busybox"]
+	assert.Equal(t, "1.36.1", busybox.Version)
+	assert.Equal(t, []string{"/bin/busybox", "/etc/inittab"}, busybox.Metadata.(pkg.BuildrootMetadata).Files)
+	assert.Len(t, busybox.Locations, 2)
+
+	utilLinux := byName["util-linux"]
+	assert.Equal(t, "2.39.1", utilLinux.Version)
+	assert.Empty(t, utilLinux.Metadata.(pkg.BuildrootMetadata).Files)
+	assert.Len(t, utilLinux.Locations, 1)
+
+	// packages that are missing from the manifest are still cataloged from the file list
+	skeleton := byName["skeleton-init-sysv"]
+	assert.Empty(t, skeleton.Version)
+	assert.Equal(t, []string{"/etc/fstab"}, skeleton.Metadata.(pkg.BuildrootMetadata).Files)
+	assert.Len(t, skeleton.Locations, 1)
+}
@@ -0,0 +1,140 @@
+package buildroot
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/common"
+)
+
+// integrity check
+var _ common.ParserFn = parsePackagesFileList
+var _ common.ParserFn = parseLegalInfoManifest
+
+// licenseQualifierRegexp matches the parenthesized scope that buildroot allows after a license, e.g. "GPL-2.0+ (programs)"
+var licenseQualifierRegexp = regexp.MustCompile(`\s*\([^)]*\)`)
+
+func newBuildrootPackage(m pkg.BuildrootMetadata) *pkg.Package {
+	return &pkg.Package{
+		Name:         m.Package,
+		Version:      m.Version,
+		Licenses:     splitLicenses(m.License),
+		Type:         pkg.BuildrootPkg,
+		MetadataType: pkg.BuildrootMetadataType,
+		Metadata:     m,
+	}
+}
+
+// parsePackagesFileList is a parser function for buildroot packages-file-list.txt contents, which attribute every file
+// installed into the target filesystem to the package that installed it (e.g. "busybox,./bin/busybox").
+func parsePackagesFileList(_ string, reader io.Reader) ([]*pkg.Package, []artifact.Relationship, error) {
+	filesByPackage := make(map[string][]string)
+
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		fields := strings.SplitN(line, ",", 2)
+		if len(fields) != 2 || fields[0] == "" {
+			return nil, nil, fmt.Errorf("unexpected buildroot packages-file-list entry: %q", line)
+		}
+
+		name, path := fields[0], strings.TrimPrefix(fields[1], ".")
+		filesByPackage[name] = append(filesByPackage[name], path)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("unable to read buildroot packages-file-list: %w", err)
+	}
+
+	names := make([]string, 0, len(filesByPackage))
+	for name := range filesByPackage {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var packages []*pkg.Package
+	for _, name := range names {
+		packages = append(packages, newBuildrootPackage(pkg.BuildrootMetadata{
+			Package: name,
+			Files:   filesByPackage[name],
+		}))
+	}
+
+	return packages, nil, nil
+}
+
+// parseLegalInfoManifest is a parser function for buildroot legal-info/manifest.csv contents, which describe the
+// version, licenses, and upstream source of every package built for the target.
+func parseLegalInfoManifest(_ string, reader io.Reader) ([]*pkg.Package, []artifact.Relationship, error) {
+	csvReader := csv.NewReader(reader)
+	// the set of columns has grown over buildroot releases
+	csvReader.FieldsPerRecord = -1
+
+	records, err := csvReader.ReadAll()
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to parse buildroot legal-info manifest: %w", err)
+	}
+
+	if len(records) == 0 {
+		return nil, nil, nil
+	}
+
+	columns := make(map[string]int)
+	for idx, column := range records[0] {
+		columns[strings.ToUpper(strings.TrimSpace(column))] = idx
+	}
+
+	if _, ok := columns["PACKAGE"]; !ok {
+		return nil, nil, fmt.Errorf("buildroot legal-info manifest is missing the PACKAGE column")
+	}
+
+	value := func(record []string, column string) string {
+		idx, ok := columns[column]
+		if !ok || idx >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[idx])
+	}
+
+	var packages []*pkg.Package
+	for _, record := range records[1:] {
+		name := value(record, "PACKAGE")
+		if name == "" {
+			continue
+		}
+
+		packages = append(packages, newBuildrootPackage(pkg.BuildrootMetadata{
+			Package:       name,
+			Version:       value(record, "VERSION"),
+			License:       value(record, "LICENSE"),
+			SourceArchive: value(record, "SOURCE ARCHIVE"),
+			SourceSite:    value(record, "SOURCE SITE"),
+			// ensure the default value for a collection is never nil since this may be shown as JSON
+			Files: make([]string, 0),
+		}))
+	}
+
+	return packages, nil, nil
+}
+
+func splitLicenses(license string) []string {
+	var licenses []string
+	for _, l := range strings.Split(licenseQualifierRegexp.ReplaceAllString(license, ""), ",") {
+		l = strings.TrimSpace(l)
+		if l != "" {
+			licenses = append(licenses, l)
+		}
+	}
+	return licenses
+}
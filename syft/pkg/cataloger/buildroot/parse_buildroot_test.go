@@ -0,0 +1,78 @@
+package buildroot
+
+import (
+	"os"
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/go-test/deep"
+)
+
+func TestParsePackagesFileList(t *testing.T) {
+	expected := []*pkg.Package{
+		newBuildrootPackage(pkg.BuildrootMetadata{Package: "busybox", Files: []string{"/bin/busybox", "/etc/inittab"}}),
+		newBuildrootPackage(pkg.BuildrootMetadata{Package: "skeleton-init-sysv", Files: []string{"/etc/fstab"}}),
+		newBuildrootPackage(pkg.BuildrootMetadata{Package: "zlib", Files: []string{"/usr/lib/libz.so.1.2.13", "/usr/lib/libz.so.1"}}),
+	}
+
+	fixture, err := os.Open("test-fixtures/packages-file-list.txt")
+	if err != nil {
+		t.Fatalf("failed to open fixture: %+v", err)
+	}
+
+	actual, _, err := parsePackagesFileList(fixture.Name(), fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, d := range deep.Equal(expected, actual) {
+		t.Errorf("diff: %+v", d)
+	}
+}
+
+func TestParseLegalInfoManifest(t *testing.T) {
+	expected := []*pkg.Package{
+		newBuildrootPackage(pkg.BuildrootMetadata{
+			Package:       "busybox",
+			Version:       "1.36.1",
+			License:       "GPL-2.0, bzip2-1.0.4",
+			SourceArchive: "busybox-1.36.1.tar.bz2",
+			SourceSite:    "https://www.busybox.net/downloads",
+			Files:         []string{},
+		}),
+		newBuildrootPackage(pkg.BuildrootMetadata{
+			Package:       "zlib",
+			Version:       "1.2.13",
+			License:       "Zlib",
+			SourceArchive: "zlib-1.2.13.tar.xz",
+			SourceSite:    "https://www.zlib.net",
+			Files:         []string{},
+		}),
+		newBuildrootPackage(pkg.BuildrootMetadata{
+			Package:       "util-linux",
+			Version:       "2.39.1",
+			License:       "GPL-2.0+ (programs), LGPL-2.1+ (libraries)",
+			SourceArchive: "util-linux-2.39.1.tar.xz",
+			SourceSite:    "https://www.kernel.org/pub/linux/utils/util-linux/v2.39",
+			Files:         []string{},
+		}),
+	}
+
+	fixture, err := os.Open("test-fixtures/legal-info/manifest.csv")
+	if err != nil {
+		t.Fatalf("failed to open fixture: %+v", err)
+	}
+
+	actual, _, err := parseLegalInfoManifest(fixture.Name(), fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, d := range deep.Equal(expected, actual) {
+		t.Errorf("diff: %+v", d)
+	}
+
+	if licenses := actual[2].Licenses; len(licenses) != 2 || licenses[0] != "GPL-2.0+" || licenses[1] != "LGPL-2.1+" {
+		t.Errorf("unexpected licenses: %+v", licenses)
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/anchore/syft/internal/bus"
 	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/internal/telemetry"
 	"github.com/anchore/syft/syft/artifact"
 	"github.com/anchore/syft/syft/distro"
 	"github.com/anchore/syft/syft/event"
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/wagoodman/go-partybus"
 	"github.com/wagoodman/go-progress"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // Monitor provides progress-related data for observing the progress of a Catalog() call (published on the event bus).
@@ -22,6 +24,22 @@ type Monitor struct {
 	PackagesDiscovered progress.Monitorable // the number of packages discovered from all registered catalogers
 }
 
+// Result describes the outcome of a single cataloger (published on the event bus as a CatalogerFinished event).
+type Result struct {
+	Cataloger     string // the name of the cataloger
+	Packages      int    // the number of packages discovered by the cataloger
+	Relationships int    // the number of relationships discovered by the cataloger
+	Err           error  // why the cataloger failed (if it did)
+}
+
+func publishCatalogerFinished(result Result) {
+	bus.Publish(partybus.Event{
+		Type:   event.CatalogerFinished,
+		Source: result.Cataloger,
+		Value:  result,
+	})
+}
+
 // newMonitor creates a new Monitor object and publishes the object on the bus as a PackageCatalogerStarted event.
 func newMonitor() (*progress.Manual, *progress.Manual) {
 	filesProcessed := progress.Manual{}
@@ -37,13 +55,14 @@ func newMonitor() (*progress.Manual, *progress.Manual) {
 	return &filesProcessed, &packagesDiscovered
 }
 
-// Catalog a given source (container image or filesystem) with the given catalogers, returning all discovered packages.
-// In order to efficiently retrieve contents from a underlying container image the content fetch requests are
+// Catalog a given source (container image or filesystem) with the given catalogers, returning all discovered packages
+// along with any warnings reported by the catalogers (see WarningReporter). In order to efficiently retrieve contents from a underlying container image the content fetch requests are
 // done in bulk. Specifically, all files of interest are collected from each catalogers and accumulated into a single
 // request.
-func Catalog(resolver source.FileResolver, theDistro *distro.Distro, catalogers ...Cataloger) (*pkg.Catalog, []artifact.Relationship, error) {
+func Catalog(resolver source.FileResolver, theDistro *distro.Distro, catalogers ...Cataloger) (*pkg.Catalog, []artifact.Relationship, []source.Warning, error) {
 	catalog := pkg.NewCatalog()
 	var allRelationships []artifact.Relationship
+	var warnings []source.Warning
 
 	filesProcessed, packagesDiscovered := newMonitor()
 
@@ -52,13 +71,18 @@ func Catalog(resolver source.FileResolver, theDistro *distro.Distro, catalogers
 	for _, c := range catalogers {
 		// find packages from the underlying raw data
 		log.Debugf("cataloging with %q", c.Name())
+		span := telemetry.StartSpan("cataloger", attribute.String("syft.cataloger", c.Name()))
 		packages, relationships, err := c.Catalog(resolver)
 		if err != nil {
+			telemetry.EndSpan(span, err)
 			errs = multierror.Append(errs, err)
+			publishCatalogerFinished(Result{Cataloger: c.Name(), Err: err})
 			continue
 		}
 
 		catalogedPackages := len(packages)
+		span.SetAttributes(attribute.Int("syft.packages", catalogedPackages))
+		telemetry.EndSpan(span, nil)
 
 		log.Debugf("discovered %d packages", catalogedPackages)
 		packagesDiscovered.N += int64(catalogedPackages)
@@ -70,6 +94,12 @@ func Catalog(resolver source.FileResolver, theDistro *distro.Distro, catalogers
 			// generate PURL (note: this is excluded from package ID, so is safe to mutate)
 			p.PURL = generatePackageURL(p, theDistro)
 
+			// normalize the version for comparison (note: this is excluded from package ID, so is safe to mutate)
+			p.NormalizedVersion = generateNormalizedVersion(p)
+
+			// determine how directly the package was observed (note: this is excluded from package ID, so is safe to mutate)
+			p.Confidence = packageConfidence(c.Name(), p)
+
 			// create file-to-package relationships for files owned by the package
 			owningRelationships, err := packageFileOwnershipRelationships(p, resolver)
 			if err != nil {
@@ -80,21 +110,37 @@ func Catalog(resolver source.FileResolver, theDistro *distro.Distro, catalogers
 
 			// add to catalog
 			catalog.Add(p)
+
+			bus.Publish(partybus.Event{
+				Type:   event.PackageDiscovered,
+				Source: c.Name(),
+				Value:  p,
+			})
 		}
 
 		allRelationships = append(allRelationships, relationships...)
+		if reporter, ok := c.(WarningReporter); ok {
+			warnings = append(warnings, reporter.Warnings()...)
+		}
+		publishCatalogerFinished(Result{
+			Cataloger:     c.Name(),
+			Packages:      catalogedPackages,
+			Relationships: len(relationships),
+		})
 	}
 
 	allRelationships = append(allRelationships, pkg.NewRelationships(catalog)...)
 
 	if errs != nil {
-		return nil, nil, errs
+		return nil, nil, nil, errs
 	}
 
 	filesProcessed.SetCompleted()
 	packagesDiscovered.SetCompleted()
 
-	return catalog, allRelationships, nil
+	source.SortWarnings(warnings)
+
+	return catalog, allRelationships, warnings, nil
 }
 
 func packageFileOwnershipRelationships(p pkg.Package, resolver source.FilePathResolver) ([]artifact.Relationship, error) {
@@ -0,0 +1,79 @@
+package cataloger
+
+import (
+	"io"
+	"testing"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/common"
+	"github.com/anchore/syft/syft/source"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestCatalog_SpanPerCataloger(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	original := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() {
+		otel.SetTracerProvider(original)
+	})
+
+	resolver := source.NewMockResolverForPaths("/go.mod", "/a/go.mod", "/package.json")
+
+	_, _, _, err := Catalog(resolver, nil,
+		globCataloger{name: "go-cataloger", glob: "**/go.mod"},
+		globCataloger{name: "js-cataloger", glob: "**/package.json"},
+	)
+	require.NoError(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+
+	expected := map[string]int64{
+		"go-cataloger": 2,
+		"js-cataloger": 1,
+	}
+	for _, span := range spans {
+		assert.Equal(t, "cataloger", span.Name())
+
+		attrs := make(map[attribute.Key]attribute.Value)
+		for _, kv := range span.Attributes() {
+			attrs[kv.Key] = kv.Value
+		}
+		name := attrs["syft.cataloger"].AsString()
+		assert.Equal(t, expected[name], attrs["syft.packages"].AsInt64(), "cataloger=%s", name)
+	}
+}
+
+func TestCatalog_Warnings(t *testing.T) {
+	unsupported := func(string, io.Reader) ([]*pkg.Package, []artifact.Relationship, error) {
+		return nil, nil, common.UnsupportedVersionError{Kind: "some.lock", Version: "9", Supported: []string{"1"}}
+	}
+	resolver := source.NewMockResolverForPaths(
+		"test-fixtures/warnings/b/some.lock",
+		"test-fixtures/warnings/a/some.lock",
+		"/package.json",
+	)
+
+	// warnings are reported through decorated catalogers as well
+	catalogers := LimitSearchDepth([]Cataloger{
+		common.NewGenericCataloger(nil, map[string]common.ParserFn{"**/some.lock": unsupported}, "lock-cataloger"),
+		globCataloger{name: "js-cataloger", glob: "**/package.json"},
+	}, map[string]int{"lock-cataloger": 5})
+
+	catalog, _, warnings, err := Catalog(resolver, nil, catalogers...)
+	require.NoError(t, err)
+	assert.Equal(t, 1, catalog.PackageCount())
+
+	message := `unsupported some.lock version "9" (supported versions: 1): no packages were cataloged from this file`
+	assert.Equal(t, []source.Warning{
+		{Path: "test-fixtures/warnings/a/some.lock", Message: message},
+		{Path: "test-fixtures/warnings/b/some.lock", Message: message},
+	}, warnings)
+}
@@ -9,15 +9,21 @@ import (
 	"github.com/anchore/syft/syft/artifact"
 	"github.com/anchore/syft/syft/pkg"
 	"github.com/anchore/syft/syft/pkg/cataloger/apkdb"
+	"github.com/anchore/syft/syft/pkg/cataloger/buildroot"
 	"github.com/anchore/syft/syft/pkg/cataloger/deb"
 	"github.com/anchore/syft/syft/pkg/cataloger/golang"
 	"github.com/anchore/syft/syft/pkg/cataloger/java"
 	"github.com/anchore/syft/syft/pkg/cataloger/javascript"
+	"github.com/anchore/syft/syft/pkg/cataloger/opkg"
 	"github.com/anchore/syft/syft/pkg/cataloger/php"
 	"github.com/anchore/syft/syft/pkg/cataloger/python"
 	"github.com/anchore/syft/syft/pkg/cataloger/rpmdb"
 	"github.com/anchore/syft/syft/pkg/cataloger/ruby"
+	"github.com/anchore/syft/syft/pkg/cataloger/runtime"
 	"github.com/anchore/syft/syft/pkg/cataloger/rust"
+	"github.com/anchore/syft/syft/pkg/cataloger/staticlib"
+	"github.com/anchore/syft/syft/pkg/cataloger/webserver"
+	"github.com/anchore/syft/syft/pkg/cataloger/yocto"
 	"github.com/anchore/syft/syft/source"
 )
 
@@ -31,54 +37,93 @@ type Cataloger interface {
 	Catalog(resolver source.FileResolver) ([]pkg.Package, []artifact.Relationship, error)
 }
 
+// WarningReporter is implemented by catalogers that can report non-fatal problems with the files they catalog (e.g. a
+// lockfile version that is not supported). The problems found by the last call to Catalog are returned.
+type WarningReporter interface {
+	Warnings() []source.Warning
+}
+
 // ImageCatalogers returns a slice of locally implemented catalogers that are fit for detecting installations of packages.
 func ImageCatalogers(cfg Config) []Cataloger {
 	return []Cataloger{
 		ruby.NewGemSpecCataloger(),
 		python.NewPythonPackageCataloger(),
+		python.NewPythonZipappCataloger(cfg.PythonZipapp()),
 		php.NewPHPComposerInstalledCataloger(),
+		php.NewPHPPeclCataloger(),
 		javascript.NewJavascriptPackageCataloger(),
+		javascript.NewJavascriptBundleCataloger(),
+		javascript.NewJavascriptSourceMapCataloger(cfg.Javascript()),
 		deb.NewDpkgdbCataloger(),
 		rpmdb.NewRpmdbCataloger(),
 		java.NewJavaCataloger(cfg.Java()),
 		apkdb.NewApkdbCataloger(),
+		opkg.NewOpkgCataloger(),
+		yocto.NewYoctoCataloger(),
 		golang.NewGoModuleBinaryCataloger(),
+		runtime.NewRuntimeCataloger(),
+		staticlib.NewStaticLibraryCataloger(),
+		webserver.NewWebServerModuleCataloger(),
 	}
 }
 
 // DirectoryCatalogers returns a slice of locally implemented catalogers that are fit for detecting packages from index files (and select installations)
 func DirectoryCatalogers(cfg Config) []Cataloger {
 	return []Cataloger{
-		ruby.NewGemFileLockCataloger(),
-		python.NewPythonIndexCataloger(),
+		ruby.NewGemFileLockCataloger(cfg.Ruby()),
+		python.NewPythonIndexCataloger(cfg.Python),
 		python.NewPythonPackageCataloger(),
+		python.NewPythonZipappCataloger(cfg.PythonZipapp()),
 		php.NewPHPComposerLockCataloger(),
+		php.NewPHPPeclCataloger(),
 		javascript.NewJavascriptLockCataloger(),
+		javascript.NewJavascriptBundleCataloger(),
+		javascript.NewJavascriptSourceMapCataloger(cfg.Javascript()),
 		deb.NewDpkgdbCataloger(),
+		deb.NewDpkgBuildInfoCataloger(),
 		rpmdb.NewRpmdbCataloger(),
 		java.NewJavaCataloger(cfg.Java()),
 		apkdb.NewApkdbCataloger(),
+		opkg.NewOpkgCataloger(),
+		yocto.NewYoctoCataloger(),
+		buildroot.NewBuildrootCataloger(),
 		golang.NewGoModuleBinaryCataloger(),
-		golang.NewGoModFileCataloger(),
-		rust.NewCargoLockCataloger(),
+		runtime.NewRuntimeCataloger(),
+		staticlib.NewStaticLibraryCataloger(),
+		webserver.NewWebServerModuleCataloger(),
+		golang.NewGoModFileCataloger(cfg.Golang),
+		rust.NewCargoLockCataloger(cfg.Rust),
+		java.NewJavaPomCataloger(cfg.Java()),
 	}
 }
 
 // AllCatalogers returns all implemented catalogers
 func AllCatalogers(cfg Config) []Cataloger {
 	return []Cataloger{
-		ruby.NewGemFileLockCataloger(),
+		ruby.NewGemFileLockCataloger(cfg.Ruby()),
 		ruby.NewGemSpecCataloger(),
-		python.NewPythonIndexCataloger(),
+		python.NewPythonIndexCataloger(cfg.Python),
 		python.NewPythonPackageCataloger(),
+		python.NewPythonZipappCataloger(cfg.PythonZipapp()),
 		javascript.NewJavascriptLockCataloger(),
 		javascript.NewJavascriptPackageCataloger(),
+		javascript.NewJavascriptBundleCataloger(),
+		javascript.NewJavascriptSourceMapCataloger(cfg.Javascript()),
+		php.NewPHPPeclCataloger(),
 		deb.NewDpkgdbCataloger(),
+		deb.NewDpkgBuildInfoCataloger(),
 		rpmdb.NewRpmdbCataloger(),
 		java.NewJavaCataloger(cfg.Java()),
 		apkdb.NewApkdbCataloger(),
+		opkg.NewOpkgCataloger(),
+		yocto.NewYoctoCataloger(),
+		buildroot.NewBuildrootCataloger(),
 		golang.NewGoModuleBinaryCataloger(),
-		golang.NewGoModFileCataloger(),
-		rust.NewCargoLockCataloger(),
+		runtime.NewRuntimeCataloger(),
+		staticlib.NewStaticLibraryCataloger(),
+		webserver.NewWebServerModuleCataloger(),
+		golang.NewGoModFileCataloger(cfg.Golang),
+		rust.NewCargoLockCataloger(cfg.Rust),
+		java.NewJavaPomCataloger(cfg.Java()),
 	}
 }
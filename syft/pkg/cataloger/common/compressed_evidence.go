@@ -0,0 +1,28 @@
+package common
+
+import (
+	"path"
+	"strings"
+
+	"github.com/anchore/syft/internal/file"
+	"github.com/anchore/syft/syft/source"
+	"github.com/scylladb/go-set/strset"
+)
+
+// PreferUncompressed drops compressed evidence files (e.g. "status.gz") from the given locations when the uncompressed
+// file they were derived from (e.g. "status") was selected as well, so the same entries are not cataloged twice.
+func PreferUncompressed(locations []source.Location) (results []source.Location) {
+	selected := strset.New()
+	for _, location := range locations {
+		selected.Add(location.RealPath)
+	}
+
+	for _, location := range locations {
+		if file.IsCompressedEvidence(location.RealPath) &&
+			selected.Has(strings.TrimSuffix(location.RealPath, path.Ext(location.RealPath))) {
+			continue
+		}
+		results = append(results, location)
+	}
+	return results
+}
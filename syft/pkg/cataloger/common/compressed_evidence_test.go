@@ -0,0 +1,52 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/syft/source"
+)
+
+func TestPreferUncompressed(t *testing.T) {
+	tests := []struct {
+		name     string
+		paths    []string
+		expected []string
+	}{
+		{
+			name:     "only compressed",
+			paths:    []string{"/var/lib/opkg/status.gz"},
+			expected: []string{"/var/lib/opkg/status.gz"},
+		},
+		{
+			name:     "compressed and uncompressed",
+			paths:    []string{"/var/lib/opkg/status.gz", "/var/lib/opkg/status"},
+			expected: []string{"/var/lib/opkg/status"},
+		},
+		{
+			name:     "uncompressed in another directory",
+			paths:    []string{"/var/lib/opkg/status.gz", "/usr/lib/opkg/status"},
+			expected: []string{"/var/lib/opkg/status.gz", "/usr/lib/opkg/status"},
+		},
+		{
+			name:     "compressed archive",
+			paths:    []string{"/archive.tar.gz", "/archive.tar"},
+			expected: []string{"/archive.tar.gz", "/archive.tar"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var locations []source.Location
+			for _, p := range test.paths {
+				locations = append(locations, source.NewLocation(p))
+			}
+
+			var actual []string
+			for _, l := range PreferUncompressed(locations) {
+				actual = append(actual, l.RealPath)
+			}
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
@@ -109,6 +109,23 @@ var defaultCandidateAdditions = buildCandidateLookup(
 			candidateKey{PkgName: "yajl-ruby"},
 			candidateAddition{AdditionalProducts: []string{"yajl-ruby_gem"}},
 		},
+		// language runtimes
+		{
+			pkg.RuntimePkg,
+			candidateKey{PkgName: "openjdk"},
+			candidateAddition{AdditionalVendors: []string{"oracle"}, AdditionalProducts: []string{"jdk", "jre"}},
+		},
+		{
+			pkg.RuntimePkg,
+			candidateKey{PkgName: "node"},
+			candidateAddition{AdditionalVendors: []string{"nodejs"}, AdditionalProducts: []string{"node.js"}},
+		},
+		{
+			pkg.RuntimePkg,
+			candidateKey{PkgName: "dotnet"},
+			candidateAddition{AdditionalVendors: []string{"microsoft"}, AdditionalProducts: []string{".net", ".net_core"}},
+		},
+
 		// Python packages
 		{
 			pkg.PythonPkg,
@@ -5,9 +5,16 @@ import (
 	"strings"
 )
 
+// goStdlibName is the package name given to the go standard library and runtime compiled into a go binary
+const goStdlibName = "stdlib"
+
 // candidateProductForGo attempts to find a single product name in a best-effort attempt. This implementation prefers
 // to return no vendor over returning potentially nonsensical results.
 func candidateProductForGo(name string) string {
+	if name == goStdlibName {
+		return "go"
+	}
+
 	// note: url.Parse requires a scheme for correct processing, which a golang module will not have, so one is provided.
 	u, err := url.Parse("http://" + name)
 	if err != nil {
@@ -34,6 +41,10 @@ func candidateProductForGo(name string) string {
 // candidateVendorForGo attempts to find a single vendor name in a best-effort attempt. This implementation prefers
 // to return no vendor over returning potentially nonsensical results.
 func candidateVendorForGo(name string) string {
+	if name == goStdlibName {
+		return "golang"
+	}
+
 	// note: url.Parse requires a scheme for correct processing, which a golang module will not have, so one is provided.
 	u, err := url.Parse("http://" + name)
 	if err != nil {
@@ -43,6 +43,10 @@ func TestCandidateProductForGo(t *testing.T) {
 			pkg:      "github.com/someone/something/long/package/name",
 			expected: "something",
 		},
+		{
+			pkg:      "stdlib",
+			expected: "go",
+		},
 	}
 
 	for _, test := range tests {
@@ -73,6 +77,10 @@ func TestCandidateVendorForGo(t *testing.T) {
 			pkg:      "place",
 			expected: "",
 		},
+		{
+			pkg:      "stdlib",
+			expected: "golang",
+		},
 		{
 			pkg:      "place.com/",
 			expected: "",
@@ -4,11 +4,15 @@ Package common provides generic utilities used by multiple catalogers.
 package common
 
 import (
+	"errors"
 	"fmt"
+	"io"
+	"io/fs"
 
 	"github.com/anchore/syft/syft/artifact"
 
 	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/file"
 	"github.com/anchore/syft/internal/log"
 	"github.com/anchore/syft/syft/pkg"
 	"github.com/anchore/syft/syft/source"
@@ -20,6 +24,8 @@ type GenericCataloger struct {
 	globParsers       map[string]ParserFn
 	pathParsers       map[string]ParserFn
 	upstreamCataloger string
+	decompress        bool
+	warnings          []source.Warning
 }
 
 // NewGenericCataloger if provided path-to-parser-function and glob-to-parser-function lookups creates a GenericCataloger
@@ -31,6 +37,15 @@ func NewGenericCataloger(pathParsers map[string]ParserFn, globParsers map[string
 	}
 }
 
+// NewCompressedEvidenceCataloger creates a GenericCataloger (see NewGenericCataloger) whose parsers are given the
+// uncompressed contents of compressed evidence files (e.g. a rotated status.gz). When a compressed file and the file it
+// was derived from are both selected, only the uncompressed file is parsed.
+func NewCompressedEvidenceCataloger(pathParsers map[string]ParserFn, globParsers map[string]ParserFn, upstreamCataloger string) *GenericCataloger {
+	c := NewGenericCataloger(pathParsers, globParsers, upstreamCataloger)
+	c.decompress = true
+	return c
+}
+
 // Name returns a string that uniquely describes the upstream cataloger that this Generic Cataloger represents.
 func (c *GenericCataloger) Name() string {
 	return c.upstreamCataloger
@@ -40,16 +55,51 @@ func (c *GenericCataloger) Name() string {
 func (c *GenericCataloger) Catalog(resolver source.FileResolver) ([]pkg.Package, []artifact.Relationship, error) {
 	var packages []pkg.Package
 	var relationships []artifact.Relationship
+	c.warnings = nil
 
 	for location, parser := range c.selectFiles(resolver) {
-		contentReader, err := resolver.FileContentsByLocation(location)
+		fileReader, err := resolver.FileContentsByLocation(location)
+		if errors.Is(err, fs.ErrPermission) {
+			c.skipUnreadable(location)
+			continue
+		}
 		if err != nil {
 			// TODO: fail or log?
 			return nil, nil, fmt.Errorf("unable to fetch contents at location=%v: %w", location, err)
 		}
 
+		// contents may be read lazily, so access problems may only surface while parsing
+		recorder := &readErrRecorder{ReadCloser: fileReader}
+		var contentReader io.ReadCloser = recorder
+
+		if c.decompress {
+			// compressed evidence (e.g. a rotated status.gz) is handed to the parser already decompressed
+			contentReader, err = decompressOrClose(location, contentReader)
+			if errors.Is(recorder.err, fs.ErrPermission) {
+				c.skipUnreadable(location)
+				continue
+			}
+			if err != nil {
+				log.Warnf("cataloger '%s' failed to decompress location=%+v: %+v", c.upstreamCataloger, location, err)
+				continue
+			}
+		}
+
 		discoveredPackages, discoveredRelationships, err := parser(location.RealPath, contentReader)
 		internal.CloseAndLogError(contentReader, location.VirtualPath)
+		if errors.Is(err, fs.ErrPermission) || errors.Is(recorder.err, fs.ErrPermission) {
+			c.skipUnreadable(location)
+			continue
+		}
+		var versionErr UnsupportedVersionError
+		if errors.As(err, &versionErr) {
+			log.Warnf("cataloger '%s' skipped location=%+v: %+v", c.upstreamCataloger, location, err)
+			c.warnings = append(c.warnings, source.Warning{
+				Path:    location.RealPath,
+				Message: versionErr.Error(),
+			})
+			continue
+		}
 		if err != nil {
 			// TODO: should we fail? or only log?
 			log.Warnf("cataloger '%s' failed to parse entries at location=%+v: %+v", c.upstreamCataloger, location, err)
@@ -69,6 +119,36 @@ func (c *GenericCataloger) Catalog(resolver source.FileResolver) ([]pkg.Package,
 	return packages, relationships, nil
 }
 
+// Warnings returns the files skipped by the last call to Catalog because they could not be read (e.g. when not running
+// as root) or because their format version is not supported by the parser (see UnsupportedVersionError).
+func (c *GenericCataloger) Warnings() []source.Warning {
+	return c.warnings
+}
+
+// skipUnreadable records a file that could not be cataloged since the current user is not permitted to read it.
+func (c *GenericCataloger) skipUnreadable(location source.Location) {
+	log.Warnf("cataloger '%s' skipped location=%+v: permission denied", c.upstreamCataloger, location)
+	c.warnings = append(c.warnings, source.Warning{
+		Path:    location.RealPath,
+		Message: "unable to read file: permission denied",
+	})
+}
+
+// readErrRecorder keeps the first error from reading file contents, so that access problems are recognized regardless
+// of how (or whether) a parser reports them.
+type readErrRecorder struct {
+	io.ReadCloser
+	err error
+}
+
+func (r *readErrRecorder) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	if err != nil && err != io.EOF && r.err == nil {
+		r.err = err
+	}
+	return n, err
+}
+
 // SelectFiles takes a set of file trees and resolves and file references of interest for future cataloging
 func (c *GenericCataloger) selectFiles(resolver source.FilePathResolver) map[source.Location]ParserFn {
 	var parserByLocation = make(map[source.Location]ParserFn)
@@ -95,5 +175,28 @@ func (c *GenericCataloger) selectFiles(resolver source.FilePathResolver) map[sou
 		}
 	}
 
+	if c.decompress {
+		var locations []source.Location
+		for location := range parserByLocation {
+			locations = append(locations, location)
+		}
+		selected := make(map[source.Location]ParserFn)
+		for _, location := range PreferUncompressed(locations) {
+			selected[location] = parserByLocation[location]
+		}
+		return selected
+	}
+
 	return parserByLocation
 }
+
+// decompressOrClose returns a reader of the uncompressed contents for compressed evidence files, closing the given
+// reader if the contents cannot be decompressed.
+func decompressOrClose(location source.Location, reader io.ReadCloser) (io.ReadCloser, error) {
+	decompressed, err := file.NewDecompressingReadCloser(location.RealPath, reader)
+	if err != nil {
+		internal.CloseAndLogError(reader, location.VirtualPath)
+		return nil, err
+	}
+	return decompressed, nil
+}
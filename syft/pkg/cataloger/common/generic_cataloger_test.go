@@ -3,6 +3,7 @@ package common
 import (
 	"fmt"
 	"io"
+	"io/fs"
 	"io/ioutil"
 	"testing"
 
@@ -69,3 +70,73 @@ func TestGenericCataloger(t *testing.T) {
 		}
 	}
 }
+
+func TestGenericCataloger_CompressedEvidence(t *testing.T) {
+	globParsers := map[string]ParserFn{
+		"**/compressed.txt.gz": parser,
+	}
+	upstream := "some-other-cataloger"
+
+	resolver := source.NewMockResolverForPaths("test-fixtures/compressed.txt.gz")
+	cataloger := NewCompressedEvidenceCataloger(nil, globParsers, upstream)
+
+	actualPkgs, _, err := cataloger.Catalog(resolver)
+	assert.NoError(t, err)
+	assert.Len(t, actualPkgs, 1)
+
+	// the parser is given the decompressed contents, while the location refers to the compressed file
+	assert.Equal(t, "test-fixtures/compressed.txt.gz file contents!", actualPkgs[0].Name)
+	assert.Equal(t, "test-fixtures/compressed.txt.gz", actualPkgs[0].Locations[0].RealPath)
+}
+
+func TestGenericCataloger_UnsupportedVersion(t *testing.T) {
+	unsupported := func(string, io.Reader) ([]*pkg.Package, []artifact.Relationship, error) {
+		return nil, nil, UnsupportedVersionError{Kind: "some.lock", Version: "9", Supported: []string{"1", "2"}}
+	}
+	globParsers := map[string]ParserFn{
+		"**/a-path.txt":       unsupported,
+		"**/another-path.txt": parser,
+	}
+
+	resolver := source.NewMockResolverForPaths("test-fixtures/a-path.txt", "test-fixtures/another-path.txt")
+	cataloger := NewGenericCataloger(nil, globParsers, "some-cataloger")
+
+	actualPkgs, _, err := cataloger.Catalog(resolver)
+	assert.NoError(t, err)
+	assert.Len(t, actualPkgs, 1)
+	assert.Equal(t, []source.Warning{
+		{
+			Path:    "test-fixtures/a-path.txt",
+			Message: `unsupported some.lock version "9" (supported versions: 1, 2): no packages were cataloged from this file`,
+		},
+	}, cataloger.Warnings())
+
+	// warnings are not carried over between calls
+	_, _, err = cataloger.Catalog(source.NewMockResolverForPaths("test-fixtures/another-path.txt"))
+	assert.NoError(t, err)
+	assert.Empty(t, cataloger.Warnings())
+}
+
+func TestGenericCataloger_UnreadableFile(t *testing.T) {
+	unreadable := func(path string, _ io.Reader) ([]*pkg.Package, []artifact.Relationship, error) {
+		return nil, nil, fmt.Errorf("unable to read: %w", &fs.PathError{Op: "open", Path: path, Err: fs.ErrPermission})
+	}
+	globParsers := map[string]ParserFn{
+		"**/a-path.txt":       unreadable,
+		"**/another-path.txt": parser,
+	}
+
+	resolver := source.NewMockResolverForPaths("test-fixtures/a-path.txt", "test-fixtures/another-path.txt")
+	cataloger := NewGenericCataloger(nil, globParsers, "some-cataloger")
+
+	// the unreadable file is skipped while the remaining files are still cataloged
+	actualPkgs, _, err := cataloger.Catalog(resolver)
+	assert.NoError(t, err)
+	assert.Len(t, actualPkgs, 1)
+	assert.Equal(t, []source.Warning{
+		{
+			Path:    "test-fixtures/a-path.txt",
+			Message: "unable to read file: permission denied",
+		},
+	}, cataloger.Warnings())
+}
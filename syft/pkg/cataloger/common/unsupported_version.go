@@ -0,0 +1,23 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+)
+
+// UnsupportedVersionError is returned by parsers given a file in a format version they do not understand (e.g. a
+// lockfile written by a newer package manager). No packages are returned for the file, and the cataloger reports the
+// file and version as a warning, rather than silently finding nothing.
+type UnsupportedVersionError struct {
+	Kind      string   // the kind of file (e.g. "package-lock.json")
+	Version   string   // the version found within the file
+	Supported []string // the versions the parser understands
+}
+
+func (e UnsupportedVersionError) Error() string {
+	msg := fmt.Sprintf("unsupported %s version %q", e.Kind, e.Version)
+	if len(e.Supported) > 0 {
+		msg += fmt.Sprintf(" (supported versions: %s)", strings.Join(e.Supported, ", "))
+	}
+	return msg + ": no packages were cataloged from this file"
+}
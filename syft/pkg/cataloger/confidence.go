@@ -0,0 +1,49 @@
+package cataloger
+
+import (
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// confidenceByCataloger describes how directly the packages discovered by each cataloger are observed.
+var confidenceByCataloger = map[string]pkg.Confidence{
+	// installed package databases and manifests, or build information embedded within binaries
+	"apkdb-cataloger":                  pkg.ExactMetadataConfidence,
+	"buildroot-cataloger":              pkg.ExactMetadataConfidence,
+	"dpkg-buildinfo-cataloger":         pkg.ExactMetadataConfidence,
+	"dpkgdb-cataloger":                 pkg.ExactMetadataConfidence,
+	"go-module-binary-cataloger":       pkg.ExactMetadataConfidence,
+	"java-cataloger":                   pkg.ExactMetadataConfidence,
+	"javascript-package-cataloger":     pkg.ExactMetadataConfidence,
+	"opkg-cataloger":                   pkg.ExactMetadataConfidence,
+	"php-composer-installed-cataloger": pkg.ExactMetadataConfidence,
+	"php-pecl-cataloger":               pkg.ExactMetadataConfidence,
+	"python-package-cataloger":         pkg.ExactMetadataConfidence,
+	"python-zipapp-cataloger":          pkg.ExactMetadataConfidence,
+	"rpmdb-cataloger":                  pkg.ExactMetadataConfidence,
+	"ruby-gemspec-cataloger":           pkg.ExactMetadataConfidence,
+	"yocto-cataloger":                  pkg.ExactMetadataConfidence,
+
+	// lockfiles and manifests declaring packages that are not necessarily installed
+	"go-mod-file-cataloger":       pkg.LockfileDeclaredConfidence,
+	"javascript-lock-cataloger":   pkg.LockfileDeclaredConfidence,
+	"php-composer-lock-cataloger": pkg.LockfileDeclaredConfidence,
+	"python-index-cataloger":      pkg.LockfileDeclaredConfidence,
+	"ruby-gemfile-cataloger":      pkg.LockfileDeclaredConfidence,
+	"rust-cataloger":              pkg.LockfileDeclaredConfidence,
+
+	// packages inferred from patterns within file contents
+	"javascript-bundle-cataloger":     pkg.HeuristicBinaryMatchConfidence,
+	"javascript-source-map-cataloger": pkg.HeuristicBinaryMatchConfidence,
+	"runtime-cataloger":               pkg.HeuristicBinaryMatchConfidence,
+	"static-library-cataloger":        pkg.HeuristicBinaryMatchConfidence,
+	"webserver-module-cataloger":      pkg.HeuristicBinaryMatchConfidence,
+}
+
+// packageConfidence returns how directly the given package was observed, preferring any confidence set by the
+// cataloger itself over the confidence of the cataloger as a whole.
+func packageConfidence(catalogerName string, p pkg.Package) pkg.Confidence {
+	if p.Confidence != "" {
+		return p.Confidence
+	}
+	return confidenceByCataloger[catalogerName]
+}
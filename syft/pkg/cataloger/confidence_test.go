@@ -0,0 +1,59 @@
+package cataloger
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfidenceByCataloger_AllCatalogersCovered(t *testing.T) {
+	var catalogers []Cataloger
+	catalogers = append(catalogers, AllCatalogers(Config{})...)
+	catalogers = append(catalogers, ImageCatalogers(Config{})...)
+	catalogers = append(catalogers, DirectoryCatalogers(Config{})...)
+
+	for _, c := range catalogers {
+		assert.Contains(t, confidenceByCataloger, c.Name(), "no confidence defined for cataloger %q", c.Name())
+	}
+}
+
+func TestPackageConfidence(t *testing.T) {
+	tests := []struct {
+		name      string
+		cataloger string
+		pkg       pkg.Package
+		expected  pkg.Confidence
+	}{
+		{
+			name:      "installed package",
+			cataloger: "dpkgdb-cataloger",
+			expected:  pkg.ExactMetadataConfidence,
+		},
+		{
+			name:      "lockfile package",
+			cataloger: "javascript-lock-cataloger",
+			expected:  pkg.LockfileDeclaredConfidence,
+		},
+		{
+			name:      "binary match",
+			cataloger: "runtime-cataloger",
+			expected:  pkg.HeuristicBinaryMatchConfidence,
+		},
+		{
+			name:      "confidence set by the cataloger",
+			cataloger: "java-cataloger",
+			pkg:       pkg.Package{Confidence: pkg.HeuristicBinaryMatchConfidence},
+			expected:  pkg.HeuristicBinaryMatchConfidence,
+		},
+		{
+			name:      "unknown cataloger",
+			cataloger: "made-up",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, packageConfidence(test.cataloger, test.pkg))
+		})
+	}
+}
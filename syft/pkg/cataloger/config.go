@@ -1,22 +1,59 @@
 package cataloger
 
 import (
+	"github.com/anchore/syft/syft/pkg/cataloger/golang"
 	"github.com/anchore/syft/syft/pkg/cataloger/java"
+	"github.com/anchore/syft/syft/pkg/cataloger/javascript"
+	"github.com/anchore/syft/syft/pkg/cataloger/python"
+	"github.com/anchore/syft/syft/pkg/cataloger/ruby"
+	"github.com/anchore/syft/syft/pkg/cataloger/rust"
 )
 
 type Config struct {
-	Search SearchConfig
+	Search                SearchConfig
+	Golang                golang.Config     // options that only apply to the go catalogers
+	Python                python.Config     // options that only apply to the python catalogers
+	Rust                  rust.Config       // options that only apply to the rust catalogers
+	ResolveJavaParentPoms bool              // resolve pom.xml properties and managed versions from parent poms and imported BOMs within the source
+	RegistryMappings      []RegistryMapping // internal package registries whose packages are given canonical package URLs
+	OwnershipRules        []OwnershipRule   // rules attaching organizational metadata (e.g. the owning team) to packages
 }
 
 func DefaultConfig() Config {
 	return Config{
-		Search: DefaultSearchConfig(),
+		Search:                DefaultSearchConfig(),
+		ResolveJavaParentPoms: true,
 	}
 }
 
 func (c Config) Java() java.Config {
 	return java.Config{
-		SearchUnindexedArchives: c.Search.IncludeUnindexedArchives,
-		SearchIndexedArchives:   c.Search.IncludeIndexedArchives,
+		SearchUnindexedArchives:    c.Search.IncludeUnindexedArchives,
+		SearchIndexedArchives:      c.Search.IncludeIndexedArchives,
+		MaxArchiveNestingDepth:     c.Search.ArchiveLimits.MaxNestingDepth,
+		MaxArchiveDecompressedSize: c.Search.ArchiveLimits.MaxDecompressedSize,
+		MaxArchiveFileSize:         c.Search.ArchiveLimits.MaxFileSize,
+		ResolveParentPoms:          c.ResolveJavaParentPoms,
+	}
+}
+
+func (c Config) PythonZipapp() python.ZipappConfig {
+	return python.ZipappConfig{
+		MaxArchiveNestingDepth:     c.Search.ArchiveLimits.MaxNestingDepth,
+		MaxArchiveDecompressedSize: c.Search.ArchiveLimits.MaxDecompressedSize,
+		MaxArchiveFileSize:         c.Search.ArchiveLimits.MaxFileSize,
+	}
+}
+
+func (c Config) Ruby() ruby.Config {
+	return ruby.Config{
+		MaxArchiveDecompressedSize: c.Search.ArchiveLimits.MaxDecompressedSize,
+		MaxArchiveFileSize:         c.Search.ArchiveLimits.MaxFileSize,
+	}
+}
+
+func (c Config) Javascript() javascript.Config {
+	return javascript.Config{
+		SearchSourceMaps: c.Search.IncludeSourceMaps,
 	}
 }
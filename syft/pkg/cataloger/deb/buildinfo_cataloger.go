@@ -0,0 +1,16 @@
+package deb
+
+import (
+	"github.com/anchore/syft/syft/pkg/cataloger/common"
+)
+
+// NewDpkgBuildInfoCataloger returns a new cataloger object for Debian .buildinfo and .changes files, capturing the
+// exact toolchain package versions that were used to build an artifact (and the binary packages that were produced).
+func NewDpkgBuildInfoCataloger() *common.GenericCataloger {
+	globParsers := map[string]common.ParserFn{
+		"**/*.buildinfo": parseBuildInfo,
+		"**/*.changes":   parseChanges,
+	}
+
+	return common.NewGenericCataloger(nil, globParsers, "dpkg-buildinfo-cataloger")
+}
@@ -11,10 +11,11 @@ import (
 	"sort"
 
 	"github.com/anchore/syft/internal"
-
+	"github.com/anchore/syft/internal/file"
 	"github.com/anchore/syft/internal/log"
 	"github.com/anchore/syft/syft/artifact"
 	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/common"
 	"github.com/anchore/syft/syft/source"
 )
 
@@ -24,6 +25,9 @@ const (
 	docsPath     = "/usr/share/doc"
 )
 
+// maintainerScriptTypes are the dpkg info file extensions for the scripts run when installing or removing a package.
+var maintainerScriptTypes = []string{"preinst", "postinst", "prerm", "postrm"}
+
 type Cataloger struct{}
 
 // NewDpkgdbCataloger returns a new Deb package cataloger object.
@@ -44,12 +48,19 @@ func (c *Cataloger) Catalog(resolver source.FileResolver) ([]pkg.Package, []arti
 	}
 
 	var allPackages []pkg.Package
-	for _, dbLocation := range dbFileMatches {
+	for _, dbLocation := range common.PreferUncompressed(dbFileMatches) {
 		dbContents, err := resolver.FileContentsByLocation(dbLocation)
 		if err != nil {
 			return nil, nil, err
 		}
 
+		decompressed, err := file.NewDecompressingReadCloser(dbLocation.RealPath, dbContents)
+		if err != nil {
+			internal.CloseAndLogError(dbContents, dbLocation.VirtualPath)
+			return nil, nil, err
+		}
+		dbContents = decompressed
+
 		pkgs, err := parseDpkgStatus(dbContents)
 		internal.CloseAndLogError(dbContents, dbLocation.VirtualPath)
 		if err != nil {
@@ -69,6 +80,12 @@ func (c *Cataloger) Catalog(resolver source.FileResolver) ([]pkg.Package, []arti
 			// fetch additional data from the copyright file to derive the license information
 			addLicenses(resolver, dbLocation, p)
 
+			// fill in the source package from the (compressed) changelog when the status entry does not list it
+			addChangelogSource(resolver, dbLocation, p)
+
+			// note any scripts that are run by dpkg on behalf of the package (and what they contain)
+			addMaintainerScripts(resolver, dbLocation, p)
+
 			p.SetID()
 		}
 
@@ -91,6 +108,71 @@ func addLicenses(resolver source.FileResolver, dbLocation source.Location, p *pk
 	}
 }
 
+func addChangelogSource(resolver source.FileResolver, dbLocation source.Location, p *pkg.Package) {
+	metadata := p.Metadata.(pkg.DpkgMetadata)
+	if metadata.Source != "" && metadata.SourceVersion != "" {
+		return
+	}
+
+	changelogReader, changelogLocation := fetchChangelogContents(resolver, dbLocation, p)
+	if changelogReader == nil || changelogLocation == nil {
+		return
+	}
+	defer internal.CloseAndLogError(changelogReader, changelogLocation.VirtualPath)
+
+	sourceName, sourceVersion, err := parseChangelogSource(changelogReader)
+	if err != nil {
+		log.Debugf("unable to read deb changelog (package=%s): %+v", p.Name, err)
+		return
+	}
+
+	// as within the status file, the source is only noted when it differs from the binary package
+	changed := false
+	if metadata.Source == "" && sourceName != p.Name {
+		metadata.Source = sourceName
+		changed = true
+	}
+	if metadata.SourceVersion == "" && sourceVersion != metadata.Version {
+		metadata.SourceVersion = sourceVersion
+		changed = true
+	}
+	if !changed {
+		return
+	}
+
+	// persist alterations
+	p.Metadata = metadata
+
+	// keep a record of the file where this was discovered
+	p.Locations = append(p.Locations, *changelogLocation)
+}
+
+func addMaintainerScripts(resolver source.FileResolver, dbLocation source.Location, p *pkg.Package) {
+	metadata := p.Metadata.(pkg.DpkgMetadata)
+
+	for _, scriptType := range maintainerScriptTypes {
+		reader, location := fetchInfoFileContents(resolver, dbLocation, p, "."+scriptType)
+		if reader == nil || location == nil {
+			continue
+		}
+
+		script, err := parseDpkgMaintainerScript(reader, scriptType)
+		internal.CloseAndLogError(reader, location.VirtualPath)
+		if err != nil {
+			log.Warnf("failed to read deb %s script (package=%s): %+v", scriptType, p.Name, err)
+			continue
+		}
+
+		metadata.MaintainerScripts = append(metadata.MaintainerScripts, script)
+
+		// keep a record of the file where this was discovered
+		p.Locations = append(p.Locations, *location)
+	}
+
+	// persist alterations
+	p.Metadata = metadata
+}
+
 func mergeFileListing(resolver source.FileResolver, dbLocation source.Location, p *pkg.Package) {
 	metadata := p.Metadata.(pkg.DpkgMetadata)
 
@@ -203,6 +285,33 @@ func fetchConffileContents(resolver source.FileResolver, dbLocation source.Locat
 	return reader, location
 }
 
+func fetchInfoFileContents(resolver source.FileResolver, dbLocation source.Location, p *pkg.Package, ext string) (io.ReadCloser, *source.Location) {
+	var reader io.ReadCloser
+	var err error
+
+	parentPath := filepath.Dir(dbLocation.RealPath)
+
+	// look for /var/lib/dpkg/info/NAME:ARCH.EXT
+	name := md5Key(p)
+	location := resolver.RelativeFileByPath(dbLocation, path.Join(parentPath, "info", name+ext))
+
+	if location == nil {
+		// the most specific key did not work, fallback to just the name
+		// look for /var/lib/dpkg/info/NAME.EXT
+		location = resolver.RelativeFileByPath(dbLocation, path.Join(parentPath, "info", p.Name+ext))
+	}
+
+	// most packages do not have every kind of info file, ignore missing files
+	if location != nil {
+		reader, err = resolver.FileContentsByLocation(*location)
+		if err != nil {
+			log.Warnf("failed to fetch deb %s contents (package=%s): %+v", ext, p.Name, err)
+		}
+	}
+
+	return reader, location
+}
+
 func fetchCopyrightContents(resolver source.FileResolver, dbLocation source.Location, p *pkg.Package) (io.ReadCloser, *source.Location) {
 	// look for /usr/share/docs/NAME/copyright files
 	name := p.Name
@@ -222,6 +331,31 @@ func fetchCopyrightContents(resolver source.FileResolver, dbLocation source.Loca
 	return reader, location
 }
 
+func fetchChangelogContents(resolver source.FileResolver, dbLocation source.Location, p *pkg.Package) (io.ReadCloser, *source.Location) {
+	// look for /usr/share/doc/NAME/changelog.Debian.gz files
+	location := resolver.RelativeFileByPath(dbLocation, path.Join(docsPath, p.Name, "changelog.Debian.gz"))
+
+	// most packages in container images do not have a changelog (since docs are often excluded), ignore missing files
+	if location == nil {
+		return nil, nil
+	}
+
+	reader, err := resolver.FileContentsByLocation(*location)
+	if err != nil {
+		log.Warnf("failed to fetch deb changelog contents (package=%s): %+v", p.Name, err)
+		return nil, nil
+	}
+
+	decompressed, err := file.NewDecompressingReadCloser(location.RealPath, reader)
+	if err != nil {
+		internal.CloseAndLogError(reader, location.VirtualPath)
+		log.Warnf("failed to decompress deb changelog (package=%s): %+v", p.Name, err)
+		return nil, nil
+	}
+
+	return decompressed, location
+}
+
 func md5Key(p *pkg.Package) string {
 	metadata := p.Metadata.(pkg.DpkgMetadata)
 
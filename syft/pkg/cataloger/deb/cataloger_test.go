@@ -1,9 +1,16 @@
 package deb
 
 import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/anchore/syft/syft/file"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/anchore/stereoscope/pkg/imagetest"
 	"github.com/anchore/syft/syft/pkg"
@@ -26,6 +33,8 @@ func TestDpkgCataloger(t *testing.T) {
 					"/var/lib/dpkg/info/libpam-runtime.md5sums",
 					"/var/lib/dpkg/info/libpam-runtime.conffiles",
 					"/usr/share/doc/libpam-runtime/copyright",
+					"/var/lib/dpkg/info/libpam-runtime.postinst",
+					"/var/lib/dpkg/info/libpam-runtime.prerm",
 				},
 			},
 			expected: []pkg.Package{
@@ -43,6 +52,8 @@ func TestDpkgCataloger(t *testing.T) {
 						Architecture:  "all",
 						Maintainer:    "Steve Langasek <vorlon@debian.org>",
 						InstalledSize: 1016,
+						Section:       "admin",
+						Priority:      "required",
 						Files: []pkg.DpkgFileRecord{
 							{
 								Path: "/etc/pam.conf",
@@ -77,6 +88,24 @@ func TestDpkgCataloger(t *testing.T) {
 								Value:     "a4fae96070439a5209a62ae5b8017ab2",
 							}},
 						},
+						MaintainerScripts: []pkg.MaintainerScript{
+							{
+								Type:        "postinst",
+								Interpreter: "/bin/sh",
+								Digest: &file.Digest{
+									Algorithm: "sha256",
+									Value:     "9ef07609f25165c61e9a5c876f3095fe3277149fbb5adce3f40181752ee99ee5",
+								},
+							},
+							{
+								Type:        "prerm",
+								Interpreter: "/bin/sh",
+								Digest: &file.Digest{
+									Algorithm: "sha256",
+									Value:     "f4768757cac7226059f870e93034b3d24d054620811ae821fc531a0fc0b25b5d",
+								},
+							},
+						},
 					},
 				},
 			},
@@ -136,3 +165,40 @@ func TestDpkgCataloger(t *testing.T) {
 	}
 
 }
+
+func TestDpkgCataloger_ChangelogSource(t *testing.T) {
+	var changelog bytes.Buffer
+	gz := gzip.NewWriter(&changelog)
+	_, err := gz.Write([]byte("foo (1.2-3) unstable; urgency=medium\n\n  * Initial release.\n"))
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+
+	root := t.TempDir()
+	for p, contents := range map[string][]byte{
+		"var/lib/dpkg/status": []byte("Package: libfoo1\nStatus: install ok installed\nVersion: 1.2-3+b1\nArchitecture: amd64\n"),
+		// a binNMU of a library built from the "foo" source package
+		"usr/share/doc/libfoo1/changelog.Debian.gz": changelog.Bytes(),
+	} {
+		require.NoError(t, os.MkdirAll(filepath.Join(root, filepath.Dir(p)), 0755))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(root, p), contents, 0644))
+	}
+
+	src, err := source.NewFromDirectory(root)
+	require.NoError(t, err)
+	resolver, err := src.FileResolver(source.SquashedScope)
+	require.NoError(t, err)
+
+	pkgs, _, err := NewDpkgdbCataloger().Catalog(resolver)
+	require.NoError(t, err)
+	require.Len(t, pkgs, 1)
+
+	metadata := pkgs[0].Metadata.(pkg.DpkgMetadata)
+	assert.Equal(t, "foo", metadata.Source)
+	assert.Equal(t, "1.2-3", metadata.SourceVersion)
+
+	var paths []string
+	for _, l := range pkgs[0].Locations {
+		paths = append(paths, l.RealPath)
+	}
+	assert.Contains(t, paths, "usr/share/doc/libfoo1/changelog.Debian.gz")
+}
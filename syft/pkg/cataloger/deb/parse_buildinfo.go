@@ -0,0 +1,187 @@
+package deb
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"strings"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/common"
+)
+
+// integrity check
+var _ common.ParserFn = parseBuildInfo
+var _ common.ParserFn = parseChanges
+
+// buildDependencyRegexp matches a single Installed-Build-Depends entry, e.g. "libc6-dev:amd64 (= 2.31-13+deb11u2)"
+var buildDependencyRegexp = regexp.MustCompile(`^(?P<name>[^\s:(]+)(:(?P<arch>[^\s(]+))?\s*\(=\s*(?P<version>[^)\s]+)\s*\)`)
+
+// parseBuildInfo is a parser function for Debian .buildinfo contents, returning the exact set of packages that were
+// installed in the build environment (see https://wiki.debian.org/ReproducibleBuilds/BuildinfoFiles).
+func parseBuildInfo(_ string, reader io.Reader) ([]*pkg.Package, []artifact.Relationship, error) {
+	fields, err := readControlFields(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to parse buildinfo: %w", err)
+	}
+
+	buildArch := fieldValue(fields, "BuildArchitecture")
+
+	var packages []*pkg.Package
+	for _, entry := range splitFieldList(fieldValue(fields, "InstalledBuildDepends"), ",") {
+		match := internal.MatchNamedCaptureGroups(buildDependencyRegexp, entry)
+		if match["name"] == "" || match["version"] == "" {
+			continue
+		}
+
+		arch := match["arch"]
+		if arch == "" {
+			arch = buildArch
+		}
+
+		packages = append(packages, newDpkgBuildDependencyPackage(pkg.DpkgBuildDependencyMetadata{
+			Package:      match["name"],
+			Version:      match["version"],
+			Architecture: arch,
+		}))
+	}
+
+	return packages, nil, nil
+}
+
+// newDpkgBuildDependencyPackage returns a package for a build environment entry. The distinct metadata type keeps
+// these from being mistaken for packages installed from a dpkg status DB (e.g. by vulnerability matchers).
+func newDpkgBuildDependencyPackage(d pkg.DpkgBuildDependencyMetadata) *pkg.Package {
+	return &pkg.Package{
+		Name:         d.Package,
+		Version:      d.Version,
+		Type:         pkg.DebPkg,
+		MetadataType: pkg.DpkgBuildDependencyMetadataType,
+		Metadata:     d,
+	}
+}
+
+// parseChanges is a parser function for Debian .changes contents, returning the binary packages produced by the
+// described upload (see https://www.debian.org/doc/debian-policy/ch-controlfields.html#debian-changes-files-changes).
+func parseChanges(_ string, reader io.Reader) ([]*pkg.Package, []artifact.Relationship, error) {
+	fields, err := readControlFields(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to parse changes: %w", err)
+	}
+
+	version := fieldValue(fields, "Version")
+	if version == "" {
+		return nil, nil, nil
+	}
+
+	source, sourceVersion := extractSourceVersion(fieldValue(fields, "Source"))
+	if sourceVersion == "" {
+		sourceVersion = version
+	}
+
+	var packages []*pkg.Package
+	for _, binary := range splitFieldList(fieldValue(fields, "Binary"), " ") {
+		metadata := pkg.DpkgUploadMetadata{
+			Package:    binary,
+			Version:    version,
+			Maintainer: fieldValue(fields, "Maintainer"),
+		}
+
+		if source != binary {
+			metadata.Source = source
+			if sourceVersion != version {
+				metadata.SourceVersion = sourceVersion
+			}
+		}
+
+		packages = append(packages, newDpkgUploadPackage(metadata))
+	}
+
+	return packages, nil, nil
+}
+
+// newDpkgUploadPackage returns a package for a binary produced by an upload. As with build dependencies, the distinct
+// metadata type keeps these from being mistaken for installed packages.
+func newDpkgUploadPackage(d pkg.DpkgUploadMetadata) *pkg.Package {
+	return &pkg.Package{
+		Name:         d.Package,
+		Version:      d.Version,
+		Type:         pkg.DebPkg,
+		MetadataType: pkg.DpkgUploadMetadataType,
+		Metadata:     d,
+	}
+}
+
+// readControlFields reads the first paragraph of a (possibly clearsigned) Debian control file.
+func readControlFields(reader io.Reader) (map[string]interface{}, error) {
+	contents, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := extractAllFields(bufio.NewReader(bytes.NewReader(stripPGPSignature(contents))))
+	if err != nil && !errors.Is(err, errEndOfPackages) {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// stripPGPSignature removes the OpenPGP cleartext signature framework (RFC 4880 section 7) that commonly wraps
+// .buildinfo and .changes files, returning only the signed message.
+func stripPGPSignature(contents []byte) []byte {
+	const (
+		messageHeader   = "-----BEGIN PGP SIGNED MESSAGE-----"
+		signatureHeader = "-----BEGIN PGP SIGNATURE-----"
+	)
+
+	if !bytes.HasPrefix(bytes.TrimSpace(contents), []byte(messageHeader)) {
+		return contents
+	}
+
+	var result bytes.Buffer
+	inHeaders := true
+	scanner := bufio.NewScanner(bytes.NewReader(bytes.TrimSpace(contents)))
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case inHeaders:
+			// the armor headers (e.g. "Hash: SHA256") are terminated by an empty line
+			if strings.TrimSpace(line) == "" {
+				inHeaders = false
+			}
+			continue
+		case line == signatureHeader:
+			return result.Bytes()
+		case strings.HasPrefix(line, "- "):
+			// undo dash-escaping of the signed text
+			line = strings.TrimPrefix(line, "- ")
+		}
+		result.WriteString(line + "\n")
+	}
+	return result.Bytes()
+}
+
+func fieldValue(fields map[string]interface{}, key string) string {
+	if value, ok := fields[key].(string); ok {
+		return strings.TrimSpace(value)
+	}
+	return ""
+}
+
+func splitFieldList(value, separator string) (results []string) {
+	for _, line := range strings.Split(value, "\n") {
+		for _, item := range strings.Split(line, separator) {
+			item = strings.TrimSpace(item)
+			if item != "" {
+				results = append(results, item)
+			}
+		}
+	}
+	return results
+}
@@ -0,0 +1,80 @@
+package deb
+
+import (
+	"os"
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/go-test/deep"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseBuildInfo(t *testing.T) {
+	expected := []*pkg.Package{
+		newDpkgBuildDependencyPackage(pkg.DpkgBuildDependencyMetadata{Package: "autoconf", Version: "2.69-14", Architecture: "amd64"}),
+		newDpkgBuildDependencyPackage(pkg.DpkgBuildDependencyMetadata{Package: "base-files", Version: "11", Architecture: "amd64"}),
+		newDpkgBuildDependencyPackage(pkg.DpkgBuildDependencyMetadata{Package: "gcc-10", Version: "10.2.0-9", Architecture: "amd64"}),
+		newDpkgBuildDependencyPackage(pkg.DpkgBuildDependencyMetadata{Package: "libc6", Version: "2.31-3", Architecture: "amd64"}),
+		newDpkgBuildDependencyPackage(pkg.DpkgBuildDependencyMetadata{Package: "libc6-dev", Version: "2.31-3", Architecture: "amd64"}),
+		newDpkgBuildDependencyPackage(pkg.DpkgBuildDependencyMetadata{Package: "make", Version: "4.3-4", Architecture: "amd64"}),
+	}
+
+	fixture, err := os.Open("test-fixtures/buildinfo/hello_2.10-2_amd64.buildinfo")
+	if err != nil {
+		t.Fatalf("failed to open fixture: %+v", err)
+	}
+
+	actual, _, err := parseBuildInfo(fixture.Name(), fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, d := range deep.Equal(expected, actual) {
+		t.Errorf("diff: %+v", d)
+	}
+}
+
+func TestParseChanges(t *testing.T) {
+	expected := []*pkg.Package{
+		newDpkgUploadPackage(pkg.DpkgUploadMetadata{Package: "hello", Version: "2.10-2", Maintainer: "Santiago Vila <sanvila@debian.org>"}),
+		newDpkgUploadPackage(pkg.DpkgUploadMetadata{Package: "hello-dbgsym", Source: "hello", Version: "2.10-2", Maintainer: "Santiago Vila <sanvila@debian.org>"}),
+	}
+
+	fixture, err := os.Open("test-fixtures/buildinfo/hello_2.10-2_amd64.changes")
+	if err != nil {
+		t.Fatalf("failed to open fixture: %+v", err)
+	}
+
+	actual, _, err := parseChanges(fixture.Name(), fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, d := range deep.Equal(expected, actual) {
+		t.Errorf("diff: %+v", d)
+	}
+}
+
+func TestStripPGPSignature(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "unsigned",
+			input:    "Source: hello\nVersion: 1.0\n",
+			expected: "Source: hello\nVersion: 1.0\n",
+		},
+		{
+			name:     "clearsigned",
+			input:    "-----BEGIN PGP SIGNED MESSAGE-----\nHash: SHA256\n\nSource: hello\n- -dashed\n-----BEGIN PGP SIGNATURE-----\n\nabc\n-----END PGP SIGNATURE-----\n",
+			expected: "Source: hello\n-dashed\n",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, string(stripPGPSignature([]byte(test.input))))
+		})
+	}
+}
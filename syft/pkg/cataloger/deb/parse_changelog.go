@@ -0,0 +1,36 @@
+package deb
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/anchore/syft/internal"
+)
+
+// For more information see: https://www.debian.org/doc/debian-policy/ch-source.html#debian-changelog-debian-changelog
+
+// changelogHeaderPattern matches the first line of a changelog entry, e.g. "openssl (1.1.1k-1+deb11u1) bullseye-security; urgency=medium"
+var changelogHeaderPattern = regexp.MustCompile(`^(?P<source>[a-z0-9][a-z0-9+.\-]+) \((?P<version>[^)\s]+)\)`)
+
+// parseChangelogSource returns the source package name and version of the latest entry within the given changelog.
+func parseChangelogSource(reader io.Reader) (string, string, error) {
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		match := internal.MatchNamedCaptureGroups(changelogHeaderPattern, line)
+		if match["source"] == "" || match["version"] == "" {
+			return "", "", fmt.Errorf("unexpected changelog entry header: %q", line)
+		}
+		return match["source"], match["version"], nil
+	}
+	if err := scanner.Err(); err != nil {
+		return "", "", err
+	}
+	return "", "", fmt.Errorf("no changelog entries found")
+}
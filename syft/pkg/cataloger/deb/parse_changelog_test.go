@@ -0,0 +1,60 @@
+package deb
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseChangelogSource(t *testing.T) {
+	tests := []struct {
+		name            string
+		contents        string
+		expectedSource  string
+		expectedVersion string
+		wantErr         require.ErrorAssertionFunc
+	}{
+		{
+			name: "latest entry",
+			contents: `openssl (1.1.1k-1+deb11u1) bullseye-security; urgency=medium
+
+  * Fix CVE-2021-3711.
+
+ -- Debian Security Team <team@security.debian.org>  Tue, 24 Aug 2021 20:30:06 +0200
+
+openssl (1.1.1k-1) unstable; urgency=medium
+`,
+			expectedSource:  "openssl",
+			expectedVersion: "1.1.1k-1+deb11u1",
+		},
+		{
+			name:            "epoch and leading blank lines",
+			contents:        "\n\nlibpam-runtime (1:1.4.0-9) unstable; urgency=medium\n",
+			expectedSource:  "libpam-runtime",
+			expectedVersion: "1:1.4.0-9",
+		},
+		{
+			name:     "not a changelog",
+			contents: "this is not a changelog\n",
+			wantErr:  require.Error,
+		},
+		{
+			name:     "empty",
+			contents: "",
+			wantErr:  require.Error,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.wantErr == nil {
+				test.wantErr = require.NoError
+			}
+			source, version, err := parseChangelogSource(strings.NewReader(test.contents))
+			test.wantErr(t, err)
+			assert.Equal(t, test.expectedSource, source)
+			assert.Equal(t, test.expectedVersion, version)
+		})
+	}
+}
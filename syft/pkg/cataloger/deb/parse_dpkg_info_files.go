@@ -2,7 +2,11 @@ package deb
 
 import (
 	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"fmt"
 	"io"
+	"io/ioutil"
 	"strings"
 
 	"github.com/anchore/syft/syft/file"
@@ -72,3 +76,30 @@ func parseDpkgConffileInfo(reader io.Reader) (findings []pkg.DpkgFileRecord) {
 	}
 	return findings
 }
+
+// parseDpkgMaintainerScript summarizes a maintainer script (e.g. "postinst") by the interpreter named in the shebang
+// and a digest of the script contents.
+func parseDpkgMaintainerScript(reader io.Reader, scriptType string) (pkg.MaintainerScript, error) {
+	contents, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return pkg.MaintainerScript{}, err
+	}
+
+	var interpreter string
+	firstLine := contents
+	if i := bytes.IndexByte(firstLine, '\n'); i >= 0 {
+		firstLine = firstLine[:i]
+	}
+	if bytes.HasPrefix(firstLine, []byte("#!")) {
+		interpreter = strings.TrimSpace(string(firstLine[2:]))
+	}
+
+	return pkg.MaintainerScript{
+		Type:        scriptType,
+		Interpreter: interpreter,
+		Digest: &file.Digest{
+			Algorithm: "sha256",
+			Value:     fmt.Sprintf("%x", sha256.Sum256(contents)),
+		},
+	}, nil
+}
@@ -118,3 +118,60 @@ func TestConffileInfoParsing(t *testing.T) {
 		})
 	}
 }
+
+func TestMaintainerScriptParsing(t *testing.T) {
+	tests := []struct {
+		fixture    string
+		scriptType string
+		expected   pkg.MaintainerScript
+	}{
+		{
+			fixture:    "test-fixtures/info/libpam-runtime.postinst",
+			scriptType: "postinst",
+			expected: pkg.MaintainerScript{
+				Type:        "postinst",
+				Interpreter: "/bin/sh",
+				Digest: &file.Digest{
+					Algorithm: "sha256",
+					Value:     "9ef07609f25165c61e9a5c876f3095fe3277149fbb5adce3f40181752ee99ee5",
+				},
+			},
+		},
+		{
+			// scripts without a shebang are still captured, the interpreter is just unknown
+			fixture:    "test-fixtures/info/zlib1g.postrm",
+			scriptType: "postrm",
+			expected: pkg.MaintainerScript{
+				Type: "postrm",
+				Digest: &file.Digest{
+					Algorithm: "sha256",
+					Value:     "adbf54c6b6311cc3da553e059141f601134e18684e08e577ee953660f5246d1b",
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.fixture, func(t *testing.T) {
+			file, err := os.Open(test.fixture)
+			if err != nil {
+				t.Fatal("Unable to read: ", err)
+			}
+			defer func() {
+				err := file.Close()
+				if err != nil {
+					t.Fatal("closing file failed:", err)
+				}
+			}()
+
+			actual, err := parseDpkgMaintainerScript(file, test.scriptType)
+			if err != nil {
+				t.Fatalf("failed to parse script: %+v", err)
+			}
+
+			for _, d := range deep.Equal(actual, test.expected) {
+				t.Errorf("diff: %+v", d)
+			}
+		})
+	}
+}
@@ -32,6 +32,8 @@ func TestSinglePackage(t *testing.T) {
 				Architecture:  "amd64",
 				InstalledSize: 4064,
 				Maintainer:    "APT Development Team <deity@lists.debian.org>",
+				Section:       "admin",
+				Priority:      "required",
 				Files: []pkg.DpkgFileRecord{
 					{
 						Path: "/etc/apt/apt.conf.d/01autoremove",
@@ -110,6 +112,8 @@ func TestMultiplePackages(t *testing.T) {
 					Architecture:  "all",
 					InstalledSize: 3036,
 					Maintainer:    "GNU Libc Maintainers <debian-glibc@lists.debian.org>",
+					Section:       "localization",
+					Priority:      "required",
 					Files:         []pkg.DpkgFileRecord{},
 				},
 				{
@@ -118,6 +122,8 @@ func TestMultiplePackages(t *testing.T) {
 					Architecture:  "amd64",
 					InstalledSize: 4327,
 					Maintainer:    "LaMont Jones <lamont@debian.org>",
+					Section:       "utils",
+					Priority:      "required",
 					Files: []pkg.DpkgFileRecord{
 						{
 							Path: "/etc/default/hwclock",
@@ -0,0 +1,53 @@
+package cataloger
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/anchore/syft/syft/distro"
+	"github.com/anchore/syft/syft/eol"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/source"
+)
+
+// AnnotateEOL records the end-of-life status (as of the given time) of every runtime package and of the given distro
+// that is known to the given dataset. A warning is returned for every runtime or distro that has reached end of life.
+// The package IDs are unchanged (the end-of-life status is not part of the ID).
+func AnnotateEOL(catalog *pkg.Catalog, d *distro.Distro, dataset *eol.Dataset, at time.Time) (*pkg.Catalog, []source.Warning) {
+	if dataset == nil {
+		return catalog, nil
+	}
+
+	var warnings []source.Warning
+	if d != nil {
+		d.EOL = dataset.DistroStatus(string(d.Type), d.RawVersion, at)
+		if d.EOL != nil && d.EOL.Expired {
+			warnings = append(warnings, source.Warning{Message: expiredMessage(d.String(), d.EOL)})
+		}
+	}
+
+	if catalog == nil {
+		return catalog, warnings
+	}
+
+	var packages []pkg.Package
+	for _, p := range catalog.Sorted() {
+		p.EOL = dataset.PackageStatus(string(p.Type), p.Name, p.Version, at)
+		if p.EOL != nil && p.EOL.Expired {
+			var path string
+			if len(p.Locations) > 0 {
+				path = p.Locations[0].RealPath
+			}
+			warnings = append(warnings, source.Warning{
+				Path:    path,
+				Message: expiredMessage(fmt.Sprintf("%s %s", p.Name, p.Version), p.EOL),
+			})
+		}
+		packages = append(packages, p)
+	}
+	return pkg.NewCatalog(packages...), warnings
+}
+
+func expiredMessage(subject string, status *eol.Status) string {
+	return fmt.Sprintf("%s reached end of life on %s (%s %s)", subject, status.EOL, status.Product, status.Cycle)
+}
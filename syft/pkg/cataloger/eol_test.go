@@ -0,0 +1,51 @@
+package cataloger
+
+import (
+	"testing"
+	"time"
+
+	"github.com/anchore/syft/syft/distro"
+	"github.com/anchore/syft/syft/eol"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/source"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnnotateEOL(t *testing.T) {
+	python := pkg.Package{
+		Name:      "python3.6",
+		Version:   "3.6.9-1",
+		Type:      pkg.DebPkg,
+		Locations: []source.Location{source.NewLocation("/var/lib/dpkg/status")},
+	}
+	python.SetID()
+	node := pkg.Package{
+		Name:    "node",
+		Version: "16.13.0",
+		Type:    pkg.RuntimePkg,
+	}
+	node.SetID()
+	lodash := pkg.Package{
+		Name:    "lodash",
+		Version: "4.17.21",
+		Type:    pkg.NpmPkg,
+	}
+	lodash.SetID()
+
+	d, err := distro.NewDistro(distro.Debian, "9", "")
+	require.NoError(t, err)
+
+	at := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+	catalog, warnings := AnnotateEOL(pkg.NewCatalog(python, node, lodash), &d, eol.DefaultDataset(), at)
+
+	assert.Equal(t, &eol.Status{Product: "debian", Cycle: "9", EOL: "2020-07-06", Expired: true}, d.EOL)
+	assert.Equal(t, &eol.Status{Product: "python", Cycle: "3.6", EOL: "2021-12-23", Expired: true}, catalog.Package(python.ID()).EOL)
+	assert.Equal(t, &eol.Status{Product: "nodejs", Cycle: "16", EOL: "2023-09-11", Expired: false}, catalog.Package(node.ID()).EOL)
+	assert.Nil(t, catalog.Package(lodash.ID()).EOL)
+
+	assert.Equal(t, []source.Warning{
+		{Message: "debian 9 reached end of life on 2020-07-06 (debian 9)"},
+		{Path: "/var/lib/dpkg/status", Message: "python3.6 3.6.9-1 reached end of life on 2021-12-23 (python 3.6)"},
+	}, warnings)
+}
@@ -0,0 +1,6 @@
+package golang
+
+type Config struct {
+	SearchLocalModCache bool   // fill in license and hash information for go.mod dependencies from the local Go module cache (no network access is performed)
+	LocalModCacheDir    string // the module cache to search (defaults to $GOMODCACHE, then $GOPATH/pkg/mod, then ~/go/pkg/mod)
+}
@@ -0,0 +1,132 @@
+package golang
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/internal/spdxlicense"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/mitchellh/go-homedir"
+	"golang.org/x/mod/module"
+)
+
+// licenseFilePrefixes are the (lowercase) file name prefixes of license files at the root of a module.
+var licenseFilePrefixes = []string{"license", "licence", "copying"}
+
+// modCache looks up modules in a local Go module cache (as populated by "go mod download"). Only the local filesystem
+// is read, the network is never consulted.
+type modCache struct {
+	dir string
+}
+
+func newModCache(dir string) *modCache {
+	if dir == "" {
+		dir = defaultModCacheDir()
+	}
+	log.Debugf("searching the local go module cache: %s", dir)
+	return &modCache{dir: dir}
+}
+
+// defaultModCacheDir returns the module cache location the go command would use.
+func defaultModCacheDir() string {
+	if dir := os.Getenv("GOMODCACHE"); dir != "" {
+		return dir
+	}
+	if gopath := filepath.SplitList(os.Getenv("GOPATH")); len(gopath) > 0 && gopath[0] != "" {
+		return filepath.Join(gopath[0], "pkg", "mod")
+	}
+	home, err := homedir.Dir()
+	if err != nil {
+		log.Debugf("unable to determine the go module cache: %+v", err)
+		return ""
+	}
+	return filepath.Join(home, "go", "pkg", "mod")
+}
+
+// enrich fills in the licenses and module hash of the given package when the module is in the cache. Packages that
+// are already described or that cannot be found are left untouched.
+func (c *modCache) enrich(p *pkg.Package) {
+	if c.dir == "" || p.Version == "" {
+		// local replacements (without a version) are never in the cache
+		return
+	}
+	escapedPath, err := module.EscapePath(p.Name)
+	if err != nil {
+		return
+	}
+	escapedVersion, err := module.EscapeVersion(p.Version)
+	if err != nil {
+		return
+	}
+
+	if len(p.Licenses) == 0 {
+		p.Licenses = c.licenses(filepath.Join(c.dir, filepath.FromSlash(escapedPath)+"@"+escapedVersion))
+	}
+
+	if p.Metadata == nil {
+		if digest := c.h1Digest(escapedPath, escapedVersion); digest != "" {
+			p.MetadataType = pkg.GolangModMetadataType
+			p.Metadata = pkg.GolangModMetadata{
+				H1Digest: digest,
+			}
+		}
+	}
+}
+
+// licenses identifies the licenses of the files at the root of the extracted module directory.
+func (c *modCache) licenses(moduleDir string) []string {
+	entries, err := ioutil.ReadDir(moduleDir)
+	if err != nil {
+		return nil
+	}
+
+	found := make(map[string]struct{})
+	for _, entry := range entries {
+		if !entry.Mode().IsRegular() || !isLicenseFile(entry.Name()) {
+			continue
+		}
+		contents, err := ioutil.ReadFile(filepath.Join(moduleDir, entry.Name()))
+		if err != nil {
+			log.Debugf("unable to read go module license file: %+v", err)
+			continue
+		}
+		if id, ok := spdxlicense.IDFromText(string(contents)); ok {
+			found[id] = struct{}{}
+		}
+	}
+
+	var licenses []string
+	for id := range found {
+		licenses = append(licenses, id)
+	}
+	sort.Strings(licenses)
+	return licenses
+}
+
+// h1Digest returns the module hash recorded by the go command when the module was downloaded (the same value found in
+// go.sum), or an empty string if the module was never downloaded.
+func (c *modCache) h1Digest(escapedPath, escapedVersion string) string {
+	contents, err := ioutil.ReadFile(filepath.Join(c.dir, "cache", "download", filepath.FromSlash(escapedPath), "@v", escapedVersion+".ziphash"))
+	if err != nil {
+		return ""
+	}
+	digest := strings.TrimSpace(string(contents))
+	if !strings.HasPrefix(digest, "h1:") {
+		return ""
+	}
+	return digest
+}
+
+func isLicenseFile(name string) bool {
+	name = strings.ToLower(name)
+	for _, prefix := range licenseFilePrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
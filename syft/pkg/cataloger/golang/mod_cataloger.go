@@ -4,14 +4,80 @@ Package golang provides a concrete Cataloger implementation for go.mod files.
 package golang
 
 import (
+	"path"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/pkg"
 	"github.com/anchore/syft/syft/pkg/cataloger/common"
+	"github.com/anchore/syft/syft/source"
 )
 
+// GoModCataloger catalogs go.mod files, recording the module hashes found in the go.sum file next to each go.mod.
+type GoModCataloger struct {
+	*common.GenericCataloger
+}
+
 // NewGoModFileCataloger returns a new Go module cataloger object.
-func NewGoModFileCataloger() *common.GenericCataloger {
+func NewGoModFileCataloger(cfg Config) *GoModCataloger {
 	globParsers := map[string]common.ParserFn{
-		"**/go.mod": parseGoMod,
+		"**/go.mod": newGoModParser(cfg).parse,
+	}
+
+	return &GoModCataloger{
+		GenericCataloger: common.NewGenericCataloger(nil, globParsers, "go-mod-file-cataloger"),
+	}
+}
+
+// Catalog is given an object to resolve file references and content, this function returns any discovered Packages after analyzing the catalog source.
+func (c *GoModCataloger) Catalog(resolver source.FileResolver) ([]pkg.Package, []artifact.Relationship, error) {
+	packages, relationships, err := c.GenericCataloger.Catalog(resolver)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return common.NewGenericCataloger(nil, globParsers, "go-mod-file-cataloger")
+	sums := make(map[source.Coordinates]map[string]string)
+	for i := range packages {
+		p := &packages[i]
+		if p.Metadata != nil || len(p.Locations) == 0 {
+			// already described by the local module cache
+			continue
+		}
+		location := p.Locations[0]
+		hashes, ok := sums[location.Coordinates]
+		if !ok {
+			hashes = readGoSum(resolver, location)
+			sums[location.Coordinates] = hashes
+		}
+		if digest, ok := hashes[p.Name+"@"+p.Version]; ok {
+			p.MetadataType = pkg.GolangModMetadataType
+			p.Metadata = pkg.GolangModMetadata{
+				H1Digest: digest,
+			}
+		}
+	}
+
+	return packages, relationships, nil
+}
+
+// readGoSum returns the module hashes from the go.sum file beside the given go.mod (nil if there is none).
+func readGoSum(resolver source.FileResolver, goModLocation source.Location) map[string]string {
+	location := resolver.RelativeFileByPath(goModLocation, path.Join(path.Dir(goModLocation.RealPath), "go.sum"))
+	if location == nil {
+		return nil
+	}
+	reader, err := resolver.FileContentsByLocation(*location)
+	if err != nil {
+		log.Debugf("unable to read go.sum (%s): %+v", location.RealPath, err)
+		return nil
+	}
+	defer internal.CloseAndLogError(reader, location.VirtualPath)
+
+	hashes, err := parseGoSum(reader)
+	if err != nil {
+		log.Debugf("unable to parse go.sum (%s): %+v", location.RealPath, err)
+		return nil
+	}
+	return hashes
 }
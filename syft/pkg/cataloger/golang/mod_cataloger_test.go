@@ -0,0 +1,31 @@
+package golang
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/source"
+)
+
+func TestGoModCataloger_GoSum(t *testing.T) {
+	resolver := source.NewMockResolverForPaths("test-fixtures/go-sum/go.mod", "test-fixtures/go-sum/go.sum")
+
+	packages, _, err := NewGoModFileCataloger(Config{}).Catalog(resolver)
+	require.NoError(t, err)
+
+	metadata := make(map[string]interface{})
+	for _, p := range packages {
+		metadata[p.Name] = p.Metadata
+	}
+
+	assert.Equal(t, map[string]interface{}{
+		"github.com/bmatcuk/doublestar/v4": pkg.GolangModMetadata{
+			H1Digest: "h1:X0krlUVAVmtr2cRoTqR8aDMrDqnB36ht8wpWTiQ3jsA=",
+		},
+		// only the go.mod hash is recorded for this module
+		"github.com/go-test/deep": nil,
+	}, metadata)
+}
@@ -13,6 +13,8 @@ import (
 const (
 	packageIdentifier = "dep"
 	replaceIdentifier = "=>"
+	// stdlibName is the package name used to represent the go standard library (and runtime) compiled into a binary
+	stdlibName = "stdlib"
 )
 
 type exeOpener func(file io.ReadCloser) ([]exe, error)
@@ -39,6 +41,31 @@ func newGoBinaryPackage(name, version, h1Digest, goVersion, architecture string,
 	return p
 }
 
+// newGoStdlibPackage creates a package for the go standard library and runtime that was compiled into a binary, which
+// allows for vulnerabilities in the toolchain itself to be matched (not just vulnerabilities in third-party modules).
+func newGoStdlibPackage(goVersion, architecture string, location source.Location) *pkg.Package {
+	// the compiled version is in the form "go1.17.2", where only "1.17.2" is the version of the standard library
+	version := strings.TrimPrefix(goVersion, "go")
+	if version == "" || version == goVersion {
+		return nil
+	}
+
+	return &pkg.Package{
+		Name:     stdlibName,
+		Version:  version,
+		Language: pkg.Go,
+		Type:     pkg.GoModulePkg,
+		Locations: []source.Location{
+			location,
+		},
+		MetadataType: pkg.GolangBinMetadataType,
+		Metadata: pkg.GolangBinMetadata{
+			GoCompiledVersion: goVersion,
+			Architecture:      architecture,
+		},
+	}
+}
+
 func parseGoBin(location source.Location, reader io.ReadCloser, opener exeOpener) (pkgs []pkg.Package, err error) {
 	var exes []exe
 	// it has been found that there are stdlib paths within openExe that can panic. We want to prevent this behavior
@@ -58,6 +85,11 @@ func parseGoBin(location source.Location, reader io.ReadCloser, opener exeOpener
 	for _, x := range exes {
 		goVersion, mod := findVers(x)
 		pkgs = append(pkgs, buildGoPkgInfo(location, mod, goVersion, x.ArchName())...)
+
+		if stdlib := newGoStdlibPackage(goVersion, x.ArchName(), location); stdlib != nil {
+			stdlib.SetID()
+			pkgs = append(pkgs, *stdlib)
+		}
 	}
 	return pkgs, err
 }
@@ -163,6 +163,44 @@ func TestBuildGoPkgInfo(t *testing.T) {
 	}
 }
 
+func Test_newGoStdlibPackage(t *testing.T) {
+	location := source.NewLocation("/a-path")
+	tests := []struct {
+		name      string
+		goVersion string
+		expected  *pkg.Package
+	}{
+		{
+			name:      "release toolchain",
+			goVersion: "go1.17.2",
+			expected: &pkg.Package{
+				Name:         "stdlib",
+				Version:      "1.17.2",
+				Language:     pkg.Go,
+				Type:         pkg.GoModulePkg,
+				Locations:    []source.Location{location},
+				MetadataType: pkg.GolangBinMetadataType,
+				Metadata: pkg.GolangBinMetadata{
+					GoCompiledVersion: "go1.17.2",
+					Architecture:      "amd64",
+				},
+			},
+		},
+		{
+			name:      "development toolchain",
+			goVersion: "devel +b7a85e0003",
+		},
+		{
+			name: "no version",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, newGoStdlibPackage(test.goVersion, "amd64", location))
+		})
+	}
+}
+
 func Test_parseGoBin_recoversFromPanic(t *testing.T) {
 	freakOut := func(file io.ReadCloser) ([]exe, error) {
 		panic("baaahhh!")
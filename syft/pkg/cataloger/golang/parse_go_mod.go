@@ -8,9 +8,37 @@ import (
 
 	"github.com/anchore/syft/syft/artifact"
 	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/common"
 	"golang.org/x/mod/modfile"
 )
 
+// integrity check
+var _ common.ParserFn = goModParser{}.parse
+
+type goModParser struct {
+	modCache *modCache // the local module cache to enrich packages from (nil when disabled)
+}
+
+func newGoModParser(cfg Config) goModParser {
+	var parser goModParser
+	if cfg.SearchLocalModCache {
+		parser.modCache = newModCache(cfg.LocalModCacheDir)
+	}
+	return parser
+}
+
+// parse takes a go.mod and lists all packages discovered, filling in details from the local module cache when enabled.
+func (p goModParser) parse(path string, reader io.Reader) ([]*pkg.Package, []artifact.Relationship, error) {
+	packages, relationships, err := parseGoMod(path, reader)
+	if err != nil || p.modCache == nil {
+		return packages, relationships, err
+	}
+	for _, goPkg := range packages {
+		p.modCache.enrich(goPkg)
+	}
+	return packages, relationships, nil
+}
+
 // parseGoMod takes a go.mod and lists all packages discovered.
 func parseGoMod(path string, reader io.Reader) ([]*pkg.Package, []artifact.Relationship, error) {
 	packages := make(map[string]*pkg.Package)
@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/go-test/deep"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/anchore/syft/syft/pkg"
 )
@@ -105,3 +107,52 @@ func TestParseGoMod(t *testing.T) {
 		})
 	}
 }
+
+func TestGoModParser_LocalModCache(t *testing.T) {
+	f, err := os.Open("test-fixtures/mod-cache-packages")
+	require.NoError(t, err)
+	defer f.Close()
+
+	parser := newGoModParser(Config{
+		SearchLocalModCache: true,
+		LocalModCacheDir:    "test-fixtures/mod-cache",
+	})
+	actual, _, err := parser.parse("test-fixtures/mod-cache-packages", f)
+	require.NoError(t, err)
+
+	expected := []*pkg.Package{
+		{
+			// the module path is escaped in the cache ("!burnt!sushi") and only the license is cached
+			Name:     "github.com/BurntSushi/toml",
+			Version:  "v0.3.1",
+			Licenses: []string{"BSD-2-Clause"},
+			Language: pkg.Go,
+			Type:     pkg.GoModulePkg,
+		},
+		{
+			// local replacements are never looked up
+			Name:     "../stereoscope",
+			Language: pkg.Go,
+			Type:     pkg.GoModulePkg,
+		},
+		{
+			Name:         "github.com/bmatcuk/doublestar",
+			Version:      "v1.3.1",
+			Licenses:     []string{"MIT"},
+			Language:     pkg.Go,
+			Type:         pkg.GoModulePkg,
+			MetadataType: pkg.GolangModMetadataType,
+			Metadata: pkg.GolangModMetadata{
+				H1Digest: "h1:gPypJ5xD31uhX6Tf54sDPUOBXTqKH4c9aPY66CyQrS0=",
+			},
+		},
+		{
+			// not in the cache
+			Name:     "github.com/go-test/deep",
+			Version:  "v1.0.6",
+			Language: pkg.Go,
+			Type:     pkg.GoModulePkg,
+		},
+	}
+	assert.ElementsMatch(t, expected, actual)
+}
@@ -0,0 +1,29 @@
+package golang
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// parseGoSum returns the h1 hash of each module listed in a go.sum file, keyed by "path@version". Only the hashes of
+// the full module content are kept; the hashes of the go.mod files alone ("version/go.mod" entries) are skipped.
+func parseGoSum(reader io.Reader) (map[string]string, error) {
+	hashes := make(map[string]string)
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 3 {
+			continue
+		}
+		modPath, version, hash := fields[0], fields[1], fields[2]
+		if strings.HasSuffix(version, "/go.mod") || !strings.HasPrefix(hash, "h1:") {
+			continue
+		}
+		hashes[modPath+"@"+version] = hash
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return hashes, nil
+}
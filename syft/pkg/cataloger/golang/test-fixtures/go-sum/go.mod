@@ -0,0 +1,8 @@
+module github.com/anchore/syft-go-sum-fixture
+
+go 1.16
+
+require (
+	github.com/bmatcuk/doublestar/v4 v4.0.2
+	github.com/go-test/deep v1.0.8
+)
@@ -0,0 +1,44 @@
+package java
+
+import (
+	"io"
+
+	"github.com/anchore/syft/internal/file"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/common"
+)
+
+// archiveLimits captures the resource limits for processing a single archive found by the cataloger. The limits are
+// shared with (and consumed by) all archives nested within it.
+type archiveLimits struct {
+	depth    int // how deeply nested the current archive is (the archive found by the cataloger has a depth of 1)
+	maxDepth int
+	budget   *file.ReadBudget
+}
+
+func newArchiveLimits(cfg Config) archiveLimits {
+	return archiveLimits{
+		depth:    1,
+		maxDepth: cfg.MaxArchiveNestingDepth,
+		budget:   file.NewReadBudget(cfg.MaxArchiveFileSize, cfg.MaxArchiveDecompressedSize),
+	}
+}
+
+// nested returns the limits for an archive found within the current archive.
+func (l archiveLimits) nested() archiveLimits {
+	l.depth++
+	return l
+}
+
+// allowsNested indicates if archives found within the current archive may be processed.
+func (l archiveLimits) allowsNested() bool {
+	return l.maxDepth <= 0 || l.depth < l.maxDepth
+}
+
+// withArchiveLimits creates a parser function that processes each archive with a fresh set of limits from the given config.
+func withArchiveLimits(cfg Config, parser func(string, io.Reader, archiveLimits) ([]*pkg.Package, []artifact.Relationship, error)) common.ParserFn {
+	return func(virtualPath string, reader io.Reader) ([]*pkg.Package, []artifact.Relationship, error) {
+		return parser(virtualPath, reader, newArchiveLimits(cfg))
+	}
+}
@@ -0,0 +1,49 @@
+package java
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArchiveLimits_allowsNested(t *testing.T) {
+	tests := []struct {
+		name     string
+		maxDepth int
+		// the number of archives deep that are expected to be processed
+		expectedDepth int
+	}{
+		{
+			name:          "top-level archive only",
+			maxDepth:      1,
+			expectedDepth: 1,
+		},
+		{
+			name:          "one level of nesting",
+			maxDepth:      2,
+			expectedDepth: 2,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			limits := newArchiveLimits(Config{MaxArchiveNestingDepth: test.maxDepth})
+			for limits.allowsNested() {
+				limits = limits.nested()
+			}
+			assert.Equal(t, test.expectedDepth, limits.depth)
+		})
+	}
+}
+
+func TestArchiveLimits_unlimitedDepth(t *testing.T) {
+	limits := newArchiveLimits(Config{})
+	for i := 0; i < 100; i++ {
+		assert.True(t, limits.allowsNested())
+		limits = limits.nested()
+	}
+}
+
+func TestArchiveLimits_nestedSharesBudget(t *testing.T) {
+	limits := newArchiveLimits(Config{MaxArchiveDecompressedSize: 10})
+	assert.Same(t, limits.budget, limits.nested().budget)
+}
@@ -40,11 +40,18 @@ type archiveParser struct {
 	contentPath  string
 	fileInfo     archiveFilename
 	detectNested bool
+	limits       archiveLimits
 }
 
 // parseJavaArchive is a parser function for java archive contents, returning all Java libraries and nested archives.
 func parseJavaArchive(virtualPath string, reader io.Reader) ([]*pkg.Package, []artifact.Relationship, error) {
-	parser, cleanupFn, err := newJavaArchiveParser(virtualPath, reader, true)
+	return parseJavaArchiveWithLimits(virtualPath, reader, newArchiveLimits(Config{}))
+}
+
+// parseJavaArchiveWithLimits is a parser function for java archive contents that stops processing once the given
+// limits have been reached.
+func parseJavaArchiveWithLimits(virtualPath string, reader io.Reader, limits archiveLimits) ([]*pkg.Package, []artifact.Relationship, error) {
+	parser, cleanupFn, err := newJavaArchiveParser(virtualPath, reader, true, limits)
 	// note: even on error, we should always run cleanup functions
 	defer cleanupFn()
 	if err != nil {
@@ -63,7 +70,7 @@ func uniquePkgKey(p *pkg.Package) string {
 
 // newJavaArchiveParser returns a new java archive parser object for the given archive. Can be configured to discover
 // and parse nested archives or ignore them.
-func newJavaArchiveParser(virtualPath string, reader io.Reader, detectNested bool) (*archiveParser, func(), error) {
+func newJavaArchiveParser(virtualPath string, reader io.Reader, detectNested bool, limits archiveLimits) (*archiveParser, func(), error) {
 	// fetch the last element of the virtual path
 	virtualElements := strings.Split(virtualPath, ":")
 	currentFilepath := virtualElements[len(virtualElements)-1]
@@ -85,6 +92,7 @@ func newJavaArchiveParser(virtualPath string, reader io.Reader, detectNested boo
 		contentPath:  contentPath,
 		fileInfo:     newJavaArchiveFilename(currentFilepath),
 		detectNested: detectNested,
+		limits:       limits,
 	}, cleanupFn, nil
 }
 
@@ -136,7 +144,7 @@ func (j *archiveParser) discoverMainPackage() (*pkg.Package, error) {
 	}
 
 	// fetch the manifest file
-	contents, err := file.ContentsFromZip(j.archivePath, manifestMatches...)
+	contents, err := file.ContentsFromZip(j.archivePath, j.limits.budget, manifestMatches...)
 	if err != nil {
 		return nil, fmt.Errorf("unable to extract java manifests (%s): %w", j.virtualPath, err)
 	}
@@ -173,12 +181,12 @@ func (j *archiveParser) discoverPkgsFromAllMavenFiles(parentPkg *pkg.Package) ([
 
 	var pkgs []*pkg.Package
 
-	properties, err := pomPropertiesByParentPath(j.archivePath, j.fileManifest.GlobMatch(pomPropertiesGlob), j.virtualPath)
+	properties, err := pomPropertiesByParentPath(j.archivePath, j.limits.budget, j.fileManifest.GlobMatch(pomPropertiesGlob), j.virtualPath)
 	if err != nil {
 		return nil, err
 	}
 
-	projects, err := pomProjectByParentPath(j.archivePath, j.fileManifest.GlobMatch(pomXMLGlob), j.virtualPath)
+	projects, err := pomProjectByParentPath(j.archivePath, j.limits.budget, j.fileManifest.GlobMatch(pomXMLGlob), j.virtualPath)
 	if err != nil {
 		return nil, err
 	}
@@ -200,28 +208,34 @@ func (j *archiveParser) discoverPkgsFromAllMavenFiles(parentPkg *pkg.Package) ([
 
 func (j *archiveParser) discoverPkgsFromNestedArchives(parentPkg *pkg.Package) ([]*pkg.Package, []artifact.Relationship, error) {
 	// we know that all java archives are zip formatted files, so we can use the shared zip helper
-	return discoverPkgsFromZip(j.virtualPath, j.archivePath, j.contentPath, j.fileManifest, parentPkg)
+	return discoverPkgsFromZip(j.virtualPath, j.archivePath, j.contentPath, j.fileManifest, parentPkg, j.limits)
 }
 
 // discoverPkgsFromZip finds Java archives within Java archives, returning all listed Java packages found and
 // associating each discovered package to the given parent package.
-func discoverPkgsFromZip(virtualPath, archivePath, contentPath string, fileManifest file.ZipFileManifest, parentPkg *pkg.Package) ([]*pkg.Package, []artifact.Relationship, error) {
+func discoverPkgsFromZip(virtualPath, archivePath, contentPath string, fileManifest file.ZipFileManifest, parentPkg *pkg.Package, limits archiveLimits) ([]*pkg.Package, []artifact.Relationship, error) {
+	nestedArchives := fileManifest.GlobMatch(archiveFormatGlobs...)
+	if len(nestedArchives) > 0 && !limits.allowsNested() {
+		log.Warnf("skipping %d nested java archives within %q: max archive nesting depth (%d) reached", len(nestedArchives), virtualPath, limits.maxDepth)
+		return nil, nil, nil
+	}
+
 	// search and parse pom.properties files & fetch the contents
-	openers, err := file.ExtractFromZipToUniqueTempFile(archivePath, contentPath, fileManifest.GlobMatch(archiveFormatGlobs...)...)
+	openers, err := file.ExtractFromZipToUniqueTempFile(archivePath, contentPath, limits.budget, nestedArchives...)
 	if err != nil {
 		return nil, nil, fmt.Errorf("unable to extract files from zip: %w", err)
 	}
 
-	return discoverPkgsFromOpeners(virtualPath, openers, parentPkg)
+	return discoverPkgsFromOpeners(virtualPath, openers, parentPkg, limits)
 }
 
 // discoverPkgsFromOpeners finds Java archives within the given files and associates them with the given parent package.
-func discoverPkgsFromOpeners(virtualPath string, openers map[string]file.Opener, parentPkg *pkg.Package) ([]*pkg.Package, []artifact.Relationship, error) {
+func discoverPkgsFromOpeners(virtualPath string, openers map[string]file.Opener, parentPkg *pkg.Package, limits archiveLimits) ([]*pkg.Package, []artifact.Relationship, error) {
 	var pkgs []*pkg.Package
 	var relationships []artifact.Relationship
 
 	for pathWithinArchive, archiveOpener := range openers {
-		nestedPkgs, nestedRelationships, err := discoverPkgsFromOpener(virtualPath, pathWithinArchive, archiveOpener)
+		nestedPkgs, nestedRelationships, err := discoverPkgsFromOpener(virtualPath, pathWithinArchive, archiveOpener, limits.nested())
 		if err != nil {
 			log.Warnf("unable to discover java packages from opener (%s): %+v", virtualPath, err)
 			continue
@@ -245,7 +259,7 @@ func discoverPkgsFromOpeners(virtualPath string, openers map[string]file.Opener,
 }
 
 // discoverPkgsFromOpener finds Java archives within the given file.
-func discoverPkgsFromOpener(virtualPath, pathWithinArchive string, archiveOpener file.Opener) ([]*pkg.Package, []artifact.Relationship, error) {
+func discoverPkgsFromOpener(virtualPath, pathWithinArchive string, archiveOpener file.Opener, limits archiveLimits) ([]*pkg.Package, []artifact.Relationship, error) {
 	archiveReadCloser, err := archiveOpener.Open()
 	if err != nil {
 		return nil, nil, fmt.Errorf("unable to open archived file from tempdir: %w", err)
@@ -257,7 +271,7 @@ func discoverPkgsFromOpener(virtualPath, pathWithinArchive string, archiveOpener
 	}()
 
 	nestedPath := fmt.Sprintf("%s:%s", virtualPath, pathWithinArchive)
-	nestedPkgs, nestedRelationships, err := parseJavaArchive(nestedPath, archiveReadCloser)
+	nestedPkgs, nestedRelationships, err := parseJavaArchiveWithLimits(nestedPath, archiveReadCloser, limits)
 	if err != nil {
 		return nil, nil, fmt.Errorf("unable to process nested java archive (%s): %w", pathWithinArchive, err)
 	}
@@ -265,8 +279,8 @@ func discoverPkgsFromOpener(virtualPath, pathWithinArchive string, archiveOpener
 	return nestedPkgs, nestedRelationships, nil
 }
 
-func pomPropertiesByParentPath(archivePath string, extractPaths []string, virtualPath string) (map[string]pkg.PomProperties, error) {
-	contentsOfMavenPropertiesFiles, err := file.ContentsFromZip(archivePath, extractPaths...)
+func pomPropertiesByParentPath(archivePath string, budget *file.ReadBudget, extractPaths []string, virtualPath string) (map[string]pkg.PomProperties, error) {
+	contentsOfMavenPropertiesFiles, err := file.ContentsFromZip(archivePath, budget, extractPaths...)
 	if err != nil {
 		return nil, fmt.Errorf("unable to extract maven files: %w", err)
 	}
@@ -293,8 +307,8 @@ func pomPropertiesByParentPath(archivePath string, extractPaths []string, virtua
 	return propertiesByParentPath, nil
 }
 
-func pomProjectByParentPath(archivePath string, extractPaths []string, virtualPath string) (map[string]pkg.PomProject, error) {
-	contentsOfMavenProjectFiles, err := file.ContentsFromZip(archivePath, extractPaths...)
+func pomProjectByParentPath(archivePath string, budget *file.ReadBudget, extractPaths []string, virtualPath string) (map[string]pkg.PomProject, error) {
+	contentsOfMavenProjectFiles, err := file.ContentsFromZip(archivePath, budget, extractPaths...)
 	if err != nil {
 		return nil, fmt.Errorf("unable to extract maven files: %w", err)
 	}
@@ -346,6 +360,12 @@ func newPackageFromMavenData(pomProperties pkg.PomProperties, pomProject *pkg.Po
 		},
 	}
 
+	if pomProject != nil {
+		p.OriginURLs = pkg.OriginURLs{
+			Homepage: pomProject.URL,
+		}
+	}
+
 	if packageIdentitiesMatch(p, parentPkg) {
 		updatePackage(p, parentPkg)
 		return nil
@@ -387,6 +407,11 @@ func updatePackage(p pkg.Package, parentPkg *pkg.Package) {
 	// we may have learned more about the type via data in the pom properties
 	parentPkg.Type = p.Type
 
+	// the pom project may describe where the parent package comes from
+	if parentPkg.OriginURLs.IsEmpty() {
+		parentPkg.OriginURLs = p.OriginURLs
+	}
+
 	metadata, ok := p.Metadata.(pkg.JavaMetadata)
 	if !ok {
 		return
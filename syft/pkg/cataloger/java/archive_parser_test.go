@@ -220,6 +220,9 @@ func TestParseJar(t *testing.T) {
 							URL:         "http://www.joda.org/joda-time/",
 						},
 					},
+					OriginURLs: pkg.OriginURLs{
+						Homepage: "http://www.joda.org/joda-time/",
+					},
 				},
 			},
 		},
@@ -235,7 +238,7 @@ func TestParseJar(t *testing.T) {
 				t.Fatalf("failed to open fixture: %+v", err)
 			}
 
-			parser, cleanupFn, err := newJavaArchiveParser(fixture.Name(), fixture, false)
+			parser, cleanupFn, err := newJavaArchiveParser(fixture.Name(), fixture, false, newArchiveLimits(Config{}))
 			defer cleanupFn()
 			if err != nil {
 				t.Fatalf("should not have filed... %+v", err)
@@ -715,6 +718,9 @@ func Test_newPackageFromMavenData(t *testing.T) {
 						},
 					},
 				},
+				OriginURLs: pkg.OriginURLs{
+					Homepage: "aweso.me",
+				},
 			},
 		},
 		{
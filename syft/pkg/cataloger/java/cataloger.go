@@ -13,20 +13,20 @@ func NewJavaCataloger(cfg Config) *common.GenericCataloger {
 
 	// java archive formats
 	for _, pattern := range archiveFormatGlobs {
-		globParsers[pattern] = parseJavaArchive
+		globParsers[pattern] = withArchiveLimits(cfg, parseJavaArchiveWithLimits)
 	}
 
 	if cfg.SearchIndexedArchives {
 		// java archives wrapped within zip files
 		for _, pattern := range genericZipGlobs {
-			globParsers[pattern] = parseZipWrappedJavaArchive
+			globParsers[pattern] = withArchiveLimits(cfg, parseZipWrappedJavaArchiveWithLimits)
 		}
 	}
 
 	if cfg.SearchUnindexedArchives {
 		// java archives wrapped within tar files
 		for _, pattern := range genericTarGlobs {
-			globParsers[pattern] = parseTarWrappedJavaArchive
+			globParsers[pattern] = withArchiveLimits(cfg, parseTarWrappedJavaArchiveWithLimits)
 		}
 	}
 
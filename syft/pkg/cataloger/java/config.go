@@ -1,6 +1,10 @@
 package java
 
 type Config struct {
-	SearchUnindexedArchives bool
-	SearchIndexedArchives   bool
+	SearchUnindexedArchives    bool
+	SearchIndexedArchives      bool
+	MaxArchiveNestingDepth     int   // the max depth of archives within archives to process (0 = unlimited)
+	MaxArchiveDecompressedSize int64 // the max bytes to extract from an archive, including all nested archives (0 = unlimited)
+	MaxArchiveFileSize         int64 // the max bytes to extract for any single entry within an archive (0 = 2GB)
+	ResolveParentPoms          bool  // resolve pom.xml properties and managed versions from parent poms and imported BOMs within the source
 }
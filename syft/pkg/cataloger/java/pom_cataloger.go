@@ -0,0 +1,242 @@
+package java
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/source"
+)
+
+const pomDependenciesGlob = "**/pom.xml"
+
+// PomCataloger catalogs the dependencies declared by maven pom.xml files. Versions that are defined by a parent pom
+// (as a property or in the dependencyManagement section), or by an imported BOM, are resolved when that pom is also
+// within the source.
+type PomCataloger struct {
+	resolveParents bool
+}
+
+// pomFile is a pom.xml found within the source.
+type pomFile struct {
+	location source.Location
+	doc      *pomDocument
+}
+
+// pomIndex resolves the poms referenced by other poms (parents and BOM imports) among the poms within the source.
+type pomIndex struct {
+	byPath        map[string]*pomFile
+	byCoordinates map[string]*pomFile // "groupId:artifactId:version" -> pom
+}
+
+// NewJavaPomCataloger returns a new cataloger for the dependencies declared in maven pom.xml files.
+func NewJavaPomCataloger(cfg Config) *PomCataloger {
+	return &PomCataloger{
+		resolveParents: cfg.ResolveParentPoms,
+	}
+}
+
+// Name returns a string that uniquely describes a cataloger
+func (c *PomCataloger) Name() string {
+	return "java-pom-cataloger"
+}
+
+// Catalog returns the dependencies declared by all pom.xml files within the source.
+func (c *PomCataloger) Catalog(resolver source.FileResolver) ([]pkg.Package, []artifact.Relationship, error) {
+	locations, err := resolver.FilesByGlob(pomDependenciesGlob)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find pom.xml files by glob: %w", err)
+	}
+
+	index := pomIndex{
+		byPath:        make(map[string]*pomFile),
+		byCoordinates: make(map[string]*pomFile),
+	}
+	var poms []*pomFile
+	for _, location := range locations {
+		pom := c.parse(resolver, location)
+		if pom == nil {
+			continue
+		}
+		poms = append(poms, pom)
+		index.byPath[location.RealPath] = pom
+		index.byCoordinates[pom.coordinates()] = pom
+	}
+
+	var packages []pkg.Package
+	for _, pom := range poms {
+		packages = append(packages, c.dependencies(pom, index)...)
+	}
+	return packages, nil, nil
+}
+
+func (c *PomCataloger) parse(resolver source.FileResolver, location source.Location) *pomFile {
+	reader, err := resolver.FileContentsByLocation(location)
+	if err != nil {
+		log.Warnf("cataloger '%s' unable to fetch contents at location=%+v: %+v", c.Name(), location, err)
+		return nil
+	}
+	defer internal.CloseAndLogError(reader, location.VirtualPath)
+
+	doc, err := decodePomDocument(reader)
+	if err != nil {
+		log.Warnf("cataloger '%s' failed to parse entries at location=%+v: %+v", c.Name(), location, err)
+		return nil
+	}
+	return &pomFile{location: location, doc: doc}
+}
+
+// dependencies returns a package for each dependency declared by the given pom.
+func (c *PomCataloger) dependencies(pom *pomFile, index pomIndex) []pkg.Package {
+	lineage := []*pomFile{pom}
+	if c.resolveParents {
+		lineage = index.lineage(pom)
+	}
+	properties := effectiveProperties(lineage)
+
+	managed := c.managedVersions(lineage, properties, index, internal.NewStringSet())
+
+	var packages []pkg.Package
+	for _, dep := range pom.doc.Dependencies {
+		groupID := interpolate(dep.GroupID, properties)
+		artifactID := interpolate(dep.ArtifactID, properties)
+		if artifactID == "" {
+			continue
+		}
+
+		version := interpolate(dep.Version, properties)
+		if version == "" {
+			version = managed[groupID+":"+artifactID]
+		}
+
+		props := pkg.PomProperties{
+			Path:       pom.location.RealPath,
+			GroupID:    groupID,
+			ArtifactID: artifactID,
+			Version:    version,
+		}
+		p := pkg.Package{
+			Name:         artifactID,
+			Version:      version,
+			FoundBy:      c.Name(),
+			Locations:    []source.Location{pom.location},
+			Language:     pkg.Java,
+			Type:         props.PkgTypeIndicated(),
+			MetadataType: pkg.JavaMetadataType,
+			Metadata: pkg.JavaMetadata{
+				VirtualPath:   pom.location.RealPath,
+				PomProperties: &props,
+			},
+		}
+		p.SetID()
+		packages = append(packages, p)
+	}
+	return packages
+}
+
+// managedVersions returns the versions of the managed dependencies ("groupId:artifactId" -> version) of the project
+// described by the given lineage (as returned by pomIndex.lineage). Managed dependencies declared by a pom take
+// precedence over those of its parents, and declared ones take precedence over imported BOMs.
+func (c *PomCataloger) managedVersions(lineage []*pomFile, properties map[string]string, index pomIndex, visited internal.StringSet) map[string]string {
+	declared := make(map[string]string)
+	var imports []pomDependency
+	for _, pom := range lineage {
+		visited.Add(pom.location.RealPath)
+		for _, dep := range pom.doc.DependencyManagement {
+			if dep.isBOMImport() {
+				imports = append(imports, dep)
+				continue
+			}
+			declared[interpolate(dep.GroupID, properties)+":"+interpolate(dep.ArtifactID, properties)] = interpolate(dep.Version, properties)
+		}
+	}
+
+	if !c.resolveParents {
+		return declared
+	}
+
+	for _, dep := range imports {
+		bom, ok := index.byCoordinates[interpolate(dep.GroupID, properties)+":"+interpolate(dep.ArtifactID, properties)+":"+interpolate(dep.Version, properties)]
+		if !ok || visited.Contains(bom.location.RealPath) {
+			continue
+		}
+		bomLineage := index.lineage(bom)
+		for key, version := range c.managedVersions(bomLineage, effectiveProperties(bomLineage), index, visited) {
+			// the first import of a dependency wins
+			if _, exists := declared[key]; !exists {
+				declared[key] = version
+			}
+		}
+	}
+	return declared
+}
+
+// coordinates returns the "groupId:artifactId:version" of the project described by the pom.
+func (p *pomFile) coordinates() string {
+	own := p.doc.Properties
+	return interpolate(p.doc.effectiveGroupID(), own) + ":" + interpolate(p.doc.ArtifactID, own) + ":" + interpolate(p.doc.effectiveVersion(), own)
+}
+
+// parent returns the parent pom of the given pom when it is within the source: found by the relative path of the
+// parent (the parent directory by default) or else by its coordinates.
+func (i pomIndex) parent(pom *pomFile) *pomFile {
+	ref := pom.doc.Parent
+	if ref.ArtifactID == "" {
+		return nil
+	}
+
+	relativePath := "../pom.xml"
+	if ref.RelativePath != nil {
+		relativePath = strings.TrimSpace(*ref.RelativePath)
+	}
+	if relativePath != "" {
+		candidatePath := path.Join(path.Dir(pom.location.RealPath), relativePath)
+		if !strings.HasSuffix(candidatePath, ".xml") {
+			candidatePath = path.Join(candidatePath, "pom.xml")
+		}
+		if candidate, ok := i.byPath[candidatePath]; ok && candidate.doc.ArtifactID == ref.ArtifactID && candidate.doc.effectiveGroupID() == ref.GroupID {
+			return candidate
+		}
+	}
+
+	return i.byCoordinates[ref.GroupID+":"+ref.ArtifactID+":"+ref.Version]
+}
+
+// lineage returns the given pom along with all of its ancestors within the source, starting from the root ancestor.
+func (i pomIndex) lineage(pom *pomFile) []*pomFile {
+	lineage := []*pomFile{pom}
+	visited := internal.NewStringSet(pom.location.RealPath)
+	for current := i.parent(pom); current != nil; current = i.parent(current) {
+		if visited.Contains(current.location.RealPath) {
+			log.Debugf("cyclic pom parents found for pom=%q", pom.location.RealPath)
+			break
+		}
+		visited.Add(current.location.RealPath)
+		lineage = append([]*pomFile{current}, lineage...)
+	}
+	return lineage
+}
+
+// effectiveProperties returns the properties available to the last pom of the given lineage: the properties of all
+// poms (where a pom overrides the properties of its ancestors) and the built-in project properties.
+func effectiveProperties(lineage []*pomFile) map[string]string {
+	properties := make(map[string]string)
+	for _, pom := range lineage {
+		for k, v := range pom.doc.Properties {
+			properties[k] = v
+		}
+	}
+
+	doc := lineage[len(lineage)-1].doc
+	properties["project.groupId"] = doc.effectiveGroupID()
+	properties["project.artifactId"] = doc.ArtifactID
+	properties["project.version"] = doc.effectiveVersion()
+	properties["project.parent.groupId"] = doc.Parent.GroupID
+	properties["project.parent.artifactId"] = doc.Parent.ArtifactID
+	properties["project.parent.version"] = doc.Parent.Version
+	return properties
+}
@@ -0,0 +1,80 @@
+package java
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/source"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPomCataloger(t *testing.T) {
+	tests := []struct {
+		name             string
+		resolveParents   bool
+		expectedVersions map[string]string
+	}{
+		{
+			name:           "resolve parent poms and BOM imports",
+			resolveParents: true,
+			expectedVersions: map[string]string{
+				// managed by the parent, which takes precedence over the imported BOM
+				"com.fasterxml.jackson.core:jackson-databind": "2.13.0",
+				// managed by the BOM imported by the parent
+				"org.apache.commons:commons-text": "1.9",
+				// a parent property overridden by the child
+				"com.google.guava:guava": "31.0-jre",
+				// the group and version are inherited from the parent
+				"com.example:example-lib": "1.0.0",
+				// the property is not defined within the source
+				"org.example:external-lib": "${external.version}",
+			},
+		},
+		{
+			name:           "only use the pom itself",
+			resolveParents: false,
+			expectedVersions: map[string]string{
+				"com.fasterxml.jackson.core:jackson-databind": "",
+				"org.apache.commons:commons-text":             "",
+				"com.google.guava:guava":                      "31.0-jre",
+				"com.example:example-lib":                     "1.0.0",
+				"org.example:external-lib":                    "${external.version}",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			src, err := source.NewFromDirectory("test-fixtures/pom-project")
+			require.NoError(t, err)
+
+			resolver, err := src.FileResolver(source.SquashedScope)
+			require.NoError(t, err)
+
+			pkgs, _, err := NewJavaPomCataloger(Config{ResolveParentPoms: test.resolveParents}).Catalog(resolver)
+			require.NoError(t, err)
+
+			actualVersions := make(map[string]string)
+			for _, p := range pkgs {
+				metadata := p.Metadata.(pkg.JavaMetadata)
+				assert.Equal(t, "/app/pom.xml", metadata.VirtualPath)
+				assert.Equal(t, p.Version, metadata.PomProperties.Version)
+				actualVersions[metadata.PomProperties.GroupID+":"+p.Name] = p.Version
+			}
+			assert.Equal(t, test.expectedVersions, actualVersions)
+		})
+	}
+}
+
+func TestInterpolate(t *testing.T) {
+	properties := map[string]string{
+		"major":   "2",
+		"version": "${major}.13.0",
+		"self":    "${self}",
+	}
+
+	assert.Equal(t, "2.13.0", interpolate("${version}", properties))
+	assert.Equal(t, "2.13.0-${missing}", interpolate("${version}-${missing}", properties))
+	assert.Equal(t, "${self}", interpolate("${self}", properties))
+	assert.Equal(t, "1.0", interpolate(" 1.0 ", properties))
+}
@@ -0,0 +1,116 @@
+package java
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html/charset"
+)
+
+// maxInterpolationPasses bounds the resolution of properties that refer to other properties.
+const maxInterpolationPasses = 10
+
+var pomPropertyPattern = regexp.MustCompile(`\$\{([^}]+)\}`)
+
+// pomDocument is the subset of a pom.xml needed to catalog the declared dependencies of a maven project.
+type pomDocument struct {
+	GroupID              string          `xml:"groupId"`
+	ArtifactID           string          `xml:"artifactId"`
+	Version              string          `xml:"version"`
+	Parent               pomParentRef    `xml:"parent"`
+	Properties           pomProperties   `xml:"properties"`
+	DependencyManagement []pomDependency `xml:"dependencyManagement>dependencies>dependency"`
+	Dependencies         []pomDependency `xml:"dependencies>dependency"`
+}
+
+type pomParentRef struct {
+	GroupID      string  `xml:"groupId"`
+	ArtifactID   string  `xml:"artifactId"`
+	Version      string  `xml:"version"`
+	RelativePath *string `xml:"relativePath"` // nil when not given (defaults to "../pom.xml"), empty to disable the lookup by path
+}
+
+type pomDependency struct {
+	GroupID    string `xml:"groupId"`
+	ArtifactID string `xml:"artifactId"`
+	Version    string `xml:"version"`
+	Type       string `xml:"type"`
+	Scope      string `xml:"scope"`
+}
+
+// pomProperties are the user defined properties of a pom.xml (the arbitrary elements within <properties>).
+type pomProperties map[string]string
+
+func (p *pomProperties) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var properties struct {
+		Entries []struct {
+			XMLName xml.Name
+			Value   string `xml:",chardata"`
+		} `xml:",any"`
+	}
+	if err := d.DecodeElement(&properties, &start); err != nil {
+		return err
+	}
+
+	*p = make(pomProperties)
+	for _, entry := range properties.Entries {
+		(*p)[entry.XMLName.Local] = strings.TrimSpace(entry.Value)
+	}
+	return nil
+}
+
+func decodePomDocument(reader io.Reader) (*pomDocument, error) {
+	var doc pomDocument
+
+	decoder := xml.NewDecoder(reader)
+	// prevent against warnings for "xml: encoding "iso-8859-1" declared but Decoder.CharsetReader is nil"
+	decoder.CharsetReader = charset.NewReaderLabel
+
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal pom.xml: %w", err)
+	}
+	return &doc, nil
+}
+
+// effectiveGroupID returns the group ID of the project, which is inherited from the parent when not given.
+func (d pomDocument) effectiveGroupID() string {
+	if d.GroupID != "" {
+		return d.GroupID
+	}
+	return d.Parent.GroupID
+}
+
+// effectiveVersion returns the version of the project, which is inherited from the parent when not given.
+func (d pomDocument) effectiveVersion() string {
+	if d.Version != "" {
+		return d.Version
+	}
+	return d.Parent.Version
+}
+
+// isBOMImport indicates if the managed dependency imports the dependency management of another pom (a "bill of
+// materials").
+func (d pomDependency) isBOMImport() bool {
+	return d.Scope == "import" && d.Type == "pom"
+}
+
+// interpolate replaces the ${...} property references within the given value. References to unknown properties are
+// left in place.
+func interpolate(value string, properties map[string]string) string {
+	for i := 0; i < maxInterpolationPasses && strings.Contains(value, "${"); i++ {
+		replaced := pomPropertyPattern.ReplaceAllStringFunc(value, func(reference string) string {
+			if v, ok := properties[reference[2:len(reference)-1]]; ok {
+				return v
+			}
+			return reference
+		})
+		if replaced == value {
+			break
+		}
+		value = replaced
+	}
+	return strings.TrimSpace(value)
+}
@@ -5,6 +5,7 @@ import (
 	"io"
 
 	"github.com/anchore/syft/internal/file"
+	"github.com/anchore/syft/internal/log"
 
 	"github.com/anchore/syft/syft/artifact"
 	"github.com/anchore/syft/syft/pkg"
@@ -47,6 +48,12 @@ var genericTarGlobs = []string{
 // due to the fact that there is no central directory header (say as in zip), which means that in order to get
 // a file listing within the archive you must decompress the entire archive and seek through all of the entries.
 func parseTarWrappedJavaArchive(virtualPath string, reader io.Reader) ([]*pkg.Package, []artifact.Relationship, error) {
+	return parseTarWrappedJavaArchiveWithLimits(virtualPath, reader, newArchiveLimits(Config{}))
+}
+
+// parseTarWrappedJavaArchiveWithLimits is a parser function for java archive contents contained within arbitrary tar
+// files that stops processing once the given limits have been reached.
+func parseTarWrappedJavaArchiveWithLimits(virtualPath string, reader io.Reader, limits archiveLimits) ([]*pkg.Package, []artifact.Relationship, error) {
 	contentPath, archivePath, cleanupFn, err := saveArchiveToTmp(virtualPath, reader)
 	// note: even on error, we should always run cleanup functions
 	defer cleanupFn()
@@ -55,14 +62,19 @@ func parseTarWrappedJavaArchive(virtualPath string, reader io.Reader) ([]*pkg.Pa
 	}
 
 	// look for java archives within the tar archive
-	return discoverPkgsFromTar(virtualPath, archivePath, contentPath)
+	return discoverPkgsFromTar(virtualPath, archivePath, contentPath, limits)
 }
 
-func discoverPkgsFromTar(virtualPath, archivePath, contentPath string) ([]*pkg.Package, []artifact.Relationship, error) {
-	openers, err := file.ExtractGlobsFromTarToUniqueTempFile(archivePath, contentPath, archiveFormatGlobs...)
+func discoverPkgsFromTar(virtualPath, archivePath, contentPath string, limits archiveLimits) ([]*pkg.Package, []artifact.Relationship, error) {
+	if !limits.allowsNested() {
+		log.Warnf("skipping java archives within %q: max archive nesting depth (%d) reached", virtualPath, limits.maxDepth)
+		return nil, nil, nil
+	}
+
+	openers, err := file.ExtractGlobsFromTarToUniqueTempFile(archivePath, contentPath, limits.budget, archiveFormatGlobs...)
 	if err != nil {
 		return nil, nil, fmt.Errorf("unable to extract files from tar: %w", err)
 	}
 
-	return discoverPkgsFromOpeners(virtualPath, openers, nil)
+	return discoverPkgsFromOpeners(virtualPath, openers, nil, limits)
 }
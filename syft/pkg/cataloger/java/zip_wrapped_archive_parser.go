@@ -22,6 +22,12 @@ var genericZipGlobs = []string{
 
 // parseZipWrappedJavaArchive is a parser function for java archive contents contained within arbitrary zip files.
 func parseZipWrappedJavaArchive(virtualPath string, reader io.Reader) ([]*pkg.Package, []artifact.Relationship, error) {
+	return parseZipWrappedJavaArchiveWithLimits(virtualPath, reader, newArchiveLimits(Config{}))
+}
+
+// parseZipWrappedJavaArchiveWithLimits is a parser function for java archive contents contained within arbitrary zip
+// files that stops processing once the given limits have been reached.
+func parseZipWrappedJavaArchiveWithLimits(virtualPath string, reader io.Reader, limits archiveLimits) ([]*pkg.Package, []artifact.Relationship, error) {
 	contentPath, archivePath, cleanupFn, err := saveArchiveToTmp(virtualPath, reader)
 	// note: even on error, we should always run cleanup functions
 	defer cleanupFn()
@@ -39,5 +45,5 @@ func parseZipWrappedJavaArchive(virtualPath string, reader io.Reader) ([]*pkg.Pa
 	}
 
 	// look for java archives within the zip archive
-	return discoverPkgsFromZip(virtualPath, archivePath, contentPath, fileManifest, nil)
+	return discoverPkgsFromZip(virtualPath, archivePath, contentPath, fileManifest, nil, limits)
 }
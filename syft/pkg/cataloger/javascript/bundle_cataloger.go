@@ -0,0 +1,76 @@
+package javascript
+
+import (
+	"fmt"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/common"
+	"github.com/anchore/syft/syft/source"
+)
+
+const (
+	bundleCatalogerName = "javascript-bundle-cataloger"
+	asarGlob            = "**/*.asar"
+)
+
+type BundleCataloger struct{}
+
+// NewJavascriptBundleCataloger returns a new cataloger for npm packages bundled within Electron app archives (app.asar)
+// and within Node.js applications compiled to a single executable (with pkg or nexe).
+func NewJavascriptBundleCataloger() *BundleCataloger {
+	return &BundleCataloger{}
+}
+
+// Name returns a string that uniquely describes a cataloger
+func (c *BundleCataloger) Name() string {
+	return bundleCatalogerName
+}
+
+// Catalog is given an object to resolve file references and content, this function returns any discovered Packages after unpacking the embedded filesystems of Electron archives and Node.js single executables.
+func (c *BundleCataloger) Catalog(resolver source.FileResolver) ([]pkg.Package, []artifact.Relationship, error) {
+	asarLocations, err := resolver.FilesByGlob(asarGlob)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find files by glob: %s", asarGlob)
+	}
+
+	binLocations, err := resolver.FilesByMIMEType(internal.ExecutableMIMETypeSet.List()...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find bin by mime types: %w", err)
+	}
+
+	var pkgs []pkg.Package
+	for _, entry := range []struct {
+		locations []source.Location
+		parser    common.ParserFn
+	}{
+		{asarLocations, parseAsar},
+		{binLocations, parseNodeBinary},
+	} {
+		for _, location := range entry.locations {
+			reader, err := resolver.FileContentsByLocation(location)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to resolve file contents by location=%q: %w", location.RealPath, err)
+			}
+
+			discoveredPkgs, _, err := entry.parser(location.RealPath, reader)
+			internal.CloseAndLogError(reader, location.RealPath)
+			if err != nil {
+				log.Warnf("could not unpack possible javascript bundle at %q: %+v", location.RealPath, err)
+				continue
+			}
+
+			for _, p := range discoveredPkgs {
+				p.FoundBy = c.Name()
+				p.Locations = append(p.Locations, location)
+				p.SetID()
+
+				pkgs = append(pkgs, *p)
+			}
+		}
+	}
+
+	return pkgs, nil, nil
+}
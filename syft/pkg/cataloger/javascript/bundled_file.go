@@ -0,0 +1,71 @@
+package javascript
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"path"
+	"regexp"
+	"sort"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// maxBundledPackageJSONSize guards against corrupt embedded filesystem indexes claiming huge package.json files.
+const maxBundledPackageJSONSize = 10 * 1024 * 1024
+
+// nodeModulePackageJSONPattern matches the package.json at the root of an installed (possibly scoped) npm module.
+var nodeModulePackageJSONPattern = regexp.MustCompile(`(^|/)node_modules/(@[^/]+/)?[^/]+/package\.json$`)
+
+// bundledFile is a file within the embedded filesystem of an Electron archive or Node.js single executable.
+type bundledFile struct {
+	path   string
+	offset int64
+	size   int64
+}
+
+// selectBundledPackageJSONFiles returns the package.json files describing either the bundled application itself or
+// an installed npm module (ignoring package.json files nested elsewhere within a module, such as test fixtures),
+// ordered by their offset within the bundle.
+func selectBundledPackageJSONFiles(files []bundledFile) []bundledFile {
+	var selected []bundledFile
+	for _, f := range files {
+		if path.Base(f.path) != "package.json" || f.size > maxBundledPackageJSONSize {
+			continue
+		}
+		if pathContainsNodeModulesDirectory(f.path) && !nodeModulePackageJSONPattern.MatchString(f.path) {
+			continue
+		}
+		selected = append(selected, f)
+	}
+
+	sort.Slice(selected, func(i, j int) bool {
+		return selected[i].offset < selected[j].offset
+	})
+	return selected
+}
+
+// packagesFromBundledPackageJSON returns the packages described by the given package.json contents from a bundle.
+func packagesFromBundledPackageJSON(f bundledFile, contents []byte) []*pkg.Package {
+	// some bundlers optionally compress file contents
+	if bytes.HasPrefix(contents, []byte{0x1f, 0x8b}) {
+		gz, err := gzip.NewReader(bytes.NewReader(contents))
+		if err != nil {
+			log.Warnf("unable to decompress bundled package.json (path=%q): %+v", f.path, err)
+			return nil
+		}
+		contents, err = ioutil.ReadAll(gz)
+		if err != nil {
+			log.Warnf("unable to decompress bundled package.json (path=%q): %+v", f.path, err)
+			return nil
+		}
+	}
+
+	pkgs, _, err := parsePackageJSON(f.path, bytes.NewReader(contents))
+	if err != nil {
+		log.Warnf("unable to parse bundled package.json (path=%q): %+v", f.path, err)
+		return nil
+	}
+	return pkgs
+}
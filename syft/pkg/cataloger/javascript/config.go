@@ -0,0 +1,5 @@
+package javascript
+
+type Config struct {
+	SearchSourceMaps bool // recover the npm packages compiled into bundles from their source maps and license banners
+}
@@ -0,0 +1,30 @@
+package javascript
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+
+	"github.com/anchore/syft/syft/file"
+)
+
+// parseIntegrity converts a subresource integrity value (e.g. "sha512-<base64 digest>", as found in the "integrity"
+// field of package-lock.json) into digests. The value may hold several space-separated digests, and any that cannot
+// be decoded are ignored.
+func parseIntegrity(integrity string) (digests []file.Digest) {
+	for _, field := range strings.Fields(integrity) {
+		fields := strings.SplitN(field, "-", 2)
+		if len(fields) != 2 {
+			continue
+		}
+		value, err := base64.StdEncoding.DecodeString(fields[1])
+		if err != nil {
+			continue
+		}
+		digests = append(digests, file.Digest{
+			Algorithm: file.CleanDigestAlgorithmName(fields[0]),
+			Value:     hex.EncodeToString(value),
+		})
+	}
+	return digests
+}
@@ -0,0 +1,47 @@
+package javascript
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseIntegrity(t *testing.T) {
+	tests := []struct {
+		name      string
+		integrity string
+		expected  []file.Digest
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:      "sha1",
+			integrity: "sha1-7QMXwyIGT3lGbAKWa922Bas32Zg=",
+			expected: []file.Digest{
+				{Algorithm: "sha1", Value: "ed0317c322064f79466c02966bddb605ab37d998"},
+			},
+		},
+		{
+			name:      "multiple digests",
+			integrity: "sha512-rdg5k5PsHFVJheO/pmE3aDg2rUDDTfPJau6yYkZYlHFktUz+UxbE+IgnUAEyyCyv4noL5ltxXD0gZzmHPCy/9g== sha1-7QMXwyIGT3lGbAKWa922Bas32Zg=",
+			expected: []file.Digest{
+				{Algorithm: "sha512", Value: "add8399393ec1c554985e3bfa66137683836ad40c34df3c96aeeb2624658947164b54cfe5316c4f88827500132c82cafe27a0be65b715c3d206739873c2cbff6"},
+				{Algorithm: "sha1", Value: "ed0317c322064f79466c02966bddb605ab37d998"},
+			},
+		},
+		{
+			name:      "invalid values are ignored",
+			integrity: "sha1 sha1-!!! sha1-7QMXwyIGT3lGbAKWa922Bas32Zg=",
+			expected: []file.Digest{
+				{Algorithm: "sha1", Value: "ed0317c322064f79466c02966bddb605ab37d998"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, parseIntegrity(test.integrity))
+		})
+	}
+}
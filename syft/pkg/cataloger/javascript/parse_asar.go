@@ -0,0 +1,102 @@
+package javascript
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"strconv"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/common"
+)
+
+// integrity check
+var _ common.ParserFn = parseAsar
+
+// asarEntry is a node within the JSON header of an asar archive, describing either a directory (files) or a file
+// (offset and size relative to the end of the header).
+type asarEntry struct {
+	Files    map[string]asarEntry `json:"files"`
+	Offset   string               `json:"offset"`
+	Size     int64                `json:"size"`
+	Unpacked bool                 `json:"unpacked"`
+	Link     string               `json:"link"`
+}
+
+// parseAsar is a parser function for Electron asar archives (e.g. resources/app.asar), returning the application and
+// all npm modules packed within. The archive starts with a chromium pickle holding the size of a JSON header, which is
+// followed by the contents of all files.
+func parseAsar(_ string, reader io.Reader) ([]*pkg.Package, []artifact.Relationship, error) {
+	// [pickle payload size (always 4), header pickle size, header payload size, header JSON length]
+	var sizes [4]uint32
+	if err := binary.Read(reader, binary.LittleEndian, &sizes); err != nil {
+		return nil, nil, fmt.Errorf("unable to read asar header size: %w", err)
+	}
+	if sizes[0] != 4 || sizes[3] > sizes[1] {
+		return nil, nil, fmt.Errorf("not an asar archive")
+	}
+
+	headerJSON := make([]byte, sizes[3])
+	if _, err := io.ReadFull(reader, headerJSON); err != nil {
+		return nil, nil, fmt.Errorf("unable to read asar header: %w", err)
+	}
+
+	var root asarEntry
+	if err := json.Unmarshal(headerJSON, &root); err != nil {
+		return nil, nil, fmt.Errorf("unable to parse asar header: %w", err)
+	}
+
+	// file contents begin after the pickled header (which is padded to a 4 byte boundary)
+	pos := int64(16 + sizes[3])
+	dataStart := int64(8 + sizes[1])
+
+	var pkgs []*pkg.Package
+	for _, f := range selectBundledPackageJSONFiles(asarFiles("", root)) {
+		// files are read in offset order, so the archive can be streamed rather than held in memory
+		if skip := dataStart + f.offset - pos; skip > 0 {
+			if _, err := io.CopyN(ioutil.Discard, reader, skip); err != nil {
+				return nil, nil, fmt.Errorf("unable to seek to asar entry=%q: %w", f.path, err)
+			}
+			pos += skip
+		} else if skip < 0 {
+			// overlapping entries can only come from a malformed header
+			continue
+		}
+
+		contents := make([]byte, f.size)
+		if _, err := io.ReadFull(reader, contents); err != nil {
+			return nil, nil, fmt.Errorf("unable to read asar entry=%q: %w", f.path, err)
+		}
+		pos += f.size
+
+		pkgs = append(pkgs, packagesFromBundledPackageJSON(f, contents)...)
+	}
+
+	return pkgs, nil, nil
+}
+
+// asarFiles flattens the given asar header node into the files packed within the archive. Unpacked files live next to
+// the archive (in app.asar.unpacked/) and are left for the regular package catalogers.
+func asarFiles(dir string, entry asarEntry) []bundledFile {
+	var files []bundledFile
+	for name, child := range entry.Files {
+		p := path.Join(dir, name)
+		switch {
+		case child.Files != nil:
+			files = append(files, asarFiles(p, child)...)
+		case child.Unpacked || child.Link != "":
+			continue
+		default:
+			offset, err := strconv.ParseInt(child.Offset, 10, 64)
+			if err != nil || offset < 0 || child.Size < 0 {
+				continue
+			}
+			files = append(files, bundledFile{path: p, offset: offset, size: child.Size})
+		}
+	}
+	return files
+}
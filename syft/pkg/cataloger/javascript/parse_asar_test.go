@@ -0,0 +1,103 @@
+package javascript
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/go-test/deep"
+	"github.com/stretchr/testify/require"
+)
+
+type bundleFixtureFile struct {
+	path     string
+	contents string
+}
+
+// bundleFixtureFiles is the embedded filesystem shared by the asar, pkg, and nexe fixtures.
+var bundleFixtureFiles = []bundleFixtureFile{
+	{"package.json", `{"name": "my-electron-app", "version": "1.0.0", "license": "MIT"}`},
+	{"main.js", `require("left-pad")`},
+	{"node_modules/left-pad/package.json", `{"name": "left-pad", "version": "1.3.0", "license": "WTFPL"}`},
+	{"node_modules/left-pad/test/fixtures/package.json", `{"name": "not-a-module", "version": "0.0.1"}`},
+	{"node_modules/@scope/util/package.json", `{"name": "@scope/util", "version": "2.1.0"}`},
+}
+
+func expectedBundlePackages() []*pkg.Package {
+	newPkg := func(name, version string, licenses []string) *pkg.Package {
+		return &pkg.Package{
+			Name:         name,
+			Version:      version,
+			Type:         pkg.NpmPkg,
+			Licenses:     licenses,
+			Language:     pkg.JavaScript,
+			MetadataType: pkg.NpmPackageJSONMetadataType,
+			Metadata: pkg.NpmPackageJSONMetadata{
+				Licenses: licenses,
+			},
+		}
+	}
+	return []*pkg.Package{
+		newPkg("my-electron-app", "1.0.0", []string{"MIT"}),
+		newPkg("left-pad", "1.3.0", []string{"WTFPL"}),
+		newPkg("@scope/util", "2.1.0", []string{}),
+	}
+}
+
+// writeAsarFixture lays out the given files as an asar archive: a pickled JSON header followed by the file contents.
+func writeAsarFixture(t *testing.T, files []bundleFixtureFile) []byte {
+	t.Helper()
+
+	root := map[string]interface{}{"files": map[string]interface{}{}}
+	var data bytes.Buffer
+	for _, f := range files {
+		dir := root
+		parts := strings.Split(f.path, "/")
+		for _, part := range parts[:len(parts)-1] {
+			children := dir["files"].(map[string]interface{})
+			if _, ok := children[part]; !ok {
+				children[part] = map[string]interface{}{"files": map[string]interface{}{}}
+			}
+			dir = children[part].(map[string]interface{})
+		}
+		dir["files"].(map[string]interface{})[parts[len(parts)-1]] = map[string]interface{}{
+			"offset": strconv.Itoa(data.Len()),
+			"size":   len(f.contents),
+		}
+		data.WriteString(f.contents)
+	}
+
+	header, err := json.Marshal(root)
+	require.NoError(t, err)
+	padding := (4 - len(header)%4) % 4
+	headerPayloadSize := 4 + len(header) + padding
+
+	var buf bytes.Buffer
+	for _, size := range []int{4, 4 + headerPayloadSize, headerPayloadSize, len(header)} {
+		require.NoError(t, binary.Write(&buf, binary.LittleEndian, uint32(size)))
+	}
+	buf.Write(header)
+	buf.Write(make([]byte, padding))
+	buf.Write(data.Bytes())
+	return buf.Bytes()
+}
+
+func TestParseAsar(t *testing.T) {
+	fixture := writeAsarFixture(t, bundleFixtureFiles)
+
+	actual, _, err := parseAsar("resources/app.asar", bytes.NewReader(fixture))
+	require.NoError(t, err)
+
+	for _, d := range deep.Equal(expectedBundlePackages(), actual) {
+		t.Errorf("diff: %+v", d)
+	}
+}
+
+func TestParseAsar_NotAnArchive(t *testing.T) {
+	_, _, err := parseAsar("resources/app.asar", strings.NewReader("definitely not an asar archive"))
+	require.Error(t, err)
+}
@@ -0,0 +1,149 @@
+package javascript
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/common"
+)
+
+// integrity check
+var _ common.ParserFn = parseNodeBinary
+
+// pkg (https://github.com/vercel/pkg) replaces placeholders within the node bootstrap with the location of the payload
+// and appends a virtual filesystem index (keyed by /snapshot/ paths) after the prelude.
+var (
+	pkgPayloadPositionPattern = regexp.MustCompile(`PAYLOAD_POSITION\s*=\s*'\s*(\d+)\s*'`)
+	pkgSnapshotIndexMarker    = []byte(`{"/snapshot/`)
+)
+
+// pkgStoreContent is the virtual filesystem store holding raw file contents (as opposed to blobs, links, and stats).
+const pkgStoreContent = "1"
+
+// nexe (https://github.com/nexe/nexe) appends the application script and resources to the node binary, followed by a
+// sentinel and the sizes of both sections (as little-endian doubles). The script declares the resource index.
+var (
+	nexeSentinel              = []byte("<nexe~~sentinel>")
+	nexeResourceHeaderPattern = regexp.MustCompile(`process\.__nexe\s*=\s*`)
+)
+
+// parseNodeBinary is a parser function for Node.js applications compiled into a single executable with pkg or nexe,
+// returning the application and all npm modules embedded within. Executables that are not Node.js single
+// executables yield no packages.
+func parseNodeBinary(_ string, reader io.Reader) ([]*pkg.Package, []artifact.Relationship, error) {
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to read executable: %w", err)
+	}
+
+	files, err := nexeBundledFiles(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	if files == nil {
+		files, err = pkgBundledFiles(data)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var pkgs []*pkg.Package
+	for _, f := range selectBundledPackageJSONFiles(files) {
+		if f.offset < 0 || f.offset+f.size > int64(len(data)) {
+			continue
+		}
+		pkgs = append(pkgs, packagesFromBundledPackageJSON(f, data[f.offset:f.offset+f.size])...)
+	}
+	return pkgs, nil, nil
+}
+
+// nexeBundledFiles returns the resources embedded by nexe (with offsets relative to the start of the executable), or
+// nil if the executable was not built by nexe.
+func nexeBundledFiles(data []byte) ([]bundledFile, error) {
+	trailerSize := len(nexeSentinel) + 16
+	if len(data) < trailerSize || !bytes.Equal(data[len(data)-trailerSize:len(data)-16], nexeSentinel) {
+		return nil, nil
+	}
+
+	contentSize := math.Float64frombits(binary.LittleEndian.Uint64(data[len(data)-16:]))
+	resourceSize := math.Float64frombits(binary.LittleEndian.Uint64(data[len(data)-8:]))
+	resourceStart := int64(len(data)-trailerSize) - int64(resourceSize)
+	contentStart := resourceStart - int64(contentSize)
+	if contentSize < 0 || resourceSize < 0 || contentStart < 0 {
+		return nil, fmt.Errorf("invalid nexe trailer")
+	}
+
+	script := data[contentStart:resourceStart]
+	loc := nexeResourceHeaderPattern.FindIndex(script)
+	if loc == nil {
+		return nil, fmt.Errorf("unable to find nexe resource index")
+	}
+
+	var header struct {
+		Resources map[string][2]int64 `json:"resources"`
+	}
+	if err := json.NewDecoder(bytes.NewReader(script[loc[1]:])).Decode(&header); err != nil {
+		return nil, fmt.Errorf("unable to parse nexe resource index: %w", err)
+	}
+
+	files := []bundledFile{}
+	for p, r := range header.Resources {
+		files = append(files, bundledFile{
+			path:   strings.TrimPrefix(p, "./"),
+			offset: resourceStart + r[0],
+			size:   r[1],
+		})
+	}
+	return files, nil
+}
+
+// pkgBundledFiles returns the files embedded by pkg (with offsets relative to the start of the executable), or nil if
+// the executable was not built by pkg.
+func pkgBundledFiles(data []byte) ([]bundledFile, error) {
+	match := pkgPayloadPositionPattern.FindSubmatch(data)
+	if match == nil {
+		return nil, nil
+	}
+	indexStart := bytes.Index(data, pkgSnapshotIndexMarker)
+	if indexStart < 0 {
+		return nil, nil
+	}
+
+	payloadPosition, err := strconv.ParseInt(string(match[1]), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pkg payload position: %w", err)
+	}
+
+	var index map[string]map[string]json.RawMessage
+	if err := json.NewDecoder(bytes.NewReader(data[indexStart:])).Decode(&index); err != nil {
+		return nil, fmt.Errorf("unable to parse pkg virtual filesystem index: %w", err)
+	}
+
+	files := []bundledFile{}
+	for p, stores := range index {
+		raw, ok := stores[pkgStoreContent]
+		if !ok {
+			continue
+		}
+		var r [2]int64
+		if err := json.Unmarshal(raw, &r); err != nil {
+			continue
+		}
+		files = append(files, bundledFile{
+			path:   strings.TrimPrefix(p, "/snapshot/"),
+			offset: payloadPosition + r[0],
+			size:   r[1],
+		})
+	}
+	return files, nil
+}
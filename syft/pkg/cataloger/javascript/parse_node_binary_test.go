@@ -0,0 +1,106 @@
+package javascript
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/go-test/deep"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeNodeExecutable stands in for the node runtime that pkg and nexe append applications to.
+var fakeNodeExecutable = []byte("\x7fELF\x02\x01\x01\x00 node runtime ")
+
+// writePkgFixture lays out the given files as pkg does: the runtime (with the payload position placeholder replaced),
+// the payload, then the prelude and its virtual filesystem index.
+func writePkgFixture(t *testing.T, files []bundleFixtureFile) []byte {
+	t.Helper()
+
+	var payload bytes.Buffer
+	index := map[string]map[string][2]int{}
+	for _, f := range files {
+		index["/snapshot/app/"+f.path] = map[string][2]int{
+			pkgStoreContent: {payload.Len(), len(f.contents)},
+			"3":             {0, 0},
+		}
+		payload.WriteString(f.contents)
+	}
+	indexJSON, err := json.Marshal(index)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	buf.Write(fakeNodeExecutable)
+	// the placeholder is padded to keep the executable size stable, so the position is computed up front
+	bootstrap := "const PAYLOAD_POSITION = '%-22d' | 0;\n"
+	position := buf.Len() + len(fmt.Sprintf(bootstrap, 0))
+	buf.WriteString(fmt.Sprintf(bootstrap, position))
+	buf.Write(payload.Bytes())
+	buf.WriteString("(function(process, require) { /* prelude */ })\n//# sourceMappingURL=common.js.map\n, ")
+	buf.Write(indexJSON)
+	buf.WriteString(`, "/snapshot/app/main.js", {}, {}, 0`)
+	return buf.Bytes()
+}
+
+// writeNexeFixture lays out the given files as nexe does: the runtime, the application script (which declares the
+// resource index), the resources, then the sentinel and the sizes of the script and resources.
+func writeNexeFixture(t *testing.T, files []bundleFixtureFile) []byte {
+	t.Helper()
+
+	var resources bytes.Buffer
+	index := map[string][2]int{}
+	for _, f := range files {
+		index["./"+f.path] = [2]int{resources.Len(), len(f.contents)}
+		resources.WriteString(f.contents)
+	}
+	indexJSON, err := json.Marshal(map[string]interface{}{"resources": index})
+	require.NoError(t, err)
+
+	script := fmt.Sprintf("!(function () {process.__nexe = %s;\n})();\nrequire('./main.js')", indexJSON)
+
+	var buf bytes.Buffer
+	buf.Write(fakeNodeExecutable)
+	buf.WriteString(script)
+	buf.Write(resources.Bytes())
+	buf.Write(nexeSentinel)
+	require.NoError(t, binary.Write(&buf, binary.LittleEndian, math.Float64bits(float64(len(script)))))
+	require.NoError(t, binary.Write(&buf, binary.LittleEndian, math.Float64bits(float64(resources.Len()))))
+	return buf.Bytes()
+}
+
+func TestParseNodeBinary(t *testing.T) {
+	tests := []struct {
+		name    string
+		fixture []byte
+	}{
+		{
+			name:    "pkg",
+			fixture: writePkgFixture(t, bundleFixtureFiles),
+		},
+		{
+			name:    "nexe",
+			fixture: writeNexeFixture(t, bundleFixtureFiles),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, _, err := parseNodeBinary("usr/local/bin/app", bytes.NewReader(test.fixture))
+			require.NoError(t, err)
+
+			for _, d := range deep.Equal(expectedBundlePackages(), actual) {
+				t.Errorf("diff: %+v", d)
+			}
+		})
+	}
+}
+
+func TestParseNodeBinary_PlainExecutable(t *testing.T) {
+	actual, _, err := parseNodeBinary("usr/bin/node", bytes.NewReader(fakeNodeExecutable))
+	require.NoError(t, err)
+	assert.Empty(t, actual)
+}
@@ -204,6 +204,10 @@ func newPackageJSONPackage(p PackageJSON) *pkg.Package {
 			URL:      p.Repository.URL,
 			Licenses: licenses,
 		},
+		OriginURLs: pkg.OriginURLs{
+			Homepage:   p.Homepage,
+			Repository: p.Repository.URL,
+		},
 	}
 }
 
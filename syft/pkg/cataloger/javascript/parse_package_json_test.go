@@ -29,6 +29,10 @@ func TestParsePackageJSON(t *testing.T) {
 					URL:      "https://github.com/npm/cli",
 					Licenses: []string{"Artistic-2.0"},
 				},
+				OriginURLs: pkg.OriginURLs{
+					Homepage:   "https://docs.npmjs.com/",
+					Repository: "https://github.com/npm/cli",
+				},
 			},
 		},
 		{
@@ -46,6 +50,10 @@ func TestParsePackageJSON(t *testing.T) {
 					URL:      "https://github.com/npm/cli",
 					Licenses: []string{"ISC"},
 				},
+				OriginURLs: pkg.OriginURLs{
+					Homepage:   "https://docs.npmjs.com/",
+					Repository: "https://github.com/npm/cli",
+				},
 			},
 		},
 		{
@@ -63,6 +71,10 @@ func TestParsePackageJSON(t *testing.T) {
 					URL:      "https://github.com/npm/cli",
 					Licenses: []string{"MIT", "Apache-2.0"},
 				},
+				OriginURLs: pkg.OriginURLs{
+					Homepage:   "https://docs.npmjs.com/",
+					Repository: "https://github.com/npm/cli",
+				},
 			},
 		},
 		{
@@ -80,6 +92,10 @@ func TestParsePackageJSON(t *testing.T) {
 					URL:      "https://github.com/npm/cli",
 					Licenses: []string{},
 				},
+				OriginURLs: pkg.OriginURLs{
+					Homepage:   "https://docs.npmjs.com/",
+					Repository: "https://github.com/npm/cli",
+				},
 			},
 		},
 		{
@@ -97,6 +113,10 @@ func TestParsePackageJSON(t *testing.T) {
 					URL:      "https://github.com/npm/cli",
 					Licenses: []string{"Artistic-2.0"},
 				},
+				OriginURLs: pkg.OriginURLs{
+					Homepage:   "https://docs.npmjs.com/",
+					Repository: "https://github.com/npm/cli",
+				},
 			},
 		},
 		{
@@ -114,6 +134,10 @@ func TestParsePackageJSON(t *testing.T) {
 					URL:      "git://github.com/Raynos/function-bind.git",
 					Licenses: []string{"MIT"},
 				},
+				OriginURLs: pkg.OriginURLs{
+					Homepage:   "https://github.com/Raynos/function-bind",
+					Repository: "git://github.com/Raynos/function-bind.git",
+				},
 			},
 		},
 	}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"strconv"
 
 	"github.com/anchore/syft/syft/artifact"
 	"github.com/anchore/syft/syft/pkg"
@@ -13,6 +14,10 @@ import (
 // integrity check
 var _ common.ParserFn = parsePackageLock
 
+// maxPackageLockVersion is the newest lockfileVersion understood by the parser. Version 3 lockfiles only describe
+// packages within the "packages" section, which is not parsed.
+const maxPackageLockVersion = 2
+
 // PackageLock represents a JavaScript package.lock json file
 type PackageLock struct {
 	Requires        bool `json:"requires"`
@@ -46,12 +51,23 @@ func parsePackageLock(path string, reader io.Reader) ([]*pkg.Package, []artifact
 		} else if err != nil {
 			return nil, nil, fmt.Errorf("failed to parse package-lock.json file: %w", err)
 		}
+		if lock.LockfileVersion > maxPackageLockVersion {
+			return nil, nil, common.UnsupportedVersionError{
+				Kind:      "package-lock.json",
+				Version:   strconv.Itoa(lock.LockfileVersion),
+				Supported: []string{"1", "2"},
+			}
+		}
 		for name, pkgMeta := range lock.Dependencies {
 			packages = append(packages, &pkg.Package{
 				Name:     name,
 				Version:  pkgMeta.Version,
 				Language: pkg.JavaScript,
 				Type:     pkg.NpmPkg,
+				OriginURLs: pkg.OriginURLs{
+					Download: pkgMeta.Resolved,
+				},
+				Checksums: parseIntegrity(pkgMeta.Integrity),
 			})
 		}
 	}
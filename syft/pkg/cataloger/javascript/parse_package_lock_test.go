@@ -6,7 +6,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/anchore/syft/syft/file"
 	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/common"
 )
 
 func assertPkgsEqual(t *testing.T, actual []*pkg.Package, expected map[string]pkg.Package) {
@@ -25,9 +27,33 @@ func assertPkgsEqual(t *testing.T, actual []*pkg.Package, expected map[string]pk
 		assert.Equal(t, expectedPkg.Language, a.Language, "bad language")
 		assert.Equal(t, expectedPkg.Type, a.Type, "bad type")
 		assert.Equal(t, expectedPkg.Licenses, a.Licenses, "bad license count")
+		assert.Equal(t, expectedPkg.OriginURLs, a.OriginURLs, "bad origin URLs")
 	}
 }
 
+func TestParsePackageLock_Checksums(t *testing.T) {
+	fixture, err := os.Open("test-fixtures/pkg-lock/package-lock.json")
+	if err != nil {
+		t.Fatalf("failed to open fixture: %+v", err)
+	}
+
+	actual, _, err := parsePackageLock(fixture.Name(), fixture)
+	if err != nil {
+		t.Fatalf("failed to parse package-lock.json: %+v", err)
+	}
+
+	for _, p := range actual {
+		if p.Name != "ansi-regex" {
+			continue
+		}
+		assert.Equal(t, []file.Digest{
+			{Algorithm: "sha1", Value: "ed0317c322064f79466c02966bddb605ab37d998"},
+		}, p.Checksums)
+		return
+	}
+	t.Fatal("missing package ansi-regex")
+}
+
 func TestParsePackageLock(t *testing.T) {
 	expected := map[string]pkg.Package{
 		"wordwrap": {
@@ -35,60 +61,90 @@ func TestParsePackageLock(t *testing.T) {
 			Version:  "0.0.3",
 			Language: pkg.JavaScript,
 			Type:     pkg.NpmPkg,
+			OriginURLs: pkg.OriginURLs{
+				Download: "https://registry.npmjs.org/wordwrap/-/wordwrap-0.0.3.tgz",
+			},
 		},
 		"get-stdin": {
 			Name:     "get-stdin",
 			Version:  "5.0.1",
 			Language: pkg.JavaScript,
 			Type:     pkg.NpmPkg,
+			OriginURLs: pkg.OriginURLs{
+				Download: "https://registry.npmjs.org/get-stdin/-/get-stdin-5.0.1.tgz",
+			},
 		},
 		"minimist": {
 			Name:     "minimist",
 			Version:  "0.0.10",
 			Language: pkg.JavaScript,
 			Type:     pkg.NpmPkg,
+			OriginURLs: pkg.OriginURLs{
+				Download: "https://registry.npmjs.org/minimist/-/minimist-0.0.10.tgz",
+			},
 		},
 		"optimist": {
 			Name:     "optimist",
 			Version:  "0.6.1",
 			Language: pkg.JavaScript,
 			Type:     pkg.NpmPkg,
+			OriginURLs: pkg.OriginURLs{
+				Download: "https://registry.npmjs.org/optimist/-/optimist-0.6.1.tgz",
+			},
 		},
 		"string-width": {
 			Name:     "string-width",
 			Version:  "2.1.1",
 			Language: pkg.JavaScript,
 			Type:     pkg.NpmPkg,
+			OriginURLs: pkg.OriginURLs{
+				Download: "https://registry.npmjs.org/string-width/-/string-width-2.1.1.tgz",
+			},
 		},
 		"strip-ansi": {
 			Name:     "strip-ansi",
 			Version:  "4.0.0",
 			Language: pkg.JavaScript,
 			Type:     pkg.NpmPkg,
+			OriginURLs: pkg.OriginURLs{
+				Download: "https://registry.npmjs.org/strip-ansi/-/strip-ansi-4.0.0.tgz",
+			},
 		},
 		"strip-eof": {
 			Name:     "wordwrap",
 			Version:  "1.0.0",
 			Language: pkg.JavaScript,
 			Type:     pkg.NpmPkg,
+			OriginURLs: pkg.OriginURLs{
+				Download: "https://registry.npmjs.org/strip-eof/-/strip-eof-1.0.0.tgz",
+			},
 		},
 		"ansi-regex": {
 			Name:     "ansi-regex",
 			Version:  "3.0.0",
 			Language: pkg.JavaScript,
 			Type:     pkg.NpmPkg,
+			OriginURLs: pkg.OriginURLs{
+				Download: "https://registry.npmjs.org/ansi-regex/-/ansi-regex-3.0.0.tgz",
+			},
 		},
 		"is-fullwidth-code-point": {
 			Name:     "is-fullwidth-code-point",
 			Version:  "2.0.0",
 			Language: pkg.JavaScript,
 			Type:     pkg.NpmPkg,
+			OriginURLs: pkg.OriginURLs{
+				Download: "https://registry.npmjs.org/is-fullwidth-code-point/-/is-fullwidth-code-point-2.0.0.tgz",
+			},
 		},
 		"cowsay": {
 			Name:     "cowsay",
 			Version:  "1.4.0",
 			Language: pkg.JavaScript,
 			Type:     pkg.NpmPkg,
+			OriginURLs: pkg.OriginURLs{
+				Download: "https://registry.npmjs.org/cowsay/-/cowsay-1.4.0.tgz",
+			},
 		},
 	}
 	fixture, err := os.Open("test-fixtures/pkg-lock/package-lock.json")
@@ -105,3 +161,18 @@ func TestParsePackageLock(t *testing.T) {
 	assertPkgsEqual(t, actual, expected)
 
 }
+
+func TestParsePackageLock_UnsupportedVersion(t *testing.T) {
+	fixture, err := os.Open("test-fixtures/pkg-lock-v3/package-lock.json")
+	if err != nil {
+		t.Fatalf("failed to open fixture: %+v", err)
+	}
+
+	actual, _, err := parsePackageLock(fixture.Name(), fixture)
+	assert.Empty(t, actual)
+	assert.Equal(t, common.UnsupportedVersionError{
+		Kind:      "package-lock.json",
+		Version:   "3",
+		Supported: []string{"1", "2"},
+	}, err)
+}
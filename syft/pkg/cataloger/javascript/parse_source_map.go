@@ -0,0 +1,153 @@
+package javascript
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"regexp"
+	"strings"
+)
+
+var (
+	// banners are comments preserved by minifiers, e.g. "/*! jQuery v3.6.0 | (c) OpenJS Foundation */" or
+	// "/** @license React v17.0.2 */"
+	licenseBannerCommentPattern = regexp.MustCompile(`(?s)/\*[!*].*?\*/`)
+	licenseBannerPackagePattern = regexp.MustCompile(`([@\w.\-/]+)\s+v?(\d+\.\d+\.\d+[\w.\-+]*)`)
+)
+
+// bundledModule is an npm module compiled into a javascript bundle.
+type bundledModule struct {
+	name    string
+	version string
+}
+
+// licenseBanner is a package name and version declared within a license comment.
+type licenseBanner struct {
+	name    string
+	version string
+	comment string
+}
+
+type sourceMap struct {
+	Sources        []string  `json:"sources"`
+	SourcesContent []*string `json:"sourcesContent"`
+}
+
+// parseSourceMap returns the npm modules that the sources listed within a source map belong to (e.g.
+// "webpack:///./node_modules/react/index.js"), in the order first seen. Versions are taken from the embedded source
+// content where possible (a bundled package.json or a license banner).
+func parseSourceMap(reader io.Reader) ([]bundledModule, error) {
+	var sm sourceMap
+	if err := json.NewDecoder(reader).Decode(&sm); err != nil {
+		return nil, fmt.Errorf("failed to parse source map: %w", err)
+	}
+
+	var modules []bundledModule
+	indexByName := make(map[string]int)
+	for i, src := range sm.Sources {
+		name, pathWithinModule := nodeModuleFromSource(src)
+		if name == "" {
+			continue
+		}
+
+		idx, exists := indexByName[name]
+		if !exists {
+			idx = len(modules)
+			indexByName[name] = idx
+			modules = append(modules, bundledModule{name: name})
+		}
+
+		if modules[idx].version != "" || i >= len(sm.SourcesContent) || sm.SourcesContent[i] == nil {
+			continue
+		}
+		content := *sm.SourcesContent[i]
+
+		if pathWithinModule == "package.json" {
+			var p PackageJSON
+			if err := json.Unmarshal([]byte(content), &p); err == nil && p.Name == name {
+				modules[idx].version = p.Version
+			}
+			continue
+		}
+		modules[idx].version = bannerVersion(licenseBannersFromText(content), name)
+	}
+
+	return modules, nil
+}
+
+// nodeModuleFromSource returns the name of the (innermost) npm module a source map source path belongs to, along with
+// the path of the source within that module.
+func nodeModuleFromSource(src string) (string, string) {
+	// webpack may suffix sources with loader queries (e.g. "?5a3c")
+	if i := strings.Index(src, "?"); i >= 0 {
+		src = src[:i]
+	}
+
+	fields := filepathSeparator.Split(src, -1)
+	for i := len(fields) - 2; i >= 0; i-- {
+		if fields[i] != "node_modules" {
+			continue
+		}
+		name, rest := fields[i+1], fields[i+2:]
+		if strings.HasPrefix(name, "@") {
+			if len(rest) == 0 {
+				return "", ""
+			}
+			name, rest = name+"/"+rest[0], rest[1:]
+		}
+		if name == "" || strings.HasPrefix(name, ".") {
+			return "", ""
+		}
+		return name, strings.Join(rest, "/")
+	}
+	return "", ""
+}
+
+// parseLicenseBanners returns the package names and versions declared within the license comments of the given
+// javascript bundle (or license file extracted from the bundle).
+func parseLicenseBanners(reader io.Reader) ([]licenseBanner, error) {
+	contents, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read javascript bundle: %w", err)
+	}
+	return licenseBannersFromText(string(contents)), nil
+}
+
+func licenseBannersFromText(text string) []licenseBanner {
+	var banners []licenseBanner
+	for _, comment := range licenseBannerCommentPattern.FindAllString(text, -1) {
+		match := licenseBannerPackagePattern.FindStringSubmatch(comment)
+		if match == nil {
+			continue
+		}
+		banners = append(banners, licenseBanner{
+			// banners may name the package by its homepage (e.g. "https://mths.be/punycode v1.4.1")
+			name:    strings.ToLower(path.Base(match[1])),
+			version: match[2],
+			comment: comment,
+		})
+	}
+	return banners
+}
+
+// bannerVersion returns the version from the banner for the given npm module (by its name without scope). Banners
+// declaring the module name right before the version are preferred over those only mentioning the module name (e.g.
+// "jQuery JavaScript Library v3.6.0").
+func bannerVersion(banners []licenseBanner, moduleName string) string {
+	name := strings.ToLower(path.Base(moduleName))
+	for _, b := range banners {
+		if b.name == name {
+			return b.version
+		}
+	}
+
+	mention := regexp.MustCompile(`(?i)(^|[^\w\-@/.])` + regexp.QuoteMeta(name) + `($|[^\w\-])`)
+	for _, b := range banners {
+		if mention.MatchString(b.comment) {
+			return b.version
+		}
+	}
+	return ""
+}
@@ -0,0 +1,73 @@
+package javascript
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNodeModuleFromSource(t *testing.T) {
+	tests := []struct {
+		source       string
+		expectedName string
+		expectedPath string
+	}{
+		{
+			source:       "webpack:///./node_modules/react/index.js",
+			expectedName: "react",
+			expectedPath: "index.js",
+		},
+		{
+			source:       "webpack://app/./node_modules/@babel/runtime/helpers/esm/extends.js",
+			expectedName: "@babel/runtime",
+			expectedPath: "helpers/esm/extends.js",
+		},
+		{
+			// nested modules belong to the innermost module
+			source:       "../node_modules/a/node_modules/b/lib/b.js?5a3c",
+			expectedName: "b",
+			expectedPath: "lib/b.js",
+		},
+		{
+			source: "webpack:///./src/index.js",
+		},
+		{
+			source: "node_modules/@scope",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.source, func(t *testing.T) {
+			name, p := nodeModuleFromSource(test.source)
+			assert.Equal(t, test.expectedName, name)
+			assert.Equal(t, test.expectedPath, p)
+		})
+	}
+}
+
+func TestBannerVersion(t *testing.T) {
+	banners := licenseBannersFromText(`
+/*! jQuery JavaScript Library v3.6.0 | jquery.org/license */
+/** @license React v17.0.2
+ * react-dom.production.min.js */
+/*! https://mths.be/punycode v1.4.1 by @mathias */
+/* not a banner v1.0.0 */
+`)
+
+	tests := []struct {
+		module   string
+		expected string
+	}{
+		{module: "jquery", expected: "3.6.0"},
+		{module: "react", expected: "17.0.2"},
+		{module: "punycode", expected: "1.4.1"},
+		{module: "banner", expected: ""},
+		{module: "lodash", expected: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.module, func(t *testing.T) {
+			assert.Equal(t, test.expected, bannerVersion(banners, test.module))
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"regexp"
+	"strings"
 
 	"github.com/anchore/syft/internal"
 	"github.com/anchore/syft/syft/artifact"
@@ -28,11 +29,18 @@ var (
 	// versionExp matches the "version" line of a yarn.lock entry and captures the version value.
 	// For example: version "4.10.1" (...and the value "4.10.1" is captured)
 	versionExp = regexp.MustCompile(`^\W+version\W+"([\w-_.]+)"`)
+
+	// metadataVersionExp matches the lockfile version within the "__metadata" section written by yarn 2 and newer,
+	// whose lockfiles are in a different format than the yarn 1 lockfiles understood by the parser.
+	// For example: version: 6 (...and the value "6" is captured)
+	metadataVersionExp = regexp.MustCompile(`^\s+version:\s*"?([\w.]+)"?\s*$`)
 )
 
 const (
 	noPackage = ""
 	noVersion = ""
+
+	metadataSection = "__metadata:"
 )
 
 func parseYarnLock(path string, reader io.Reader) ([]*pkg.Package, []artifact.Relationship, error) {
@@ -46,10 +54,27 @@ func parseYarnLock(path string, reader io.Reader) ([]*pkg.Package, []artifact.Re
 	scanner := bufio.NewScanner(reader)
 	parsedPackages := internal.NewStringSet()
 	currentPackage := noPackage
+	inMetadata := false
 
 	for scanner.Scan() {
 		line := scanner.Text()
 
+		if line == metadataSection {
+			inMetadata = true
+			continue
+		}
+		if inMetadata {
+			if matches := metadataVersionExp.FindStringSubmatch(line); len(matches) >= 2 {
+				return nil, nil, common.UnsupportedVersionError{
+					Kind:      "yarn.lock",
+					Version:   matches[1],
+					Supported: []string{"1"},
+				}
+			}
+			inMetadata = strings.HasPrefix(line, " ")
+			continue
+		}
+
 		if currentPackage == noPackage {
 			// Scan until we find the next package
 
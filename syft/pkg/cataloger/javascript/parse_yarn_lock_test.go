@@ -4,7 +4,10 @@ import (
 	"os"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+
 	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/common"
 )
 
 func TestParseYarnLock(t *testing.T) {
@@ -78,3 +81,18 @@ func TestParseYarnLock(t *testing.T) {
 
 	assertPkgsEqual(t, actual, expected)
 }
+
+func TestParseYarnLock_UnsupportedVersion(t *testing.T) {
+	fixture, err := os.Open("test-fixtures/yarn-berry/yarn.lock")
+	if err != nil {
+		t.Fatalf("failed to open fixture: %+v", err)
+	}
+
+	actual, _, err := parseYarnLock(fixture.Name(), fixture)
+	assert.Empty(t, actual)
+	assert.Equal(t, common.UnsupportedVersionError{
+		Kind:      "yarn.lock",
+		Version:   "6",
+		Supported: []string{"1"},
+	}, err)
+}
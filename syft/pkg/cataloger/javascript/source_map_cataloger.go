@@ -0,0 +1,119 @@
+package javascript
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/source"
+)
+
+const (
+	sourceMapCatalogerName = "javascript-source-map-cataloger"
+	sourceMapGlob          = "**/*.js.map"
+	// webpack (with terser) extracts license comments from the bundle into a sibling file
+	extractedLicensesSuffix = ".LICENSE.txt"
+)
+
+type SourceMapCataloger struct {
+	cfg Config
+}
+
+// NewJavascriptSourceMapCataloger returns a new cataloger for npm packages compiled into minified frontend bundles
+// (e.g. by webpack or rollup), as described by the bundle source maps and license banner comments.
+func NewJavascriptSourceMapCataloger(cfg Config) *SourceMapCataloger {
+	return &SourceMapCataloger{
+		cfg: cfg,
+	}
+}
+
+// Name returns a string that uniquely describes a cataloger
+func (c *SourceMapCataloger) Name() string {
+	return sourceMapCatalogerName
+}
+
+// Catalog is given an object to resolve file references and content, this function returns any discovered Packages after analyzing javascript bundle source maps.
+func (c *SourceMapCataloger) Catalog(resolver source.FileResolver) ([]pkg.Package, []artifact.Relationship, error) {
+	if !c.cfg.SearchSourceMaps {
+		return nil, nil, nil
+	}
+
+	locations, err := resolver.FilesByGlob(sourceMapGlob)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find files by glob: %s", sourceMapGlob)
+	}
+
+	var pkgs []pkg.Package
+	for _, location := range locations {
+		discoveredPkgs, err := c.catalogSourceMap(resolver, location)
+		if err != nil {
+			log.Warnf("unable to catalog javascript source map=%q: %+v", location.RealPath, err)
+			continue
+		}
+		pkgs = append(pkgs, discoveredPkgs...)
+	}
+	return pkgs, nil, nil
+}
+
+// catalogSourceMap returns the packages listed in the given source map, filling in versions missing from the source
+// map with those declared by license banners within the bundle (or the licenses extracted from the bundle).
+func (c *SourceMapCataloger) catalogSourceMap(resolver source.FileResolver, mapLocation source.Location) ([]pkg.Package, error) {
+	mapContents, err := resolver.FileContentsByLocation(mapLocation)
+	if err != nil {
+		return nil, err
+	}
+	modules, err := parseSourceMap(mapContents)
+	internal.CloseAndLogError(mapContents, mapLocation.RealPath)
+	if err != nil {
+		return nil, err
+	}
+	if len(modules) == 0 {
+		return nil, nil
+	}
+
+	locations := []source.Location{mapLocation}
+	var banners []licenseBanner
+
+	bundlePath := strings.TrimSuffix(mapLocation.RealPath, ".map")
+	for _, p := range []string{bundlePath, bundlePath + extractedLicensesSuffix} {
+		location := resolver.RelativeFileByPath(mapLocation, p)
+		if location == nil {
+			continue
+		}
+		locations = append(locations, *location)
+
+		contents, err := resolver.FileContentsByLocation(*location)
+		if err != nil {
+			return nil, err
+		}
+		discoveredBanners, err := parseLicenseBanners(contents)
+		internal.CloseAndLogError(contents, location.RealPath)
+		if err != nil {
+			return nil, err
+		}
+		banners = append(banners, discoveredBanners...)
+	}
+
+	var pkgs []pkg.Package
+	for _, m := range modules {
+		if m.version == "" {
+			m.version = bannerVersion(banners, m.name)
+		}
+
+		p := pkg.Package{
+			Name:      m.name,
+			Version:   m.version,
+			FoundBy:   c.Name(),
+			Locations: locations,
+			Language:  pkg.JavaScript,
+			Type:      pkg.NpmPkg,
+		}
+		p.SetID()
+
+		pkgs = append(pkgs, p)
+	}
+	return pkgs, nil
+}
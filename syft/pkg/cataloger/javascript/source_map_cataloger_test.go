@@ -0,0 +1,66 @@
+package javascript
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/source"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSourceMapCataloger(t *testing.T) {
+	src, err := source.NewFromDirectory("test-fixtures/source-maps")
+	require.NoError(t, err)
+
+	resolver, err := src.FileResolver(source.SquashedScope)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		cfg      Config
+		expected map[string]string
+	}{
+		{
+			name:     "disabled by default",
+			cfg:      Config{},
+			expected: map[string]string{},
+		},
+		{
+			name: "enabled",
+			cfg:  Config{SearchSourceMaps: true},
+			expected: map[string]string{
+				// no version within the source map or the license banners
+				"object-assign": "",
+				// from the banner in the license file extracted from the bundle
+				"react": "17.0.2",
+				// from the package.json compiled into the bundle
+				"@babel/runtime": "7.16.3",
+				// from the banner within the source content
+				"jquery": "3.6.0",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkgs, _, err := NewJavascriptSourceMapCataloger(test.cfg).Catalog(resolver)
+			require.NoError(t, err)
+
+			actual := make(map[string]string)
+			for _, p := range pkgs {
+				actual[p.Name] = p.Version
+
+				var paths []string
+				for _, l := range p.Locations {
+					paths = append(paths, l.RealPath)
+				}
+				assert.ElementsMatch(t, []string{
+					"usr/share/nginx/html/static/js/main.3f2a9c.js.map",
+					"usr/share/nginx/html/static/js/main.3f2a9c.js",
+					"usr/share/nginx/html/static/js/main.3f2a9c.js.LICENSE.txt",
+				}, paths)
+			}
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
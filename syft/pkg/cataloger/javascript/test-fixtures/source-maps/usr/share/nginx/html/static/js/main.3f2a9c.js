@@ -0,0 +1,3 @@
+/*! For license information please see main.3f2a9c.js.LICENSE.txt */
+(()=>{var e={418:e=>{"use strict";e.exports=function(e){return e}},294:(e,t,n)=>{e.exports=n(418)}};console.log(e)})();
+//# sourceMappingURL=main.3f2a9c.js.map
@@ -0,0 +1,110 @@
+package cataloger
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/anchore/syft/syft/pkg"
+	hashiVer "github.com/hashicorp/go-version"
+)
+
+// e.g. 1.2.3_rc1-r0 or 2.0_pre20220101-r1
+var apkPreReleasePattern = regexp.MustCompile(`_(alpha|beta|pre|rc)\d*`)
+
+// generateNormalizedVersion returns the version of the given package decomposed according to the versioning scheme of
+// its ecosystem, or nil if the version cannot be normalized.
+func generateNormalizedVersion(p pkg.Package) *pkg.NormalizedVersion {
+	if p.Version == "" {
+		return nil
+	}
+
+	switch p.Type {
+	case pkg.RpmPkg:
+		if metadata, ok := p.Metadata.(pkg.RpmdbMetadata); ok {
+			return &pkg.NormalizedVersion{
+				Scheme:     pkg.EVRScheme,
+				Epoch:      metadata.Epoch,
+				Version:    metadata.Version,
+				Release:    metadata.Release,
+				PreRelease: strings.Contains(metadata.Version, "~"),
+			}
+		}
+		return normalizeEVR(p.Version, "-")
+	case pkg.DebPkg, pkg.OpkgPkg:
+		v := normalizeEVR(p.Version, "-")
+		if v != nil {
+			// debian versions sort any version with a tilde before the version without it (e.g. 1.0~rc1 < 1.0)
+			v.PreRelease = strings.Contains(v.Version, "~")
+		}
+		return v
+	case pkg.ApkPkg:
+		v := normalizeEVR(p.Version, "-r")
+		if v != nil {
+			v.PreRelease = apkPreReleasePattern.MatchString(v.Version)
+		}
+		return v
+	case pkg.GemPkg, pkg.PythonPkg, pkg.PhpComposerPkg, pkg.PhpPeclPkg, pkg.NpmPkg, pkg.GoModulePkg, pkg.RustPkg,
+		pkg.JavaPkg, pkg.JenkinsPluginPkg, pkg.RuntimePkg, pkg.StaticLibraryPkg, pkg.WebServerModulePkg:
+		return normalizeSemver(p.Version)
+	}
+	return nil
+}
+
+// normalizeEVR splits the given version into the optional epoch (before the first ":"), the upstream version, and the
+// release (after the last occurrence of the release separator).
+func normalizeEVR(version, releaseSeparator string) *pkg.NormalizedVersion {
+	v := pkg.NormalizedVersion{
+		Scheme:  pkg.EVRScheme,
+		Version: version,
+	}
+
+	if fields := strings.SplitN(v.Version, ":", 2); len(fields) == 2 {
+		epoch, err := strconv.Atoi(fields[0])
+		if err != nil {
+			return nil
+		}
+		v.Epoch = &epoch
+		v.Version = fields[1]
+	}
+
+	if i := strings.LastIndex(v.Version, releaseSeparator); i > 0 {
+		v.Release = strings.TrimPrefix(v.Version[i:], "-")
+		v.Version = v.Version[:i]
+	}
+
+	if v.Version == "" {
+		return nil
+	}
+	return &v
+}
+
+// normalizeSemver returns the given version as MAJOR.MINOR.PATCH[-PRERELEASE][+BUILD] (e.g. "v1.2" becomes "1.2.0" and
+// "2.0-SNAPSHOT" becomes "2.0.0-SNAPSHOT"), or nil if the version cannot be expressed as a semantic version.
+func normalizeSemver(version string) *pkg.NormalizedVersion {
+	parsed, err := hashiVer.NewVersion(version)
+	if err != nil {
+		return nil
+	}
+
+	segments := parsed.Segments()
+	if len(segments) != 3 {
+		// versions with more than three components (e.g. 1.2.3.4) have no semantic version equivalent
+		return nil
+	}
+
+	normalized := fmt.Sprintf("%d.%d.%d", segments[0], segments[1], segments[2])
+	if parsed.Prerelease() != "" {
+		normalized += "-" + parsed.Prerelease()
+	}
+	if parsed.Metadata() != "" {
+		normalized += "+" + parsed.Metadata()
+	}
+
+	return &pkg.NormalizedVersion{
+		Scheme:     pkg.SemverScheme,
+		Version:    normalized,
+		PreRelease: parsed.Prerelease() != "",
+	}
+}
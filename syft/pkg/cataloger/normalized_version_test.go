@@ -0,0 +1,166 @@
+package cataloger
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateNormalizedVersion(t *testing.T) {
+	epoch := 2
+
+	tests := []struct {
+		name     string
+		pkg      pkg.Package
+		expected *pkg.NormalizedVersion
+	}{
+		{
+			name: "rpm from metadata",
+			pkg: pkg.Package{
+				Version: "2:1.0.1-3.el8",
+				Type:    pkg.RpmPkg,
+				Metadata: pkg.RpmdbMetadata{
+					Epoch:   &epoch,
+					Version: "1.0.1",
+					Release: "3.el8",
+				},
+			},
+			expected: &pkg.NormalizedVersion{
+				Scheme:  pkg.EVRScheme,
+				Epoch:   &epoch,
+				Version: "1.0.1",
+				Release: "3.el8",
+			},
+		},
+		{
+			name: "deb with epoch and revision",
+			pkg: pkg.Package{
+				Version: "2:8.2.2434-3+deb11u1",
+				Type:    pkg.DebPkg,
+			},
+			expected: &pkg.NormalizedVersion{
+				Scheme:  pkg.EVRScheme,
+				Epoch:   &epoch,
+				Version: "8.2.2434",
+				Release: "3+deb11u1",
+			},
+		},
+		{
+			name: "deb pre-release with hyphenated upstream version",
+			pkg: pkg.Package{
+				Version: "1.0-beta~rc1-2",
+				Type:    pkg.DebPkg,
+			},
+			expected: &pkg.NormalizedVersion{
+				Scheme:     pkg.EVRScheme,
+				Version:    "1.0-beta~rc1",
+				Release:    "2",
+				PreRelease: true,
+			},
+		},
+		{
+			name: "native deb without revision",
+			pkg: pkg.Package{
+				Version: "2021a",
+				Type:    pkg.DebPkg,
+			},
+			expected: &pkg.NormalizedVersion{
+				Scheme:  pkg.EVRScheme,
+				Version: "2021a",
+			},
+		},
+		{
+			name: "deb with invalid epoch",
+			pkg: pkg.Package{
+				Version: "a:1.0-1",
+				Type:    pkg.DebPkg,
+			},
+		},
+		{
+			name: "apk",
+			pkg: pkg.Package{
+				Version: "1.35.0_rc1-r4",
+				Type:    pkg.ApkPkg,
+			},
+			expected: &pkg.NormalizedVersion{
+				Scheme:     pkg.EVRScheme,
+				Version:    "1.35.0_rc1",
+				Release:    "r4",
+				PreRelease: true,
+			},
+		},
+		{
+			name: "semver with leading v and missing patch",
+			pkg: pkg.Package{
+				Version: "v1.2",
+				Type:    pkg.GoModulePkg,
+			},
+			expected: &pkg.NormalizedVersion{
+				Scheme:  pkg.SemverScheme,
+				Version: "1.2.0",
+			},
+		},
+		{
+			name: "maven snapshot",
+			pkg: pkg.Package{
+				Version: "2.0-SNAPSHOT",
+				Type:    pkg.JavaPkg,
+			},
+			expected: &pkg.NormalizedVersion{
+				Scheme:     pkg.SemverScheme,
+				Version:    "2.0.0-SNAPSHOT",
+				PreRelease: true,
+			},
+		},
+		{
+			name: "go pseudo-version",
+			pkg: pkg.Package{
+				Version: "v0.0.0-20210914181456-a9c52348da63",
+				Type:    pkg.GoModulePkg,
+			},
+			expected: &pkg.NormalizedVersion{
+				Scheme:     pkg.SemverScheme,
+				Version:    "0.0.0-20210914181456-a9c52348da63",
+				PreRelease: true,
+			},
+		},
+		{
+			name: "semver with build metadata",
+			pkg: pkg.Package{
+				Version: "11.0.14+9",
+				Type:    pkg.RuntimePkg,
+			},
+			expected: &pkg.NormalizedVersion{
+				Scheme:  pkg.SemverScheme,
+				Version: "11.0.14+9",
+			},
+		},
+		{
+			name: "too many components for semver",
+			pkg: pkg.Package{
+				Version: "1.2.3.4",
+				Type:    pkg.PythonPkg,
+			},
+		},
+		{
+			name: "unparsable version",
+			pkg: pkg.Package{
+				Version: "1.0.dev1",
+				Type:    pkg.PythonPkg,
+			},
+		},
+		{
+			name: "unsupported package type",
+			pkg: pkg.Package{
+				Version: "1.0.0",
+				Type:    pkg.KbPkg,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, generateNormalizedVersion(test.pkg))
+		})
+	}
+}
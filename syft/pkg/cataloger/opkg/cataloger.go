@@ -0,0 +1,18 @@
+/*
+Package opkg provides a concrete Cataloger implementation for opkg package DB status files (OpenWrt, Yocto).
+*/
+package opkg
+
+import (
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/common"
+)
+
+// NewOpkgCataloger returns a new opkg status file cataloger object.
+func NewOpkgCataloger() *common.GenericCataloger {
+	globParsers := map[string]common.ParserFn{
+		pkg.OpkgDBGlob: parseOpkgStatus,
+	}
+
+	return common.NewCompressedEvidenceCataloger(nil, globParsers, "opkg-cataloger")
+}
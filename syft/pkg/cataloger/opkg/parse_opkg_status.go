@@ -0,0 +1,81 @@
+package opkg
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/common"
+)
+
+// integrity check
+var _ common.ParserFn = parseOpkgStatus
+
+func newOpkgPackage(m pkg.OpkgMetadata) *pkg.Package {
+	return &pkg.Package{
+		Name:         m.Package,
+		Version:      m.Version,
+		Type:         pkg.OpkgPkg,
+		MetadataType: pkg.OpkgMetadataType,
+		Metadata:     m,
+	}
+}
+
+// parseOpkgStatus is a parser function for opkg status contents, returning all installed packages. The status file
+// follows the debian control file format, however, only the fields needed to describe the installed package are kept.
+func parseOpkgStatus(_ string, reader io.Reader) ([]*pkg.Package, []artifact.Relationship, error) {
+	var packages []*pkg.Package
+	entry := pkg.OpkgMetadata{}
+	installed := false
+
+	flush := func() {
+		if entry.Package != "" && installed {
+			packages = append(packages, newOpkgPackage(entry))
+		}
+		entry = pkg.OpkgMetadata{}
+		installed = false
+	}
+
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.TrimSpace(line) == "" {
+			flush()
+			continue
+		}
+
+		if strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t") {
+			// a field-body continuation (e.g. conffiles), which is not captured
+			continue
+		}
+
+		fields := strings.SplitN(line, ":", 2)
+		if len(fields) != 2 {
+			return nil, nil, fmt.Errorf("unexpected opkg status line: %q", line)
+		}
+
+		value := strings.TrimSpace(fields[1])
+		switch fields[0] {
+		case "Package":
+			entry.Package = value
+		case "Version":
+			entry.Version = value
+		case "Architecture":
+			entry.Architecture = value
+		case "Status":
+			// e.g. "install ok installed" (want, flags, state)
+			state := strings.Fields(value)
+			installed = len(state) > 0 && state[len(state)-1] == "installed"
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("unable to read opkg status: %w", err)
+	}
+	flush()
+
+	return packages, nil, nil
+}
@@ -0,0 +1,61 @@
+package opkg
+
+import (
+	"os"
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/source"
+	"github.com/go-test/deep"
+)
+
+func TestParseOpkgStatus(t *testing.T) {
+	expected := []*pkg.Package{
+		newOpkgPackage(pkg.OpkgMetadata{Package: "busybox", Version: "1.35.0-r0", Architecture: "cortexa57"}),
+		newOpkgPackage(pkg.OpkgMetadata{Package: "libc6", Version: "2.35-r0", Architecture: "cortexa57"}),
+		newOpkgPackage(pkg.OpkgMetadata{Package: "kernel-module-bluetooth", Version: "5.15.72+git0+44a58c8d3c-r0", Architecture: "qemuarm64"}),
+	}
+
+	fixture, err := os.Open("test-fixtures/status")
+	if err != nil {
+		t.Fatalf("failed to open fixture: %+v", err)
+	}
+
+	actual, _, err := parseOpkgStatus(fixture.Name(), fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, d := range deep.Equal(expected, actual) {
+		t.Errorf("diff: %+v", d)
+	}
+}
+
+func TestOpkgCataloger_CompressedStatus(t *testing.T) {
+	src, err := source.NewFromDirectory("test-fixtures/compressed-root")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resolver, err := src.FileResolver(source.SquashedScope)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual, _, err := NewOpkgCataloger().Catalog(resolver)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	for _, p := range actual {
+		names = append(names, p.Name)
+		if len(p.Locations) != 1 || p.Locations[0].RealPath != "var/lib/opkg/status.gz" {
+			t.Errorf("unexpected locations for %q: %+v", p.Name, p.Locations)
+		}
+	}
+
+	for _, d := range deep.Equal([]string{"busybox", "libc6", "kernel-module-bluetooth"}, names) {
+		t.Errorf("diff: %+v", d)
+	}
+}
@@ -0,0 +1,113 @@
+package cataloger
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// OwnershipRule attaches organizational metadata (e.g. the owning team or business unit) to every package that is
+// within one of the given package URL namespaces or that is supplied by one of the given suppliers.
+type OwnershipRule struct {
+	Namespaces []string          // package URL namespaces (e.g. "com.example" or "@example"), which also match the namespaces within them (e.g. "com.example.billing")
+	Suppliers  []string          // case-insensitive fragments of the supplier of the package, as declared by its metadata (e.g. a deb maintainer or an rpm vendor)
+	Properties map[string]string // the metadata attached to matching packages (e.g. "team": "payments")
+}
+
+// AttachOwnership records the properties of every rule that matches a package on the package. When several rules give
+// the same property to a package, the first of these rules wins. The package IDs are unchanged (the ownership is not
+// part of the ID).
+func AttachOwnership(catalog *pkg.Catalog, rules []OwnershipRule) *pkg.Catalog {
+	if len(rules) == 0 || catalog == nil {
+		return catalog
+	}
+
+	var packages []pkg.Package
+	for _, p := range catalog.Sorted() {
+		ownership := make(map[string]string)
+		for key, value := range p.Ownership {
+			ownership[key] = value
+		}
+
+		namespace := packageNamespace(p)
+		supplier := strings.ToLower(packageSupplier(p))
+		for _, rule := range rules {
+			if !rule.matches(namespace, supplier) {
+				continue
+			}
+			for key, value := range rule.Properties {
+				if _, exists := ownership[key]; !exists {
+					ownership[key] = value
+				}
+			}
+		}
+
+		if len(ownership) > 0 {
+			p.Ownership = ownership
+		}
+		packages = append(packages, p)
+	}
+	return pkg.NewCatalog(packages...)
+}
+
+func (r OwnershipRule) matches(namespace, supplier string) bool {
+	for _, n := range r.Namespaces {
+		if namespace != "" && withinNamespace(namespace, n) {
+			return true
+		}
+	}
+	for _, s := range r.Suppliers {
+		if supplier != "" && s != "" && strings.Contains(supplier, strings.ToLower(s)) {
+			return true
+		}
+	}
+	return false
+}
+
+// withinNamespace indicates if the given namespace is the other namespace, or is nested within it (namespaces are
+// nested with "." for java packages and with "/" for go modules).
+func withinNamespace(namespace, other string) bool {
+	if !strings.HasPrefix(namespace, other) {
+		return false
+	}
+	rest := namespace[len(other):]
+	return rest == "" || rest[0] == '.' || rest[0] == '/'
+}
+
+func packageNamespace(p pkg.Package) string {
+	if p.PURL == "" {
+		return ""
+	}
+	purl, err := packageurl.FromString(p.PURL)
+	if err != nil {
+		log.Debugf("unable to parse package URL %q: %+v", p.PURL, err)
+		return ""
+	}
+	// npm scopes are escaped within package URLs (e.g. "%40example" for "@example")
+	if namespace, err := url.PathUnescape(purl.Namespace); err == nil {
+		return namespace
+	}
+	return purl.Namespace
+}
+
+// packageSupplier returns who supplies the package, as declared by the package metadata (if at all).
+func packageSupplier(p pkg.Package) string {
+	switch metadata := p.Metadata.(type) {
+	case pkg.DpkgMetadata:
+		return metadata.Maintainer
+	case pkg.ApkMetadata:
+		return metadata.Maintainer
+	case pkg.RpmdbMetadata:
+		return metadata.Vendor
+	case pkg.NpmPackageJSONMetadata:
+		return metadata.Author
+	case pkg.PythonPackageMetadata:
+		return metadata.Author
+	case pkg.GemMetadata:
+		return strings.Join(metadata.Authors, ", ")
+	}
+	return ""
+}
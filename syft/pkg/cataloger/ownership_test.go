@@ -0,0 +1,102 @@
+package cataloger
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAttachOwnership(t *testing.T) {
+	rules := []OwnershipRule{
+		{
+			Namespaces: []string{"com.example.billing"},
+			Properties: map[string]string{"team": "billing"},
+		},
+		{
+			Namespaces: []string{"com.example", "@example", "github.com/example"},
+			Suppliers:  []string{"example corp"},
+			Properties: map[string]string{"team": "platform", "business-unit": "payments"},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		p        pkg.Package
+		expected map[string]string
+	}{
+		{
+			name: "first matching rule wins",
+			p: pkg.Package{
+				Name:    "invoices",
+				Version: "1.0.0",
+				PURL:    "pkg:maven/com.example.billing.core/invoices@1.0.0",
+			},
+			expected: map[string]string{"team": "billing", "business-unit": "payments"},
+		},
+		{
+			name: "namespace",
+			p: pkg.Package{
+				Name:    "ui",
+				Version: "2.0.0",
+				PURL:    "pkg:npm/%40example/ui@2.0.0",
+			},
+			expected: map[string]string{"team": "platform", "business-unit": "payments"},
+		},
+		{
+			name: "nested go module namespace",
+			p: pkg.Package{
+				Name:    "github.com/example/tools/cli",
+				Version: "v1.2.0",
+				PURL:    "pkg:golang/github.com/example/tools/cli@v1.2.0",
+			},
+			expected: map[string]string{"team": "platform", "business-unit": "payments"},
+		},
+		{
+			name: "namespace with a common prefix",
+			p: pkg.Package{
+				Name:    "lib",
+				Version: "1.0.0",
+				PURL:    "pkg:maven/com.examples/lib@1.0.0",
+			},
+		},
+		{
+			name: "supplier",
+			p: pkg.Package{
+				Name:         "agent",
+				Version:      "3.1-1",
+				PURL:         "pkg:rpm/agent@3.1-1",
+				MetadataType: pkg.RpmdbMetadataType,
+				Metadata:     pkg.RpmdbMetadata{Vendor: "Example Corp, Inc."},
+			},
+			expected: map[string]string{"team": "platform", "business-unit": "payments"},
+		},
+		{
+			name: "existing ownership is kept",
+			p: pkg.Package{
+				Name:      "ui",
+				Version:   "2.0.0",
+				PURL:      "pkg:npm/%40example/ui@2.0.0",
+				Ownership: map[string]string{"team": "design"},
+			},
+			expected: map[string]string{"team": "design", "business-unit": "payments"},
+		},
+		{
+			name: "no match",
+			p: pkg.Package{
+				Name:    "lodash",
+				Version: "4.17.21",
+				PURL:    "pkg:npm/lodash@4.17.21",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.p.SetID()
+			catalog := AttachOwnership(pkg.NewCatalog(test.p), rules)
+			actual := catalog.Package(test.p.ID())
+			assert.Equal(t, test.expected, actual.Ownership)
+		})
+	}
+}
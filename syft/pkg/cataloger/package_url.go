@@ -29,7 +29,7 @@ func generatePackageURL(p pkg.Package, d *distro.Distro) string {
 		// there is no purl type, don't attempt to craft a purl
 		// TODO: should this be a "generic" purl type instead?
 		return ""
-	case p.Type == pkg.GoModulePkg:
+	case p.Type == pkg.GoModulePkg && strings.Contains(p.Name, "/"):
 		re := regexp.MustCompile(`(/)[^/]*$`)
 		fields := re.Split(p.Name, -1)
 		namespace = fields[0]
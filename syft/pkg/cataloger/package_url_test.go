@@ -24,6 +24,15 @@ func TestPackageURL(t *testing.T) {
 			},
 			expected: "pkg:golang/github.com/anchore/syft@v0.1.0",
 		},
+		{
+			name: "golang stdlib",
+			pkg: pkg.Package{
+				Name:    "stdlib",
+				Version: "1.17.2",
+				Type:    pkg.GoModulePkg,
+			},
+			expected: "pkg:golang/stdlib@1.17.2",
+		},
 		{
 			name: "pip with vcs url",
 			pkg: pkg.Package{
@@ -6,6 +6,7 @@ import (
 	"io"
 
 	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
 
 	"github.com/anchore/syft/syft/pkg"
 )
@@ -18,6 +19,27 @@ type ComposerLock struct {
 type Dependency struct {
 	Name    string `json:"name"`
 	Version string `json:"version"`
+	Dist    Dist   `json:"dist"`
+}
+
+// Dist describes the archive a composer package is installed from.
+type Dist struct {
+	URL    string `json:"url"`
+	Shasum string `json:"shasum"`
+}
+
+// checksums returns the sha1 digest of the dist archive, when recorded (composer commonly leaves this empty for
+// archives downloaded from GitHub).
+func (d Dependency) checksums() []file.Digest {
+	if d.Dist.Shasum == "" {
+		return nil
+	}
+	return []file.Digest{
+		{
+			Algorithm: "sha1",
+			Value:     d.Dist.Shasum,
+		},
+	}
 }
 
 // parseComposerLock is a parser function for Composer.lock contents, returning "Default" php packages discovered.
@@ -36,10 +58,11 @@ func parseComposerLock(_ string, reader io.Reader) ([]*pkg.Package, []artifact.R
 			version := pkgMeta.Version
 			name := pkgMeta.Name
 			packages = append(packages, &pkg.Package{
-				Name:     name,
-				Version:  version,
-				Language: pkg.PHP,
-				Type:     pkg.PhpComposerPkg,
+				Name:      name,
+				Version:   version,
+				Language:  pkg.PHP,
+				Type:      pkg.PhpComposerPkg,
+				Checksums: pkgMeta.checksums(),
 			})
 		}
 	}
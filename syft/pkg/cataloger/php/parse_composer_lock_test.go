@@ -4,6 +4,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/anchore/syft/syft/file"
 	"github.com/anchore/syft/syft/pkg"
 	"github.com/go-test/deep"
 )
@@ -21,6 +22,9 @@ func TestParseComposerFileLock(t *testing.T) {
 			Version:  "1.1.11",
 			Language: pkg.PHP,
 			Type:     pkg.PhpComposerPkg,
+			Checksums: []file.Digest{
+				{Algorithm: "sha1", Value: "dc6f6c1ee1e2e32fd6c4cc9e7fcc7c1f7c7c9d12"},
+			},
 		},
 	}
 	fixture, err := os.Open("test-fixtures/composer.lock")
@@ -55,10 +55,11 @@ func parseInstalledJSON(_ string, reader io.Reader) ([]*pkg.Package, []artifact.
 			version := pkgMeta.Version
 			name := pkgMeta.Name
 			packages = append(packages, &pkg.Package{
-				Name:     name,
-				Version:  version,
-				Language: pkg.PHP,
-				Type:     pkg.PhpComposerPkg,
+				Name:      name,
+				Version:   version,
+				Language:  pkg.PHP,
+				Type:      pkg.PhpComposerPkg,
+				Checksums: pkgMeta.checksums(),
 			})
 		}
 	}
@@ -0,0 +1,202 @@
+package php
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/common"
+)
+
+// integrity check
+var _ common.ParserFn = parsePearRegistry
+
+// parsePearRegistry is a parser function for PECL/PEAR registry entries (e.g.
+// /usr/local/lib/php/.registry/.channel.pecl.php.net/redis.reg), returning the installed package described. Registry
+// entries hold the package.xml contents of the installed package as a PHP serialized array.
+func parsePearRegistry(path string, reader io.Reader) ([]*pkg.Package, []artifact.Relationship, error) {
+	value, err := phpUnserialize(bufio.NewReader(reader))
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to parse PEAR registry entry=%q: %w", path, err)
+	}
+
+	entry, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, nil, fmt.Errorf("unexpected PEAR registry entry=%q: not an array", path)
+	}
+
+	metadata := pkg.PhpPeclMetadata{
+		Name:    phpString(entry["name"]),
+		Channel: phpString(entry["channel"]),
+		Summary: strings.TrimSpace(phpString(entry["summary"])),
+		License: phpString(entry["license"]),
+	}
+
+	// package.xml v2 holds the versions of the release and the API, while v1 only holds the release version
+	if version, ok := entry["version"].(map[string]interface{}); ok {
+		metadata.Version = phpString(version["release"])
+	} else {
+		metadata.Version = phpString(entry["version"])
+	}
+
+	if metadata.Name == "" || metadata.Version == "" {
+		return nil, nil, nil
+	}
+
+	return []*pkg.Package{newPeclPackage(metadata)}, nil, nil
+}
+
+func newPeclPackage(metadata pkg.PhpPeclMetadata) *pkg.Package {
+	var licenses []string
+	if metadata.License != "" {
+		licenses = []string{metadata.License}
+	}
+
+	return &pkg.Package{
+		Name:         metadata.Name,
+		Version:      metadata.Version,
+		Licenses:     licenses,
+		Language:     pkg.PHP,
+		Type:         pkg.PhpPeclPkg,
+		MetadataType: pkg.PhpPeclMetadataType,
+		Metadata:     metadata,
+	}
+}
+
+// phpString returns the string held by a PHP value. For elements converted from XML (such as the license) the text
+// content of the element is returned.
+func phpString(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case map[string]interface{}:
+		return phpString(v["_content"])
+	}
+	return ""
+}
+
+// phpUnserialize decodes a single value encoded with the PHP serialize() function. Arrays and objects are decoded into
+// maps keyed by the string form of their keys.
+func phpUnserialize(r *bufio.Reader) (interface{}, error) {
+	kind, err := r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+
+	switch kind {
+	case 'N':
+		return nil, expectByte(r, ';')
+	case 'b', 'i', 'd':
+		if err := expectByte(r, ':'); err != nil {
+			return nil, err
+		}
+		token, err := r.ReadString(';')
+		if err != nil {
+			return nil, err
+		}
+		token = strings.TrimSuffix(token, ";")
+		switch kind {
+		case 'b':
+			return token == "1", nil
+		case 'i':
+			return strconv.ParseInt(token, 10, 64)
+		default:
+			return strconv.ParseFloat(token, 64)
+		}
+	case 's':
+		s, err := readPhpString(r)
+		if err != nil {
+			return nil, err
+		}
+		return s, expectByte(r, ';')
+	case 'a':
+		if err := expectByte(r, ':'); err != nil {
+			return nil, err
+		}
+		return readPhpArray(r)
+	case 'O':
+		// the class name is not of interest, only the properties
+		if _, err := readPhpString(r); err != nil {
+			return nil, err
+		}
+		if err := expectByte(r, ':'); err != nil {
+			return nil, err
+		}
+		return readPhpArray(r)
+	}
+	return nil, fmt.Errorf("unsupported PHP serialized type: %q", kind)
+}
+
+// readPhpString reads a length-prefixed, quoted string (e.g. `:5:"redis"`).
+func readPhpString(r *bufio.Reader) (string, error) {
+	if err := expectByte(r, ':'); err != nil {
+		return "", err
+	}
+	length, err := readPhpLength(r)
+	if err != nil {
+		return "", err
+	}
+	if err := expectByte(r, '"'); err != nil {
+		return "", err
+	}
+	var buf strings.Builder
+	if _, err := io.CopyN(&buf, r, int64(length)); err != nil {
+		return "", err
+	}
+	return buf.String(), expectByte(r, '"')
+}
+
+// readPhpArray reads the element count and elements of an array (e.g. `1:{s:4:"name";s:5:"redis";}`).
+func readPhpArray(r *bufio.Reader) (map[string]interface{}, error) {
+	count, err := readPhpLength(r)
+	if err != nil {
+		return nil, err
+	}
+	if err := expectByte(r, '{'); err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]interface{})
+	for i := 0; i < count; i++ {
+		key, err := phpUnserialize(r)
+		if err != nil {
+			return nil, err
+		}
+		value, err := phpUnserialize(r)
+		if err != nil {
+			return nil, err
+		}
+		result[fmt.Sprintf("%v", key)] = value
+	}
+	return result, expectByte(r, '}')
+}
+
+// readPhpLength reads a length terminated by ':' (the terminator is consumed).
+func readPhpLength(r *bufio.Reader) (int, error) {
+	token, err := r.ReadString(':')
+	if err != nil {
+		return 0, err
+	}
+	length, err := strconv.Atoi(strings.TrimSuffix(token, ":"))
+	if err != nil || length < 0 {
+		return 0, fmt.Errorf("invalid PHP serialized length: %q", token)
+	}
+	return length, nil
+}
+
+func expectByte(r *bufio.Reader, expected byte) error {
+	b, err := r.ReadByte()
+	if err != nil {
+		return err
+	}
+	if b != expected {
+		return fmt.Errorf("unexpected character in PHP serialized data: %q (expected %q)", b, expected)
+	}
+	return nil
+}
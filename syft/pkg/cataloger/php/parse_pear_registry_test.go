@@ -0,0 +1,76 @@
+package php
+
+import (
+	"os"
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/go-test/deep"
+)
+
+func TestParsePearRegistry(t *testing.T) {
+	tests := []struct {
+		fixture  string
+		expected []*pkg.Package
+	}{
+		{
+			fixture: "test-fixtures/pecl/usr/local/lib/php/.registry/.channel.pecl.php.net/redis.reg",
+			expected: []*pkg.Package{
+				{
+					Name:         "redis",
+					Version:      "5.3.7",
+					Licenses:     []string{"PHP"},
+					Language:     pkg.PHP,
+					Type:         pkg.PhpPeclPkg,
+					MetadataType: pkg.PhpPeclMetadataType,
+					Metadata: pkg.PhpPeclMetadata{
+						Name:    "redis",
+						Version: "5.3.7",
+						Channel: "pecl.php.net",
+						License: "PHP",
+						Summary: "PHP extension for interfacing with Redis",
+					},
+				},
+			},
+		},
+		{
+			fixture: "test-fixtures/pecl/usr/local/lib/php/.registry/archive_tar.reg",
+			expected: []*pkg.Package{
+				{
+					Name:         "Archive_Tar",
+					Version:      "1.4.14",
+					Licenses:     []string{"New BSD License"},
+					Language:     pkg.PHP,
+					Type:         pkg.PhpPeclPkg,
+					MetadataType: pkg.PhpPeclMetadataType,
+					Metadata: pkg.PhpPeclMetadata{
+						Name:    "Archive_Tar",
+						Version: "1.4.14",
+						Channel: "pear.php.net",
+						License: "New BSD License",
+						Summary: "Tar file management class with compression support (gzip, bzip2, lzma2)",
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.fixture, func(t *testing.T) {
+			fixture, err := os.Open(test.fixture)
+			if err != nil {
+				t.Fatalf("failed to open fixture: %+v", err)
+			}
+			defer fixture.Close()
+
+			actual, _, err := parsePearRegistry(fixture.Name(), fixture)
+			if err != nil {
+				t.Fatalf("failed to parse PEAR registry: %+v", err)
+			}
+
+			for _, d := range deep.Equal(test.expected, actual) {
+				t.Errorf("diff: %+v", d)
+			}
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package php
+
+import (
+	"bytes"
+	"debug/elf"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/common"
+)
+
+// integrity check
+var _ common.ParserFn = parsePhpExtension
+
+const (
+	// zend API numbers are the date the API was introduced (e.g. 20200930 for PHP 8.0)
+	minZendAPI = 20020429
+	maxZendAPI = 20991231
+	// the longest name or version string read from a zend module entry
+	maxModuleEntryStringLen = 256
+)
+
+// parsePhpExtension is a parser function for compiled PHP extensions (e.g.
+// /usr/local/lib/php/extensions/no-debug-non-zts-20200930/redis.so), returning the extension described by the zend
+// module entry compiled into the shared object.
+func parsePhpExtension(path string, reader io.Reader) ([]*pkg.Package, []artifact.Relationship, error) {
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to read PHP extension: %w", err)
+	}
+
+	f, err := elf.NewFile(bytes.NewReader(data))
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to parse PHP extension=%q as an ELF shared object: %w", path, err)
+	}
+	defer f.Close()
+
+	name, version, zendAPI := findZendModuleEntry(f)
+	if name == "" || version == "" {
+		return nil, nil, nil
+	}
+
+	return []*pkg.Package{newPeclPackage(pkg.PhpPeclMetadata{
+		Name:      name,
+		Version:   version,
+		Extension: path,
+		ZendAPI:   zendAPI,
+	})}, nil, nil
+}
+
+// findZendModuleEntry searches the data sections of the given extension for the zend_module_entry returned by the
+// get_module() entrypoint of every PHP extension, returning the module name, version, and zend API number. The entry
+// is recognized by its header:
+//
+//	unsigned short size; unsigned int zend_api; unsigned char zend_debug; unsigned char zts;
+//
+// followed by pointers to the ini entries, dependencies, name, functions, five lifecycle hooks, and the version.
+func findZendModuleEntry(f *elf.File) (string, string, int) {
+	ptrSize := 8
+	if f.Class == elf.ELFCLASS32 {
+		ptrSize = 4
+	}
+	// the header is padded to pointer alignment
+	headerSize := (10 + ptrSize - 1) / ptrSize * ptrSize
+	nameOffset := headerSize + 2*ptrSize
+	versionOffset := headerSize + 9*ptrSize
+
+	relocations := relativeRelocations(f)
+
+	for _, section := range f.Sections {
+		if section.Type != elf.SHT_PROGBITS || section.Flags&elf.SHF_WRITE == 0 {
+			continue
+		}
+		data, err := section.Data()
+		if err != nil {
+			continue
+		}
+
+		for offset := 0; offset+versionOffset+ptrSize <= len(data); offset += ptrSize {
+			size := int(f.ByteOrder.Uint16(data[offset:]))
+			zendAPI := int(f.ByteOrder.Uint32(data[offset+4:]))
+			if zendAPI < minZendAPI || zendAPI > maxZendAPI || size <= versionOffset || size > 1024 {
+				continue
+			}
+
+			pointerAt := func(fieldOffset int) uint64 {
+				addr := section.Addr + uint64(offset+fieldOffset)
+				var value uint64
+				if ptrSize == 8 {
+					value = f.ByteOrder.Uint64(data[offset+fieldOffset:])
+				} else {
+					value = uint64(f.ByteOrder.Uint32(data[offset+fieldOffset:]))
+				}
+				if value == 0 {
+					// position independent code leaves pointers to be filled in by the dynamic linker
+					value = relocations[addr]
+				}
+				return value
+			}
+
+			name := readCString(f, pointerAt(nameOffset))
+			version := readCString(f, pointerAt(versionOffset))
+			if name != "" && version != "" {
+				return name, version, zendAPI
+			}
+		}
+	}
+	return "", "", 0
+}
+
+// relativeRelocations returns the addends of the relative relocations within the given shared object, keyed by the
+// address they apply to.
+func relativeRelocations(f *elf.File) map[uint64]uint64 {
+	relocations := make(map[uint64]uint64)
+	if f.Class != elf.ELFCLASS64 {
+		// 32-bit objects use REL relocations, where the addend is left in place
+		return relocations
+	}
+
+	for _, section := range f.Sections {
+		if section.Type != elf.SHT_RELA {
+			continue
+		}
+		data, err := section.Data()
+		if err != nil {
+			continue
+		}
+
+		var rela elf.Rela64
+		r := bytes.NewReader(data)
+		for binary.Read(r, f.ByteOrder, &rela) == nil {
+			// relative relocations do not reference a symbol
+			if elf.R_SYM64(rela.Info) == 0 && rela.Addend > 0 {
+				relocations[rela.Off] = uint64(rela.Addend)
+			}
+		}
+	}
+	return relocations
+}
+
+// readCString returns the printable, NUL-terminated string at the given virtual address.
+func readCString(f *elf.File, addr uint64) string {
+	if addr == 0 {
+		return ""
+	}
+	for _, section := range f.Sections {
+		if section.Type != elf.SHT_PROGBITS || addr < section.Addr || addr >= section.Addr+section.Size {
+			continue
+		}
+		buf := make([]byte, maxModuleEntryStringLen)
+		n, err := section.ReadAt(buf, int64(addr-section.Addr))
+		if err != nil && err != io.EOF {
+			return ""
+		}
+		end := bytes.IndexByte(buf[:n], 0)
+		if end <= 0 {
+			return ""
+		}
+		for _, c := range buf[:end] {
+			if c < 0x20 || c > 0x7e {
+				return ""
+			}
+		}
+		return string(buf[:end])
+	}
+	return ""
+}
@@ -0,0 +1,52 @@
+package php
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/go-test/deep"
+)
+
+func TestParsePhpExtension(t *testing.T) {
+	// see test-fixtures/pecl/src for how the fixture is built
+	const fixturePath = "test-fixtures/pecl/usr/local/lib/php/extensions/no-debug-non-zts-20200930/redis.so"
+
+	fixture, err := os.Open(fixturePath)
+	if err != nil {
+		t.Fatalf("failed to open fixture: %+v", err)
+	}
+	defer fixture.Close()
+
+	actual, _, err := parsePhpExtension(fixture.Name(), fixture)
+	if err != nil {
+		t.Fatalf("failed to parse PHP extension: %+v", err)
+	}
+
+	expected := []*pkg.Package{
+		{
+			Name:         "redis",
+			Version:      "5.3.7",
+			Language:     pkg.PHP,
+			Type:         pkg.PhpPeclPkg,
+			MetadataType: pkg.PhpPeclMetadataType,
+			Metadata: pkg.PhpPeclMetadata{
+				Name:      "redis",
+				Version:   "5.3.7",
+				Extension: fixturePath,
+				ZendAPI:   20200930,
+			},
+		},
+	}
+
+	for _, d := range deep.Equal(expected, actual) {
+		t.Errorf("diff: %+v", d)
+	}
+}
+
+func TestParsePhpExtension_NotAnExtension(t *testing.T) {
+	if _, _, err := parsePhpExtension("redis.so", strings.NewReader("not an ELF file")); err == nil {
+		t.Errorf("expected an error for a file that is not a shared object")
+	}
+}
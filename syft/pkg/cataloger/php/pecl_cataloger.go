@@ -0,0 +1,127 @@
+package php
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/common"
+	"github.com/anchore/syft/syft/source"
+)
+
+const peclCatalogerName = "php-pecl-cataloger"
+
+var (
+	pearRegistryGlobs = []string{
+		"**/.registry/**/*.reg",
+	}
+	phpExtensionGlobs = []string{
+		// e.g. /usr/local/lib/php/extensions/no-debug-non-zts-20200930/redis.so (official docker images)
+		"**/php/extensions/**/*.so",
+		// e.g. /usr/lib/php/20190902/redis.so (debian)
+		"**/lib/php/*/*.so",
+		// e.g. /usr/lib/php81/modules/redis.so (alpine)
+		"**/lib/php*/modules/*.so",
+	}
+)
+
+type PeclCataloger struct{}
+
+// NewPHPPeclCataloger returns a new cataloger for PHP extensions and libraries installed with PECL/PEAR, as well as
+// compiled PHP extensions installed by other means (e.g. docker-php-ext-install or distro packages).
+func NewPHPPeclCataloger() *PeclCataloger {
+	return &PeclCataloger{}
+}
+
+// Name returns a string that uniquely describes a cataloger
+func (c *PeclCataloger) Name() string {
+	return peclCatalogerName
+}
+
+// Catalog is given an object to resolve file references and content, this function returns any discovered Packages after analyzing PECL/PEAR registries and compiled PHP extensions.
+func (c *PeclCataloger) Catalog(resolver source.FileResolver) ([]pkg.Package, []artifact.Relationship, error) {
+	registryPkgs, err := c.catalogGlobs(resolver, pearRegistryGlobs, parsePearRegistry)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	extensionPkgs, err := c.catalogGlobs(resolver, phpExtensionGlobs, parsePhpExtension)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// extensions installed with PECL are described by both the registry and the compiled extension
+	pkgs := registryPkgs
+	registryIndex := make(map[string]int)
+	for i, p := range registryPkgs {
+		registryIndex[strings.ToLower(p.Name)] = i
+	}
+
+	for _, p := range extensionPkgs {
+		i, exists := registryIndex[strings.ToLower(p.Name)]
+		if !exists {
+			pkgs = append(pkgs, p)
+			continue
+		}
+
+		metadata := pkgs[i].Metadata.(pkg.PhpPeclMetadata)
+		if metadata.Extension != "" {
+			// the registry entry has already been matched (e.g. the same extension built for several PHP versions)
+			pkgs = append(pkgs, p)
+			continue
+		}
+		extension := p.Metadata.(pkg.PhpPeclMetadata)
+		metadata.Extension = extension.Extension
+		metadata.ZendAPI = extension.ZendAPI
+
+		pkgs[i].Metadata = metadata
+		pkgs[i].Locations = append(pkgs[i].Locations, p.Locations...)
+	}
+
+	for i := range pkgs {
+		pkgs[i].FoundBy = c.Name()
+		pkgs[i].SetID()
+	}
+
+	return pkgs, nil, nil
+}
+
+// catalogGlobs returns the packages found by the given parser within all files matching the given globs.
+func (c *PeclCataloger) catalogGlobs(resolver source.FileResolver, globs []string, parser common.ParserFn) ([]pkg.Package, error) {
+	seen := internal.NewStringSet()
+	var pkgs []pkg.Package
+	for _, glob := range globs {
+		locations, err := resolver.FilesByGlob(glob)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find files by glob: %s", glob)
+		}
+
+		for _, location := range locations {
+			if seen.Contains(location.RealPath) {
+				continue
+			}
+			seen.Add(location.RealPath)
+
+			reader, err := resolver.FileContentsByLocation(location)
+			if err != nil {
+				return nil, fmt.Errorf("failed to resolve file contents by location=%q: %w", location.RealPath, err)
+			}
+
+			discoveredPkgs, _, err := parser(location.RealPath, reader)
+			internal.CloseAndLogError(reader, location.RealPath)
+			if err != nil {
+				log.Warnf("cataloger '%s' failed to parse entries at location=%+v: %+v", c.Name(), location, err)
+				continue
+			}
+
+			for _, p := range discoveredPkgs {
+				p.Locations = append(p.Locations, location)
+				pkgs = append(pkgs, *p)
+			}
+		}
+	}
+	return pkgs, nil
+}
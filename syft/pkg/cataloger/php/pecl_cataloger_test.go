@@ -0,0 +1,40 @@
+package php
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/source"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeclCataloger(t *testing.T) {
+	src, err := source.NewFromDirectory("test-fixtures/pecl")
+	require.NoError(t, err)
+
+	resolver, err := src.FileResolver(source.SquashedScope)
+	require.NoError(t, err)
+
+	pkgs, _, err := NewPHPPeclCataloger().Catalog(resolver)
+	require.NoError(t, err)
+	require.Len(t, pkgs, 2)
+
+	byName := make(map[string]pkg.Package)
+	for _, p := range pkgs {
+		byName[p.Name] = p
+	}
+
+	// the registry entry and the compiled extension describe the same package
+	redis := byName["redis"]
+	assert.Equal(t, "5.3.7", redis.Version)
+	assert.Len(t, redis.Locations, 2)
+	metadata := redis.Metadata.(pkg.PhpPeclMetadata)
+	assert.Equal(t, "pecl.php.net", metadata.Channel)
+	assert.Equal(t, "usr/local/lib/php/extensions/no-debug-non-zts-20200930/redis.so", metadata.Extension)
+	assert.Equal(t, 20200930, metadata.ZendAPI)
+
+	archiveTar := byName["Archive_Tar"]
+	assert.Equal(t, "1.4.14", archiveTar.Version)
+	assert.Len(t, archiveTar.Locations, 1)
+}
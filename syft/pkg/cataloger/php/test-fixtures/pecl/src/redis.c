@@ -0,0 +1,42 @@
+/* a stand-in for a compiled PECL extension: only the zend module entry (as laid out by PHP 7/8) is of interest */
+#include <stddef.h>
+
+typedef struct _zend_module_entry {
+	unsigned short size;
+	unsigned int zend_api;
+	unsigned char zend_debug;
+	unsigned char zts;
+	const void *ini_entry;
+	const void *deps;
+	const char *name;
+	const void *functions;
+	void *module_startup_func;
+	void *module_shutdown_func;
+	void *request_startup_func;
+	void *request_shutdown_func;
+	void *info_func;
+	const char *version;
+	size_t globals_size;
+	void *globals_ptr;
+	void *globals_ctor;
+	void *globals_dtor;
+	void *post_deactivate_func;
+	int module_started;
+	unsigned char type;
+	void *handle;
+	int module_number;
+	const char *build_id;
+} zend_module_entry;
+
+zend_module_entry redis_module_entry = {
+	sizeof(zend_module_entry), 20200930, 0, 0,
+	NULL, NULL, "redis", NULL,
+	NULL, NULL, NULL, NULL, NULL,
+	"5.3.7",
+	0, NULL, NULL, NULL, NULL, 0, 0, NULL, 0,
+	"API20200930,NTS"
+};
+
+zend_module_entry *get_module(void) {
+	return &redis_module_entry;
+}
@@ -0,0 +1,12 @@
+package python
+
+type Config struct {
+	GuessUnpinnedRequirements bool // catalog requirements that are not pinned to a version, using the lowest version allowed by the constraint (if any)
+}
+
+// ZipappConfig bounds the resources used when extracting zipapps (and the wheels bundled within them).
+type ZipappConfig struct {
+	MaxArchiveNestingDepth     int   // the max depth of archives within archives to process (0 = unlimited)
+	MaxArchiveDecompressedSize int64 // the max bytes to extract from a zipapp, including all bundled wheels (0 = unlimited)
+	MaxArchiveFileSize         int64 // the max bytes to extract for any single entry within an archive (0 = 2GB)
+}
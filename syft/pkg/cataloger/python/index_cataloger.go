@@ -8,9 +8,9 @@ import (
 )
 
 // NewPythonIndexCataloger returns a new cataloger for python packages referenced from poetry lock files, requirements.txt files, and setup.py files.
-func NewPythonIndexCataloger() *common.GenericCataloger {
+func NewPythonIndexCataloger(cfg Config) *common.GenericCataloger {
 	globParsers := map[string]common.ParserFn{
-		"**/*requirements*.txt": parseRequirementsTxt,
+		"**/*requirements*.txt": newRequirementsTxtParser(cfg).parse,
 		"**/poetry.lock":        parsePoetryLock,
 		"**/Pipfile.lock":       parsePipfileLock,
 		"**/setup.py":           parseSetup,
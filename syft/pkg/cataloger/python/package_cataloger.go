@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"path/filepath"
+	"strings"
 
 	"github.com/anchore/syft/internal"
 
@@ -46,8 +47,9 @@ func (c *PackageCataloger) Catalog(resolver source.FileResolver) ([]pkg.Package,
 	}
 
 	var pkgs []pkg.Package
+	envs := newVirtualEnvs(resolver)
 	for _, location := range fileMatches {
-		p, err := c.catalogEggOrWheel(resolver, location)
+		p, err := c.catalogEggOrWheel(resolver, envs, location)
 		if err != nil {
 			return nil, nil, fmt.Errorf("unable to catalog python package=%+v: %w", location.RealPath, err)
 		}
@@ -59,8 +61,8 @@ func (c *PackageCataloger) Catalog(resolver source.FileResolver) ([]pkg.Package,
 }
 
 // catalogEggOrWheel takes the primary metadata file reference and returns the python package it represents.
-func (c *PackageCataloger) catalogEggOrWheel(resolver source.FileResolver, metadataLocation source.Location) (*pkg.Package, error) {
-	metadata, sources, err := c.assembleEggOrWheelMetadata(resolver, metadataLocation)
+func (c *PackageCataloger) catalogEggOrWheel(resolver source.FileResolver, envs *virtualEnvs, metadataLocation source.Location) (*pkg.Package, error) {
+	metadata, originURLs, sources, err := c.assembleEggOrWheelMetadata(resolver, envs, metadataLocation)
 	if err != nil {
 		return nil, err
 	}
@@ -84,6 +86,7 @@ func (c *PackageCataloger) catalogEggOrWheel(resolver source.FileResolver, metad
 		Licenses:     licenses,
 		Language:     pkg.Python,
 		Type:         pkg.PythonPkg,
+		OriginURLs:   originURLs,
 		MetadataType: pkg.PythonPackageMetadataType,
 		Metadata:     *metadata,
 	}
@@ -188,25 +191,25 @@ func (c *PackageCataloger) fetchDirectURLData(resolver source.FileResolver, meta
 	}, sources, nil
 }
 
-// assembleEggOrWheelMetadata discovers and accumulates python package metadata from multiple file sources and returns a single metadata object as well as a list of files where the metadata was derived from.
-func (c *PackageCataloger) assembleEggOrWheelMetadata(resolver source.FileResolver, metadataLocation source.Location) (*pkg.PythonPackageMetadata, []source.Location, error) {
+// assembleEggOrWheelMetadata discovers and accumulates python package metadata from multiple file sources and returns a single metadata object, the URLs the package originates from, as well as a list of files where the metadata was derived from.
+func (c *PackageCataloger) assembleEggOrWheelMetadata(resolver source.FileResolver, envs *virtualEnvs, metadataLocation source.Location) (*pkg.PythonPackageMetadata, pkg.OriginURLs, []source.Location, error) {
 	var sources = []source.Location{metadataLocation}
 
 	metadataContents, err := resolver.FileContentsByLocation(metadataLocation)
 	if err != nil {
-		return nil, nil, err
+		return nil, pkg.OriginURLs{}, nil, err
 	}
 	defer internal.CloseAndLogError(metadataContents, metadataLocation.VirtualPath)
 
-	metadata, err := parseWheelOrEggMetadata(metadataLocation.RealPath, metadataContents)
+	metadata, originURLs, err := parseWheelOrEggMetadata(metadataLocation.RealPath, metadataContents)
 	if err != nil {
-		return nil, nil, err
+		return nil, pkg.OriginURLs{}, nil, err
 	}
 
 	// attach any python files found for the given wheel/egg installation
 	r, s, err := c.fetchRecordFiles(resolver, metadataLocation)
 	if err != nil {
-		return nil, nil, err
+		return nil, pkg.OriginURLs{}, nil, err
 	}
 	sources = append(sources, s...)
 	metadata.Files = r
@@ -214,7 +217,7 @@ func (c *PackageCataloger) assembleEggOrWheelMetadata(resolver source.FileResolv
 	// attach any top-level package names found for the given wheel/egg installation
 	p, s, err := c.fetchTopLevelPackages(resolver, metadataLocation)
 	if err != nil {
-		return nil, nil, err
+		return nil, pkg.OriginURLs{}, nil, err
 	}
 	sources = append(sources, s...)
 	metadata.TopLevelPackages = p
@@ -222,10 +225,31 @@ func (c *PackageCataloger) assembleEggOrWheelMetadata(resolver source.FileResolv
 	// attach any direct-url package data found for the given wheel/egg installation
 	d, s, err := c.fetchDirectURLData(resolver, metadataLocation)
 	if err != nil {
-		return nil, nil, err
+		return nil, pkg.OriginURLs{}, nil, err
 	}
 	sources = append(sources, s...)
 	metadata.DirectURLOrigin = d
+	if d != nil {
+		// the package was installed directly from a VCS repository or archive rather than from an index
+		switch {
+		case d.VCS != "":
+			originURLs.Repository = d.VCS + "+" + d.URL
+		case !strings.HasPrefix(d.URL, "file:"):
+			originURLs.Download = d.URL
+		}
+	}
+
+	// attach the virtual environment (and the interpreter it links against) that the package is installed within, so
+	// that the same package installed into several environments can be told apart
+	env, envLocation, err := envs.find(metadataLocation, metadata.SitePackagesRootPath)
+	if err != nil {
+		return nil, pkg.OriginURLs{}, nil, err
+	}
+	if envLocation != nil {
+		sources = append(sources, *envLocation)
+	}
+	metadata.VirtualEnv = env
+	metadata.Runtime = pythonRuntime(metadata.SitePackagesRootPath, env)
 
-	return &metadata, sources, nil
+	return &metadata, originURLs, sources, nil
 }
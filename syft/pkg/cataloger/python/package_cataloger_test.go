@@ -47,6 +47,9 @@ func TestPythonPackageWheelCataloger(t *testing.T) {
 					},
 					TopLevelPackages: []string{"requests"},
 				},
+				OriginURLs: pkg.OriginURLs{
+					Homepage: "http://python-requests.org",
+				},
 			},
 		},
 		{
@@ -85,6 +88,10 @@ func TestPythonPackageWheelCataloger(t *testing.T) {
 					TopLevelPackages: []string{"pygments", "something_else"},
 					DirectURLOrigin:  &pkg.PythonDirectURLOriginInfo{URL: "https://github.com/python-test/test.git", VCS: "git", CommitID: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"},
 				},
+				OriginURLs: pkg.OriginURLs{
+					Homepage:   "https://pygments.org/",
+					Repository: "git+https://github.com/python-test/test.git",
+				},
 			},
 		},
 		{
@@ -109,6 +116,9 @@ func TestPythonPackageWheelCataloger(t *testing.T) {
 					AuthorEmail:          "georg@python.org",
 					SitePackagesRootPath: "test-fixtures",
 				},
+				OriginURLs: pkg.OriginURLs{
+					Homepage: "https://pygments.org/",
+				},
 			},
 		},
 		{
@@ -131,6 +141,9 @@ func TestPythonPackageWheelCataloger(t *testing.T) {
 					AuthorEmail:          "me@kennethreitz.org",
 					SitePackagesRootPath: "test-fixtures",
 				},
+				OriginURLs: pkg.OriginURLs{
+					Homepage: "http://python-requests.org",
+				},
 			},
 		},
 	}
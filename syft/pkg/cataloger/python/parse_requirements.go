@@ -12,11 +12,22 @@ import (
 )
 
 // integrity check
-var _ common.ParserFn = parseRequirementsTxt
+var _ common.ParserFn = requirementsTxtParser{}.parse
 
-// parseRequirementsTxt takes a Python requirements.txt file, returning all Python packages that are locked to a
-// specific version.
-func parseRequirementsTxt(_ string, reader io.Reader) ([]*pkg.Package, []artifact.Relationship, error) {
+// requirementsTxtParser parses Python requirements.txt files.
+type requirementsTxtParser struct {
+	guessUnpinnedRequirements bool
+}
+
+func newRequirementsTxtParser(cfg Config) requirementsTxtParser {
+	return requirementsTxtParser{
+		guessUnpinnedRequirements: cfg.GuessUnpinnedRequirements,
+	}
+}
+
+// parse takes a Python requirements.txt file, returning all Python packages that are locked to a specific version
+// (along with unpinned requirements, when configured to guess their versions).
+func (p requirementsTxtParser) parse(_ string, reader io.Reader) ([]*pkg.Package, []artifact.Relationship, error) {
 	packages := make([]*pkg.Package, 0)
 
 	scanner := bufio.NewScanner(reader)
@@ -35,7 +46,11 @@ func parseRequirementsTxt(_ string, reader io.Reader) ([]*pkg.Package, []artifac
 		case len(strings.Split(line, "==")) < 2:
 			// a package without a version, or a range (unpinned) which
 			// does not tell us exactly what will be installed
-			// XXX only needed if we want to log this, otherwise the next case catches it
+			if p.guessUnpinnedRequirements {
+				if unpinned := parseUnpinnedRequirement(line); unpinned != nil {
+					packages = append(packages, unpinned)
+				}
+			}
 			continue
 		case len(strings.Split(line, "==")) == 2:
 			// remove comments if present
@@ -62,6 +77,51 @@ func parseRequirementsTxt(_ string, reader io.Reader) ([]*pkg.Package, []artifac
 	return packages, nil, nil
 }
 
+// parseUnpinnedRequirement returns the package for a requirement that is not pinned to a version (e.g. "requests" or
+// "sqlalchemy >= 1.0.0, < 2"), where the version is guessed as the lower bound of the constraint (when there is one).
+func parseUnpinnedRequirement(line string) *pkg.Package {
+	requirement := strings.TrimSpace(removeTrailingComment(line))
+	if requirement == "" || strings.HasPrefix(requirement, "-") {
+		// options such as "-r other-requirements.txt" or "--index-url ..."
+		return nil
+	}
+
+	// environment markers do not affect the version
+	requirement = strings.TrimSpace(strings.SplitN(requirement, ";", 2)[0])
+
+	nameEnd := strings.IndexAny(requirement, "<>=!~[ ")
+	if nameEnd == -1 {
+		nameEnd = len(requirement)
+	}
+	name := requirement[:nameEnd]
+	if name == "" || strings.Contains(name, "/") {
+		// URLs and paths do not name a package
+		return nil
+	}
+
+	var version string
+	constraints := requirement[nameEnd:]
+	if end := strings.Index(constraints, "]"); end != -1 {
+		// skip any extras (e.g. "requests[security] >= 2.8.1")
+		constraints = constraints[end+1:]
+	}
+	for _, constraint := range strings.Split(constraints, ",") {
+		constraint = strings.TrimSpace(constraint)
+		for _, operator := range []string{">=", "~="} {
+			if strings.HasPrefix(constraint, operator) {
+				version = strings.TrimSpace(strings.TrimPrefix(constraint, operator))
+			}
+		}
+	}
+
+	return &pkg.Package{
+		Name:     name,
+		Version:  version,
+		Language: pkg.Python,
+		Type:     pkg.PythonPkg,
+	}
+}
+
 // removeTrailingComment takes a requirements.txt line and strips off comment strings.
 func removeTrailingComment(line string) string {
 	parts := strings.Split(line, "#")
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/go-test/deep"
 
@@ -51,7 +52,7 @@ func TestParseRequirementsTxt(t *testing.T) {
 	}
 
 	// TODO: no relationships are under test yet
-	actual, _, err := parseRequirementsTxt(fixture.Name(), fixture)
+	actual, _, err := requirementsTxtParser{}.parse(fixture.Name(), fixture)
 	if err != nil {
 		t.Fatalf("failed to parse requirements: %+v", err)
 	}
@@ -59,3 +60,68 @@ func TestParseRequirementsTxt(t *testing.T) {
 	assertPackagesEqual(t, actual, expected)
 
 }
+
+func TestParseRequirementsTxt_GuessUnpinnedRequirements(t *testing.T) {
+	expected := map[string]pkg.Package{
+		"foo": {
+			Name:     "foo",
+			Version:  "1.0.0",
+			Language: pkg.Python,
+			Type:     pkg.PythonPkg,
+		},
+		"flask": {
+			Name:     "flask",
+			Version:  "4.0.0",
+			Language: pkg.Python,
+			Type:     pkg.PythonPkg,
+		},
+		"sqlalchemy": {
+			Name:     "sqlalchemy",
+			Version:  "1.0.0",
+			Language: pkg.Python,
+			Type:     pkg.PythonPkg,
+		},
+	}
+	fixture, err := os.Open("test-fixtures/requires/requirements.txt")
+	if err != nil {
+		t.Fatalf("failed to open fixture: %+v", err)
+	}
+
+	parser := newRequirementsTxtParser(Config{GuessUnpinnedRequirements: true})
+	actual, _, err := parser.parse(fixture.Name(), fixture)
+	if err != nil {
+		t.Fatalf("failed to parse requirements: %+v", err)
+	}
+
+	assertPackagesEqual(t, actual, expected)
+}
+
+func TestParseUnpinnedRequirement(t *testing.T) {
+	tests := []struct {
+		line     string
+		name     string
+		version  string
+		excluded bool
+	}{
+		{line: "requests", name: "requests"},
+		{line: "requests >= 2.8.1, < 3", name: "requests", version: "2.8.1"},
+		{line: "requests[security]>=2.8.1 # a comment", name: "requests", version: "2.8.1"},
+		{line: "django~=3.2", name: "django", version: "3.2"},
+		{line: "pywin32 > 1.0; sys_platform == 'win32'", name: "pywin32"},
+		{line: "-r other-requirements.txt", excluded: true},
+		{line: "https://example.com/archive.zip", excluded: true},
+		{line: "   ", excluded: true},
+	}
+	for _, test := range tests {
+		t.Run(test.line, func(t *testing.T) {
+			actual := parseUnpinnedRequirement(test.line)
+			if test.excluded {
+				assert.Nil(t, actual)
+				return
+			}
+			require.NotNil(t, actual)
+			assert.Equal(t, test.name, actual.Name)
+			assert.Equal(t, test.version, actual.Version)
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/anchore/syft/internal"
 	"github.com/anchore/syft/internal/file"
 	"github.com/anchore/syft/internal/log"
 
@@ -15,10 +16,15 @@ import (
 	"github.com/anchore/syft/syft/pkg"
 )
 
+// project URL labels conventionally used for the source code repository (see
+// https://packaging.python.org/specifications/core-metadata/#project-url-multiple-use)
+var repositoryProjectURLLabels = internal.NewStringSetFromSlice([]string{"source", "source code", "repository", "code", "github", "gitlab"})
+
 // parseWheelOrEggMetadata takes a Python Egg or Wheel (which share the same format and values for our purposes),
-// returning all Python packages listed.
-func parseWheelOrEggMetadata(path string, reader io.Reader) (pkg.PythonPackageMetadata, error) {
+// returning all Python packages listed along with the URLs the package originates from.
+func parseWheelOrEggMetadata(path string, reader io.Reader) (pkg.PythonPackageMetadata, pkg.OriginURLs, error) {
 	fields := make(map[string]string)
+	var projectURLs []string
 	var key string
 
 	scanner := bufio.NewScanner(reader)
@@ -44,7 +50,7 @@ func parseWheelOrEggMetadata(path string, reader io.Reader) (pkg.PythonPackageMe
 			// a field-body continuation
 			updatedValue, err := handleFieldBodyContinuation(key, line, fields)
 			if err != nil {
-				return pkg.PythonPackageMetadata{}, err
+				return pkg.PythonPackageMetadata{}, pkg.OriginURLs{}, err
 			}
 
 			fields[key] = updatedValue
@@ -55,6 +61,11 @@ func parseWheelOrEggMetadata(path string, reader io.Reader) (pkg.PythonPackageMe
 				key = strings.ReplaceAll(strings.TrimSpace(line[0:i]), "-", "")
 				val := strings.TrimSpace(line[i+1:])
 
+				// project URLs are the only field of interest which may be specified multiple times
+				if key == "ProjectURL" {
+					projectURLs = append(projectURLs, val)
+				}
+
 				fields[key] = val
 			} else {
 				log.Warnf("cannot parse field from path: %q from line: %q", path, line)
@@ -63,19 +74,53 @@ func parseWheelOrEggMetadata(path string, reader io.Reader) (pkg.PythonPackageMe
 	}
 
 	if err := scanner.Err(); err != nil {
-		return pkg.PythonPackageMetadata{}, fmt.Errorf("failed to parse python wheel/egg: %w", err)
+		return pkg.PythonPackageMetadata{}, pkg.OriginURLs{}, fmt.Errorf("failed to parse python wheel/egg: %w", err)
 	}
 
 	var metadata pkg.PythonPackageMetadata
 	if err := mapstructure.Decode(fields, &metadata); err != nil {
-		return pkg.PythonPackageMetadata{}, fmt.Errorf("unable to parse APK metadata: %w", err)
+		return pkg.PythonPackageMetadata{}, pkg.OriginURLs{}, fmt.Errorf("unable to parse APK metadata: %w", err)
 	}
 
 	// add additional metadata not stored in the egg/wheel metadata file
 
 	metadata.SitePackagesRootPath = determineSitePackagesRootPath(path)
 
-	return metadata, nil
+	return metadata, originURLsFromMetadataFields(fields, projectURLs), nil
+}
+
+// originURLsFromMetadataFields returns the homepage, repository, and download URLs declared within the given metadata
+// fields and "Project-URL" values (e.g. "Source, https://github.com/pallets/flask/").
+func originURLsFromMetadataFields(fields map[string]string, projectURLs []string) pkg.OriginURLs {
+	urls := pkg.OriginURLs{
+		Homepage: noneIfUnknown(fields["Homepage"]),
+		Download: noneIfUnknown(fields["DownloadURL"]),
+	}
+
+	for _, projectURL := range projectURLs {
+		parts := strings.SplitN(projectURL, ",", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		label := strings.ToLower(strings.TrimSpace(parts[0]))
+		value := strings.TrimSpace(parts[1])
+
+		switch {
+		case urls.Homepage == "" && (label == "homepage" || label == "home"):
+			urls.Homepage = value
+		case urls.Repository == "" && repositoryProjectURLLabels.Contains(label):
+			urls.Repository = value
+		}
+	}
+	return urls
+}
+
+// noneIfUnknown returns an empty string for the placeholder value setuptools writes for unspecified fields.
+func noneIfUnknown(value string) string {
+	if value == "UNKNOWN" {
+		return ""
+	}
+	return value
 }
 
 // isEggRegularFile determines if the specified path is the regular file variant
@@ -10,8 +10,9 @@ import (
 
 func TestParseWheelEggMetadata(t *testing.T) {
 	tests := []struct {
-		Fixture          string
-		ExpectedMetadata pkg.PythonPackageMetadata
+		Fixture            string
+		ExpectedMetadata   pkg.PythonPackageMetadata
+		ExpectedOriginURLs pkg.OriginURLs
 	}{
 		{
 			Fixture: "test-fixtures/egg-info/PKG-INFO",
@@ -24,6 +25,9 @@ func TestParseWheelEggMetadata(t *testing.T) {
 				AuthorEmail:          "me@kennethreitz.org",
 				SitePackagesRootPath: "test-fixtures",
 			},
+			ExpectedOriginURLs: pkg.OriginURLs{
+				Homepage: "http://python-requests.org",
+			},
 		},
 		{
 			Fixture: "test-fixtures/dist-info/METADATA",
@@ -36,6 +40,9 @@ func TestParseWheelEggMetadata(t *testing.T) {
 				AuthorEmail:          "georg@python.org",
 				SitePackagesRootPath: "test-fixtures",
 			},
+			ExpectedOriginURLs: pkg.OriginURLs{
+				Homepage: "https://pygments.org/",
+			},
 		},
 	}
 
@@ -46,7 +53,7 @@ func TestParseWheelEggMetadata(t *testing.T) {
 				t.Fatalf("failed to open fixture: %+v", err)
 			}
 
-			actual, err := parseWheelOrEggMetadata(test.Fixture, fixture)
+			actual, actualOriginURLs, err := parseWheelOrEggMetadata(test.Fixture, fixture)
 			if err != nil {
 				t.Fatalf("failed to parse: %+v", err)
 			}
@@ -54,6 +61,56 @@ func TestParseWheelEggMetadata(t *testing.T) {
 			for _, d := range deep.Equal(actual, test.ExpectedMetadata) {
 				t.Errorf("diff: %+v", d)
 			}
+			for _, d := range deep.Equal(actualOriginURLs, test.ExpectedOriginURLs) {
+				t.Errorf("origin URLs diff: %+v", d)
+			}
+		})
+	}
+}
+
+func TestOriginURLsFromMetadataFields(t *testing.T) {
+	tests := []struct {
+		name        string
+		fields      map[string]string
+		projectURLs []string
+		expected    pkg.OriginURLs
+	}{
+		{
+			name: "home page and download URL",
+			fields: map[string]string{
+				"Homepage":    "https://palletsprojects.com/p/flask",
+				"DownloadURL": "https://pypi.org/project/Flask/#files",
+			},
+			expected: pkg.OriginURLs{
+				Homepage: "https://palletsprojects.com/p/flask",
+				Download: "https://pypi.org/project/Flask/#files",
+			},
+		},
+		{
+			name: "project URLs",
+			fields: map[string]string{
+				"Homepage": "UNKNOWN",
+			},
+			projectURLs: []string{
+				"Documentation, https://flask.palletsprojects.com/",
+				"Code of Conduct, https://palletsprojects.com/conduct/",
+				"Source Code, https://github.com/pallets/flask/",
+				"Homepage, https://palletsprojects.com/p/flask",
+				"malformed",
+			},
+			expected: pkg.OriginURLs{
+				Homepage:   "https://palletsprojects.com/p/flask",
+				Repository: "https://github.com/pallets/flask/",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := originURLsFromMetadataFields(test.fields, test.projectURLs)
+			for _, d := range deep.Equal(actual, test.expected) {
+				t.Errorf("diff: %+v", d)
+			}
 		})
 	}
 }
@@ -140,7 +197,7 @@ func TestParseWheelEggMetadataInvalid(t *testing.T) {
 				t.Fatalf("failed to open fixture: %+v", err)
 			}
 
-			actual, err := parseWheelOrEggMetadata(test.Fixture, fixture)
+			actual, _, err := parseWheelOrEggMetadata(test.Fixture, fixture)
 			if err != nil {
 				t.Fatalf("failed to parse: %+v", err)
 			}
@@ -0,0 +1,194 @@
+package python
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/anchore/syft/internal/file"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/common"
+)
+
+// integrity check
+var _ common.ParserFn = parseZipapp
+
+const wheelFileGlob = "**/*.whl"
+
+// parseZipapp is a parser function for python zipapps (.pyz), PEX files, and shiv archives, returning the python
+// packages bundled within. All of these are zip archives (usually with a shebang prepended) that carry their
+// dependencies either as installed wheels (shiv's site-packages/, PEX's .deps/<wheel>/) or as packed wheel files.
+func parseZipapp(virtualPath string, reader io.Reader) ([]*pkg.Package, []artifact.Relationship, error) {
+	return parseZipappWithLimits(virtualPath, reader, file.NewReadBudget(0, 0), true)
+}
+
+// parseZipappWithLimits is a parser function for python zipapps that stops extracting once the given budget has been
+// used up. Packed wheels within the zipapp are only searched when searchWheels is set.
+func parseZipappWithLimits(virtualPath string, reader io.Reader, budget *file.ReadBudget, searchWheels bool) ([]*pkg.Package, []artifact.Relationship, error) {
+	tempDir, err := ioutil.TempDir("", "syft-zipapp-contents-")
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to create tempdir for zipapp processing: %w", err)
+	}
+	defer func() {
+		if err := os.RemoveAll(tempDir); err != nil {
+			log.Errorf("unable to cleanup zipapp tempdir: %+v", err)
+		}
+	}()
+
+	archivePath, err := saveZipappToTmp(tempDir, path.Base(virtualPath), reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pkgs, err := discoverZipappPackages(archivePath, tempDir, "", budget, searchWheels)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to catalog python zipapp=%q: %w", virtualPath, err)
+	}
+	return pkgs, nil, nil
+}
+
+func saveZipappToTmp(dir, name string, reader io.Reader) (string, error) {
+	archiveFile, err := ioutil.TempFile(dir, "archive-"+name+"-")
+	if err != nil {
+		return "", fmt.Errorf("unable to create archive: %w", err)
+	}
+	defer archiveFile.Close()
+
+	if _, err := io.Copy(archiveFile, reader); err != nil {
+		return "", fmt.Errorf("unable to copy archive: %w", err)
+	}
+	return archiveFile.Name(), nil
+}
+
+// discoverZipappPackages returns the packages described by wheel and egg metadata within the given zip archive. When
+// searchWheels is set, packed wheels found within the archive are opened and searched as well (but no deeper).
+func discoverZipappPackages(archivePath, tempDir, pathPrefix string, budget *file.ReadBudget, searchWheels bool) ([]*pkg.Package, error) {
+	// we use our zip helper functions instead of the standard lib since zipapps usually have a shebang prepended
+	manifest, err := file.NewZipFileManifest(archivePath)
+	if err != nil {
+		return nil, err
+	}
+
+	metadataPaths := manifest.GlobMatch(wheelMetadataGlob, eggMetadataGlob)
+	extractPaths := metadataPaths
+	for _, metadataPath := range metadataPaths {
+		for _, name := range []string{"RECORD", "top_level.txt"} {
+			if _, exists := manifest[path.Join(path.Dir(metadataPath), name)]; exists {
+				extractPaths = append(extractPaths, path.Join(path.Dir(metadataPath), name))
+			}
+		}
+	}
+
+	contents, err := file.ContentsFromZip(archivePath, budget, extractPaths...)
+	if err != nil {
+		return nil, err
+	}
+
+	var pkgs []*pkg.Package
+	for _, metadataPath := range metadataPaths {
+		p, err := newZipappPackage(path.Join(pathPrefix, metadataPath), metadataPath, contents)
+		if err != nil {
+			return nil, err
+		}
+		if p != nil {
+			pkgs = append(pkgs, p)
+		}
+	}
+
+	var wheelPaths []string
+	for _, wheelPath := range manifest.GlobMatch(wheelFileGlob) {
+		// PEX files may hold wheels as installed directories named after the wheel file, which were handled above
+		if !manifest[wheelPath].IsDir() {
+			wheelPaths = append(wheelPaths, wheelPath)
+		}
+	}
+
+	if !searchWheels {
+		if pathPrefix == "" && len(wheelPaths) > 0 {
+			log.Warnf("skipping %d python wheels bundled within a zipapp: max archive nesting depth reached", len(wheelPaths))
+		}
+		return pkgs, nil
+	}
+
+	openers, err := file.ExtractFromZipToUniqueTempFile(archivePath, tempDir, budget, wheelPaths...)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, wheelPath := range wheelPaths {
+		wheelPkgs, err := discoverPackedWheelPackages(wheelPath, openers[wheelPath], tempDir, budget)
+		if err != nil {
+			// a single corrupt wheel should not prevent cataloging the remainder of the zipapp
+			log.Warnf("unable to catalog bundled python wheel=%q: %+v", wheelPath, err)
+			continue
+		}
+		pkgs = append(pkgs, wheelPkgs...)
+	}
+
+	return pkgs, nil
+}
+
+func discoverPackedWheelPackages(wheelPath string, opener file.Opener, tempDir string, budget *file.ReadBudget) ([]*pkg.Package, error) {
+	reader, err := opener.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	archivePath, err := saveZipappToTmp(tempDir, path.Base(wheelPath), reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return discoverZipappPackages(archivePath, tempDir, wheelPath, budget, false)
+}
+
+// newZipappPackage creates a python package from the metadata file at the given path within the archive contents,
+// enriching it with the sibling RECORD and top_level.txt files when present.
+func newZipappPackage(virtualPath, metadataPath string, contents map[string]string) (*pkg.Package, error) {
+	metadata, originURLs, err := parseWheelOrEggMetadata(virtualPath, strings.NewReader(contents[metadataPath]))
+	if err != nil {
+		return nil, err
+	}
+
+	// see catalogEggOrWheel: the python runtime itself is not a package
+	if metadata.Name == "" || metadata.Name == "Python" {
+		return nil, nil
+	}
+
+	if record, exists := contents[path.Join(path.Dir(metadataPath), "RECORD")]; exists {
+		metadata.Files, err = parseWheelOrEggRecord(strings.NewReader(record))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if topLevel, exists := contents[path.Join(path.Dir(metadataPath), "top_level.txt")]; exists {
+		for _, line := range strings.Split(topLevel, "\n") {
+			if line = strings.TrimSpace(line); line != "" {
+				metadata.TopLevelPackages = append(metadata.TopLevelPackages, line)
+			}
+		}
+	}
+
+	var licenses []string
+	if metadata.License != "" {
+		licenses = []string{metadata.License}
+	}
+
+	return &pkg.Package{
+		Name:         metadata.Name,
+		Version:      metadata.Version,
+		Licenses:     licenses,
+		Language:     pkg.Python,
+		Type:         pkg.PythonPkg,
+		OriginURLs:   originURLs,
+		MetadataType: pkg.PythonPackageMetadataType,
+		Metadata:     metadata,
+	}, nil
+}
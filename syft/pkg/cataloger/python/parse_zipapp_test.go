@@ -0,0 +1,134 @@
+package python
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/anchore/syft/internal/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/go-test/deep"
+)
+
+func TestParseZipapp(t *testing.T) {
+	tests := []struct {
+		fixture  string
+		expected []*pkg.Package
+	}{
+		{
+			// shiv archives carry an installed site-packages directory
+			fixture: "test-fixtures/zipapp/app.pyz",
+			expected: []*pkg.Package{
+				{
+					Name:         "six",
+					Version:      "1.16.0",
+					Licenses:     []string{"MIT"},
+					Language:     pkg.Python,
+					Type:         pkg.PythonPkg,
+					MetadataType: pkg.PythonPackageMetadataType,
+					Metadata: pkg.PythonPackageMetadata{
+						Name:        "six",
+						Version:     "1.16.0",
+						License:     "MIT",
+						Author:      "Benjamin Peterson",
+						AuthorEmail: "benjamin@python.org",
+						Platform:    "UNKNOWN",
+						Files: []pkg.PythonFileRecord{
+							{Path: "six.py", Digest: &pkg.PythonFileDigest{Algorithm: "sha256", Value: "TOOfQi7nFGfMrIvtdr6wX4wyHH8M7aknmuLfo2cBBrM"}, Size: "34549"},
+							{Path: "six-1.16.0.dist-info/METADATA", Digest: &pkg.PythonFileDigest{Algorithm: "sha256", Value: "VQcGIFCAEmfZcl77E5riPCN4v2TIsc_qtacnjxKHJoI"}, Size: "1795"},
+							{Path: "six-1.16.0.dist-info/RECORD"},
+						},
+						SitePackagesRootPath: "site-packages",
+						TopLevelPackages:     []string{"six"},
+					},
+				},
+			},
+		},
+		{
+			// PEX files carry both installed wheel directories and packed wheel files under .deps/
+			fixture: "test-fixtures/zipapp/app.pex",
+			expected: []*pkg.Package{
+				{
+					Name:         "requests",
+					Version:      "2.26.0",
+					Licenses:     []string{"Apache 2.0"},
+					Language:     pkg.Python,
+					Type:         pkg.PythonPkg,
+					MetadataType: pkg.PythonPackageMetadataType,
+					Metadata: pkg.PythonPackageMetadata{
+						Name:                 "requests",
+						Version:              "2.26.0",
+						License:              "Apache 2.0",
+						Author:               "Kenneth Reitz",
+						AuthorEmail:          "me@kennethreitz.org",
+						Platform:             "UNKNOWN",
+						SitePackagesRootPath: ".deps/requests-2.26.0-py2.py3-none-any.whl",
+						TopLevelPackages:     []string{"requests"},
+					},
+				},
+				{
+					Name:         "attrs",
+					Version:      "21.2.0",
+					Licenses:     []string{"MIT"},
+					Language:     pkg.Python,
+					Type:         pkg.PythonPkg,
+					MetadataType: pkg.PythonPackageMetadataType,
+					Metadata: pkg.PythonPackageMetadata{
+						Name:                 "attrs",
+						Version:              "21.2.0",
+						License:              "MIT",
+						Author:               "Hynek Schlawack",
+						AuthorEmail:          "hs@ox.cx",
+						Platform:             "UNKNOWN",
+						SitePackagesRootPath: ".deps/attrs-21.2.0-py2.py3-none-any.whl",
+						TopLevelPackages:     []string{"attrs"},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.fixture, func(t *testing.T) {
+			fixture, err := os.Open(test.fixture)
+			if err != nil {
+				t.Fatalf("failed to open fixture: %+v", err)
+			}
+			defer fixture.Close()
+
+			actual, _, err := parseZipapp(fixture.Name(), fixture)
+			if err != nil {
+				t.Fatalf("failed to parse zipapp: %+v", err)
+			}
+
+			for _, d := range deep.Equal(test.expected, actual) {
+				t.Errorf("diff: %+v", d)
+			}
+		})
+	}
+}
+
+func TestParseZipapp_NotAnArchive(t *testing.T) {
+	fixture, err := os.Open("test-fixtures/setup/setup.py")
+	if err != nil {
+		t.Fatalf("failed to open fixture: %+v", err)
+	}
+	defer fixture.Close()
+
+	if _, _, err := parseZipapp(fixture.Name(), fixture); err == nil {
+		t.Errorf("expected an error for a file that is not a zip archive")
+	}
+}
+
+func TestParseZipappWithLimits_ReadLimitExceeded(t *testing.T) {
+	fixture, err := os.Open("test-fixtures/zipapp/app.pyz")
+	if err != nil {
+		t.Fatalf("failed to open fixture: %+v", err)
+	}
+	defer fixture.Close()
+
+	_, _, err = parseZipappWithLimits(fixture.Name(), fixture, file.NewReadBudget(0, 16), true)
+	if !errors.Is(err, file.ErrReadLimitExceeded) {
+		t.Errorf("expected the read limit to be exceeded, got: %+v", err)
+	}
+}
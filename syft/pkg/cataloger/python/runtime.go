@@ -0,0 +1,50 @@
+package python
+
+import (
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// runtimeDirPattern matches the directory of the standard library of a python installation (e.g. "python3.11"),
+// which holds the site-packages directory of that installation.
+var runtimeDirPattern = regexp.MustCompile(`^python(\d+(?:\.\d+)?)$`)
+
+// pythonRuntime returns the python installation that packages within the given site-packages directory are installed
+// for, so that packages of several installations within the same image (e.g. python 3.8 and 3.11) are not mixed up.
+// Packages within a virtual environment are installed for the interpreter the environment was created from.
+func pythonRuntime(sitePackagesRootPath string, env *pkg.PythonVirtualEnv) *pkg.PythonRuntime {
+	if env != nil && env.Home != "" {
+		runtime := pkg.PythonRuntime{
+			Version: env.Version,
+			Prefix:  filepath.Dir(env.Home),
+		}
+		if runtime.Version == "" {
+			runtime.Version = runtimeVersionFromPath(sitePackagesRootPath)
+		}
+		return &runtime
+	}
+
+	// e.g. /usr/local/lib/python3.11/site-packages or /usr/lib64/python3.8/site-packages
+	runtimeDir := filepath.Dir(filepath.Clean(sitePackagesRootPath))
+	match := runtimeDirPattern.FindStringSubmatch(filepath.Base(runtimeDir))
+	libDir := filepath.Dir(runtimeDir)
+	if match == nil || !strings.HasPrefix(strings.ToLower(filepath.Base(libDir)), "lib") {
+		return nil
+	}
+	return &pkg.PythonRuntime{
+		Version: match[1],
+		Prefix:  filepath.Dir(libDir),
+	}
+}
+
+// runtimeVersionFromPath returns the version of the installation from the path of its site-packages directory.
+func runtimeVersionFromPath(sitePackagesRootPath string) string {
+	match := runtimeDirPattern.FindStringSubmatch(filepath.Base(filepath.Dir(filepath.Clean(sitePackagesRootPath))))
+	if match == nil {
+		return ""
+	}
+	return match[1]
+}
@@ -0,0 +1,63 @@
+package python
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_pythonRuntime(t *testing.T) {
+	tests := []struct {
+		name         string
+		sitePackages string
+		env          *pkg.PythonVirtualEnv
+		expected     *pkg.PythonRuntime
+	}{
+		{
+			name:         "system installation",
+			sitePackages: "/usr/lib/python3.8/site-packages",
+			expected:     &pkg.PythonRuntime{Version: "3.8", Prefix: "/usr"},
+		},
+		{
+			name:         "local installation",
+			sitePackages: "/usr/local/lib/python3.11/site-packages",
+			expected:     &pkg.PythonRuntime{Version: "3.11", Prefix: "/usr/local"},
+		},
+		{
+			name:         "lib64",
+			sitePackages: "/usr/lib64/python2.7/site-packages",
+			expected:     &pkg.PythonRuntime{Version: "2.7", Prefix: "/usr"},
+		},
+		{
+			name:         "debian dist-packages",
+			sitePackages: "/usr/lib/python3/dist-packages",
+			expected:     &pkg.PythonRuntime{Version: "3", Prefix: "/usr"},
+		},
+		{
+			name:         "virtual environment",
+			sitePackages: "/opt/venv/lib/python3.9/site-packages",
+			env:          &pkg.PythonVirtualEnv{Path: "/opt/venv", Home: "/usr/local/bin", Version: "3.9.2"},
+			expected:     &pkg.PythonRuntime{Version: "3.9.2", Prefix: "/usr/local"},
+		},
+		{
+			name:         "virtual environment without a version",
+			sitePackages: "/opt/venv/lib/python3.9/site-packages",
+			env:          &pkg.PythonVirtualEnv{Path: "/opt/venv", Home: "/usr/bin"},
+			expected:     &pkg.PythonRuntime{Version: "3.9", Prefix: "/usr"},
+		},
+		{
+			name:         "not within an installation",
+			sitePackages: "/app/vendor",
+		},
+		{
+			name:         "not within a lib directory",
+			sitePackages: "/opt/python3.9/site-packages",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, pythonRuntime(test.sitePackages, test.env))
+		})
+	}
+}
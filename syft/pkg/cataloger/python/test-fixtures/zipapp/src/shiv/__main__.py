@@ -0,0 +1,2 @@
+import _bootstrap
+_bootstrap.bootstrap()
@@ -0,0 +1 @@
+def bootstrap(): pass
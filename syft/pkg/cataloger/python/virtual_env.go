@@ -0,0 +1,136 @@
+package python
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/source"
+)
+
+// virtualEnvConfigFile is written to the root of every virtual environment, by both venv and virtualenv.
+const virtualEnvConfigFile = "pyvenv.cfg"
+
+type virtualEnvResult struct {
+	env      *pkg.PythonVirtualEnv
+	location *source.Location
+}
+
+// virtualEnvs finds the virtual environment that python packages are installed within, reading the configuration of
+// each environment once (an environment usually holds many packages).
+type virtualEnvs struct {
+	resolver source.FileResolver
+	byRoot   map[string]virtualEnvResult
+}
+
+func newVirtualEnvs(resolver source.FileResolver) *virtualEnvs {
+	return &virtualEnvs{
+		resolver: resolver,
+		byRoot:   make(map[string]virtualEnvResult),
+	}
+}
+
+// find returns the virtual environment the given site-packages directory belongs to (and the location of its
+// configuration), or nil when the packages are not installed within a virtual environment.
+func (v *virtualEnvs) find(metadataLocation source.Location, sitePackagesRootPath string) (*pkg.PythonVirtualEnv, *source.Location, error) {
+	root := virtualEnvRoot(sitePackagesRootPath)
+	if root == "" {
+		return nil, nil, nil
+	}
+
+	if result, ok := v.byRoot[root]; ok {
+		return result.env, result.location, nil
+	}
+
+	var result virtualEnvResult
+	cfgLocation := v.resolver.RelativeFileByPath(metadataLocation, filepath.Join(root, virtualEnvConfigFile))
+	if cfgLocation != nil {
+		cfgContents, err := v.resolver.FileContentsByLocation(*cfgLocation)
+		if err != nil {
+			return nil, nil, err
+		}
+		defer internal.CloseAndLogError(cfgContents, cfgLocation.VirtualPath)
+
+		env, err := parseVirtualEnvConfig(cfgContents)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to parse python virtual environment config=%q: %w", cfgLocation.RealPath, err)
+		}
+		env.Path = root
+		result = virtualEnvResult{env: env, location: cfgLocation}
+	}
+
+	v.byRoot[root] = result
+	return result.env, result.location, nil
+}
+
+// virtualEnvRoot returns the directory a virtual environment would be rooted at, given the site-packages directory
+// of the environment ("<root>/lib/pythonX.Y/site-packages", or "<root>/Lib/site-packages" on windows).
+func virtualEnvRoot(sitePackagesRootPath string) string {
+	if filepath.Base(sitePackagesRootPath) != "site-packages" {
+		return ""
+	}
+
+	parent := filepath.Dir(sitePackagesRootPath)
+	if strings.HasPrefix(filepath.Base(parent), "python") {
+		parent = filepath.Dir(parent)
+	}
+	if !strings.HasPrefix(strings.ToLower(filepath.Base(parent)), "lib") {
+		return ""
+	}
+	return filepath.Dir(parent)
+}
+
+// parseVirtualEnvConfig reads the interpreter a virtual environment links against from its pyvenv.cfg file, which is
+// a list of "key = value" lines (written by both venv and virtualenv, with slightly different keys).
+func parseVirtualEnvConfig(reader io.Reader) (*pkg.PythonVirtualEnv, error) {
+	fields := make(map[string]string)
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		key, value, ok := splitVirtualEnvConfigLine(scanner.Text())
+		if !ok {
+			continue
+		}
+		fields[key] = value
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	env := pkg.PythonVirtualEnv{
+		Home:                      fields["home"],
+		IncludeSystemSitePackages: strings.EqualFold(fields["include-system-site-packages"], "true"),
+	}
+
+	// venv (python 3.11+) records "executable", virtualenv records "base-executable"
+	env.Executable = fields["executable"]
+	if env.Executable == "" {
+		env.Executable = fields["base-executable"]
+	}
+
+	// venv records "version", virtualenv records "version_info" (e.g. "3.9.2.final.0")
+	env.Version = fields["version"]
+	if env.Version == "" {
+		if versionInfo := strings.Split(fields["version_info"], "."); len(versionInfo) >= 3 {
+			env.Version = strings.Join(versionInfo[:3], ".")
+		}
+	}
+
+	return &env, nil
+}
+
+func splitVirtualEnvConfigLine(line string) (string, string, bool) {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "#") {
+		return "", "", false
+	}
+
+	fields := strings.SplitN(line, "=", 2)
+	if len(fields) != 2 {
+		return "", "", false
+	}
+	return strings.ToLower(strings.TrimSpace(fields[0])), strings.TrimSpace(fields[1]), true
+}
@@ -0,0 +1,127 @@
+package python
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/source"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPythonPackageCataloger_VirtualEnvs(t *testing.T) {
+	resolver := source.NewMockResolverForPaths(
+		"test-fixtures/venvs/app/pyvenv.cfg",
+		"test-fixtures/venvs/app/lib/python3.9/site-packages/six-1.16.0.dist-info/METADATA",
+		"test-fixtures/venvs/tools/pyvenv.cfg",
+		"test-fixtures/venvs/tools/lib/python3.11/site-packages/six-1.16.0.dist-info/METADATA",
+	)
+
+	actual, _, err := NewPythonPackageCataloger().Catalog(resolver)
+	require.NoError(t, err)
+	require.Len(t, actual, 2)
+
+	sort.Slice(actual, func(i, j int) bool {
+		return actual[i].Locations[0].RealPath < actual[j].Locations[0].RealPath
+	})
+
+	expected := []*pkg.PythonVirtualEnv{
+		{
+			Path:       "test-fixtures/venvs/app",
+			Home:       "/usr/local/bin",
+			Executable: "/usr/local/bin/python3.9",
+			Version:    "3.9.2",
+		},
+		{
+			Path:                      "test-fixtures/venvs/tools",
+			Home:                      "/usr/bin",
+			Executable:                "/usr/bin/python3.11",
+			Version:                   "3.11.2",
+			IncludeSystemSitePackages: true,
+		},
+	}
+
+	// the packages of each environment are attributed to the interpreter the environment was created from
+	expectedRuntimes := []*pkg.PythonRuntime{
+		{Version: "3.9.2", Prefix: "/usr/local"},
+		{Version: "3.11.2", Prefix: "/usr"},
+	}
+
+	for i, p := range actual {
+		metadata, ok := p.Metadata.(pkg.PythonPackageMetadata)
+		require.True(t, ok)
+		assert.Equal(t, "six", p.Name)
+		assert.Equal(t, expected[i], metadata.VirtualEnv)
+		assert.Equal(t, expectedRuntimes[i], metadata.Runtime)
+
+		var paths []string
+		for _, l := range p.Locations {
+			paths = append(paths, l.RealPath)
+		}
+		assert.Contains(t, paths, expected[i].Path+"/pyvenv.cfg")
+	}
+
+	// the same package installed into each environment is a distinct package
+	assert.NotEqual(t, actual[0].ID(), actual[1].ID())
+}
+
+func Test_virtualEnvRoot(t *testing.T) {
+	tests := []struct {
+		sitePackages string
+		expected     string
+	}{
+		{sitePackages: "/opt/venv/lib/python3.9/site-packages", expected: "/opt/venv"},
+		{sitePackages: "/opt/venv/lib64/python3.9/site-packages", expected: "/opt/venv"},
+		{sitePackages: "C:/venv/Lib/site-packages", expected: "C:/venv"},
+		{sitePackages: "/usr/lib/python3/dist-packages", expected: ""},
+		{sitePackages: "/app/vendor/site-packages", expected: ""},
+		{sitePackages: "/app", expected: ""},
+	}
+	for _, test := range tests {
+		t.Run(test.sitePackages, func(t *testing.T) {
+			assert.Equal(t, test.expected, virtualEnvRoot(test.sitePackages))
+		})
+	}
+}
+
+func Test_parseVirtualEnvConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   string
+		expected pkg.PythonVirtualEnv
+	}{
+		{
+			name:   "venv (python 3.8)",
+			config: "home = /usr/bin\ninclude-system-site-packages = false\nversion = 3.8.10\n",
+			expected: pkg.PythonVirtualEnv{
+				Home:    "/usr/bin",
+				Version: "3.8.10",
+			},
+		},
+		{
+			name:   "virtualenv",
+			config: "home = /usr/local/bin\nimplementation = CPython\nversion_info = 3.10.4.final.0\nvirtualenv = 20.14.1\ninclude-system-site-packages = true\nbase-executable = /usr/local/bin/python3.10\n",
+			expected: pkg.PythonVirtualEnv{
+				Home:                      "/usr/local/bin",
+				Executable:                "/usr/local/bin/python3.10",
+				Version:                   "3.10.4",
+				IncludeSystemSitePackages: true,
+			},
+		},
+		{
+			name:     "malformed lines are ignored",
+			config:   "# a comment\nnot a field\nHome=/bin\n",
+			expected: pkg.PythonVirtualEnv{Home: "/bin"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := parseVirtualEnvConfig(strings.NewReader(test.config))
+			require.NoError(t, err)
+			expected := test.expected
+			assert.Equal(t, &expected, actual)
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package python
+
+import (
+	"io"
+
+	"github.com/anchore/syft/internal/file"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/common"
+)
+
+// NewPythonZipappCataloger returns a new cataloger for python packages bundled within single-file python deployables
+// (zipapps, PEX files, and shiv archives).
+func NewPythonZipappCataloger(cfg ZipappConfig) *common.GenericCataloger {
+	globParsers := map[string]common.ParserFn{
+		"**/*.pyz":  withZipappLimits(cfg),
+		"**/*.pyzw": withZipappLimits(cfg),
+		"**/*.pex":  withZipappLimits(cfg),
+	}
+
+	return common.NewGenericCataloger(nil, globParsers, "python-zipapp-cataloger")
+}
+
+// withZipappLimits creates a parser function that processes each zipapp with a fresh read budget from the given config.
+func withZipappLimits(cfg ZipappConfig) common.ParserFn {
+	// the wheels bundled within a zipapp are archives nested one level deep
+	searchWheels := cfg.MaxArchiveNestingDepth <= 0 || cfg.MaxArchiveNestingDepth > 1
+	return func(virtualPath string, reader io.Reader) ([]*pkg.Package, []artifact.Relationship, error) {
+		return parseZipappWithLimits(virtualPath, reader, file.NewReadBudget(cfg.MaxArchiveFileSize, cfg.MaxArchiveDecompressedSize), searchWheels)
+	}
+}
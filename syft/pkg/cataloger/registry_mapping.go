@@ -0,0 +1,100 @@
+package cataloger
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+const repositoryURLQualifier = "repository_url"
+
+// RegistryMapping describes an internal package registry (e.g. a mirror or proxy of a public registry) so that the
+// packages downloaded from it are still identified canonically by their package URL.
+type RegistryMapping struct {
+	Host          string            // the hostname of the internal registry, as found in the download URL of a package (or an existing repository_url qualifier)
+	RepositoryURL string            // the repository_url qualifier for packages from this registry (empty to leave the qualifier out, e.g. for a mirror of the public registry)
+	Namespaces    map[string]string // package URL namespaces used by the internal registry, mapped to the canonical namespace (empty to remove the namespace)
+}
+
+// MapRegistryPackageURLs rewrites the package URL of every package that was downloaded from one of the given internal
+// registries. The package IDs are unchanged (the package URL is not part of the ID).
+func MapRegistryPackageURLs(catalog *pkg.Catalog, mappings []RegistryMapping) *pkg.Catalog {
+	if len(mappings) == 0 || catalog == nil {
+		return catalog
+	}
+
+	byHost := make(map[string]RegistryMapping)
+	for _, m := range mappings {
+		byHost[strings.ToLower(m.Host)] = m
+	}
+
+	var packages []pkg.Package
+	for _, p := range catalog.Sorted() {
+		if p.PURL != "" {
+			p.PURL = mapRegistryPackageURL(p, byHost)
+		}
+		packages = append(packages, p)
+	}
+	return pkg.NewCatalog(packages...)
+}
+
+func mapRegistryPackageURL(p pkg.Package, byHost map[string]RegistryMapping) string {
+	purl, err := packageurl.FromString(p.PURL)
+	if err != nil {
+		log.Debugf("unable to parse package URL %q: %+v", p.PURL, err)
+		return p.PURL
+	}
+
+	m, ok := byHost[registryHost(p, purl)]
+	if !ok {
+		return p.PURL
+	}
+
+	if namespace, ok := m.Namespaces[purl.Namespace]; ok {
+		purl.Namespace = namespace
+	}
+
+	var qualifiers packageurl.Qualifiers
+	for _, q := range purl.Qualifiers {
+		if q.Key != repositoryURLQualifier {
+			qualifiers = append(qualifiers, q)
+		}
+	}
+	if m.RepositoryURL != "" {
+		qualifiers = append(qualifiers, packageurl.Qualifier{Key: repositoryURLQualifier, Value: m.RepositoryURL})
+	}
+	purl.Qualifiers = qualifiers
+
+	return purl.ToString()
+}
+
+// registryHost returns the (lowercase) hostname of the registry the package was downloaded from, if known.
+func registryHost(p pkg.Package, purl packageurl.PackageURL) string {
+	if host := hostname(p.OriginURLs.Download); host != "" {
+		return host
+	}
+	for _, q := range purl.Qualifiers {
+		if q.Key == repositoryURLQualifier {
+			return hostname(q.Value)
+		}
+	}
+	return ""
+}
+
+func hostname(value string) string {
+	if value == "" {
+		return ""
+	}
+	if !strings.Contains(value, "://") {
+		// repository_url qualifiers are commonly given without a scheme (e.g. repo.example.com/maven)
+		value = "//" + value
+	}
+	u, err := url.Parse(value)
+	if err != nil {
+		return ""
+	}
+	return strings.ToLower(u.Hostname())
+}
@@ -0,0 +1,81 @@
+package cataloger
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapRegistryPackageURLs(t *testing.T) {
+	tests := []struct {
+		name     string
+		p        pkg.Package
+		mappings []RegistryMapping
+		expected string
+	}{
+		{
+			name: "drop repository_url of a mirror",
+			p: pkg.Package{
+				Name:       "lodash",
+				Version:    "4.17.21",
+				PURL:       "pkg:npm/lodash@4.17.21?repository_url=https://npm.example.internal",
+				OriginURLs: pkg.OriginURLs{Download: "https://npm.example.internal/lodash/-/lodash-4.17.21.tgz"},
+			},
+			mappings: []RegistryMapping{{Host: "NPM.example.internal"}},
+			expected: "pkg:npm/lodash@4.17.21",
+		},
+		{
+			name: "replace repository_url and rewrite namespace",
+			p: pkg.Package{
+				Name:    "commons-text",
+				Version: "1.9",
+				PURL:    "pkg:maven/mirror.org.apache.commons/commons-text@1.9?repository_url=repo.example.internal/maven",
+			},
+			mappings: []RegistryMapping{
+				{
+					Host:          "repo.example.internal",
+					RepositoryURL: "https://repo.maven.apache.org/maven2",
+					Namespaces:    map[string]string{"mirror.org.apache.commons": "org.apache.commons"},
+				},
+			},
+			expected: "pkg:maven/org.apache.commons/commons-text@1.9?repository_url=https:%2F%2Frepo.maven.apache.org%2Fmaven2",
+		},
+		{
+			name: "add repository_url from the download host",
+			p: pkg.Package{
+				Name:       "left-pad",
+				Version:    "2.0.0",
+				PURL:       "pkg:npm/left-pad@2.0.0",
+				OriginURLs: pkg.OriginURLs{Download: "https://npm.example.internal/left-pad/-/left-pad-2.0.0.tgz"},
+			},
+			mappings: []RegistryMapping{{Host: "npm.example.internal", RepositoryURL: "https://npm.example.com"}},
+			expected: "pkg:npm/left-pad@2.0.0?repository_url=https:%2F%2Fnpm.example.com",
+		},
+		{
+			name: "other hosts are untouched",
+			p: pkg.Package{
+				Name:       "lodash",
+				Version:    "4.17.21",
+				PURL:       "pkg:npm/lodash@4.17.21",
+				OriginURLs: pkg.OriginURLs{Download: "https://registry.npmjs.org/lodash/-/lodash-4.17.21.tgz"},
+			},
+			mappings: []RegistryMapping{{Host: "npm.example.internal", RepositoryURL: "https://npm.example.com"}},
+			expected: "pkg:npm/lodash@4.17.21",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.p.SetID()
+			id := test.p.ID()
+
+			catalog := MapRegistryPackageURLs(pkg.NewCatalog(test.p), test.mappings)
+
+			actual := catalog.Package(id)
+			if assert.NotNil(t, actual) {
+				assert.Equal(t, test.expected, actual.PURL)
+			}
+		})
+	}
+}
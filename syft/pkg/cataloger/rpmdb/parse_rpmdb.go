@@ -44,9 +44,16 @@ func parseRpmDB(resolver source.FilePathResolver, dbLocation source.Location, re
 		return nil, err
 	}
 
+	// the package listing does not include the group or signature, which are read from the package headers directly
+	headerDetails, err := readRpmHeaderDetails(f.Name())
+	if err != nil {
+		log.Warnf("unable to read rpm package headers: %+v", err)
+	}
+
 	allPkgs := make([]pkg.Package, 0)
 
 	for _, entry := range pkgList {
+		details := headerDetails[rpmPackageKey(entry.Name, entry.Version, entry.Release, entry.Arch)]
 		metadata := pkg.RpmdbMetadata{
 			Name:      entry.Name,
 			Version:   entry.Version,
@@ -55,6 +62,8 @@ func parseRpmDB(resolver source.FilePathResolver, dbLocation source.Location, re
 			Release:   entry.Release,
 			SourceRpm: entry.SourceRpm,
 			Vendor:    entry.Vendor,
+			Group:     details.group,
+			Signature: details.signature,
 			License:   entry.License,
 			Size:      entry.Size,
 			Files:     extractRpmdbFileRecords(resolver, entry),
@@ -0,0 +1,144 @@
+package rpmdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/anchore/go-rpmdb/pkg/bdb"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// header tags not surfaced by the RPM DB package listing (see rpmtag.h)
+const (
+	rpmTagSigPGP    = 259
+	rpmTagSigGPG    = 262
+	rpmTagDSAHeader = 267
+	rpmTagRSAHeader = 268
+
+	rpmTagName    = 1000
+	rpmTagVersion = 1001
+	rpmTagRelease = 1002
+	rpmTagGroup   = 1016
+	rpmTagArch    = 1022
+
+	rpmHeaderEntrySize = 16
+)
+
+// header value types (see rpmtag.h)
+const (
+	rpmStringType      = 6
+	rpmBinaryType      = 7
+	rpmStringArrayType = 8
+	rpmI18NStringType  = 9
+)
+
+// signature tags in order of preference: header-only signatures are what rpm reports first for an installed package
+var rpmSignatureTags = []int32{rpmTagRSAHeader, rpmTagDSAHeader, rpmTagSigPGP, rpmTagSigGPG}
+
+// rpmHeaderDetails are the package details that are read from the RPM headers directly.
+type rpmHeaderDetails struct {
+	group     string
+	signature *pkg.RpmdbSignature
+}
+
+// readRpmHeaderDetails returns the group (e.g. "System Environment/Base") and signature of every package in the given
+// RPM DB, keyed by the package name, version, release, and architecture.
+func readRpmHeaderDetails(dbPath string) (map[string]rpmHeaderDetails, error) {
+	db, err := bdb.Open(dbPath)
+	if err != nil {
+		return nil, err
+	}
+
+	details := make(map[string]rpmHeaderDetails)
+	for entry := range db.Read() {
+		if entry.Err != nil {
+			return nil, entry.Err
+		}
+
+		tags, err := parseRpmHeader(entry.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		key := rpmPackageKey(string(tags[rpmTagName]), string(tags[rpmTagVersion]), string(tags[rpmTagRelease]), string(tags[rpmTagArch]))
+		d := rpmHeaderDetails{
+			group: string(tags[rpmTagGroup]),
+		}
+
+		for _, tag := range rpmSignatureTags {
+			if len(tags[tag]) == 0 {
+				continue
+			}
+			d.signature, err = parsePGPSignature(tags[tag])
+			if err != nil {
+				log.Debugf("unable to parse signature of rpm=%q: %+v", key, err)
+				continue
+			}
+			break
+		}
+
+		if d.group == "" && d.signature == nil {
+			continue
+		}
+		details[key] = d
+	}
+	return details, nil
+}
+
+// parseRpmHeader returns the raw value of the name, version, release, group, arch, and signature tags within the given
+// raw RPM header blob. String values are returned without the NUL terminator (only the first value for arrays).
+func parseRpmHeader(data []byte) (map[int32][]byte, error) {
+	if len(data) < 8 {
+		return nil, fmt.Errorf("rpm header too short: %d bytes", len(data))
+	}
+	indexLength := int(binary.BigEndian.Uint32(data[0:4]))
+	dataLength := int(binary.BigEndian.Uint32(data[4:8]))
+	dataStart := 8 + indexLength*rpmHeaderEntrySize
+	if indexLength < 0 || dataLength < 0 || dataStart+dataLength > len(data) {
+		return nil, fmt.Errorf("invalid rpm header: %d entries with %d bytes of data", indexLength, dataLength)
+	}
+	store := data[dataStart : dataStart+dataLength]
+
+	values := make(map[int32][]byte)
+	for i := 0; i < indexLength; i++ {
+		entry := data[8+i*rpmHeaderEntrySize : 8+(i+1)*rpmHeaderEntrySize]
+		tag := int32(binary.BigEndian.Uint32(entry[0:4]))
+		valueType := int32(binary.BigEndian.Uint32(entry[4:8]))
+		offset := int(int32(binary.BigEndian.Uint32(entry[8:12])))
+		count := int(int32(binary.BigEndian.Uint32(entry[12:16])))
+
+		switch tag {
+		case rpmTagName, rpmTagVersion, rpmTagRelease, rpmTagGroup, rpmTagArch:
+		case rpmTagSigPGP, rpmTagSigGPG, rpmTagDSAHeader, rpmTagRSAHeader:
+		default:
+			continue
+		}
+		if offset < 0 || offset >= len(store) {
+			continue
+		}
+		value := store[offset:]
+
+		switch valueType {
+		case rpmStringType, rpmStringArrayType, rpmI18NStringType:
+			// string and i18n string values are NUL terminated, with the untranslated value first
+			if end := bytes.IndexByte(value, 0); end >= 0 {
+				value = value[:end]
+			}
+		case rpmBinaryType:
+			if count < 0 || count > len(value) {
+				continue
+			}
+			value = value[:count]
+		default:
+			continue
+		}
+		values[tag] = value
+	}
+	return values, nil
+}
+
+func rpmPackageKey(name, version, release, arch string) string {
+	return fmt.Sprintf("%s-%s-%s.%s", name, version, release, arch)
+}
@@ -0,0 +1,77 @@
+package rpmdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testRpmHeaderEntry struct {
+	tag       int32
+	valueType int32
+	value     []byte
+}
+
+// newTestRpmHeader encodes the given entries into a raw RPM header blob (as stored in the RPM DB).
+func newTestRpmHeader(entries ...testRpmHeaderEntry) []byte {
+	var index, store bytes.Buffer
+	for _, e := range entries {
+		count := 1
+		value := e.value
+		switch e.valueType {
+		case rpmBinaryType:
+			count = len(value)
+		default:
+			value = append(append([]byte{}, value...), 0)
+		}
+		for _, field := range []int32{e.tag, e.valueType, int32(store.Len()), int32(count)} {
+			_ = binary.Write(&index, binary.BigEndian, field)
+		}
+		store.Write(value)
+	}
+
+	var header bytes.Buffer
+	_ = binary.Write(&header, binary.BigEndian, int32(len(entries)))
+	_ = binary.Write(&header, binary.BigEndian, int32(store.Len()))
+	header.Write(index.Bytes())
+	header.Write(store.Bytes())
+	return header.Bytes()
+}
+
+func TestParseRpmHeader(t *testing.T) {
+	header := newTestRpmHeader(
+		testRpmHeaderEntry{tag: rpmTagRSAHeader, valueType: rpmBinaryType, value: []byte{0x89, 0x00, 0x01, 0x04}},
+		testRpmHeaderEntry{tag: rpmTagName, valueType: rpmStringType, value: []byte("bash")},
+		testRpmHeaderEntry{tag: rpmTagVersion, valueType: rpmStringType, value: []byte("4.4.19")},
+		testRpmHeaderEntry{tag: rpmTagRelease, valueType: rpmStringType, value: []byte("12.el8")},
+		testRpmHeaderEntry{tag: rpmTagGroup, valueType: rpmI18NStringType, value: []byte("System Environment/Shells")},
+		testRpmHeaderEntry{tag: rpmTagArch, valueType: rpmStringType, value: []byte("x86_64")},
+		// tags that are not of interest are skipped
+		testRpmHeaderEntry{tag: 1004, valueType: rpmI18NStringType, value: []byte("The GNU Bourne Again shell")},
+	)
+
+	actual, err := parseRpmHeader(header)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[int32][]byte{
+		rpmTagRSAHeader: {0x89, 0x00, 0x01, 0x04},
+		rpmTagName:      []byte("bash"),
+		rpmTagVersion:   []byte("4.4.19"),
+		rpmTagRelease:   []byte("12.el8"),
+		rpmTagGroup:     []byte("System Environment/Shells"),
+		rpmTagArch:      []byte("x86_64"),
+	}, actual)
+}
+
+func TestParseRpmHeader_Invalid(t *testing.T) {
+	header := newTestRpmHeader(testRpmHeaderEntry{tag: rpmTagName, valueType: rpmStringType, value: []byte("bash")})
+
+	_, err := parseRpmHeader(header[:len(header)-2])
+	assert.Error(t, err)
+
+	_, err = parseRpmHeader(header[:4])
+	assert.Error(t, err)
+}
@@ -0,0 +1,198 @@
+package rpmdb
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// OpenPGP values used to describe an RPM signature (see https://datatracker.ietf.org/doc/html/rfc4880)
+const (
+	pgpSignaturePacketTag = 2
+
+	pgpIssuerSubpacket            = 16
+	pgpIssuerFingerprintSubpacket = 33
+)
+
+var pgpPublicKeyAlgorithms = map[byte]string{
+	1:  "RSA",
+	2:  "RSA",
+	3:  "RSA",
+	17: "DSA",
+	19: "ECDSA",
+	22: "EdDSA",
+}
+
+var pgpHashAlgorithms = map[byte]string{
+	1:  "MD5",
+	2:  "SHA1",
+	3:  "RIPEMD160",
+	8:  "SHA256",
+	9:  "SHA384",
+	10: "SHA512",
+	11: "SHA224",
+}
+
+var errTruncatedPGPSignature = errors.New("truncated pgp signature")
+
+// parsePGPSignature describes the OpenPGP signature packet held within an RPM signature tag, capturing the algorithms
+// used and the ID of the key that made the signature.
+func parsePGPSignature(data []byte) (*pkg.RpmdbSignature, error) {
+	body, err := pgpPacketBody(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(body) < 1 {
+		return nil, errTruncatedPGPSignature
+	}
+
+	switch version := body[0]; version {
+	case 3:
+		// version, hashed length (always 5), signature type, creation time, key ID, public key and hash algorithms
+		if len(body) < 17 {
+			return nil, errTruncatedPGPSignature
+		}
+		return &pkg.RpmdbSignature{
+			PublicKeyAlgorithm: pgpAlgorithmName(pgpPublicKeyAlgorithms, body[15]),
+			HashAlgorithm:      pgpAlgorithmName(pgpHashAlgorithms, body[16]),
+			KeyID:              hex.EncodeToString(body[7:15]),
+		}, nil
+	case 4:
+		// version, signature type, public key and hash algorithms, then the hashed and unhashed subpackets
+		if len(body) < 6 {
+			return nil, errTruncatedPGPSignature
+		}
+		signature := &pkg.RpmdbSignature{
+			PublicKeyAlgorithm: pgpAlgorithmName(pgpPublicKeyAlgorithms, body[2]),
+			HashAlgorithm:      pgpAlgorithmName(pgpHashAlgorithms, body[3]),
+		}
+
+		subpackets := body[4:]
+		for i := 0; i < 2; i++ {
+			if len(subpackets) < 2 {
+				return nil, errTruncatedPGPSignature
+			}
+			length := int(binary.BigEndian.Uint16(subpackets[0:2]))
+			if len(subpackets) < 2+length {
+				return nil, errTruncatedPGPSignature
+			}
+			if signature.KeyID == "" {
+				signature.KeyID = pgpIssuerKeyID(subpackets[2 : 2+length])
+			}
+			subpackets = subpackets[2+length:]
+		}
+		return signature, nil
+	default:
+		return nil, fmt.Errorf("unsupported pgp signature version: %d", version)
+	}
+}
+
+// pgpPacketBody returns the body of the signature packet at the start of the given data, supporting both the old and
+// new packet header formats.
+func pgpPacketBody(data []byte) ([]byte, error) {
+	if len(data) < 2 || data[0]&0x80 == 0 {
+		return nil, errors.New("invalid pgp packet header")
+	}
+
+	var tag byte
+	var length, headerLength int
+	if data[0]&0x40 == 0 {
+		// old format: the tag and the size of the length field are packed into the first byte
+		tag = (data[0] >> 2) & 0x0f
+		switch data[0] & 0x03 {
+		case 0:
+			length, headerLength = int(data[1]), 2
+		case 1:
+			if len(data) < 3 {
+				return nil, errTruncatedPGPSignature
+			}
+			length, headerLength = int(binary.BigEndian.Uint16(data[1:3])), 3
+		case 2:
+			if len(data) < 5 {
+				return nil, errTruncatedPGPSignature
+			}
+			length, headerLength = int(binary.BigEndian.Uint32(data[1:5])), 5
+		default:
+			// indeterminate length: the packet extends to the end of the data
+			length, headerLength = len(data)-1, 1
+		}
+	} else {
+		// new format: the length is encoded in one, two, or five octets
+		tag = data[0] & 0x3f
+		switch first := int(data[1]); {
+		case first < 192:
+			length, headerLength = first, 2
+		case first < 224:
+			if len(data) < 3 {
+				return nil, errTruncatedPGPSignature
+			}
+			length, headerLength = ((first-192)<<8)+int(data[2])+192, 3
+		case first == 255:
+			if len(data) < 6 {
+				return nil, errTruncatedPGPSignature
+			}
+			length, headerLength = int(binary.BigEndian.Uint32(data[2:6])), 6
+		default:
+			return nil, errors.New("partial pgp packet lengths are not supported")
+		}
+	}
+
+	if tag != pgpSignaturePacketTag {
+		return nil, fmt.Errorf("not a pgp signature packet: tag=%d", tag)
+	}
+	if length < 0 || headerLength+length > len(data) {
+		return nil, errTruncatedPGPSignature
+	}
+	return data[headerLength : headerLength+length], nil
+}
+
+// pgpIssuerKeyID returns the ID of the key that made the signature from the given subpackets (if present).
+func pgpIssuerKeyID(subpackets []byte) string {
+	for len(subpackets) > 0 {
+		var length, headerLength int
+		switch first := int(subpackets[0]); {
+		case first < 192:
+			length, headerLength = first, 1
+		case first < 255:
+			if len(subpackets) < 2 {
+				return ""
+			}
+			length, headerLength = ((first-192)<<8)+int(subpackets[1])+192, 2
+		default:
+			if len(subpackets) < 5 {
+				return ""
+			}
+			length, headerLength = int(binary.BigEndian.Uint32(subpackets[1:5])), 5
+		}
+		if length < 1 || headerLength+length > len(subpackets) {
+			return ""
+		}
+		subpacket := subpackets[headerLength : headerLength+length]
+		content := subpacket[1:]
+
+		// the top bit of the subpacket type flags the subpacket as critical
+		switch subpacket[0] & 0x7f {
+		case pgpIssuerSubpacket:
+			if len(content) == 8 {
+				return hex.EncodeToString(content)
+			}
+		case pgpIssuerFingerprintSubpacket:
+			// the key ID is the low 64 bits of a v4 key fingerprint
+			if len(content) == 21 && content[0] == 4 {
+				return hex.EncodeToString(content[13:])
+			}
+		}
+		subpackets = subpackets[headerLength+length:]
+	}
+	return ""
+}
+
+func pgpAlgorithmName(names map[byte]string, id byte) string {
+	if name, ok := names[id]; ok {
+		return name
+	}
+	return fmt.Sprintf("unknown(%d)", id)
+}
@@ -0,0 +1,104 @@
+package rpmdb
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePGPSignature(t *testing.T) {
+	// a detached signature made by gpg with an RSA key (as found in the RSAHEADER tag of modern RPMs)
+	rsaSignature, err := ioutil.ReadFile("test-fixtures/rsa-sha256.sig")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		data     []byte
+		expected *pkg.RpmdbSignature
+		wantErr  bool
+	}{
+		{
+			name: "v4 signature",
+			data: rsaSignature,
+			expected: &pkg.RpmdbSignature{
+				PublicKeyAlgorithm: "RSA",
+				HashAlgorithm:      "SHA256",
+				KeyID:              "db11331528d4355b",
+			},
+		},
+		{
+			name: "v3 signature",
+			data: []byte{
+				0x88, 0x17, // old format signature packet, 23 bytes
+				0x03, 0x05, 0x00, // version, hashed length, signature type
+				0x5e, 0x0e, 0x58, 0x36, // creation time
+				0x19, 0x9e, 0x2f, 0x91, 0xfd, 0x43, 0x1d, 0x51, // key ID
+				0x01, 0x08, // public key and hash algorithms
+				0xab, 0xcd, // start of the hash
+				0x00, 0x01, 0x00, 0x00, // signature
+			},
+			expected: &pkg.RpmdbSignature{
+				PublicKeyAlgorithm: "RSA",
+				HashAlgorithm:      "SHA256",
+				KeyID:              "199e2f91fd431d51",
+			},
+		},
+		{
+			name: "v4 signature with new format header and issuer only in the unhashed subpackets",
+			data: []byte{
+				0xc2, 0x14, // new format signature packet, 20 bytes
+				0x04, 0x00, 0x11, 0x02, // version, signature type, public key and hash algorithms
+				0x00, 0x00, // no hashed subpackets
+				0x00, 0x0a, // unhashed subpackets
+				0x09, 0x10, 0x05, 0xb5, 0x55, 0xb3, 0x8b, 0xb8, 0x0c, 0x2a, // issuer key ID
+				0xab, 0xcd, // start of the hash
+			},
+			expected: &pkg.RpmdbSignature{
+				PublicKeyAlgorithm: "DSA",
+				HashAlgorithm:      "SHA1",
+				KeyID:              "05b555b38bb80c2a",
+			},
+		},
+		{
+			name: "v4 signature without issuer",
+			data: []byte{
+				0xc2, 0x08,
+				0x04, 0x00, 0x16, 0x0a,
+				0x00, 0x00,
+				0x00, 0x00,
+			},
+			expected: &pkg.RpmdbSignature{
+				PublicKeyAlgorithm: "EdDSA",
+				HashAlgorithm:      "SHA512",
+			},
+		},
+		{
+			name:    "not a signature packet",
+			data:    []byte{0x99, 0x00, 0x01, 0x04},
+			wantErr: true,
+		},
+		{
+			name:    "truncated",
+			data:    rsaSignature[:20],
+			wantErr: true,
+		},
+		{
+			name:    "empty",
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := parsePGPSignature(test.data)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
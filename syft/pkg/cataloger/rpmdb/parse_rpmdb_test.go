@@ -85,6 +85,7 @@ func TestParseRpmDB(t *testing.T) {
 						Size:      12406784,
 						License:   "MIT",
 						Vendor:    "",
+						Group:     "Development/Tools",
 						Files:     []pkg.RpmdbFileRecord{},
 					},
 				},
@@ -112,6 +113,7 @@ func TestParseRpmDB(t *testing.T) {
 						Size:      12406784,
 						License:   "MIT",
 						Vendor:    "",
+						Group:     "Development/Tools",
 						Files: []pkg.RpmdbFileRecord{
 							{
 								Path: "/usr/local/bin/dive",
@@ -0,0 +1,111 @@
+package ruby
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"strings"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/file"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/source"
+)
+
+const (
+	// bundleCacheDir is where "bundle cache" stores the gems locked by a Gemfile.lock (relative to the lockfile)
+	bundleCacheDir = "vendor/cache"
+	// gemMetadataFile is the gzip compressed YAML gemspec within a gem archive
+	gemMetadataFile = "metadata.gz"
+)
+
+// cachedGemLicenses returns the licenses declared by the gem archive that bundler cached for the given lockfile entry
+// (vendor/cache/NAME-VERSION.gem, where the version includes the platform of platform-specific gems), along with the
+// location of the archive. No location is returned when the gem was not cached or cannot be read (including when reading
+// it would exceed the archive limits of the given config).
+func cachedGemLicenses(cfg Config, resolver source.FileResolver, lockLocation source.Location, entry gemfileLockEntry) ([]string, *source.Location) {
+	gemPath := path.Join(path.Dir(lockLocation.RealPath), bundleCacheDir, fmt.Sprintf("%s-%s.gem", entry.name, entry.version))
+	location := resolver.RelativeFileByPath(lockLocation, gemPath)
+	if location == nil {
+		return nil, nil
+	}
+
+	reader, err := resolver.FileContentsByLocation(*location)
+	if err != nil {
+		log.Debugf("unable to read cached gem=%q: %+v", location.RealPath, err)
+		return nil, nil
+	}
+	defer internal.CloseAndLogError(reader, location.RealPath)
+
+	licenses, err := parseGemArchiveLicenses(reader, file.NewReadBudget(cfg.MaxArchiveFileSize, cfg.MaxArchiveDecompressedSize))
+	if errors.Is(err, file.ErrReadLimitExceeded) {
+		log.Warnf("skipping the gemspec of cached gem=%q: %+v", location.RealPath, err)
+		return nil, nil
+	}
+	if err != nil {
+		log.Debugf("unable to read the gemspec of cached gem=%q: %+v", location.RealPath, err)
+		return nil, nil
+	}
+	return licenses, location
+}
+
+// parseGemArchiveLicenses reads the licenses from the compressed gemspec within the given gem archive (a tarball),
+// extracting no more than the given budget allows.
+func parseGemArchiveLicenses(reader io.Reader, budget *file.ReadBudget) ([]string, error) {
+	tarReader := tar.NewReader(reader)
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("no %s found within the gem", gemMetadataFile)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if header.Name != gemMetadataFile {
+			continue
+		}
+
+		metadata, err := file.NewDecompressingReadCloser(gemMetadataFile, ioutil.NopCloser(tarReader))
+		if err != nil {
+			return nil, err
+		}
+		defer internal.CloseAndLogError(metadata, gemMetadataFile)
+
+		var gemspec bytes.Buffer
+		if err := budget.Copy(&gemspec, metadata); err != nil {
+			return nil, err
+		}
+		return parseGemspecYAMLLicenses(&gemspec)
+	}
+}
+
+// parseGemspecYAMLLicenses reads the "licenses" list of a YAML serialized gemspec (a Gem::Specification as written by
+// "gem build"), e.g.:
+//
+//	licenses:
+//	- MIT
+func parseGemspecYAMLLicenses(reader io.Reader) ([]string, error) {
+	var licenses []string
+	inLicenses := false
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case line == "licenses:":
+			inLicenses = true
+		case inLicenses && strings.HasPrefix(line, "- "):
+			license := strings.Trim(strings.TrimSpace(strings.TrimPrefix(line, "- ")), `"'`)
+			if license != "" {
+				licenses = append(licenses, license)
+			}
+		default:
+			inLicenses = false
+		}
+	}
+	return licenses, scanner.Err()
+}
@@ -0,0 +1,7 @@
+package ruby
+
+// Config bounds the resources used when reading the gem archives cached alongside a Gemfile.lock.
+type Config struct {
+	MaxArchiveDecompressedSize int64 // the max bytes to extract from a gem archive (0 = unlimited)
+	MaxArchiveFileSize         int64 // the max bytes to extract for any single entry within a gem archive (0 = 2GB)
+}
@@ -0,0 +1,142 @@
+package ruby
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/source"
+)
+
+const (
+	gemfileLockCatalogerName = "ruby-gemfile-cataloger"
+	gemfileLockGlob          = "**/Gemfile.lock"
+)
+
+var gitCommitPattern = regexp.MustCompile(`^[0-9a-f]{40}([0-9a-f]{24})?$`)
+
+type GemFileLockCataloger struct {
+	cfg Config
+}
+
+// NewGemFileLockCataloger returns a new Bundler cataloger object tailored for parsing index-oriented files (e.g. Gemfile.lock).
+func NewGemFileLockCataloger(cfg Config) *GemFileLockCataloger {
+	return &GemFileLockCataloger{cfg: cfg}
+}
+
+// Name returns a string that uniquely describes a cataloger
+func (c *GemFileLockCataloger) Name() string {
+	return gemfileLockCatalogerName
+}
+
+// Catalog is given an object to resolve file references and content, this function returns any discovered Packages after analyzing Gemfile.lock files.
+func (c *GemFileLockCataloger) Catalog(resolver source.FileResolver) ([]pkg.Package, []artifact.Relationship, error) {
+	locations, err := resolver.FilesByGlob(gemfileLockGlob)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find files by glob: %s", gemfileLockGlob)
+	}
+
+	var pkgs []pkg.Package
+	for _, location := range locations {
+		contents, err := resolver.FileContentsByLocation(location)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to resolve file contents by location=%q: %w", location.RealPath, err)
+		}
+		entries, err := parseGemFileLock(contents)
+		internal.CloseAndLogError(contents, location.RealPath)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to parse Gemfile.lock=%q: %w", location.RealPath, err)
+		}
+
+		for _, entry := range entries {
+			version := entry.lockedVersion()
+			if entry.section == pathSection {
+				// path gems (such as rails engines) are often vendored as git submodules, in which case the
+				// submodule commit identifies the gem better than the (rarely bumped) version within the gemspec
+				if revision := gitRevision(resolver, location, path.Join(path.Dir(location.RealPath), entry.remote)); revision != "" {
+					version = revision
+				}
+			}
+
+			p := newGemfileLockPackage(entry, version)
+			p.FoundBy = c.Name()
+			p.Locations = []source.Location{location}
+			if entry.section == gemSection {
+				// gems cached alongside the lockfile (with "bundle cache") describe themselves within the archive
+				if licenses, gemLocation := cachedGemLicenses(c.cfg, resolver, location, entry); gemLocation != nil {
+					p.Licenses = licenses
+					p.Locations = append(p.Locations, *gemLocation)
+				}
+			}
+			p.SetID()
+
+			pkgs = append(pkgs, *p)
+		}
+	}
+	return pkgs, nil, nil
+}
+
+// gitRevision returns the commit checked out within the given git working tree (either a submodule or a nested
+// clone), or an empty string if the directory is not a git working tree.
+func gitRevision(resolver source.FileResolver, from source.Location, dir string) string {
+	gitDir := path.Join(dir, ".git")
+
+	// a submodule working tree has a .git file pointing to the git directory within the superproject
+	if contents := readRelativeFile(resolver, from, gitDir); strings.HasPrefix(contents, "gitdir:") {
+		target := strings.TrimSpace(strings.TrimPrefix(contents, "gitdir:"))
+		if path.IsAbs(target) {
+			gitDir = path.Clean(target)
+		} else {
+			gitDir = path.Join(dir, target)
+		}
+	}
+
+	head := strings.TrimSpace(readRelativeFile(resolver, from, path.Join(gitDir, "HEAD")))
+	if gitCommitPattern.MatchString(head) {
+		// submodules are usually checked out with a detached HEAD
+		return head
+	}
+	if !strings.HasPrefix(head, "ref:") {
+		return ""
+	}
+
+	ref := strings.TrimSpace(strings.TrimPrefix(head, "ref:"))
+	if revision := strings.TrimSpace(readRelativeFile(resolver, from, path.Join(gitDir, ref))); gitCommitPattern.MatchString(revision) {
+		return revision
+	}
+
+	// the ref may have been packed
+	for _, line := range strings.Split(readRelativeFile(resolver, from, path.Join(gitDir, "packed-refs")), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 2 && fields[1] == ref && gitCommitPattern.MatchString(fields[0]) {
+			return fields[0]
+		}
+	}
+	return ""
+}
+
+// readRelativeFile returns the contents of the given file (relative to the given location), or an empty string if
+// the file does not exist or cannot be read.
+func readRelativeFile(resolver source.FileResolver, from source.Location, p string) string {
+	location := resolver.RelativeFileByPath(from, p)
+	if location == nil {
+		return ""
+	}
+
+	reader, err := resolver.FileContentsByLocation(*location)
+	if err != nil {
+		return ""
+	}
+	defer internal.CloseAndLogError(reader, location.RealPath)
+
+	contents, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return ""
+	}
+	return string(contents)
+}
@@ -0,0 +1,140 @@
+package ruby
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/anchore/syft/internal/file"
+	"github.com/anchore/syft/syft/source"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGemFileLockCataloger_VendoredGitRevisions(t *testing.T) {
+	const (
+		submoduleRevision = "0b8ac3b4bd5a0e6c1c6ef6a1f0b0cbc4a8b5f9d2"
+		cloneRevision     = "6f1c2d8e3a4b5c6d7e8f90a1b2c3d4e5f6a7b8c9"
+	)
+
+	lockfile, err := ioutil.ReadFile("test-fixtures/Gemfile-vendored.lock")
+	require.NoError(t, err)
+
+	root := t.TempDir()
+	for p, contents := range map[string]string{
+		"Gemfile.lock": string(lockfile),
+		// an engine vendored as a git submodule (with a detached HEAD)
+		"engines/billing/.git":              "gitdir: ../../.git/modules/engines/billing\n",
+		".git/modules/engines/billing/HEAD": submoduleRevision + "\n",
+		"engines/billing/billing.gemspec":   "",
+		"engines/admin/admin.gemspec":       "",
+		// a gem vendored as a nested clone (with packed refs)
+		"vendor/reporting/.git/HEAD":        "ref: refs/heads/main\n",
+		"vendor/reporting/.git/packed-refs": "# pack-refs with: peeled fully-peeled sorted\n" + cloneRevision + " refs/heads/main\n",
+	} {
+		require.NoError(t, os.MkdirAll(filepath.Join(root, filepath.Dir(p)), 0755))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(root, p), []byte(contents), 0644))
+	}
+
+	src, err := source.NewFromDirectory(root)
+	require.NoError(t, err)
+
+	resolver, err := src.FileResolver(source.SquashedScope)
+	require.NoError(t, err)
+
+	pkgs, _, err := NewGemFileLockCataloger(Config{}).Catalog(resolver)
+	require.NoError(t, err)
+
+	versions := make(map[string]string)
+	for _, p := range pkgs {
+		versions[p.Name] = p.Version
+		assert.Equal(t, gemfileLockCatalogerName, p.FoundBy)
+	}
+
+	assert.Equal(t, map[string]string{
+		"devise":    "8593801130f2df94a50863b5db535c272b00efe1",
+		"billing":   submoduleRevision,
+		"admin":     "0.2.0",
+		"reporting": cloneRevision,
+		"bcrypt":    "3.1.16",
+		"rails":     "6.1.4",
+	}, versions)
+}
+
+func TestGemFileLockCataloger_CachedGemLicenses(t *testing.T) {
+	lockfile, err := ioutil.ReadFile("test-fixtures/Gemfile-vendored.lock")
+	require.NoError(t, err)
+
+	root := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "vendor", "cache"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(root, "Gemfile.lock"), lockfile, 0644))
+	// only bcrypt was cached with "bundle cache"
+	gemspec := "--- !ruby/object:Gem::Specification\nname: bcrypt\nlicenses:\n- MIT\nmetadata: {}\n"
+	require.NoError(t, ioutil.WriteFile(filepath.Join(root, "vendor", "cache", "bcrypt-3.1.16.gem"), gemArchive(t, gemspec), 0644))
+
+	src, err := source.NewFromDirectory(root)
+	require.NoError(t, err)
+
+	resolver, err := src.FileResolver(source.SquashedScope)
+	require.NoError(t, err)
+
+	pkgs, _, err := NewGemFileLockCataloger(Config{}).Catalog(resolver)
+	require.NoError(t, err)
+
+	var found bool
+	for _, p := range pkgs {
+		switch p.Name {
+		case "bcrypt":
+			found = true
+			assert.Equal(t, []string{"MIT"}, p.Licenses)
+			var paths []string
+			for _, l := range p.Locations {
+				paths = append(paths, l.RealPath)
+			}
+			assert.Equal(t, []string{"Gemfile.lock", "vendor/cache/bcrypt-3.1.16.gem"}, paths)
+		default:
+			assert.Empty(t, p.Licenses, p.Name)
+			assert.Len(t, p.Locations, 1, p.Name)
+		}
+	}
+	assert.True(t, found)
+}
+
+func TestParseGemArchiveLicenses_ReadLimitExceeded(t *testing.T) {
+	archive := gemArchive(t, "--- !ruby/object:Gem::Specification\nname: bcrypt\nlicenses:\n- MIT\n")
+
+	_, err := parseGemArchiveLicenses(bytes.NewReader(archive), file.NewReadBudget(16, 0))
+	assert.ErrorIs(t, err, file.ErrReadLimitExceeded)
+
+	licenses, err := parseGemArchiveLicenses(bytes.NewReader(archive), file.NewReadBudget(0, 0))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"MIT"}, licenses)
+}
+
+// gemArchive returns a gem (a tarball with the gzip compressed gemspec and sources) with the given YAML gemspec.
+func gemArchive(t *testing.T, gemspec string) []byte {
+	t.Helper()
+
+	var metadata bytes.Buffer
+	gz := gzip.NewWriter(&metadata)
+	_, err := gz.Write([]byte(gemspec))
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+
+	var archive bytes.Buffer
+	tw := tar.NewWriter(&archive)
+	for name, contents := range map[string][]byte{
+		"data.tar.gz": {},
+		"metadata.gz": metadata.Bytes(),
+	} {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0444, Size: int64(len(contents))}))
+		_, err := tw.Write(contents)
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	return archive.Bytes()
+}
@@ -14,14 +14,45 @@ import (
 // integrity check
 var _ common.ParserFn = parseGemFileLockEntries
 
-var sectionsOfInterest = internal.NewStringSetFromSlice([]string{"GEM"})
+const (
+	gemSection  = "GEM"
+	gitSection  = "GIT"
+	pathSection = "PATH"
+)
+
+var sectionsOfInterest = internal.NewStringSetFromSlice([]string{gemSection, gitSection, pathSection})
+
+// gemfileLockEntry is a gem listed within a Gemfile.lock, along with the source section it was listed under.
+type gemfileLockEntry struct {
+	name    string
+	version string
+	section string
+	// remote is the gem server (GEM), repository (GIT), or directory relative to the Gemfile (PATH) of the source
+	remote string
+	// revision is the commit that gems from a GIT source are locked to
+	revision string
+}
 
 // parseGemFileLockEntries is a parser function for Gemfile.lock contents, returning all Gems discovered.
 func parseGemFileLockEntries(_ string, reader io.Reader) ([]*pkg.Package, []artifact.Relationship, error) {
+	entries, err := parseGemFileLock(reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	pkgs := make([]*pkg.Package, 0)
+	for _, entry := range entries {
+		pkgs = append(pkgs, newGemfileLockPackage(entry, entry.lockedVersion()))
+	}
+	return pkgs, nil, nil
+}
+
+// parseGemFileLock returns all gems listed within the GEM, GIT, and PATH sections of a Gemfile.lock.
+func parseGemFileLock(reader io.Reader) ([]gemfileLockEntry, error) {
+	var entries []gemfileLockEntry
 	scanner := bufio.NewScanner(reader)
 
-	var currentSection string
+	var currentSection, remote, revision string
 
 	for scanner.Scan() {
 		line := scanner.Text()
@@ -30,29 +61,54 @@ func parseGemFileLockEntries(_ string, reader io.Reader) ([]*pkg.Package, []arti
 		if len(line) > 1 && line[0] != ' ' {
 			// start of section
 			currentSection = sanitizedLine
+			remote, revision = "", ""
 			continue
 		} else if !sectionsOfInterest.Contains(currentSection) {
 			// skip this line, we're in the wrong section
 			continue
 		}
 
-		if isDependencyLine(line) {
+		switch {
+		case strings.HasPrefix(sanitizedLine, "remote:"):
+			remote = strings.TrimSpace(strings.TrimPrefix(sanitizedLine, "remote:"))
+		case strings.HasPrefix(sanitizedLine, "revision:"):
+			revision = strings.TrimSpace(strings.TrimPrefix(sanitizedLine, "revision:"))
+		case isDependencyLine(line):
 			candidate := strings.Fields(sanitizedLine)
 			if len(candidate) != 2 {
 				continue
 			}
-			pkgs = append(pkgs, &pkg.Package{
-				Name:     candidate[0],
-				Version:  strings.Trim(candidate[1], "()"),
-				Language: pkg.Ruby,
-				Type:     pkg.GemPkg,
+			entries = append(entries, gemfileLockEntry{
+				name:     candidate[0],
+				version:  strings.Trim(candidate[1], "()"),
+				section:  currentSection,
+				remote:   remote,
+				revision: revision,
 			})
 		}
 	}
 	if err := scanner.Err(); err != nil {
-		return nil, nil, err
+		return nil, err
+	}
+	return entries, nil
+}
+
+// lockedVersion returns the version that the gem is locked to: the commit for gems vendored from git repositories,
+// otherwise the version of the gem itself.
+func (e gemfileLockEntry) lockedVersion() string {
+	if e.section == gitSection && e.revision != "" {
+		return e.revision
+	}
+	return e.version
+}
+
+func newGemfileLockPackage(entry gemfileLockEntry, version string) *pkg.Package {
+	return &pkg.Package{
+		Name:     entry.name,
+		Version:  version,
+		Language: pkg.Ruby,
+		Type:     pkg.GemPkg,
 	}
-	return pkgs, nil, nil
 }
 
 func isDependencyLine(line string) bool {
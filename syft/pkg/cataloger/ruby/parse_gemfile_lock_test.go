@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/anchore/syft/syft/pkg"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestParseGemfileLockEntries(t *testing.T) {
@@ -100,3 +101,31 @@ func TestParseGemfileLockEntries(t *testing.T) {
 		}
 	}
 }
+
+func TestParseGemfileLockEntries_VendoredGems(t *testing.T) {
+	fixture, err := os.Open("test-fixtures/Gemfile-vendored.lock")
+	if err != nil {
+		t.Fatalf("failed to open fixture: %+v", err)
+	}
+
+	actual, _, err := parseGemFileLockEntries(fixture.Name(), fixture)
+	if err != nil {
+		t.Fatalf("failed to parse gemfile lock: %+v", err)
+	}
+
+	versions := make(map[string]string)
+	for _, a := range actual {
+		versions[a.Name] = a.Version
+	}
+
+	assert.Equal(t, map[string]string{
+		// gems from git sources are locked to a revision
+		"devise": "8593801130f2df94a50863b5db535c272b00efe1",
+		// without the source tree at hand, path gems can only be reported by their gemspec version
+		"billing":   "0.1.0",
+		"admin":     "0.2.0",
+		"reporting": "1.0.0",
+		"bcrypt":    "3.1.16",
+		"rails":     "6.1.4",
+	}, versions)
+}
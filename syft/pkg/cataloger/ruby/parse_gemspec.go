@@ -45,6 +45,10 @@ var patterns = map[string]*regexp.Regexp{
 
 	// match example:	    licenses = ["MIT".freeze]   ----> "MIT".freeze
 	"licenses": regexp.MustCompile(`.*\.licenses\s*=\s*\[(?P<licenses>.*)\] *`),
+
+	// match example:
+	// metadata = { "source_code_uri" => "https://github.com/bundler/bundler/" }   --->   https://github.com/bundler/bundler/
+	"source_code_uri": regexp.MustCompile(`.*\.metadata\s*=.*["']source_code_uri["']\s*=>\s*["'](?P<source_code_uri>[^"']*)["']`),
 }
 
 var postProcessors = map[string]postProcessor{
@@ -97,6 +101,9 @@ func parseGemSpecEntries(_ string, reader io.Reader) ([]*pkg.Package, []artifact
 			return nil, nil, fmt.Errorf("unable to decode gem metadata: %w", err)
 		}
 
+		// the source code URI is not part of the gem metadata, but is where the package originates from
+		sourceCodeURI, _ := fields["source_code_uri"].(string)
+
 		pkgs = append(pkgs, &pkg.Package{
 			Name:         metadata.Name,
 			Version:      metadata.Version,
@@ -105,6 +112,10 @@ func parseGemSpecEntries(_ string, reader io.Reader) ([]*pkg.Package, []artifact
 			Type:         pkg.GemPkg,
 			MetadataType: pkg.GemMetadataType,
 			Metadata:     metadata,
+			OriginURLs: pkg.OriginURLs{
+				Homepage:   metadata.Homepage,
+				Repository: sourceCodeURI,
+			},
 		})
 	}
 
@@ -24,6 +24,10 @@ func TestParseGemspec(t *testing.T) {
 			Licenses: []string{"MIT"},
 			Homepage: "https://bundler.io",
 		},
+		OriginURLs: pkg.OriginURLs{
+			Homepage:   "https://bundler.io",
+			Repository: "https://github.com/bundler/bundler/",
+		},
 	}
 
 	fixture, err := os.Open("test-fixtures/bundler.gemspec")
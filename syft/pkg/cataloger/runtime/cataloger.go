@@ -0,0 +1,21 @@
+/*
+Package runtime provides a concrete Cataloger implementation for language runtime installations (e.g. a JDK, Node.js,
+or .NET) which are not otherwise owned by an OS package manager.
+*/
+package runtime
+
+import (
+	"github.com/anchore/syft/syft/pkg/cataloger/common"
+)
+
+// NewRuntimeCataloger returns a new cataloger object for language runtime installations, so that vulnerabilities in
+// the runtime itself can be matched (not only the vulnerabilities in the application dependencies it runs).
+func NewRuntimeCataloger() *common.GenericCataloger {
+	globParsers := map[string]common.ParserFn{
+		"**/release":                                 parseJavaRelease,
+		"**/include/node/node_version.h":             parseNodeVersionHeader,
+		"**/shared/Microsoft.NETCore.App/*/.version": parseDotnetVersion,
+	}
+
+	return common.NewGenericCataloger(nil, globParsers, "runtime-cataloger")
+}
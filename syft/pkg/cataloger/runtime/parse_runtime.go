@@ -0,0 +1,146 @@
+package runtime
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/common"
+)
+
+// integrity check
+var _ common.ParserFn = parseJavaRelease
+var _ common.ParserFn = parseNodeVersionHeader
+var _ common.ParserFn = parseDotnetVersion
+
+const (
+	javaRuntime   = "openjdk"
+	nodeRuntime   = "node"
+	dotnetRuntime = "dotnet"
+)
+
+var nodeVersionDefinePattern = regexp.MustCompile(`^#define\s+NODE_(MAJOR|MINOR|PATCH)_VERSION\s+(\d+)`)
+
+func newRuntimePackage(m pkg.RuntimeMetadata, version string) *pkg.Package {
+	return &pkg.Package{
+		Name:         m.Runtime,
+		Version:      version,
+		Type:         pkg.RuntimePkg,
+		MetadataType: pkg.RuntimeMetadataType,
+		Metadata:     m,
+	}
+}
+
+// parseJavaRelease is a parser function for the "release" file at the root of a JDK or JRE installation, which
+// describes the version of the JVM (e.g. JAVA_VERSION="17.0.1"). Other files named "release" are ignored.
+func parseJavaRelease(filePath string, reader io.Reader) ([]*pkg.Package, []artifact.Relationship, error) {
+	var version, implementor string
+
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		fields := strings.SplitN(scanner.Text(), "=", 2)
+		if len(fields) != 2 {
+			continue
+		}
+
+		value := strings.Trim(strings.TrimSpace(fields[1]), `"`)
+		switch strings.TrimSpace(fields[0]) {
+		case "JAVA_VERSION":
+			version = value
+		case "IMPLEMENTOR":
+			implementor = value
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("unable to read java release file: %w", err)
+	}
+
+	if version == "" {
+		return nil, nil, nil
+	}
+
+	return []*pkg.Package{
+		newRuntimePackage(pkg.RuntimeMetadata{
+			Runtime:     javaRuntime,
+			Implementor: implementor,
+			InstallPath: path.Dir(filePath),
+		}, version),
+	}, nil, nil
+}
+
+// parseNodeVersionHeader is a parser function for the node_version.h header installed alongside the node binary (e.g.
+// /usr/local/include/node/node_version.h), which holds the major, minor, and patch version of the runtime.
+func parseNodeVersionHeader(filePath string, reader io.Reader) ([]*pkg.Package, []artifact.Relationship, error) {
+	parts := make(map[string]string)
+
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		match := nodeVersionDefinePattern.FindStringSubmatch(strings.TrimSpace(scanner.Text()))
+		if match == nil {
+			continue
+		}
+		// only the first definition is kept (later definitions may be within conditional blocks)
+		if _, ok := parts[match[1]]; !ok {
+			parts[match[1]] = match[2]
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("unable to read node version header: %w", err)
+	}
+
+	if len(parts) != 3 {
+		return nil, nil, nil
+	}
+
+	// the header lives at PREFIX/include/node/node_version.h
+	installPath := path.Dir(path.Dir(path.Dir(filePath)))
+
+	return []*pkg.Package{
+		newRuntimePackage(pkg.RuntimeMetadata{
+			Runtime:     nodeRuntime,
+			InstallPath: installPath,
+		}, fmt.Sprintf("%s.%s.%s", parts["MAJOR"], parts["MINOR"], parts["PATCH"])),
+	}, nil, nil
+}
+
+// parseDotnetVersion is a parser function for the .version file within a .NET shared framework directory (e.g.
+// /usr/share/dotnet/shared/Microsoft.NETCore.App/6.0.1/.version), which holds the source commit followed by the
+// version of the runtime.
+func parseDotnetVersion(filePath string, reader io.Reader) ([]*pkg.Package, []artifact.Relationship, error) {
+	var lines []string
+
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("unable to read dotnet version file: %w", err)
+	}
+
+	// fallback to the name of the framework directory when the version is not within the file
+	version := path.Base(path.Dir(filePath))
+	if len(lines) >= 2 {
+		version = lines[1]
+	}
+
+	// the version file lives at ROOT/shared/Microsoft.NETCore.App/VERSION/.version
+	installPath := path.Dir(path.Dir(path.Dir(path.Dir(filePath))))
+
+	return []*pkg.Package{
+		newRuntimePackage(pkg.RuntimeMetadata{
+			Runtime:     dotnetRuntime,
+			Implementor: "Microsoft",
+			InstallPath: installPath,
+		}, version),
+	}, nil, nil
+}
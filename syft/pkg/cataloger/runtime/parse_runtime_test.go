@@ -0,0 +1,73 @@
+package runtime
+
+import (
+	"os"
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/common"
+	"github.com/go-test/deep"
+)
+
+func TestParseRuntimes(t *testing.T) {
+	tests := []struct {
+		fixture  string
+		parser   common.ParserFn
+		expected []*pkg.Package
+	}{
+		{
+			fixture: "test-fixtures/openjdk/release",
+			parser:  parseJavaRelease,
+			expected: []*pkg.Package{
+				newRuntimePackage(pkg.RuntimeMetadata{
+					Runtime:     "openjdk",
+					Implementor: "Eclipse Adoptium",
+					InstallPath: "test-fixtures/openjdk",
+				}, "17.0.1"),
+			},
+		},
+		{
+			fixture: "test-fixtures/not-java/release",
+			parser:  parseJavaRelease,
+		},
+		{
+			fixture: "test-fixtures/node/include/node/node_version.h",
+			parser:  parseNodeVersionHeader,
+			expected: []*pkg.Package{
+				newRuntimePackage(pkg.RuntimeMetadata{
+					Runtime:     "node",
+					InstallPath: "test-fixtures/node",
+				}, "16.13.1"),
+			},
+		},
+		{
+			fixture: "test-fixtures/dotnet/shared/Microsoft.NETCore.App/6.0.1/.version",
+			parser:  parseDotnetVersion,
+			expected: []*pkg.Package{
+				newRuntimePackage(pkg.RuntimeMetadata{
+					Runtime:     "dotnet",
+					Implementor: "Microsoft",
+					InstallPath: "test-fixtures/dotnet",
+				}, "6.0.1"),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.fixture, func(t *testing.T) {
+			fixture, err := os.Open(test.fixture)
+			if err != nil {
+				t.Fatalf("failed to open fixture: %+v", err)
+			}
+
+			actual, _, err := test.parser(fixture.Name(), fixture)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, d := range deep.Equal(test.expected, actual) {
+				t.Errorf("diff: %+v", d)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package rust
 import "github.com/anchore/syft/syft/pkg"
 
 type CargoMetadata struct {
+	Version  int                        `toml:"version"` // the lockfile version (absent before version 3)
 	Packages []pkg.CargoPackageMetadata `toml:"package"`
 }
 
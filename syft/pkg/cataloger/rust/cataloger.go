@@ -8,9 +8,9 @@ import (
 )
 
 // NewCargoLockCataloger returns a new Rust Cargo lock file cataloger object.
-func NewCargoLockCataloger() *common.GenericCataloger {
+func NewCargoLockCataloger(cfg Config) *common.GenericCataloger {
 	globParsers := map[string]common.ParserFn{
-		"**/Cargo.lock": parseCargoLock,
+		"**/Cargo.lock": newCargoLockParser(cfg).parse,
 	}
 
 	return common.NewGenericCataloger(nil, globParsers, "rust-cataloger")
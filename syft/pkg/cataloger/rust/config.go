@@ -0,0 +1,6 @@
+package rust
+
+type Config struct {
+	SearchLocalRegistry bool   // fill in license and checksum information for Cargo.lock dependencies from the local cargo registry cache (no network access is performed)
+	LocalRegistryDir    string // the registry cache to search (defaults to $CARGO_HOME/registry, then ~/.cargo/registry)
+}
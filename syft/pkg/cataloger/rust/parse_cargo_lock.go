@@ -3,6 +3,7 @@ package rust
 import (
 	"fmt"
 	"io"
+	"strconv"
 
 	"github.com/anchore/syft/syft/artifact"
 	"github.com/anchore/syft/syft/pkg"
@@ -12,6 +13,35 @@ import (
 
 // integrity check
 var _ common.ParserFn = parseCargoLock
+var _ common.ParserFn = cargoLockParser{}.parse
+
+type cargoLockParser struct {
+	registry *registryCache // the local registry cache to enrich packages from (nil when disabled)
+}
+
+func newCargoLockParser(cfg Config) cargoLockParser {
+	var parser cargoLockParser
+	if cfg.SearchLocalRegistry {
+		parser.registry = newRegistryCache(cfg.LocalRegistryDir)
+	}
+	return parser
+}
+
+// parse is a parser function for Cargo.lock contents, returning all rust cargo crates discovered and filling in
+// details from the local registry cache when enabled.
+func (p cargoLockParser) parse(path string, reader io.Reader) ([]*pkg.Package, []artifact.Relationship, error) {
+	packages, relationships, err := parseCargoLock(path, reader)
+	if err != nil || p.registry == nil {
+		return packages, relationships, err
+	}
+	for _, rustPkg := range packages {
+		p.registry.enrich(rustPkg)
+	}
+	return packages, relationships, nil
+}
+
+// maxCargoLockVersion is the newest Cargo.lock version understood by the parser.
+const maxCargoLockVersion = 3
 
 // parseCargoLock is a parser function for Cargo.lock contents, returning all rust cargo crates discovered.
 func parseCargoLock(_ string, reader io.Reader) ([]*pkg.Package, []artifact.Relationship, error) {
@@ -26,5 +56,13 @@ func parseCargoLock(_ string, reader io.Reader) ([]*pkg.Package, []artifact.Rela
 		return nil, nil, fmt.Errorf("unable to parse Cargo.lock: %v", err)
 	}
 
+	if metadata.Version > maxCargoLockVersion {
+		return nil, nil, common.UnsupportedVersionError{
+			Kind:      "Cargo.lock",
+			Version:   strconv.Itoa(metadata.Version),
+			Supported: []string{"1", "2", "3"},
+		}
+	}
+
 	return metadata.Pkgs(), nil, nil
 }
@@ -4,8 +4,12 @@ import (
 	"os"
 	"testing"
 
+	"github.com/anchore/syft/syft/file"
 	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/common"
 	"github.com/go-test/deep"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestParseCargoLock(t *testing.T) {
@@ -26,6 +30,12 @@ func TestParseCargoLock(t *testing.T) {
 					"winapi",
 				},
 			},
+			OriginURLs: pkg.OriginURLs{
+				Download: "https://crates.io/api/v1/crates/ansi_term/0.12.1/download",
+			},
+			Checksums: []file.Digest{
+				{Algorithm: "sha256", Value: "d52a9bb7ec0cf484c551830a7ce27bd20d67eac647e1befb56b0be4ee39a55d2"},
+			},
 		},
 		{
 			Name:         "matches",
@@ -41,6 +51,12 @@ func TestParseCargoLock(t *testing.T) {
 				Checksum:     "7ffc5c5338469d4d3ea17d269fa8ea3512ad247247c30bd2df69e68309ed0a08",
 				Dependencies: []string{},
 			},
+			OriginURLs: pkg.OriginURLs{
+				Download: "https://crates.io/api/v1/crates/matches/0.1.8/download",
+			},
+			Checksums: []file.Digest{
+				{Algorithm: "sha256", Value: "7ffc5c5338469d4d3ea17d269fa8ea3512ad247247c30bd2df69e68309ed0a08"},
+			},
 		},
 		{
 			Name:         "memchr",
@@ -56,6 +72,12 @@ func TestParseCargoLock(t *testing.T) {
 				Checksum:     "3728d817d99e5ac407411fa471ff9800a778d88a24685968b36824eaf4bee400",
 				Dependencies: []string{},
 			},
+			OriginURLs: pkg.OriginURLs{
+				Download: "https://crates.io/api/v1/crates/memchr/2.3.3/download",
+			},
+			Checksums: []file.Digest{
+				{Algorithm: "sha256", Value: "3728d817d99e5ac407411fa471ff9800a778d88a24685968b36824eaf4bee400"},
+			},
 		},
 		{
 			Name:         "natord",
@@ -71,6 +93,12 @@ func TestParseCargoLock(t *testing.T) {
 				Checksum:     "308d96db8debc727c3fd9744aac51751243420e46edf401010908da7f8d5e57c",
 				Dependencies: []string{},
 			},
+			OriginURLs: pkg.OriginURLs{
+				Download: "https://crates.io/api/v1/crates/natord/1.0.9/download",
+			},
+			Checksums: []file.Digest{
+				{Algorithm: "sha256", Value: "308d96db8debc727c3fd9744aac51751243420e46edf401010908da7f8d5e57c"},
+			},
 		},
 		{
 			Name:         "nom",
@@ -89,6 +117,12 @@ func TestParseCargoLock(t *testing.T) {
 					"version_check",
 				},
 			},
+			OriginURLs: pkg.OriginURLs{
+				Download: "https://crates.io/api/v1/crates/nom/4.2.3/download",
+			},
+			Checksums: []file.Digest{
+				{Algorithm: "sha256", Value: "2ad2a91a8e869eeb30b9cb3119ae87773a8f4ae617f41b1eb9c154b2905f7bd6"},
+			},
 		},
 		{
 			Name:         "unicode-bidi",
@@ -106,6 +140,12 @@ func TestParseCargoLock(t *testing.T) {
 					"matches",
 				},
 			},
+			OriginURLs: pkg.OriginURLs{
+				Download: "https://crates.io/api/v1/crates/unicode-bidi/0.3.4/download",
+			},
+			Checksums: []file.Digest{
+				{Algorithm: "sha256", Value: "49f2bd0c6468a8230e1db229cff8029217cf623c767ea5d60bfbd42729ea54d5"},
+			},
 		},
 		{
 			Name:         "version_check",
@@ -121,6 +161,12 @@ func TestParseCargoLock(t *testing.T) {
 				Checksum:     "914b1a6776c4c929a602fafd8bc742e06365d4bcbe48c30f9cca5824f70dc9dd",
 				Dependencies: []string{},
 			},
+			OriginURLs: pkg.OriginURLs{
+				Download: "https://crates.io/api/v1/crates/version_check/0.1.5/download",
+			},
+			Checksums: []file.Digest{
+				{Algorithm: "sha256", Value: "914b1a6776c4c929a602fafd8bc742e06365d4bcbe48c30f9cca5824f70dc9dd"},
+			},
 		},
 		{
 			Name:         "winapi",
@@ -139,6 +185,12 @@ func TestParseCargoLock(t *testing.T) {
 					"winapi-x86_64-pc-windows-gnu",
 				},
 			},
+			OriginURLs: pkg.OriginURLs{
+				Download: "https://crates.io/api/v1/crates/winapi/0.3.9/download",
+			},
+			Checksums: []file.Digest{
+				{Algorithm: "sha256", Value: "5c839a674fcd7a98952e593242ea400abe93992746761e38641405d28b00f419"},
+			},
 		},
 		{
 			Name:         "winapi-i686-pc-windows-gnu",
@@ -154,6 +206,12 @@ func TestParseCargoLock(t *testing.T) {
 				Checksum:     "ac3b87c63620426dd9b991e5ce0329eff545bccbbb34f3be09ff6fb6ab51b7b6",
 				Dependencies: []string{},
 			},
+			OriginURLs: pkg.OriginURLs{
+				Download: "https://crates.io/api/v1/crates/winapi-i686-pc-windows-gnu/0.4.0/download",
+			},
+			Checksums: []file.Digest{
+				{Algorithm: "sha256", Value: "ac3b87c63620426dd9b991e5ce0329eff545bccbbb34f3be09ff6fb6ab51b7b6"},
+			},
 		},
 		{
 			Name:         "winapi-x86_64-pc-windows-gnu",
@@ -169,6 +227,12 @@ func TestParseCargoLock(t *testing.T) {
 				Checksum:     "712e227841d057c1ee1cd2fb22fa7e5a5461ae8e48fa2ca79ec42cfc1931183f",
 				Dependencies: []string{},
 			},
+			OriginURLs: pkg.OriginURLs{
+				Download: "https://crates.io/api/v1/crates/winapi-x86_64-pc-windows-gnu/0.4.0/download",
+			},
+			Checksums: []file.Digest{
+				{Algorithm: "sha256", Value: "712e227841d057c1ee1cd2fb22fa7e5a5461ae8e48fa2ca79ec42cfc1931183f"},
+			},
 		},
 	}
 
@@ -188,3 +252,94 @@ func TestParseCargoLock(t *testing.T) {
 		t.Errorf("returned package list differed from expectation: %+v", differences)
 	}
 }
+
+func TestCargoLockParser_LocalRegistry(t *testing.T) {
+	f, err := os.Open("test-fixtures/registry-Cargo.lock")
+	require.NoError(t, err)
+	defer f.Close()
+
+	parser := newCargoLockParser(Config{
+		SearchLocalRegistry: true,
+		LocalRegistryDir:    "test-fixtures/registry",
+	})
+	actual, _, err := parser.parse("test-fixtures/registry-Cargo.lock", f)
+	require.NoError(t, err)
+
+	expected := []*pkg.Package{
+		{
+			Name:         "ansi_term",
+			Version:      "0.12.1",
+			Language:     pkg.Rust,
+			Type:         pkg.RustPkg,
+			MetadataType: pkg.RustCargoPackageMetadataType,
+			Licenses:     []string{"MIT"},
+			Metadata: pkg.CargoPackageMetadata{
+				Name:         "ansi_term",
+				Version:      "0.12.1",
+				Source:       "registry+https://github.com/rust-lang/crates.io-index",
+				Checksum:     "d52a9bb7ec0cf484c551830a7ce27bd20d67eac647e1befb56b0be4ee39a55d2",
+				Dependencies: []string{},
+			},
+			OriginURLs: pkg.OriginURLs{
+				Download: "https://crates.io/api/v1/crates/ansi_term/0.12.1/download",
+			},
+			Checksums: []file.Digest{
+				{Algorithm: "sha256", Value: "d52a9bb7ec0cf484c551830a7ce27bd20d67eac647e1befb56b0be4ee39a55d2"},
+			},
+		},
+		{
+			// the checksum is missing from the lock file, so it is taken from the cached .crate file
+			Name:         "memchr",
+			Version:      "2.3.3",
+			Language:     pkg.Rust,
+			Type:         pkg.RustPkg,
+			MetadataType: pkg.RustCargoPackageMetadataType,
+			Licenses:     []string{"Unlicense/MIT"},
+			Metadata: pkg.CargoPackageMetadata{
+				Name:         "memchr",
+				Version:      "2.3.3",
+				Source:       "sparse+https://index.crates.io/",
+				Checksum:     "82e45fb55de14564641b01d269728100e7bb9cd7aa78a1c255a4662702e02085",
+				Dependencies: []string{},
+			},
+			OriginURLs: pkg.OriginURLs{
+				Download: "https://crates.io/api/v1/crates/memchr/2.3.3/download",
+			},
+			Checksums: []file.Digest{
+				{Algorithm: "sha256", Value: "82e45fb55de14564641b01d269728100e7bb9cd7aa78a1c255a4662702e02085"},
+			},
+		},
+		{
+			// git dependencies are never looked up in the registry cache
+			Name:         "natord",
+			Version:      "1.0.9",
+			Language:     pkg.Rust,
+			Type:         pkg.RustPkg,
+			MetadataType: pkg.RustCargoPackageMetadataType,
+			Metadata: pkg.CargoPackageMetadata{
+				Name:         "natord",
+				Version:      "1.0.9",
+				Source:       "git+https://github.com/lifthrasiir/rust-natord#7c4c1b9d8f3e8b0e7b0c1a54b0c6a3e0f1f9d3a1",
+				Dependencies: []string{},
+			},
+			OriginURLs: pkg.OriginURLs{
+				Repository: "git+https://github.com/lifthrasiir/rust-natord#7c4c1b9d8f3e8b0e7b0c1a54b0c6a3e0f1f9d3a1",
+			},
+		},
+	}
+	assert.Equal(t, expected, actual)
+}
+
+func TestParseCargoLock_UnsupportedVersion(t *testing.T) {
+	f, err := os.Open("test-fixtures/unsupported-version/Cargo.lock")
+	require.NoError(t, err)
+	defer f.Close()
+
+	actual, _, err := parseCargoLock(f.Name(), f)
+	assert.Empty(t, actual)
+	assert.Equal(t, common.UnsupportedVersionError{
+		Kind:      "Cargo.lock",
+		Version:   "4",
+		Supported: []string{"1", "2", "3"},
+	}, err)
+}
@@ -0,0 +1,127 @@
+package rust
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/mitchellh/go-homedir"
+	"github.com/pelletier/go-toml"
+)
+
+// registryCache looks up crates in a local cargo registry cache (as populated by "cargo fetch"), where the downloaded
+// .crate files are kept in "cache/<index>/" and their extracted sources in "src/<index>/". Only the local filesystem is
+// read, the network is never consulted.
+type registryCache struct {
+	dir string
+}
+
+// cargoManifest is the subset of a crate's Cargo.toml needed for enrichment.
+type cargoManifest struct {
+	Package struct {
+		License string `toml:"license"`
+	} `toml:"package"`
+}
+
+func newRegistryCache(dir string) *registryCache {
+	if dir == "" {
+		dir = defaultRegistryDir()
+	}
+	log.Debugf("searching the local cargo registry cache: %s", dir)
+	return &registryCache{dir: dir}
+}
+
+// defaultRegistryDir returns the registry cache location cargo would use.
+func defaultRegistryDir() string {
+	if dir := os.Getenv("CARGO_HOME"); dir != "" {
+		return filepath.Join(dir, "registry")
+	}
+	home, err := homedir.Dir()
+	if err != nil {
+		log.Debugf("unable to determine the cargo registry cache: %+v", err)
+		return ""
+	}
+	return filepath.Join(home, ".cargo", "registry")
+}
+
+// enrich fills in the license and checksum of the given crate when it is in the cache. Crates that do not come from
+// a registry (e.g. git or path dependencies) and details that are already known are left untouched.
+func (c *registryCache) enrich(p *pkg.Package) {
+	metadata, ok := p.Metadata.(pkg.CargoPackageMetadata)
+	if !ok || c.dir == "" || !isRegistrySource(metadata.Source) {
+		return
+	}
+	crate := fmt.Sprintf("%s-%s", metadata.Name, metadata.Version)
+
+	if len(p.Licenses) == 0 {
+		if license := c.license(crate); license != "" {
+			p.Licenses = []string{license}
+		}
+	}
+
+	if metadata.Checksum == "" {
+		metadata.Checksum = c.checksum(crate)
+		p.Metadata = metadata
+		p.Checksums = metadata.Checksums()
+	}
+}
+
+// license returns the license expression declared in the Cargo.toml of the extracted crate sources.
+func (c *registryCache) license(crate string) string {
+	for _, manifestPath := range c.find(filepath.Join("src", "*", crate, "Cargo.toml")) {
+		tree, err := toml.LoadFile(manifestPath)
+		if err != nil {
+			log.Debugf("unable to load cached Cargo.toml=%q: %+v", manifestPath, err)
+			continue
+		}
+		var manifest cargoManifest
+		if err := tree.Unmarshal(&manifest); err != nil {
+			log.Debugf("unable to parse cached Cargo.toml=%q: %+v", manifestPath, err)
+			continue
+		}
+		if manifest.Package.License != "" {
+			return manifest.Package.License
+		}
+	}
+	return ""
+}
+
+// checksum returns the sha256 digest of the downloaded .crate file, which is the value cargo records in Cargo.lock.
+func (c *registryCache) checksum(crate string) string {
+	for _, cratePath := range c.find(filepath.Join("cache", "*", crate+".crate")) {
+		f, err := os.Open(cratePath)
+		if err != nil {
+			log.Debugf("unable to open cached crate=%q: %+v", cratePath, err)
+			continue
+		}
+		hasher := sha256.New()
+		_, err = io.Copy(hasher, f)
+		internal.CloseAndLogError(f, cratePath)
+		if err != nil {
+			log.Debugf("unable to read cached crate=%q: %+v", cratePath, err)
+			continue
+		}
+		return fmt.Sprintf("%x", hasher.Sum(nil))
+	}
+	return ""
+}
+
+// find returns the paths within the registry cache matching the given pattern (relative to the cache directory).
+func (c *registryCache) find(pattern string) []string {
+	matches, err := filepath.Glob(filepath.Join(c.dir, pattern))
+	if err != nil {
+		log.Debugf("unable to search the cargo registry cache: %+v", err)
+		return nil
+	}
+	return matches
+}
+
+func isRegistrySource(source string) bool {
+	return strings.HasPrefix(source, "registry+") || strings.HasPrefix(source, "sparse+")
+}
@@ -1,11 +1,33 @@
 package cataloger
 
-import "github.com/anchore/syft/syft/source"
+import (
+	"github.com/anchore/syft/internal/file"
+	"github.com/anchore/syft/syft/source"
+)
 
 type SearchConfig struct {
 	IncludeIndexedArchives   bool
 	IncludeUnindexedArchives bool
+	IncludeSourceMaps        bool // recover the packages compiled into javascript bundles from their source maps
 	Scope                    source.Scope
+	MaxDepthByCataloger      map[string]int // cataloger name -> the max depth (relative to the source root) that cataloger may search
+	ArchiveLimits            ArchiveLimits
+}
+
+// ArchiveLimits bounds the resources used by catalogers that open archives (e.g. java archives), protecting
+// against decompression bombs and deeply nested archives.
+type ArchiveLimits struct {
+	MaxNestingDepth     int   // the max depth of archives within archives to process (0 = unlimited)
+	MaxDecompressedSize int64 // the max bytes to extract from an archive, including all nested archives (0 = unlimited)
+	MaxFileSize         int64 // the max bytes to extract for any single entry within an archive (0 = 2GB)
+}
+
+func DefaultArchiveLimits() ArchiveLimits {
+	return ArchiveLimits{
+		MaxNestingDepth:     10,
+		MaxDecompressedSize: 10 * file.GB,
+		MaxFileSize:         2 * file.GB,
+	}
 }
 
 func DefaultSearchConfig() SearchConfig {
@@ -13,5 +35,6 @@ func DefaultSearchConfig() SearchConfig {
 		IncludeIndexedArchives:   true,
 		IncludeUnindexedArchives: false,
 		Scope:                    source.SquashedScope,
+		ArchiveLimits:            DefaultArchiveLimits(),
 	}
 }
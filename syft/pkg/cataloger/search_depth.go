@@ -0,0 +1,45 @@
+package cataloger
+
+import (
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/source"
+)
+
+// depthLimitedCataloger decorates a cataloger such that it can only see files up to a maximum depth within the source.
+type depthLimitedCataloger struct {
+	Cataloger
+	maxDepth int
+}
+
+// Catalog invokes the delegate cataloger with a resolver that hides any files deeper than the configured depth.
+func (c depthLimitedCataloger) Catalog(resolver source.FileResolver) ([]pkg.Package, []artifact.Relationship, error) {
+	return c.Cataloger.Catalog(source.NewDepthLimitingResolver(resolver, c.maxDepth))
+}
+
+// Warnings returns the warnings of the delegate cataloger (if it reports any).
+func (c depthLimitedCataloger) Warnings() []source.Warning {
+	if reporter, ok := c.Cataloger.(WarningReporter); ok {
+		return reporter.Warnings()
+	}
+	return nil
+}
+
+// LimitSearchDepth restricts the search scope of each cataloger named in the given mapping to the given max depth
+// (relative to the root of the source). Catalogers without an entry (or with a non-positive depth) are left unchanged.
+func LimitSearchDepth(catalogers []Cataloger, maxDepthByCataloger map[string]int) []Cataloger {
+	if len(maxDepthByCataloger) == 0 {
+		return catalogers
+	}
+	results := make([]Cataloger, 0, len(catalogers))
+	for _, c := range catalogers {
+		if depth, ok := maxDepthByCataloger[c.Name()]; ok && depth > 0 {
+			c = depthLimitedCataloger{
+				Cataloger: c,
+				maxDepth:  depth,
+			}
+		}
+		results = append(results, c)
+	}
+	return results
+}
@@ -0,0 +1,79 @@
+package cataloger
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/source"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// globCataloger returns a package for every file matching the given glob
+type globCataloger struct {
+	name string
+	glob string
+}
+
+func (c globCataloger) Name() string {
+	return c.name
+}
+
+func (c globCataloger) Catalog(resolver source.FileResolver) ([]pkg.Package, []artifact.Relationship, error) {
+	locations, err := resolver.FilesByGlob(c.glob)
+	if err != nil {
+		return nil, nil, err
+	}
+	var packages []pkg.Package
+	for _, l := range locations {
+		packages = append(packages, pkg.Package{Name: l.RealPath})
+	}
+	return packages, nil, nil
+}
+
+func TestLimitSearchDepth(t *testing.T) {
+	resolver := source.NewMockResolverForPaths("/go.mod", "/a/go.mod", "/a/b/go.mod", "/a/b/c/go.mod")
+
+	tests := []struct {
+		name     string
+		depths   map[string]int
+		expected []string
+	}{
+		{
+			name:     "no limits",
+			expected: []string{"/go.mod", "/a/go.mod", "/a/b/go.mod", "/a/b/c/go.mod"},
+		},
+		{
+			name:     "limit for cataloger",
+			depths:   map[string]int{"go-mod": 3},
+			expected: []string{"/go.mod", "/a/go.mod", "/a/b/go.mod"},
+		},
+		{
+			name:     "limit for another cataloger",
+			depths:   map[string]int{"something-else": 1},
+			expected: []string{"/go.mod", "/a/go.mod", "/a/b/go.mod", "/a/b/c/go.mod"},
+		},
+		{
+			name:     "zero is unlimited",
+			depths:   map[string]int{"go-mod": 0},
+			expected: []string{"/go.mod", "/a/go.mod", "/a/b/go.mod", "/a/b/c/go.mod"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			catalogers := LimitSearchDepth([]Cataloger{globCataloger{name: "go-mod", glob: "**/go.mod"}}, test.depths)
+			require.Len(t, catalogers, 1)
+			assert.Equal(t, "go-mod", catalogers[0].Name())
+
+			packages, _, err := catalogers[0].Catalog(resolver)
+			require.NoError(t, err)
+
+			var actual []string
+			for _, p := range packages {
+				actual = append(actual, p.Name)
+			}
+			assert.ElementsMatch(t, test.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,18 @@
+/*
+Package staticlib provides a concrete Cataloger implementation for static libraries (ar archives of object files).
+*/
+package staticlib
+
+import (
+	"github.com/anchore/syft/syft/pkg/cataloger/common"
+)
+
+// NewStaticLibraryCataloger returns a new cataloger object for static libraries (e.g. libz.a), so that libraries
+// vendored into build images are inventoried even when no package manager knows about them.
+func NewStaticLibraryCataloger() *common.GenericCataloger {
+	globParsers := map[string]common.ParserFn{
+		"**/*.a": parseStaticLibrary,
+	}
+
+	return common.NewGenericCataloger(nil, globParsers, "static-library-cataloger")
+}
@@ -0,0 +1,205 @@
+package staticlib
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/common"
+)
+
+// integrity check
+var _ common.ParserFn = parseStaticLibrary
+
+const (
+	arMagic      = "!<arch>\n"
+	arHeaderSize = 60
+)
+
+var versionInFilenamePattern = regexp.MustCompile(`^(?P<name>.+?)[-_](?P<version>\d+(\.\d+)+[a-z]?)$`)
+
+// arMember is a single file within an ar archive.
+type arMember struct {
+	name string
+	data []byte
+}
+
+func newStaticLibraryPackage(m pkg.StaticLibraryMetadata, version string) *pkg.Package {
+	return &pkg.Package{
+		Name:         m.Library,
+		Version:      version,
+		Type:         pkg.StaticLibraryPkg,
+		MetadataType: pkg.StaticLibraryMetadataType,
+		Metadata:     m,
+	}
+}
+
+// parseStaticLibrary is a parser function for static libraries (ar archives), returning a single package for the
+// library. The version is taken from the file name (e.g. libfoo-1.2.3.a) or, failing that, from a version string
+// embedded within the objects (e.g. "libpng version 1.6.37").
+func parseStaticLibrary(filePath string, reader io.Reader) ([]*pkg.Package, []artifact.Relationship, error) {
+	contents, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to read static library: %w", err)
+	}
+
+	// there are other files with an ".a" extension, only ar archives are considered
+	if !bytes.HasPrefix(contents, []byte(arMagic)) {
+		return nil, nil, nil
+	}
+
+	members, symbols, err := readArchive(contents)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(members) == 0 {
+		return nil, nil, nil
+	}
+
+	name, version := libraryNameAndVersion(path.Base(filePath))
+	if version == "" {
+		version = embeddedVersion(name, members)
+	}
+
+	metadata := pkg.StaticLibraryMetadata{
+		Library: name,
+	}
+	for _, m := range members {
+		metadata.Objects = append(metadata.Objects, m.name)
+	}
+	for _, s := range symbols {
+		if strings.Contains(strings.ToLower(s), "version") {
+			metadata.VersionSymbols = append(metadata.VersionSymbols, s)
+		}
+	}
+	sort.Strings(metadata.VersionSymbols)
+
+	return []*pkg.Package{newStaticLibraryPackage(metadata, version)}, nil, nil
+}
+
+// readArchive returns the object files and the global symbol names (from the archive symbol table) within the given
+// ar archive contents. Both the GNU/SysV and BSD variants of long member names are supported.
+func readArchive(contents []byte) (members []arMember, symbols []string, err error) {
+	var longNames []byte
+
+	offset := len(arMagic)
+	for offset+arHeaderSize <= len(contents) {
+		header := contents[offset : offset+arHeaderSize]
+		if string(header[58:60]) != "`\n" {
+			return nil, nil, fmt.Errorf("bad ar member header at offset %d", offset)
+		}
+
+		size, err := strconv.Atoi(strings.TrimSpace(string(header[48:58])))
+		if err != nil || size < 0 || offset+arHeaderSize+size > len(contents) {
+			return nil, nil, fmt.Errorf("bad ar member size at offset %d", offset)
+		}
+
+		name := strings.TrimSpace(string(header[0:16]))
+		data := contents[offset+arHeaderSize : offset+arHeaderSize+size]
+
+		// member data is aligned to an even offset
+		offset += arHeaderSize + size + size%2
+
+		switch {
+		case name == "/" || name == "/SYM64/":
+			symbols = append(symbols, readSymbolTable(data, name == "/SYM64/")...)
+			continue
+		case name == "//":
+			longNames = data
+			continue
+		case strings.HasPrefix(name, "__.SYMDEF"):
+			continue
+		case strings.HasPrefix(name, "#1/"):
+			// BSD: the name is stored at the start of the member data
+			length, err := strconv.Atoi(name[3:])
+			if err != nil || length > len(data) {
+				return nil, nil, fmt.Errorf("bad ar member name %q", name)
+			}
+			name = strings.TrimRight(string(data[:length]), "\x00")
+			data = data[length:]
+		case strings.HasPrefix(name, "/"):
+			// GNU: the name is stored within the long names member at the given offset
+			start, err := strconv.Atoi(name[1:])
+			if err != nil || start > len(longNames) {
+				return nil, nil, fmt.Errorf("bad ar member name %q", name)
+			}
+			name = string(longNames[start:])
+			if end := strings.Index(name, "/\n"); end >= 0 {
+				name = name[:end]
+			}
+		default:
+			name = strings.TrimSuffix(name, "/")
+		}
+
+		members = append(members, arMember{name: name, data: data})
+	}
+
+	return members, symbols, nil
+}
+
+// readSymbolTable returns the symbol names within a GNU/SysV archive symbol table, which is a big-endian count,
+// followed by that many member offsets, followed by the NUL-terminated symbol names.
+func readSymbolTable(data []byte, wide bool) []string {
+	width := 4
+	if wide {
+		width = 8
+	}
+	if len(data) < width {
+		return nil
+	}
+
+	var count uint64
+	if wide {
+		count = binary.BigEndian.Uint64(data)
+	} else {
+		count = uint64(binary.BigEndian.Uint32(data))
+	}
+
+	start := uint64(width) * (count + 1)
+	if start > uint64(len(data)) {
+		return nil
+	}
+
+	var names []string
+	for _, name := range bytes.Split(data[start:], []byte{0}) {
+		if len(name) > 0 {
+			names = append(names, string(name))
+		}
+	}
+	return names
+}
+
+// libraryNameAndVersion derives the library name from the archive file name (e.g. "libz.a" -> "z"), including the
+// version when present in the file name (e.g. "libfoo-1.2.3.a" -> "foo", "1.2.3").
+func libraryNameAndVersion(fileName string) (string, string) {
+	name := strings.TrimPrefix(strings.TrimSuffix(fileName, ".a"), "lib")
+
+	if match := versionInFilenamePattern.FindStringSubmatch(name); match != nil {
+		return match[1], match[2]
+	}
+	return name, ""
+}
+
+// embeddedVersion searches the object data for a version string that names the library (e.g. "libpng version 1.6.37").
+func embeddedVersion(name string, members []arMember) string {
+	if name == "" {
+		return ""
+	}
+	pattern := regexp.MustCompile(`(?i)\b(?:lib)?` + regexp.QuoteMeta(name) + `(?: version)? v?(\d+\.\d+(?:\.\d+)*[a-z]?)\b`)
+	for _, m := range members {
+		if match := pattern.FindSubmatch(m.data); match != nil {
+			return string(match[1])
+		}
+	}
+	return ""
+}
@@ -0,0 +1,81 @@
+package staticlib
+
+import (
+	"os"
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/go-test/deep"
+)
+
+func TestParseStaticLibrary(t *testing.T) {
+	tests := []struct {
+		fixture  string
+		expected []*pkg.Package
+	}{
+		{
+			// the version is found within the object data, the long object name is within the GNU long names table
+			fixture: "test-fixtures/libdemo.a",
+			expected: []*pkg.Package{
+				newStaticLibraryPackage(pkg.StaticLibraryMetadata{
+					Library:        "demo",
+					Objects:        []string{"demo.o", "demo_compression_helpers.o"},
+					VersionSymbols: []string{"demo_version", "demo_version_string"},
+				}, "1.4.2"),
+			},
+		},
+		{
+			// the version is found within the file name
+			fixture: "test-fixtures/libwidget-2.0.1.a",
+			expected: []*pkg.Package{
+				newStaticLibraryPackage(pkg.StaticLibraryMetadata{
+					Library: "widget",
+					Objects: []string{"demo_compression_helpers.o"},
+				}, "2.0.1"),
+			},
+		},
+		{
+			fixture: "test-fixtures/not-an-archive.a",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.fixture, func(t *testing.T) {
+			fixture, err := os.Open(test.fixture)
+			if err != nil {
+				t.Fatalf("failed to open fixture: %+v", err)
+			}
+
+			actual, _, err := parseStaticLibrary(fixture.Name(), fixture)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, d := range deep.Equal(test.expected, actual) {
+				t.Errorf("diff: %+v", d)
+			}
+		})
+	}
+}
+
+func TestLibraryNameAndVersion(t *testing.T) {
+	tests := []struct {
+		fileName        string
+		expectedName    string
+		expectedVersion string
+	}{
+		{fileName: "libz.a", expectedName: "z"},
+		{fileName: "libpython3.9.a", expectedName: "python3.9"},
+		{fileName: "libfoo-1.2.3.a", expectedName: "foo", expectedVersion: "1.2.3"},
+		{fileName: "libssl_1.1.1k.a", expectedName: "ssl", expectedVersion: "1.1.1k"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.fileName, func(t *testing.T) {
+			name, version := libraryNameAndVersion(test.fileName)
+			if name != test.expectedName || version != test.expectedVersion {
+				t.Errorf("unexpected name/version: %q %q", name, version)
+			}
+		})
+	}
+}
@@ -0,0 +1,5 @@
+const char *demo_version_string = "libdemo version 1.4.2";
+
+const char *demo_version(void) {
+	return demo_version_string;
+}
@@ -0,0 +1,7 @@
+int demo_compress(const char *in, char *out, int n) {
+	int i;
+	for (i = 0; i < n; i++) {
+		out[i] = in[i];
+	}
+	return n;
+}
@@ -0,0 +1,208 @@
+/*
+Package webserver provides a concrete Cataloger implementation for dynamically loadable web server modules (Apache
+httpd modules and nginx dynamic modules), which are often built from source and not tracked by any package database.
+*/
+package webserver
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/source"
+)
+
+const catalogerName = "webserver-module-cataloger"
+
+var (
+	configGlobs = []string{
+		// e.g. /etc/apache2/mods-enabled/ssl.load (debian)
+		"**/mods-enabled/*.load",
+		// e.g. /etc/httpd/conf.modules.d/00-ssl.conf (redhat)
+		"**/conf.modules.d/*.conf",
+		// e.g. /usr/local/apache2/conf/httpd.conf (official docker images) or /etc/apache2/httpd.conf (alpine)
+		"**/httpd.conf",
+		// e.g. /etc/nginx/modules-enabled/50-mod-http-geoip2.conf (debian)
+		"**/modules-enabled/*.conf",
+		// e.g. /etc/nginx/nginx.conf
+		"**/nginx.conf",
+	}
+	moduleGlobs = []string{
+		// e.g. /usr/lib/apache2/modules/mod_ssl.so (debian) or /usr/local/apache2/modules/mod_ssl.so (official docker images)
+		"**/apache2/modules/*.so",
+		// e.g. /usr/lib/apache2/mod_ssl.so (alpine)
+		"**/lib/apache2/*.so",
+		// e.g. /usr/lib64/httpd/modules/mod_ssl.so (redhat)
+		"**/httpd/modules/*.so",
+		// e.g. /usr/lib/nginx/modules/ngx_http_geoip2_module.so or /usr/local/nginx/modules/ngx_http_geoip2_module.so
+		"**/nginx/modules/*.so",
+	}
+)
+
+type Cataloger struct{}
+
+// NewWebServerModuleCataloger returns a new cataloger for Apache httpd and nginx modules, noting which of them are
+// loaded by the web server configuration.
+func NewWebServerModuleCataloger() *Cataloger {
+	return &Cataloger{}
+}
+
+// Name returns a string that uniquely describes a cataloger
+func (c *Cataloger) Name() string {
+	return catalogerName
+}
+
+// Catalog is given an object to resolve file references and content, this function returns any discovered Packages after analyzing web server configurations and modules.
+func (c *Cataloger) Catalog(resolver source.FileResolver) ([]pkg.Package, []artifact.Relationship, error) {
+	directives, directiveLocations, err := c.catalogLoadDirectives(resolver)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	moduleLocations, err := c.moduleLocations(resolver, directives)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var pkgs []pkg.Package
+	for _, location := range moduleLocations {
+		p, err := c.catalogModule(resolver, location)
+		if err != nil {
+			return nil, nil, err
+		}
+		if p == nil {
+			continue
+		}
+
+		// modules are referenced by configurations relative to the server root, so are matched by file name
+		metadata := p.Metadata.(pkg.WebServerModuleMetadata)
+		key := directiveKey(metadata.Server, location.RealPath)
+		if configLocations, enabled := directiveLocations[key]; enabled {
+			metadata.Enabled = true
+			p.Metadata = metadata
+			p.Locations = append(p.Locations, configLocations...)
+		}
+
+		p.FoundBy = c.Name()
+		p.SetID()
+		pkgs = append(pkgs, *p)
+	}
+
+	return pkgs, nil, nil
+}
+
+// catalogLoadDirectives returns all module load directives within the web server configurations, along with the
+// locations of the configurations loading each module.
+func (c *Cataloger) catalogLoadDirectives(resolver source.FileResolver) ([]loadDirective, map[string][]source.Location, error) {
+	locations, err := findByGlobs(resolver, configGlobs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var directives []loadDirective
+	directiveLocations := make(map[string][]source.Location)
+	for _, location := range locations {
+		reader, err := resolver.FileContentsByLocation(location)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to resolve file contents by location=%q: %w", location.RealPath, err)
+		}
+
+		discovered, err := parseLoadDirectives(reader)
+		internal.CloseAndLogError(reader, location.RealPath)
+		if err != nil {
+			log.Warnf("cataloger '%s' failed to parse entries at location=%+v: %+v", c.Name(), location, err)
+			continue
+		}
+
+		for _, d := range discovered {
+			key := directiveKey(d.server, d.path)
+			directiveLocations[key] = append(directiveLocations[key], location)
+		}
+		directives = append(directives, discovered...)
+	}
+
+	return directives, directiveLocations, nil
+}
+
+// moduleLocations returns the locations of all modules within the conventional module directories, as well as any
+// modules loaded by absolute path from elsewhere.
+func (c *Cataloger) moduleLocations(resolver source.FileResolver, directives []loadDirective) ([]source.Location, error) {
+	locations, err := findByGlobs(resolver, moduleGlobs)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := internal.NewStringSet()
+	for _, location := range locations {
+		seen.Add(location.RealPath)
+	}
+
+	for _, d := range directives {
+		if !path.IsAbs(d.path) || seen.Contains(d.path) {
+			continue
+		}
+		found, err := resolver.FilesByPath(d.path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find files by path: %s", d.path)
+		}
+		for _, location := range found {
+			if seen.Contains(location.RealPath) {
+				continue
+			}
+			seen.Add(location.RealPath)
+			locations = append(locations, location)
+		}
+	}
+
+	return locations, nil
+}
+
+// catalogModule returns the package describing the web server module at the given location, if any.
+func (c *Cataloger) catalogModule(resolver source.FileResolver, location source.Location) (*pkg.Package, error) {
+	reader, err := resolver.FileContentsByLocation(location)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve file contents by location=%q: %w", location.RealPath, err)
+	}
+	defer internal.CloseAndLogError(reader, location.RealPath)
+
+	discoveredPkgs, _, err := parseWebServerModule(location.RealPath, reader)
+	if err != nil {
+		log.Warnf("cataloger '%s' failed to parse entries at location=%+v: %+v", c.Name(), location, err)
+		return nil, nil
+	}
+	if len(discoveredPkgs) == 0 {
+		return nil, nil
+	}
+
+	p := discoveredPkgs[0]
+	p.Locations = append(p.Locations, location)
+	return p, nil
+}
+
+// findByGlobs returns the unique locations of all files matching the given globs.
+func findByGlobs(resolver source.FileResolver, globs []string) ([]source.Location, error) {
+	seen := internal.NewStringSet()
+	var locations []source.Location
+	for _, glob := range globs {
+		found, err := resolver.FilesByGlob(glob)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find files by glob: %s", glob)
+		}
+
+		for _, location := range found {
+			if seen.Contains(location.RealPath) {
+				continue
+			}
+			seen.Add(location.RealPath)
+			locations = append(locations, location)
+		}
+	}
+	return locations, nil
+}
+
+func directiveKey(server, modulePath string) string {
+	return server + ":" + path.Base(modulePath)
+}
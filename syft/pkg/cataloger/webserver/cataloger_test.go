@@ -0,0 +1,38 @@
+package webserver
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/source"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebServerModuleCataloger(t *testing.T) {
+	src, err := source.NewFromDirectory("test-fixtures")
+	require.NoError(t, err)
+
+	resolver, err := src.FileResolver(source.SquashedScope)
+	require.NoError(t, err)
+
+	pkgs, _, err := NewWebServerModuleCataloger().Catalog(resolver)
+	require.NoError(t, err)
+	require.Len(t, pkgs, 2)
+
+	byName := make(map[string]pkg.Package)
+	for _, p := range pkgs {
+		byName[p.Name] = p
+	}
+
+	// both modules are loaded by the configuration, which is noted as an additional location
+	apacheModule := byName["mod_example"]
+	assert.Equal(t, "1.2.3", apacheModule.Version)
+	assert.Len(t, apacheModule.Locations, 2)
+	assert.True(t, apacheModule.Metadata.(pkg.WebServerModuleMetadata).Enabled)
+
+	nginxModule := byName["ngx_http_example_module"]
+	assert.Equal(t, "0.4.1", nginxModule.Version)
+	assert.Len(t, nginxModule.Locations, 2)
+	assert.True(t, nginxModule.Metadata.(pkg.WebServerModuleMetadata).Enabled)
+}
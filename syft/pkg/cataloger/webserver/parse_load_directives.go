@@ -0,0 +1,63 @@
+package webserver
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	apacheServer = "apache"
+	nginxServer  = "nginx"
+)
+
+// loadDirective is a single instruction within a web server configuration to load a dynamic module.
+type loadDirective struct {
+	server string
+	// module is the identifier of the module structure within the shared object (only known for apache)
+	module string
+	path   string
+}
+
+// parseLoadDirectives is a parser function for web server configuration files (e.g. /etc/apache2/mods-enabled/*.load
+// or /etc/nginx/modules-enabled/*.conf), returning every apache "LoadModule" and nginx "load_module" directive that
+// is not commented out.
+func parseLoadDirectives(reader io.Reader) ([]loadDirective, error) {
+	var directives []loadDirective
+
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+
+		fields := strings.Fields(strings.TrimSuffix(strings.TrimSpace(line), ";"))
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch {
+		case strings.EqualFold(fields[0], "LoadModule") && len(fields) == 3:
+			// e.g. LoadModule ssl_module /usr/lib/apache2/modules/mod_ssl.so
+			directives = append(directives, loadDirective{
+				server: apacheServer,
+				module: fields[1],
+				path:   strings.Trim(fields[2], `"'`),
+			})
+		case fields[0] == "load_module" && len(fields) == 2:
+			// e.g. load_module modules/ngx_http_geoip2_module.so;
+			directives = append(directives, loadDirective{
+				server: nginxServer,
+				path:   strings.Trim(fields[1], `"'`),
+			})
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("unable to read web server configuration: %w", err)
+	}
+
+	return directives, nil
+}
@@ -0,0 +1,48 @@
+package webserver
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-test/deep"
+)
+
+func TestParseLoadDirectives(t *testing.T) {
+	config := `
+# LoadModule disabled_module modules/mod_disabled.so
+LoadModule ssl_module modules/mod_ssl.so
+loadmodule wsgi_module "/usr/lib/apache2/modules/mod_wsgi.so"
+load_module modules/ngx_http_geoip2_module.so;
+load_module "/usr/lib/nginx/modules/ngx_stream_geoip2_module.so"; # stream support
+`
+
+	expected := []loadDirective{
+		{
+			server: "apache",
+			module: "ssl_module",
+			path:   "modules/mod_ssl.so",
+		},
+		{
+			server: "apache",
+			module: "wsgi_module",
+			path:   "/usr/lib/apache2/modules/mod_wsgi.so",
+		},
+		{
+			server: "nginx",
+			path:   "modules/ngx_http_geoip2_module.so",
+		},
+		{
+			server: "nginx",
+			path:   "/usr/lib/nginx/modules/ngx_stream_geoip2_module.so",
+		},
+	}
+
+	actual, err := parseLoadDirectives(strings.NewReader(config))
+	if err != nil {
+		t.Fatalf("failed to parse load directives: %+v", err)
+	}
+
+	for _, d := range deep.Equal(expected, actual) {
+		t.Errorf("diff: %+v", d)
+	}
+}
@@ -0,0 +1,224 @@
+package webserver
+
+import (
+	"bytes"
+	"debug/elf"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/common"
+)
+
+// integrity check
+var _ common.ParserFn = parseWebServerModule
+
+const (
+	// apache module magic numbers are the date the module API was introduced (e.g. 20120211 for httpd 2.4)
+	minApacheMagicNumber = 19990320
+	maxApacheMagicNumber = 20991231
+	// the high bytes of the magic cookie closing the header of every apache module structure (e.g. "AP24")
+	apacheMagicCookiePrefix = 0x4150
+	// nginx versions are encoded as MAJOR * 1000000 + MINOR * 1000 + PATCH (e.g. 1021006 for 1.21.6)
+	minNginxVersion = 1000000
+	maxNginxVersion = 9999999
+)
+
+var (
+	// e.g. "mod_wsgi/4.9.0", "ModSecurity for Apache/2.9.3 (http://www.modsecurity.org/)", or "ModSecurity-nginx v1.0.3"
+	versionStringPattern = regexp.MustCompile(`^([A-Za-z][\w-]*)[^/]*?[/ ]v?(\d+(?:\.\d+)+(?:[-+~.]?[0-9A-Za-z]+)*)`)
+	moduleNamePrefixes   = []string{"ngx_http_", "ngx_stream_", "ngx_mail_", "ngx_", "mod_"}
+	moduleNameSuffixes   = []string{"_module", "_filter"}
+)
+
+// webServerModule describes the module structure found within a shared object.
+type webServerModule struct {
+	server    string
+	module    string
+	serverAPI string
+}
+
+func newWebServerModulePackage(name, version string, m pkg.WebServerModuleMetadata) *pkg.Package {
+	return &pkg.Package{
+		Name:         name,
+		Version:      version,
+		Type:         pkg.WebServerModulePkg,
+		MetadataType: pkg.WebServerModuleMetadataType,
+		Metadata:     m,
+	}
+}
+
+// parseWebServerModule is a parser function for dynamically loadable web server modules (e.g.
+// /usr/lib/apache2/modules/mod_wsgi.so or /usr/lib/nginx/modules/ngx_http_geoip2_module.so), returning the module
+// described by the apache or nginx module structure exported by the shared object. Shared objects which are not web
+// server modules are ignored.
+func parseWebServerModule(filePath string, reader io.Reader) ([]*pkg.Package, []artifact.Relationship, error) {
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to read web server module: %w", err)
+	}
+
+	f, err := elf.NewFile(bytes.NewReader(data))
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to parse web server module=%q as an ELF shared object: %w", filePath, err)
+	}
+	defer f.Close()
+
+	module := findModuleStructure(f)
+	if module == nil {
+		return nil, nil, nil
+	}
+
+	return []*pkg.Package{
+		newWebServerModulePackage(strings.TrimSuffix(path.Base(filePath), ".so"), findModuleVersion(f, module.module), pkg.WebServerModuleMetadata{
+			Server:    module.server,
+			Module:    module.module,
+			Path:      filePath,
+			ServerAPI: module.serverAPI,
+		}),
+	}, nil, nil
+}
+
+// findModuleStructure searches the exported symbols of the given shared object for the structure describing an
+// apache module (recognized by the module magic number and cookie) or an nginx module (recognized by the unset module
+// indexes and the nginx version it was built against).
+func findModuleStructure(f *elf.File) *webServerModule {
+	symbols, err := f.DynamicSymbols()
+	if err != nil {
+		return nil
+	}
+
+	ptrSize := 8
+	if f.Class == elf.ELFCLASS32 {
+		ptrSize = 4
+	}
+	// nginx modules are declared with NGX_MODULE_UNSET_INDEX (all bits set) as the context and module index
+	unsetIndex := uint64(1)<<(8*ptrSize) - 1
+
+	for _, symbol := range symbols {
+		if elf.ST_TYPE(symbol.Info) != elf.STT_OBJECT || !strings.HasSuffix(symbol.Name, "_module") {
+			continue
+		}
+		data := symbolData(f, symbol)
+
+		// apache: int version; int minor_version; int module_index; const char *name; void *dynamic_load_handle;
+		// struct module_struct *next; unsigned long magic; ...
+		magicOffset := (12+ptrSize-1)/ptrSize*ptrSize + 3*ptrSize
+		if len(data) >= magicOffset+ptrSize {
+			magicNumber := f.ByteOrder.Uint32(data)
+			cookie := readUint(f, data[magicOffset:], ptrSize)
+			if magicNumber >= minApacheMagicNumber && magicNumber <= maxApacheMagicNumber && cookie>>16 == apacheMagicCookiePrefix {
+				return &webServerModule{
+					server:    apacheServer,
+					module:    symbol.Name,
+					serverAPI: fmt.Sprintf("%d", magicNumber),
+				}
+			}
+		}
+
+		// nginx: ngx_uint_t ctx_index; ngx_uint_t index; char *name; ngx_uint_t spare0; ngx_uint_t spare1;
+		// ngx_uint_t version; ...
+		if strings.HasPrefix(symbol.Name, "ngx_") && len(data) >= 6*ptrSize {
+			version := readUint(f, data[5*ptrSize:], ptrSize)
+			if readUint(f, data, ptrSize) == unsetIndex && readUint(f, data[ptrSize:], ptrSize) == unsetIndex &&
+				version >= minNginxVersion && version <= maxNginxVersion {
+				return &webServerModule{
+					server:    nginxServer,
+					module:    symbol.Name,
+					serverAPI: fmt.Sprintf("%d.%d.%d", version/1000000, version/1000%1000, version%1000),
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// findModuleVersion searches the read-only strings of the given shared object for a version string naming the given
+// module (e.g. "mod_wsgi/4.9.0" for the "wsgi_module" module), as is conventionally added to the server banner.
+func findModuleVersion(f *elf.File, module string) string {
+	token := moduleToken(module)
+	if token == "" {
+		return ""
+	}
+
+	for _, section := range f.Sections {
+		if section.Type != elf.SHT_PROGBITS || section.Flags&elf.SHF_ALLOC == 0 ||
+			section.Flags&(elf.SHF_WRITE|elf.SHF_EXECINSTR) != 0 {
+			continue
+		}
+		data, err := section.Data()
+		if err != nil {
+			continue
+		}
+
+		for _, value := range bytes.Split(data, []byte{0}) {
+			match := versionStringPattern.FindSubmatch(value)
+			if match == nil || !isPrintable(value) {
+				continue
+			}
+			name := strings.SplitN(string(match[1]), "-", 2)[0]
+			if moduleToken(name) == token {
+				return string(match[2])
+			}
+		}
+	}
+	return ""
+}
+
+// moduleToken returns the distinguishing part of a module name for comparison, without any conventional prefixes
+// or suffixes (e.g. "security" for "security2_module", "mod_security2", and "ModSecurity").
+func moduleToken(name string) string {
+	name = strings.ToLower(name)
+	for _, prefix := range moduleNamePrefixes {
+		name = strings.TrimPrefix(name, prefix)
+	}
+	for _, suffix := range moduleNameSuffixes {
+		name = strings.TrimSuffix(name, suffix)
+	}
+
+	name = strings.Map(func(r rune) rune {
+		if r < 'a' || r > 'z' {
+			return -1
+		}
+		return r
+	}, name)
+	return strings.TrimPrefix(name, "mod")
+}
+
+// symbolData returns the initialized data of the given symbol.
+func symbolData(f *elf.File, symbol elf.Symbol) []byte {
+	if int(symbol.Section) >= len(f.Sections) {
+		return nil
+	}
+	section := f.Sections[symbol.Section]
+	if section.Type != elf.SHT_PROGBITS || symbol.Value < section.Addr || symbol.Value+symbol.Size > section.Addr+section.Size {
+		return nil
+	}
+
+	data := make([]byte, symbol.Size)
+	if _, err := section.ReadAt(data, int64(symbol.Value-section.Addr)); err != nil {
+		return nil
+	}
+	return data
+}
+
+func readUint(f *elf.File, data []byte, size int) uint64 {
+	if size == 8 {
+		return f.ByteOrder.Uint64(data)
+	}
+	return uint64(f.ByteOrder.Uint32(data))
+}
+
+func isPrintable(value []byte) bool {
+	for _, c := range value {
+		if c < 0x20 || c > 0x7e {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,100 @@
+package webserver
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/go-test/deep"
+)
+
+func TestParseWebServerModule(t *testing.T) {
+	// see test-fixtures/src for how the fixtures are built
+	tests := []struct {
+		fixture  string
+		expected []*pkg.Package
+	}{
+		{
+			fixture: "test-fixtures/usr/lib/apache2/modules/mod_example.so",
+			expected: []*pkg.Package{
+				newWebServerModulePackage("mod_example", "1.2.3", pkg.WebServerModuleMetadata{
+					Server:    "apache",
+					Module:    "example_module",
+					Path:      "test-fixtures/usr/lib/apache2/modules/mod_example.so",
+					ServerAPI: "20120211",
+				}),
+			},
+		},
+		{
+			fixture: "test-fixtures/usr/lib/nginx/modules/ngx_http_example_module.so",
+			expected: []*pkg.Package{
+				newWebServerModulePackage("ngx_http_example_module", "0.4.1", pkg.WebServerModuleMetadata{
+					Server:    "nginx",
+					Module:    "ngx_http_example_module",
+					Path:      "test-fixtures/usr/lib/nginx/modules/ngx_http_example_module.so",
+					ServerAPI: "1.21.6",
+				}),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.fixture, func(t *testing.T) {
+			fixture, err := os.Open(test.fixture)
+			if err != nil {
+				t.Fatalf("failed to open fixture: %+v", err)
+			}
+			defer fixture.Close()
+
+			actual, _, err := parseWebServerModule(fixture.Name(), fixture)
+			if err != nil {
+				t.Fatalf("failed to parse web server module: %+v", err)
+			}
+
+			for _, d := range deep.Equal(test.expected, actual) {
+				t.Errorf("diff: %+v", d)
+			}
+		})
+	}
+}
+
+func TestParseWebServerModule_NotAModule(t *testing.T) {
+	if _, _, err := parseWebServerModule("mod_example.so", strings.NewReader("not an ELF file")); err == nil {
+		t.Errorf("expected an error for a file that is not a shared object")
+	}
+}
+
+func TestModuleToken(t *testing.T) {
+	tests := []struct {
+		names    []string
+		expected string
+	}{
+		{
+			names:    []string{"wsgi_module", "mod_wsgi"},
+			expected: "wsgi",
+		},
+		{
+			names:    []string{"security2_module", "mod_security2", "ModSecurity"},
+			expected: "security",
+		},
+		{
+			names:    []string{"ngx_http_geoip2_module", "ngx_stream_geoip2_module"},
+			expected: "geoip",
+		},
+		{
+			names:    []string{"ngx_http_headers_more_filter_module"},
+			expected: "headersmore",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expected, func(t *testing.T) {
+			for _, name := range test.names {
+				if actual := moduleToken(name); actual != test.expected {
+					t.Errorf("unexpected token for %q: %q != %q", name, actual, test.expected)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+/* a stand-in for a third-party Apache httpd module: only the module structure (as laid out by httpd 2.4) and the
+ * version component string are of interest */
+#include <stddef.h>
+
+typedef struct module_struct {
+	int version;
+	int minor_version;
+	int module_index;
+	const char *name;
+	void *dynamic_load_handle;
+	struct module_struct *next;
+	unsigned long magic;
+	void *rewrite_args;
+	void *create_dir_config;
+	void *merge_dir_config;
+	void *create_server_config;
+	void *merge_server_config;
+	const void *cmds;
+	void *register_hooks;
+	int flags;
+} module;
+
+const char *example_version_component = "mod_example/1.2.3";
+
+module example_module = {
+	20120211, 88, -1, __FILE__, NULL, NULL, 0x41503234UL,
+	NULL, NULL, NULL, NULL, NULL, NULL, NULL, 0
+};
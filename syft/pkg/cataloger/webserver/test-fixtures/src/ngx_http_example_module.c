@@ -0,0 +1,55 @@
+/* a stand-in for a third-party nginx dynamic module: only the module structure (as laid out by nginx 1.x), the
+ * generated module list, and the version string are of interest */
+#include <stddef.h>
+#include <stdint.h>
+
+typedef struct ngx_module_s {
+	uintptr_t ctx_index;
+	uintptr_t index;
+	char *name;
+	uintptr_t spare0;
+	uintptr_t spare1;
+	uintptr_t version;
+	const char *signature;
+	void *ctx;
+	void *commands;
+	uintptr_t type;
+	void *init_master;
+	void *init_module;
+	void *init_process;
+	void *init_thread;
+	void *exit_thread;
+	void *exit_process;
+	void *exit_master;
+	uintptr_t spare_hook0;
+	uintptr_t spare_hook1;
+	uintptr_t spare_hook2;
+	uintptr_t spare_hook3;
+	uintptr_t spare_hook4;
+	uintptr_t spare_hook5;
+	uintptr_t spare_hook6;
+	uintptr_t spare_hook7;
+} ngx_module_t;
+
+const char *ngx_http_example_version = "ngx_http_example v0.4.1";
+
+ngx_module_t ngx_http_example_module = {
+	(uintptr_t) -1, (uintptr_t) -1, NULL, 0, 0, 1021006, "8,4,8,0000111111010111001110101111000110",
+	NULL, NULL, 0x50545448,
+	NULL, NULL, NULL, NULL, NULL, NULL, NULL,
+	0, 0, 0, 0, 0, 0, 0, 0
+};
+
+ngx_module_t *ngx_modules[] = {
+	&ngx_http_example_module,
+	NULL
+};
+
+char *ngx_module_names[] = {
+	"ngx_http_example_module",
+	NULL
+};
+
+char *ngx_module_order[] = {
+	NULL
+};
@@ -0,0 +1,18 @@
+/*
+Package yocto provides a concrete Cataloger implementation for the image license manifests produced by Yocto builds.
+*/
+package yocto
+
+import (
+	"github.com/anchore/syft/syft/pkg/cataloger/common"
+)
+
+// NewYoctoCataloger returns a new cataloger object for Yocto (OpenEmbedded) image license manifests, which are
+// installed into the image when COPY_LIC_MANIFEST is enabled.
+func NewYoctoCataloger() *common.GenericCataloger {
+	globParsers := map[string]common.ParserFn{
+		"**/license.manifest": parseLicenseManifest,
+	}
+
+	return common.NewGenericCataloger(nil, globParsers, "yocto-cataloger")
+}
@@ -0,0 +1,93 @@
+package yocto
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/common"
+)
+
+// integrity check
+var _ common.ParserFn = parseLicenseManifest
+
+func newYoctoPackage(m pkg.YoctoMetadata) *pkg.Package {
+	return &pkg.Package{
+		Name:         m.Package,
+		Version:      m.Version,
+		Licenses:     splitLicenseExpression(m.License),
+		Type:         pkg.YoctoPkg,
+		MetadataType: pkg.YoctoMetadataType,
+		Metadata:     m,
+	}
+}
+
+// parseLicenseManifest is a parser function for Yocto license.manifest contents, returning every package installed
+// into the image. Entries are blocks of "KEY: value" lines separated by blank lines, for example:
+//
+//	PACKAGE NAME: busybox
+//	PACKAGE VERSION: 1.35.0
+//	RECIPE NAME: busybox
+//	LICENSE: GPL-2.0-only & bzip2-1.0.4
+func parseLicenseManifest(_ string, reader io.Reader) ([]*pkg.Package, []artifact.Relationship, error) {
+	var packages []*pkg.Package
+	entry := pkg.YoctoMetadata{}
+
+	flush := func() {
+		if entry.Package != "" {
+			packages = append(packages, newYoctoPackage(entry))
+		}
+		entry = pkg.YoctoMetadata{}
+	}
+
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			flush()
+			continue
+		}
+
+		fields := strings.SplitN(line, ":", 2)
+		if len(fields) != 2 {
+			return nil, nil, fmt.Errorf("unexpected yocto license manifest line: %q", line)
+		}
+
+		value := strings.TrimSpace(fields[1])
+		switch strings.ToUpper(strings.TrimSpace(fields[0])) {
+		case "PACKAGE NAME":
+			entry.Package = value
+		case "PACKAGE VERSION":
+			entry.Version = value
+		case "RECIPE NAME":
+			entry.Recipe = value
+		case "LICENSE":
+			entry.License = value
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("unable to read yocto license manifest: %w", err)
+	}
+	flush()
+
+	return packages, nil, nil
+}
+
+// splitLicenseExpression returns the individual licenses referenced by a bitbake LICENSE expression
+// (e.g. "GPL-2.0-only & (LGPL-2.1-only | MIT)").
+func splitLicenseExpression(expression string) []string {
+	var licenses []string
+	for _, l := range strings.FieldsFunc(expression, func(r rune) bool {
+		return strings.ContainsRune("&|()", r)
+	}) {
+		l = strings.TrimSpace(l)
+		if l != "" {
+			licenses = append(licenses, l)
+		}
+	}
+	return licenses
+}
@@ -0,0 +1,39 @@
+package yocto
+
+import (
+	"os"
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/go-test/deep"
+)
+
+func TestParseLicenseManifest(t *testing.T) {
+	expected := []*pkg.Package{
+		newYoctoPackage(pkg.YoctoMetadata{Package: "base-files", Version: "3.0.14", Recipe: "base-files", License: "GPL-2.0-only"}),
+		newYoctoPackage(pkg.YoctoMetadata{Package: "busybox", Version: "1.35.0", Recipe: "busybox", License: "GPL-2.0-only & bzip2-1.0.4"}),
+		newYoctoPackage(pkg.YoctoMetadata{Package: "libc6", Version: "2.35", Recipe: "glibc", License: "GPL-2.0-only & LGPL-2.1-or-later"}),
+		newYoctoPackage(pkg.YoctoMetadata{Package: "libssl3", Version: "3.0.5", Recipe: "openssl", License: "Apache-2.0"}),
+	}
+
+	fixture, err := os.Open("test-fixtures/license.manifest")
+	if err != nil {
+		t.Fatalf("failed to open fixture: %+v", err)
+	}
+
+	actual, _, err := parseLicenseManifest(fixture.Name(), fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, d := range deep.Equal(expected, actual) {
+		t.Errorf("diff: %+v", d)
+	}
+}
+
+func TestSplitLicenseExpression(t *testing.T) {
+	actual := splitLicenseExpression("GPL-2.0-only & (LGPL-2.1-only | MIT)")
+	for _, d := range deep.Equal([]string{"GPL-2.0-only", "LGPL-2.1-only", "MIT"}, actual) {
+		t.Errorf("diff: %+v", d)
+	}
+}
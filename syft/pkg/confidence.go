@@ -0,0 +1,16 @@
+package pkg
+
+// Confidence describes how directly a package was observed, allowing consumers to filter out heuristic findings.
+type Confidence string
+
+const (
+	// ExactMetadataConfidence indicates the package was described by metadata recorded when it was installed or
+	// built (e.g. a package manager database, an installed package manifest, or embedded build information).
+	ExactMetadataConfidence Confidence = "exact-metadata"
+	// LockfileDeclaredConfidence indicates the package was declared by a lockfile or manifest, which does not
+	// guarantee that the package is actually installed.
+	LockfileDeclaredConfidence Confidence = "lockfile-declared"
+	// HeuristicBinaryMatchConfidence indicates the package was inferred from patterns within file contents (e.g.
+	// version strings within a binary or banner comments within a bundle).
+	HeuristicBinaryMatchConfidence Confidence = "heuristic-binary-match"
+)
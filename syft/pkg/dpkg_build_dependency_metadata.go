@@ -0,0 +1,9 @@
+package pkg
+
+// DpkgBuildDependencyMetadata represents a single Installed-Build-Depends entry of a Debian .buildinfo file: a package
+// that was installed in the environment used to build an artifact, but is not itself installed alongside it.
+type DpkgBuildDependencyMetadata struct {
+	Package      string `json:"package"`
+	Version      string `json:"version"`
+	Architecture string `json:"architecture"`
+}
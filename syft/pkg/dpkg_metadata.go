@@ -10,7 +10,7 @@ import (
 	"github.com/scylladb/go-set/strset"
 )
 
-const DpkgDBGlob = "**/var/lib/dpkg/{status,status.d/**}"
+const DpkgDBGlob = "**/var/lib/dpkg/{status,status.gz,status.d/**}"
 
 var _ FileOwner = (*DpkgMetadata)(nil)
 
@@ -24,7 +24,11 @@ type DpkgMetadata struct {
 	Architecture  string           `mapstructure:"Architecture" json:"architecture"`
 	Maintainer    string           `mapstructure:"Maintainer" json:"maintainer"`
 	InstalledSize int              `mapstructure:"InstalledSize" json:"installedSize"`
+	Section       string           `mapstructure:"Section" json:"section,omitempty"`
+	Priority      string           `mapstructure:"Priority" json:"priority,omitempty"`
 	Files         []DpkgFileRecord `json:"files"`
+	// MaintainerScripts lists the preinst, postinst, prerm, and postrm scripts shipped with the package (if any).
+	MaintainerScripts []MaintainerScript `json:"maintainerScripts,omitempty"`
 }
 
 // DpkgFileRecord represents a single file attributed to a debian package.
@@ -0,0 +1,11 @@
+package pkg
+
+// DpkgUploadMetadata represents a binary package listed by a Debian .changes file: a package produced by the described
+// upload, which is not necessarily installed anywhere.
+type DpkgUploadMetadata struct {
+	Package       string `json:"package"`
+	Source        string `json:"source"`
+	Version       string `json:"version"`
+	SourceVersion string `json:"sourceVersion"`
+	Maintainer    string `json:"maintainer"`
+}
@@ -0,0 +1,6 @@
+package pkg
+
+// GolangModMetadata represents all captured data for a Go module referenced by a go.mod file
+type GolangModMetadata struct {
+	H1Digest string `json:"h1Digest"`
+}
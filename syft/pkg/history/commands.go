@@ -0,0 +1,231 @@
+package history
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// commandSeparatorPattern splits a shell command line into the individual commands.
+var commandSeparatorPattern = regexp.MustCompile(`&&|\|\||[;|\n]`)
+
+// shellPrefixPattern matches the shell invocation that docker adds to a RUN instruction (e.g. "/bin/sh -c ", or
+// "|1 VERSION=1.0 /bin/sh -c " when build arguments are used).
+var shellPrefixPattern = regexp.MustCompile(`^(?:\|\d+\s+(?:\S+=\S*\s+)*)?\S*sh\s+(?:-o\s+\S+\s+|-[a-zA-Z]+\s+)*-c\s+`)
+
+// redirectPattern matches the start of an output or input redirection (e.g. "> /dev/null", "2>&1").
+var redirectPattern = regexp.MustCompile(`^\d*[<>]`)
+
+// pipExecutablePattern and pythonExecutablePattern match the (possibly versioned) executables of pip and python.
+var (
+	pipExecutablePattern    = regexp.MustCompile(`^pip[\d.]*$`)
+	pythonExecutablePattern = regexp.MustCompile(`^python[\d.]*$`)
+)
+
+// pythonRequirementPattern matches the end of the name within a pip requirement (e.g. "requests[security]>=2.0").
+var pythonRequirementPattern = regexp.MustCompile(`[\[<>=!~;@ ]`)
+
+// packageAction is the installation or removal of packages by a package manager, as found in a history entry.
+type packageAction struct {
+	pkgType  pkg.Type
+	remove   bool
+	names    []string
+	virtual  string // the name of the apk virtual package grouping the installed packages (optional)
+	entryIdx int
+}
+
+// packageManager describes the command line of a package manager.
+type packageManager struct {
+	pkgType         pkg.Type
+	installCommands []string
+	removeCommands  []string
+	optionsWithArg  []string // options that consume the following argument
+	name            func(arg string) string
+}
+
+var packageManagers = map[string]packageManager{
+	"apt-get": debManager,
+	"apt":     debManager,
+	"apk": {
+		pkgType:         pkg.ApkPkg,
+		installCommands: []string{"add"},
+		removeCommands:  []string{"del"},
+		optionsWithArg:  []string{"-t", "--virtual", "-X", "--repository", "-p", "--root", "--arch"},
+		name:            versionSuffixTrimmer("="),
+	},
+	"yum":      rpmManager,
+	"dnf":      rpmManager,
+	"microdnf": rpmManager,
+	"pip": {
+		pkgType:         pkg.PythonPkg,
+		installCommands: []string{"install"},
+		removeCommands:  []string{"uninstall"},
+		optionsWithArg:  []string{"-r", "--requirement", "-c", "--constraint", "-e", "--editable", "-t", "--target", "-i", "--index-url", "--extra-index-url", "-f", "--find-links", "--prefix", "--root"},
+		name:            pythonPackageName,
+	},
+}
+
+var debManager = packageManager{
+	pkgType:         pkg.DebPkg,
+	installCommands: []string{"install"},
+	removeCommands:  []string{"remove", "purge"},
+	optionsWithArg:  []string{"-o", "-t", "--target-release"},
+	name: func(arg string) string {
+		// remove the pinned version and architecture (e.g. "curl=7.74.0-1", "libc6:i386")
+		return versionSuffixTrimmer(":")(versionSuffixTrimmer("=")(arg))
+	},
+}
+
+var rpmManager = packageManager{
+	pkgType:         pkg.RpmPkg,
+	installCommands: []string{"install"},
+	removeCommands:  []string{"remove", "erase"},
+	optionsWithArg:  []string{"--setopt", "--enablerepo", "--disablerepo", "--installroot", "--releasever"},
+	name:            func(arg string) string { return arg },
+}
+
+// parseActions returns the package installations and removals within the command that created a history entry.
+func parseActions(createdBy string, entryIdx int) []packageAction {
+	// remove the docker and shell prefixes (e.g. "/bin/sh -c #(nop) ", "RUN /bin/sh -c ") and line continuations
+	createdBy = strings.ReplaceAll(createdBy, "\\\n", " ")
+	createdBy = strings.TrimPrefix(strings.TrimSpace(createdBy), "RUN ")
+	if strings.Contains(createdBy, "#(nop)") {
+		return nil
+	}
+	createdBy = strings.TrimSuffix(createdBy, "# buildkit")
+	createdBy = shellPrefixPattern.ReplaceAllString(createdBy, "")
+
+	var actions []packageAction
+	for _, command := range commandSeparatorPattern.Split(createdBy, -1) {
+		if action := parseAction(strings.Fields(command)); action != nil {
+			action.entryIdx = entryIdx
+			actions = append(actions, *action)
+		}
+	}
+	return actions
+}
+
+func parseAction(args []string) *packageAction {
+	args = trimCommandPrefix(args)
+	if len(args) == 0 {
+		return nil
+	}
+
+	executable := args[0]
+	if idx := strings.LastIndex(executable, "/"); idx >= 0 {
+		executable = executable[idx+1:]
+	}
+	args = args[1:]
+
+	switch {
+	case pipExecutablePattern.MatchString(executable):
+		executable = "pip"
+	case pythonExecutablePattern.MatchString(executable) && len(args) >= 2 && args[0] == "-m" && strings.HasPrefix(args[1], "pip"):
+		executable = "pip"
+		args = args[2:]
+	}
+
+	manager, ok := packageManagers[executable]
+	if !ok {
+		return nil
+	}
+	return manager.parse(args)
+}
+
+// trimCommandPrefix removes any prefix that does not change the executed command (e.g. "sudo" or environment
+// variables), as well as surrounding quotes and parentheses.
+func trimCommandPrefix(args []string) []string {
+	for len(args) > 0 {
+		switch arg := strings.Trim(args[0], `'"()`); {
+		case arg == "":
+			args = args[1:]
+		case arg == "sudo" || arg == "exec" || arg == "command":
+			args = args[1:]
+		case strings.Contains(arg, "=") && !strings.HasPrefix(arg, "-"):
+			// an environment variable assignment, e.g. DEBIAN_FRONTEND=noninteractive
+			args = args[1:]
+		default:
+			args[0] = arg
+			return args
+		}
+	}
+	return nil
+}
+
+func (m packageManager) parse(args []string) *packageAction {
+	action := packageAction{pkgType: m.pkgType}
+
+	var subcommand string
+	for i := 0; i < len(args); i++ {
+		arg := strings.Trim(args[i], `'"`)
+		if redirectPattern.MatchString(arg) {
+			break
+		}
+		switch {
+		case arg == "":
+			continue
+		case strings.HasPrefix(arg, "-"):
+			if contains(m.optionsWithArg, arg) && i+1 < len(args) {
+				i++
+				if m.pkgType == pkg.ApkPkg && (arg == "--virtual" || arg == "-t") {
+					action.virtual = args[i]
+				}
+			}
+		case subcommand == "":
+			subcommand = arg
+			switch {
+			case contains(m.installCommands, subcommand):
+			case contains(m.removeCommands, subcommand):
+				action.remove = true
+			default:
+				return nil
+			}
+		default:
+			// skip anything that is not a package name (e.g. local files, URLs and variables)
+			if strings.ContainsAny(arg, "/$*") || strings.HasSuffix(arg, ".deb") || strings.HasSuffix(arg, ".rpm") || arg == "." {
+				continue
+			}
+			if name := m.name(arg); name != "" {
+				action.names = append(action.names, name)
+			}
+		}
+	}
+
+	if subcommand == "" || (len(action.names) == 0 && action.virtual == "") {
+		return nil
+	}
+	return &action
+}
+
+// versionSuffixTrimmer returns a function that removes the version pinned within a package argument (e.g.
+// "curl=7.74.0-1" for apt).
+func versionSuffixTrimmer(separator string) func(string) string {
+	return func(arg string) string {
+		if idx := strings.Index(arg, separator); idx >= 0 {
+			return arg[:idx]
+		}
+		return arg
+	}
+}
+
+func pythonPackageName(arg string) string {
+	if idx := pythonRequirementPattern.FindStringIndex(arg); idx != nil {
+		arg = arg[:idx[0]]
+	}
+	return normalizePythonName(arg)
+}
+
+// normalizePythonName returns the normalized form of a python package name (see PEP 503).
+func normalizePythonName(name string) string {
+	return strings.ToLower(strings.NewReplacer("_", "-", ".", "-").Replace(name))
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
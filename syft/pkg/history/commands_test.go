@@ -0,0 +1,60 @@
+package history
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseActions(t *testing.T) {
+	tests := []struct {
+		name      string
+		createdBy string
+		expected  []packageAction
+	}{
+		{
+			name:      "apt-get install",
+			createdBy: "/bin/sh -c apt-get update && DEBIAN_FRONTEND=noninteractive apt-get install -y --no-install-recommends curl=7.74.0-1 libc6:i386 && rm -rf /var/lib/apt/lists/*",
+			expected: []packageAction{
+				{pkgType: pkg.DebPkg, names: []string{"curl", "libc6"}, entryIdx: 3},
+			},
+		},
+		{
+			name:      "apk virtual package and pip install",
+			createdBy: "RUN /bin/sh -c apk add --no-cache --virtual .build-deps gcc musl-dev && pip3 install --no-cache-dir requests==2.25.1 'Flask_Cors[async]>=3.0' -r requirements.txt && apk del .build-deps # buildkit",
+			expected: []packageAction{
+				{pkgType: pkg.ApkPkg, names: []string{"gcc", "musl-dev"}, virtual: ".build-deps", entryIdx: 3},
+				{pkgType: pkg.PythonPkg, names: []string{"requests", "flask-cors"}, entryIdx: 3},
+				{pkgType: pkg.ApkPkg, remove: true, names: []string{".build-deps"}, entryIdx: 3},
+			},
+		},
+		{
+			name:      "build arguments",
+			createdBy: "|1 VERSION=1.0 /bin/sh -c yum install -y httpd && yum clean all",
+			expected: []packageAction{
+				{pkgType: pkg.RpmPkg, names: []string{"httpd"}, entryIdx: 3},
+			},
+		},
+		{
+			name:      "pip uninstall as a python module",
+			createdBy: "/bin/sh -c python3 -m pip uninstall -y setuptools",
+			expected: []packageAction{
+				{pkgType: pkg.PythonPkg, remove: true, names: []string{"setuptools"}, entryIdx: 3},
+			},
+		},
+		{
+			name:      "local files and redirections",
+			createdBy: "/bin/sh -c apt-get install -y ./local.deb > /dev/null 2>&1",
+		},
+		{
+			name:      "not a RUN instruction",
+			createdBy: `/bin/sh -c #(nop)  CMD ["sh"]`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, parseActions(test.createdBy, 3))
+		})
+	}
+}
@@ -0,0 +1,124 @@
+/*
+Package history cross-checks the packages found within a container image against the packages installed and removed
+by the commands that built the image, as recorded in the history of the image config.
+*/
+package history
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/source"
+)
+
+type imageConfig struct {
+	History []struct {
+		CreatedBy string `json:"created_by"`
+	} `json:"history"`
+}
+
+// packageKey identifies a package by type and (normalized) name.
+type packageKey struct {
+	pkgType pkg.Type
+	name    string
+}
+
+// packageState is the outcome of the last history entry that installed or removed a package.
+type packageState struct {
+	installed bool
+	entryIdx  int
+}
+
+// Hints returns a warning for each package that the image history installs but that was not found in the catalog,
+// and for each package that the image history removes but that was found anyway. Only the package types with at least
+// one package in the catalog are cross-checked, so that package types which were not cataloged are not flagged.
+func Hints(rawConfig []byte, catalog *pkg.Catalog) []source.Warning {
+	if len(rawConfig) == 0 || catalog == nil {
+		return nil
+	}
+
+	var config imageConfig
+	if err := json.Unmarshal(rawConfig, &config); err != nil {
+		log.Debugf("unable to parse the image config for history hints: %+v", err)
+		return nil
+	}
+
+	found := make(map[packageKey]pkg.Package)
+	foundTypes := make(map[pkg.Type]bool)
+	for _, p := range catalog.Sorted() {
+		foundTypes[p.Type] = true
+		key := newPackageKey(p.Type, p.Name)
+		if _, exists := found[key]; !exists {
+			found[key] = p
+		}
+	}
+
+	states := make(map[packageKey]packageState)
+	var order []packageKey
+	virtuals := make(map[string][]string)
+	for idx, entry := range config.History {
+		for _, action := range parseActions(entry.CreatedBy, idx) {
+			names := action.names
+			if action.remove && action.pkgType == pkg.ApkPkg {
+				names = expandVirtuals(names, virtuals)
+			} else if action.virtual != "" {
+				virtuals[action.virtual] = append(virtuals[action.virtual], names...)
+			}
+
+			for _, name := range names {
+				key := newPackageKey(action.pkgType, name)
+				if _, exists := states[key]; !exists {
+					order = append(order, key)
+				}
+				states[key] = packageState{installed: !action.remove, entryIdx: action.entryIdx}
+			}
+		}
+	}
+
+	var warnings []source.Warning
+	for _, key := range order {
+		if !foundTypes[key.pkgType] {
+			continue
+		}
+		state := states[key]
+		p, isFound := found[key]
+		switch {
+		case state.installed && !isFound:
+			warnings = append(warnings, source.Warning{
+				Message: fmt.Sprintf("the image history installs the %s package %q (history entry %d) but it was not found", key.pkgType, key.name, state.entryIdx+1),
+			})
+		case !state.installed && isFound:
+			var path string
+			if len(p.Locations) > 0 {
+				path = p.Locations[0].RealPath
+			}
+			warnings = append(warnings, source.Warning{
+				Path:    path,
+				Message: fmt.Sprintf("the image history removes the %s package %q (history entry %d) but it was found", key.pkgType, key.name, state.entryIdx+1),
+			})
+		}
+	}
+	return warnings
+}
+
+func newPackageKey(t pkg.Type, name string) packageKey {
+	if t == pkg.PythonPkg {
+		name = normalizePythonName(name)
+	}
+	return packageKey{pkgType: t, name: name}
+}
+
+// expandVirtuals replaces the names of apk virtual packages with the packages they were installed with.
+func expandVirtuals(names []string, virtuals map[string][]string) []string {
+	var expanded []string
+	for _, name := range names {
+		if members, ok := virtuals[name]; ok {
+			expanded = append(expanded, members...)
+			continue
+		}
+		expanded = append(expanded, name)
+	}
+	return expanded
+}
@@ -0,0 +1,46 @@
+package history
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/source"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHints(t *testing.T) {
+	rawConfig := []byte(`{
+  "history": [
+    {"created_by": "/bin/sh -c #(nop) ADD file:abc in / "},
+    {"created_by": "/bin/sh -c apt-get update && apt-get install -y curl wget"},
+    {"created_by": "/bin/sh -c apt-get purge -y wget"},
+    {"created_by": "/bin/sh -c pip install Flask_Cors==3.0 missing-lib"},
+    {"created_by": "/bin/sh -c apk add jq"}
+  ]
+}`)
+
+	var packages []pkg.Package
+	for _, p := range []pkg.Package{
+		{Name: "curl", Type: pkg.DebPkg},
+		{Name: "wget", Type: pkg.DebPkg},
+		{Name: "Flask-Cors", Type: pkg.PythonPkg},
+	} {
+		p.Locations = []source.Location{source.NewLocation("/var/lib/" + string(p.Type))}
+		p.SetID()
+		packages = append(packages, p)
+	}
+
+	assert.Equal(t, []source.Warning{
+		{
+			Path:    "/var/lib/deb",
+			Message: `the image history removes the deb package "wget" (history entry 3) but it was found`,
+		},
+		{
+			// note: jq is not flagged since no apk packages were found at all
+			Message: `the image history installs the python package "missing-lib" (history entry 4) but it was not found`,
+		},
+	}, Hints(rawConfig, pkg.NewCatalog(packages...)))
+
+	assert.Nil(t, Hints(nil, pkg.NewCatalog(packages...)))
+	assert.Nil(t, Hints([]byte("{"), pkg.NewCatalog(packages...)))
+}
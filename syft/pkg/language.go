@@ -30,3 +30,25 @@ var AllLanguages = []Language{
 func (l Language) String() string {
 	return string(l)
 }
+
+// LanguageFromPURL returns the programming language of the ecosystem described by the given package URL
+// (UnknownLanguage when the package URL is not valid or describes a package that is not specific to a language).
+func LanguageFromPURL(p string) Language {
+	switch TypeFromPURL(p) {
+	case JavaPkg:
+		return Java
+	case NpmPkg:
+		return JavaScript
+	case PythonPkg:
+		return Python
+	case PhpComposerPkg:
+		return PHP
+	case GemPkg:
+		return Ruby
+	case GoModulePkg:
+		return Go
+	case RustPkg:
+		return Rust
+	}
+	return UnknownLanguage
+}
@@ -0,0 +1,14 @@
+package pkg
+
+import "github.com/anchore/syft/syft/file"
+
+// MaintainerScript represents a script that the OS package manager runs on behalf of a package at some point in
+// its lifecycle (e.g. a debian "postinst" script).
+type MaintainerScript struct {
+	// Type is the package-manager specific name of the lifecycle hook (e.g. "preinst").
+	Type string `json:"type"`
+	// Interpreter is the program used to run the script (from the shebang line, if present).
+	Interpreter string `json:"interpreter,omitempty"`
+	// Digest is the digest of the script contents.
+	Digest *file.Digest `json:"digest,omitempty"`
+}
@@ -5,22 +5,34 @@ type MetadataType string
 
 const (
 	// this is the full set of data shapes that can be represented within the pkg.Package.Metadata field
-	UnknownMetadataType          MetadataType = "UnknownMetadata"
-	ApkMetadataType              MetadataType = "ApkMetadata"
-	DpkgMetadataType             MetadataType = "DpkgMetadata"
-	GemMetadataType              MetadataType = "GemMetadata"
-	JavaMetadataType             MetadataType = "JavaMetadata"
-	NpmPackageJSONMetadataType   MetadataType = "NpmPackageJsonMetadata"
-	RpmdbMetadataType            MetadataType = "RpmdbMetadata"
-	PythonPackageMetadataType    MetadataType = "PythonPackageMetadata"
-	RustCargoPackageMetadataType MetadataType = "RustCargoPackageMetadata"
-	KbPackageMetadataType        MetadataType = "KbPackageMetadata"
-	GolangBinMetadataType        MetadataType = "GolangBinMetadata"
+	UnknownMetadataType             MetadataType = "UnknownMetadata"
+	ApkMetadataType                 MetadataType = "ApkMetadata"
+	DpkgMetadataType                MetadataType = "DpkgMetadata"
+	DpkgBuildDependencyMetadataType MetadataType = "DpkgBuildDependencyMetadata"
+	DpkgUploadMetadataType          MetadataType = "DpkgUploadMetadata"
+	GemMetadataType                 MetadataType = "GemMetadata"
+	JavaMetadataType                MetadataType = "JavaMetadata"
+	NpmPackageJSONMetadataType      MetadataType = "NpmPackageJsonMetadata"
+	RpmdbMetadataType               MetadataType = "RpmdbMetadata"
+	PythonPackageMetadataType       MetadataType = "PythonPackageMetadata"
+	RustCargoPackageMetadataType    MetadataType = "RustCargoPackageMetadata"
+	KbPackageMetadataType           MetadataType = "KbPackageMetadata"
+	GolangBinMetadataType           MetadataType = "GolangBinMetadata"
+	GolangModMetadataType           MetadataType = "GolangModMetadata"
+	BuildrootMetadataType           MetadataType = "BuildrootMetadata"
+	YoctoMetadataType               MetadataType = "YoctoMetadata"
+	OpkgMetadataType                MetadataType = "OpkgMetadata"
+	RuntimeMetadataType             MetadataType = "RuntimeMetadata"
+	StaticLibraryMetadataType       MetadataType = "StaticLibraryMetadata"
+	PhpPeclMetadataType             MetadataType = "PhpPeclMetadata"
+	WebServerModuleMetadataType     MetadataType = "WebServerModuleMetadata"
 )
 
 var AllMetadataTypes = []MetadataType{
 	ApkMetadataType,
 	DpkgMetadataType,
+	DpkgBuildDependencyMetadataType,
+	DpkgUploadMetadataType,
 	GemMetadataType,
 	JavaMetadataType,
 	NpmPackageJSONMetadataType,
@@ -29,4 +41,12 @@ var AllMetadataTypes = []MetadataType{
 	RustCargoPackageMetadataType,
 	KbPackageMetadataType,
 	GolangBinMetadataType,
+	GolangModMetadataType,
+	BuildrootMetadataType,
+	YoctoMetadataType,
+	OpkgMetadataType,
+	RuntimeMetadataType,
+	StaticLibraryMetadataType,
+	PhpPeclMetadataType,
+	WebServerModuleMetadataType,
 }
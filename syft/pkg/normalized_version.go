@@ -0,0 +1,21 @@
+package pkg
+
+// VersionScheme is the versioning convention a normalized version follows.
+type VersionScheme string
+
+const (
+	// SemverScheme versions are MAJOR.MINOR.PATCH with optional pre-release and build metadata (see https://semver.org).
+	SemverScheme VersionScheme = "semver"
+	// EVRScheme versions are split into the epoch, upstream version, and distro release (as used by OS package managers).
+	EVRScheme VersionScheme = "evr"
+)
+
+// NormalizedVersion is the version of a package decomposed according to the versioning scheme of its ecosystem, so
+// that versions can be compared without re-implementing the version parsing of each ecosystem.
+type NormalizedVersion struct {
+	Scheme     VersionScheme `json:"scheme"`
+	Epoch      *int          `json:"epoch,omitempty"`      // the epoch (evr only)
+	Version    string        `json:"version"`              // the semantic version, or the upstream version (evr)
+	Release    string        `json:"release,omitempty"`    // the distro release or revision (evr only)
+	PreRelease bool          `json:"preRelease,omitempty"` // whether the version is a pre-release or snapshot build
+}
@@ -0,0 +1,10 @@
+package pkg
+
+const OpkgDBGlob = "**/{var,usr}/lib/opkg/{status,status.gz}"
+
+// OpkgMetadata represents all captured data for an opkg (OpenWrt, Yocto) package DB entry.
+type OpkgMetadata struct {
+	Package      string `json:"package"`
+	Version      string `json:"version"`
+	Architecture string `json:"architecture,omitempty"`
+}
@@ -0,0 +1,13 @@
+package pkg
+
+// OriginURLs are the locations a package comes from, as declared by the package metadata of its ecosystem.
+type OriginURLs struct {
+	Homepage   string `json:"homepage,omitempty"`   // the website of the project
+	Repository string `json:"repository,omitempty"` // the source code repository of the project (e.g. git+https://github.com/org/project.git)
+	Download   string `json:"download,omitempty"`   // where the package itself was downloaded from
+}
+
+// IsEmpty returns true if no origin URLs are known.
+func (o OriginURLs) IsEmpty() bool {
+	return o == OriginURLs{}
+}
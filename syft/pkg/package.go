@@ -8,24 +8,32 @@ import (
 
 	"github.com/anchore/syft/internal/log"
 	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/eol"
+	"github.com/anchore/syft/syft/file"
 	"github.com/anchore/syft/syft/source"
 )
 
 // Package represents an application or library that has been bundled into a distributable format.
 // TODO: if we ignore FoundBy for ID generation should we merge the field to show it was found in two places?
 type Package struct {
-	id           artifact.ID       `hash:"ignore"`
-	Name         string            // the package name
-	Version      string            // the version of the package
-	FoundBy      string            // the specific cataloger that discovered this package
-	Locations    []source.Location // the locations that lead to the discovery of this package (note: this is not necessarily the locations that make up this package)
-	Licenses     []string          // licenses discovered with the package metadata
-	Language     Language          // the language ecosystem this package belongs to (e.g. JavaScript, Python, etc)
-	Type         Type              // the package type (e.g. Npm, Yarn, Python, Rpm, Deb, etc)
-	CPEs         []CPE             `hash:"ignore"` // all possible Common Platform Enumerators (note: this is NOT included in the definition of the ID since all fields on a CPE are derived from other fields)
-	PURL         string            `hash:"ignore"` // the Package URL (see https://github.com/package-url/purl-spec) (note: this is NOT included in the definition of the ID since all fields on a pURL are derived from other fields)
-	MetadataType MetadataType      // the shape of the additional data in the "metadata" field
-	Metadata     interface{}       // additional data found while parsing the package source
+	id                artifact.ID        `hash:"ignore"`
+	Name              string             // the package name
+	Version           string             // the version of the package
+	FoundBy           string             // the specific cataloger that discovered this package
+	Locations         []source.Location  // the locations that lead to the discovery of this package (note: this is not necessarily the locations that make up this package)
+	Licenses          []string           // licenses discovered with the package metadata
+	Language          Language           // the language ecosystem this package belongs to (e.g. JavaScript, Python, etc)
+	Type              Type               // the package type (e.g. Npm, Yarn, Python, Rpm, Deb, etc)
+	CPEs              []CPE              `hash:"ignore"` // all possible Common Platform Enumerators (note: this is NOT included in the definition of the ID since all fields on a CPE are derived from other fields)
+	PURL              string             `hash:"ignore"` // the Package URL (see https://github.com/package-url/purl-spec) (note: this is NOT included in the definition of the ID since all fields on a pURL are derived from other fields)
+	Confidence        Confidence         `hash:"ignore"` // how directly the package was observed (note: this is NOT included in the definition of the ID since it is derived from the cataloger that found the package)
+	NormalizedVersion *NormalizedVersion `hash:"ignore"` // the version decomposed according to the versioning scheme of the ecosystem (note: this is NOT included in the definition of the ID since it is derived from the version)
+	OriginURLs        OriginURLs         `hash:"ignore"` // where the package comes from, as declared by the package metadata (note: this is NOT included in the definition of the ID since it describes the origin of the package, not the package itself)
+	Checksums         []file.Digest      `hash:"ignore"` // the digests of the artifact the package is installed from (e.g. a tarball or crate), as declared by a lock file (note: this is NOT included in the definition of the ID since it describes the origin of the package, not the package itself)
+	EOL               *eol.Status        `hash:"ignore"` // the end-of-life status of the runtime the package installs, if known (note: this is NOT included in the definition of the ID since it is derived from the version and the time of the scan)
+	Ownership         map[string]string  `hash:"ignore"` // organizational metadata attached to the package by ownership rules (e.g. the owning team) (note: this is NOT included in the definition of the ID since it describes who owns the package, not the package itself)
+	MetadataType      MetadataType       // the shape of the additional data in the "metadata" field
+	Metadata          interface{}        // additional data found while parsing the package source
 }
 
 func (p *Package) SetID() {
@@ -38,6 +46,12 @@ func (p *Package) SetID() {
 	p.id = id
 }
 
+// OverrideID sets the ID of the package to a previously generated value (e.g. when reading an existing SBOM), which
+// keeps the package (and the relationships that refer to it) identifiable as the same package across documents.
+func (p *Package) OverrideID(id artifact.ID) {
+	p.id = id
+}
+
 func (p Package) ID() artifact.ID {
 	return p.id
 }
@@ -86,6 +86,36 @@ func TestFingerprint(t *testing.T) {
 			},
 			expectIdentical: true,
 		},
+		{
+			name: "virtual path is ignored",
+			transform: func(pkg Package) Package {
+				// note: the same file may be reached by different paths (symlinks) between runs
+				pkg.Locations = []source.Location{
+					{
+						Coordinates: pkg.Locations[0].Coordinates,
+						VirtualPath: "/Modern-Greece",
+					},
+				}
+				return pkg
+			},
+			expectIdentical: true,
+		},
+		{
+			name: "location layer is reflected",
+			transform: func(pkg Package) Package {
+				pkg.Locations = []source.Location{
+					{
+						Coordinates: source.Coordinates{
+							RealPath:     pkg.Locations[0].RealPath,
+							FileSystemID: "Mars",
+						},
+						VirtualPath: pkg.Locations[0].VirtualPath,
+					},
+				}
+				return pkg
+			},
+			expectIdentical: false,
+		},
 		{
 			name: "name is reflected",
 			transform: func(pkg Package) Package {
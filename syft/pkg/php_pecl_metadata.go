@@ -0,0 +1,13 @@
+package pkg
+
+// PhpPeclMetadata represents all captured data for a PHP extension or library installed with PECL/PEAR, or a compiled
+// PHP extension.
+type PhpPeclMetadata struct {
+	Name      string `json:"name"`
+	Version   string `json:"version"`
+	Channel   string `json:"channel,omitempty"`
+	License   string `json:"license,omitempty"`
+	Summary   string `json:"summary,omitempty"`
+	Extension string `json:"extension,omitempty"`
+	ZendAPI   int    `json:"zendApi,omitempty"`
+}
@@ -41,6 +41,25 @@ type PythonPackageMetadata struct {
 	SitePackagesRootPath string                     `json:"sitePackagesRootPath"`
 	TopLevelPackages     []string                   `json:"topLevelPackages,omitempty"`
 	DirectURLOrigin      *PythonDirectURLOriginInfo `json:"directUrlOrigin,omitempty"`
+	VirtualEnv           *PythonVirtualEnv          `json:"virtualEnv,omitempty"`
+	Runtime              *PythonRuntime             `json:"runtime,omitempty"`
+}
+
+// PythonRuntime identifies the python installation that a package is installed for (several installations may exist
+// within the same image).
+type PythonRuntime struct {
+	Version string `json:"version"` // the version of the installation (major.minor when only known from the install path)
+	Prefix  string `json:"prefix"`  // the prefix the installation is installed under (e.g. /usr/local)
+}
+
+// PythonVirtualEnv describes the virtual environment (created by venv or virtualenv) that a python package is installed
+// within, and the interpreter that the environment links against.
+type PythonVirtualEnv struct {
+	Path                      string `json:"path"`                 // the root directory of the environment
+	Home                      string `json:"home,omitempty"`       // the directory of the interpreter the environment was created from
+	Executable                string `json:"executable,omitempty"` // the interpreter the environment was created from (recorded by python 3.11+ and virtualenv)
+	Version                   string `json:"version,omitempty"`    // the version of the interpreter
+	IncludeSystemSitePackages bool   `json:"includeSystemSitePackages"`
 }
 
 type DirectURLOrigin struct {
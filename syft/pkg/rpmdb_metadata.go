@@ -28,9 +28,18 @@ type RpmdbMetadata struct {
 	Size      int               `json:"size"`
 	License   string            `json:"license"`
 	Vendor    string            `json:"vendor"`
+	Group     string            `json:"group,omitempty"`
+	Signature *RpmdbSignature   `json:"signature,omitempty"`
 	Files     []RpmdbFileRecord `json:"files"`
 }
 
+// RpmdbSignature describes the OpenPGP signature made over the header of an installed RPM package.
+type RpmdbSignature struct {
+	PublicKeyAlgorithm string `json:"publicKeyAlgorithm"`
+	HashAlgorithm      string `json:"hashAlgorithm"`
+	KeyID              string `json:"keyId,omitempty"`
+}
+
 // RpmdbFileRecord represents the file metadata for a single file attributed to a RPM package.
 type RpmdbFileRecord struct {
 	Path      string        `json:"path"`
@@ -0,0 +1,8 @@
+package pkg
+
+// RuntimeMetadata represents all captured data for a language runtime installation (e.g. a JDK, Node.js, or .NET).
+type RuntimeMetadata struct {
+	Runtime     string `json:"runtime"`
+	Implementor string `json:"implementor,omitempty"`
+	InstallPath string `json:"installPath"`
+}
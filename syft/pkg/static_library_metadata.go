@@ -0,0 +1,8 @@
+package pkg
+
+// StaticLibraryMetadata represents all captured data for a static library (ar archive of object files).
+type StaticLibraryMetadata struct {
+	Library        string   `json:"library"`
+	Objects        []string `json:"objects"`
+	VersionSymbols []string `json:"versionSymbols,omitempty"`
+}
@@ -7,19 +7,26 @@ type Type string
 
 const (
 	// the full set of supported packages
-	UnknownPkg       Type = "UnknownPackage"
-	ApkPkg           Type = "apk"
-	GemPkg           Type = "gem"
-	DebPkg           Type = "deb"
-	RpmPkg           Type = "rpm"
-	NpmPkg           Type = "npm"
-	PythonPkg        Type = "python"
-	PhpComposerPkg   Type = "php-composer"
-	JavaPkg          Type = "java-archive"
-	JenkinsPluginPkg Type = "jenkins-plugin"
-	GoModulePkg      Type = "go-module"
-	RustPkg          Type = "rust-crate"
-	KbPkg            Type = "msrc-kb"
+	UnknownPkg         Type = "UnknownPackage"
+	ApkPkg             Type = "apk"
+	GemPkg             Type = "gem"
+	DebPkg             Type = "deb"
+	RpmPkg             Type = "rpm"
+	NpmPkg             Type = "npm"
+	PythonPkg          Type = "python"
+	PhpComposerPkg     Type = "php-composer"
+	PhpPeclPkg         Type = "php-pecl"
+	JavaPkg            Type = "java-archive"
+	JenkinsPluginPkg   Type = "jenkins-plugin"
+	GoModulePkg        Type = "go-module"
+	RustPkg            Type = "rust-crate"
+	KbPkg              Type = "msrc-kb"
+	BuildrootPkg       Type = "buildroot"
+	YoctoPkg           Type = "yocto"
+	OpkgPkg            Type = "opkg"
+	RuntimePkg         Type = "runtime"
+	StaticLibraryPkg   Type = "static-library"
+	WebServerModulePkg Type = "webserver-module"
 )
 
 // AllPkgs represents all supported package types
@@ -31,11 +38,18 @@ var AllPkgs = []Type{
 	NpmPkg,
 	PythonPkg,
 	PhpComposerPkg,
+	PhpPeclPkg,
 	JavaPkg,
 	JenkinsPluginPkg,
 	GoModulePkg,
 	RustPkg,
 	KbPkg,
+	BuildrootPkg,
+	YoctoPkg,
+	OpkgPkg,
+	RuntimePkg,
+	StaticLibraryPkg,
+	WebServerModulePkg,
 }
 
 // PackageURLType returns the PURL package type for the current package.
@@ -66,3 +80,36 @@ func (t Type) PackageURLType() string {
 		return ""
 	}
 }
+
+// TypeFromPURL returns the package type described by the given package URL (UnknownPkg when the package URL is not
+// valid or describes a type of package that syft does not catalog).
+func TypeFromPURL(p string) Type {
+	purl, err := packageurl.FromString(p)
+	if err != nil {
+		return UnknownPkg
+	}
+
+	switch purl.Type {
+	case "alpine", "apk":
+		return ApkPkg
+	case packageurl.TypeGem:
+		return GemPkg
+	case "deb":
+		return DebPkg
+	case packageurl.TypePyPi:
+		return PythonPkg
+	case packageurl.TypeComposer:
+		return PhpComposerPkg
+	case packageurl.TypeNPM:
+		return NpmPkg
+	case packageurl.TypeMaven:
+		return JavaPkg
+	case packageurl.TypeRPM:
+		return RpmPkg
+	case packageurl.TypeGolang:
+		return GoModulePkg
+	case "cargo":
+		return RustPkg
+	}
+	return UnknownPkg
+}
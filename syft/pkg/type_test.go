@@ -0,0 +1,40 @@
+package pkg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTypeFromPURL(t *testing.T) {
+	tests := []struct {
+		purl     string
+		expected Type
+		language Language
+	}{
+		{purl: "pkg:alpine/musl@1.2.2-r7?arch=x86_64", expected: ApkPkg, language: UnknownLanguage},
+		{purl: "pkg:deb/debian/bash@5.1-2?arch=amd64", expected: DebPkg, language: UnknownLanguage},
+		{purl: "pkg:maven/org.apache.commons/commons-text@1.9", expected: JavaPkg, language: Java},
+		{purl: "pkg:npm/lodash@4.17.21", expected: NpmPkg, language: JavaScript},
+		{purl: "pkg:pypi/requests@2.26.0", expected: PythonPkg, language: Python},
+		{purl: "pkg:golang/github.com/anchore/syft@v0.33.0", expected: GoModulePkg, language: Go},
+		{purl: "pkg:cargo/serde@1.0.130", expected: RustPkg, language: Rust},
+		{purl: "pkg:github/anchore/syft@v0.33.0", expected: UnknownPkg, language: UnknownLanguage},
+		{purl: "not-a-purl", expected: UnknownPkg, language: UnknownLanguage},
+	}
+
+	for _, test := range tests {
+		t.Run(test.purl, func(t *testing.T) {
+			assert.Equal(t, test.expected, TypeFromPURL(test.purl))
+			assert.Equal(t, test.language, LanguageFromPURL(test.purl))
+		})
+	}
+
+	// every package type with a package URL type is recognized again
+	for _, ty := range AllPkgs {
+		if ty.PackageURLType() == "" || ty == JenkinsPluginPkg {
+			continue
+		}
+		assert.Equal(t, ty, TypeFromPURL("pkg:"+ty.PackageURLType()+"/name@1.0"), ty)
+	}
+}
@@ -0,0 +1,11 @@
+package pkg
+
+// WebServerModuleMetadata represents all captured data for a dynamically loadable web server module (e.g. an Apache
+// httpd module or an nginx dynamic module).
+type WebServerModuleMetadata struct {
+	Server    string `json:"server"`
+	Module    string `json:"module"`
+	Path      string `json:"path"`
+	ServerAPI string `json:"serverApi,omitempty"`
+	Enabled   bool   `json:"enabled"`
+}
@@ -0,0 +1,9 @@
+package pkg
+
+// YoctoMetadata represents all captured data for a package entry within a Yocto (OpenEmbedded) image license manifest.
+type YoctoMetadata struct {
+	Package string `json:"package"`
+	Version string `json:"version"`
+	Recipe  string `json:"recipe,omitempty"`
+	License string `json:"license,omitempty"`
+}
@@ -0,0 +1,102 @@
+package sbom
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/source"
+	"github.com/google/uuid"
+)
+
+// DeterministicTimestamp is the creation time of a deterministic document without a pinned timestamp (the unix epoch,
+// as when SOURCE_DATE_EPOCH=0).
+var DeterministicTimestamp = time.Unix(0, 0).UTC()
+
+// contentNamespace is the namespace of the document UUIDs derived from the content of an SBOM.
+var contentNamespace = uuid.NewSHA1(uuid.NameSpaceURL, []byte("https://github.com/anchore/syft"))
+
+// MakeDeterministic removes the values of the SBOM that would differ between two scans of the same input, so that
+// every output format is byte-identical for identical inputs: the creation time is the unix epoch and the document
+// UUID is derived from the content (unless either is pinned), the scan duration is dropped and the relationships and
+// warnings are sorted.
+func MakeDeterministic(s *SBOM) {
+	s.Descriptor.Duration = 0
+	if s.Descriptor.Timestamp.IsZero() {
+		s.Descriptor.Timestamp = DeterministicTimestamp
+	}
+	SortRelationships(s.Relationships)
+	source.SortWarnings(s.Artifacts.Warnings)
+	for i := range s.Nested {
+		MakeDeterministic(&s.Nested[i].SBOM)
+	}
+	if s.Descriptor.UUID == uuid.Nil {
+		s.Descriptor.UUID = ContentUUID(*s)
+	}
+}
+
+// SortRelationships orders the given relationships by the IDs of both sides and then by type.
+func SortRelationships(relationships []artifact.Relationship) {
+	sort.SliceStable(relationships, func(i, j int) bool {
+		a, b := relationships[i], relationships[j]
+		if a.From.ID() != b.From.ID() {
+			return a.From.ID() < b.From.ID()
+		}
+		if a.To.ID() != b.To.ID() {
+			return a.To.ID() < b.To.ID()
+		}
+		return a.Type < b.Type
+	})
+}
+
+// ContentUUID returns a UUID (version 5) derived from what was cataloged: the source, the packages (by ID, which
+// covers their content), the relationships, the file digests and any nested SBOMs. Scans that find the same content
+// get the same UUID.
+func ContentUUID(s SBOM) uuid.UUID {
+	h := sha256.New()
+	writeContent(h, s)
+	return uuid.NewSHA1(contentNamespace, h.Sum(nil))
+}
+
+func writeContent(w io.Writer, s SBOM) {
+	src := s.Source
+	fmt.Fprintf(w, "source:%s:%s:%s:%s:%s\n", src.Scheme, src.Path, src.ImageMetadata.UserInput, src.ImageMetadata.ID, src.ImageMetadata.ManifestDigest)
+
+	if s.Artifacts.PackageCatalog != nil {
+		var ids []string
+		for p := range s.Artifacts.PackageCatalog.Enumerate() {
+			ids = append(ids, string(p.ID()))
+		}
+		sort.Strings(ids)
+		for _, id := range ids {
+			fmt.Fprintf(w, "package:%s\n", id)
+		}
+	}
+
+	var relationships []string
+	for _, r := range s.Relationships {
+		relationships = append(relationships, fmt.Sprintf("relationship:%s:%s:%s\n", r.From.ID(), r.To.ID(), r.Type))
+	}
+	sort.Strings(relationships)
+	for _, r := range relationships {
+		fmt.Fprint(w, r)
+	}
+
+	var digests []string
+	for coordinates, ds := range s.Artifacts.FileDigests {
+		for _, d := range ds {
+			digests = append(digests, fmt.Sprintf("digest:%s:%s:%s:%s\n", coordinates.RealPath, coordinates.FileSystemID, d.Algorithm, d.Value))
+		}
+	}
+	sort.Strings(digests)
+	for _, d := range digests {
+		fmt.Fprint(w, d)
+	}
+
+	for _, n := range s.Nested {
+		fmt.Fprintf(w, "nested:%s:%s:%s\n", n.Location.RealPath, n.Location.FileSystemID, ContentUUID(n.SBOM))
+	}
+}
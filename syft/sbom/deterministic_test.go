@@ -0,0 +1,68 @@
+package sbom
+
+import (
+	"testing"
+	"time"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/source"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func newDeterministicTestSBOM(relationships ...artifact.Relationship) SBOM {
+	return SBOM{
+		Artifacts: Artifacts{
+			PackageCatalog: pkg.NewCatalog(),
+			Warnings: []source.Warning{
+				{Path: "/b", Message: "unreadable"},
+				{Path: "/a", Message: "unreadable"},
+			},
+		},
+		Relationships: relationships,
+		Source: source.Metadata{
+			Scheme: source.DirectoryScheme,
+			Path:   "/some/path",
+		},
+		Descriptor: Descriptor{
+			Duration: 3 * time.Second,
+		},
+	}
+}
+
+func TestMakeDeterministic(t *testing.T) {
+	app := pkg.Package{Name: "app", Version: "1.0.0"}
+	app.SetID()
+	zlib := pkg.Package{Name: "zlib", Version: "1.2.11"}
+	zlib.SetID()
+	libssl := pkg.Package{Name: "libssl", Version: "1.1.1"}
+	libssl.SetID()
+
+	zlibDep := artifact.Relationship{From: zlib, To: app, Type: artifact.DependencyOfRelationship}
+	libsslDep := artifact.Relationship{From: libssl, To: app, Type: artifact.DependencyOfRelationship}
+
+	first := newDeterministicTestSBOM(zlibDep, libsslDep)
+	second := newDeterministicTestSBOM(libsslDep, zlibDep)
+	MakeDeterministic(&first)
+	MakeDeterministic(&second)
+
+	assert.Equal(t, first, second)
+	assert.Equal(t, DeterministicTimestamp, first.Descriptor.Timestamp)
+	assert.Zero(t, first.Descriptor.Duration)
+	assert.NotEqual(t, uuid.Nil, first.Descriptor.UUID)
+	assert.Equal(t, "/a", first.Artifacts.Warnings[0].Path)
+
+	// different content results in a different document identifier
+	other := newDeterministicTestSBOM(zlibDep)
+	MakeDeterministic(&other)
+	assert.NotEqual(t, first.Descriptor.UUID, other.Descriptor.UUID)
+
+	// pinned values are kept
+	pinned := newDeterministicTestSBOM(zlibDep, libsslDep)
+	pinned.Descriptor.UUID = uuid.MustParse("3f7d6c4d-9c8f-4d8e-bc6a-0e2f2c4b5a61")
+	pinned.Descriptor.Timestamp = time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+	MakeDeterministic(&pinned)
+	assert.Equal(t, uuid.MustParse("3f7d6c4d-9c8f-4d8e-bc6a-0e2f2c4b5a61"), pinned.Descriptor.UUID)
+	assert.Equal(t, time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC), pinned.Descriptor.Timestamp)
+}
@@ -0,0 +1,32 @@
+package sbom
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"time"
+)
+
+// DocumentOrigin describes an SBOM document that another document was derived from (e.g. by converting it to another
+// format or by merging it with other documents). Since the origin may itself be derived, the origins form a chain that
+// traces a document back to the documents that were created from cataloging a source.
+type DocumentOrigin struct {
+	Tool      string           // the name of the tool that created the document
+	Version   string           // the version of the tool that created the document
+	Format    string           // the format of the document (e.g. "spdx-json")
+	Timestamp time.Time        // when the document was created (optional, only when recorded within the document)
+	Digest    string           // the digest of the document contents ("sha256:<hex>")
+	Origins   []DocumentOrigin // the documents the document was itself derived from
+}
+
+// NewDocumentOrigin describes the document with the given contents (decoded as the given SBOM) as the origin of a
+// derived document.
+func NewDocumentOrigin(s SBOM, format string, contents []byte) DocumentOrigin {
+	return DocumentOrigin{
+		Tool:      s.Descriptor.Name,
+		Version:   s.Descriptor.Version,
+		Format:    format,
+		Timestamp: s.Descriptor.Timestamp,
+		Digest:    fmt.Sprintf("sha256:%x", sha256.Sum256(contents)),
+		Origins:   s.Descriptor.Origins,
+	}
+}
@@ -1,11 +1,14 @@
 package sbom
 
 import (
+	"time"
+
 	"github.com/anchore/syft/syft/artifact"
 	"github.com/anchore/syft/syft/distro"
 	"github.com/anchore/syft/syft/file"
 	"github.com/anchore/syft/syft/pkg"
 	"github.com/anchore/syft/syft/source"
+	"github.com/google/uuid"
 )
 
 type SBOM struct {
@@ -13,6 +16,13 @@ type SBOM struct {
 	Relationships []artifact.Relationship
 	Source        source.Metadata
 	Descriptor    Descriptor
+	Nested        []NestedSBOM // SBOMs for container images stored within the source (optional)
+}
+
+// NestedSBOM describes a container image that was found within the files of the source being cataloged.
+type NestedSBOM struct {
+	Location source.Coordinates // where the image (a docker-archive tarball or an OCI layout index) was found
+	SBOM     SBOM
 }
 
 type Artifacts struct {
@@ -20,15 +30,43 @@ type Artifacts struct {
 	FileMetadata        map[source.Coordinates]source.FileMetadata
 	FileDigests         map[source.Coordinates][]file.Digest
 	FileClassifications map[source.Coordinates][]file.Classification
+	FileExecutables     map[source.Coordinates]file.Executable
+	FileImports         map[source.Coordinates][]string
 	FileContents        map[source.Coordinates]string
 	Secrets             map[source.Coordinates][]file.SearchResult
 	Distro              *distro.Distro
+	Warnings            []source.Warning // non-fatal problems found while cataloging (e.g. unreadable or skipped files)
 }
 
 type Descriptor struct {
 	Name          string
 	Version       string
 	Configuration interface{}
+	Author        string           // the person responsible for creating the document (optional)
+	Organization  string           // the organization on whose behalf the document was created (optional)
+	Supplier      string           // the organization that supplies the software described by the document (optional)
+	Timestamp     time.Time        // the time the document was created (optional, the time of encoding is used when not provided)
+	UUID          uuid.UUID        // the unique identifier for the document (optional, a random UUID is used when not provided)
+	DocumentName  string           // the name of the document (optional, derived from the source when not provided)
+	Namespace     string           // the SPDX document namespace (optional, derived from the document name and UUID when not provided)
+	Origins       []DocumentOrigin // the documents this document was derived from, e.g. when converting or merging SBOMs (optional)
+	Duration      time.Duration    // how long cataloging the source took (optional)
+}
+
+// CreationTime returns the pinned document creation time, or the current time if a timestamp was not provided.
+func (d Descriptor) CreationTime() time.Time {
+	if d.Timestamp.IsZero() {
+		return time.Now()
+	}
+	return d.Timestamp
+}
+
+// DocumentUUID returns the pinned document identifier, or a new random UUID if one was not provided.
+func (d Descriptor) DocumentUUID() uuid.UUID {
+	if d.UUID == uuid.Nil {
+		return uuid.New()
+	}
+	return d.UUID
 }
 
 func AllCoordinates(sbom SBOM) []source.Coordinates {
@@ -42,6 +80,12 @@ func AllCoordinates(sbom SBOM) []source.Coordinates {
 	for coordinates := range sbom.Artifacts.FileClassifications {
 		set.Add(coordinates)
 	}
+	for coordinates := range sbom.Artifacts.FileExecutables {
+		set.Add(coordinates)
+	}
+	for coordinates := range sbom.Artifacts.FileImports {
+		set.Add(coordinates)
+	}
 	for coordinates := range sbom.Artifacts.FileDigests {
 		set.Add(coordinates)
 	}
@@ -64,3 +108,119 @@ func extractCoordinates(relationship artifact.Relationship) (results []source.Co
 
 	return results
 }
+
+// DependencyRelationships returns all package-to-package dependencies within the SBOM (with the dependency as the parent
+// and the dependent package as the child): any explicit dependency relationships, as well as the dependencies implied
+// by dynamic links between files (see LinkedPackageRelationships).
+func DependencyRelationships(sbom SBOM) []artifact.Relationship {
+	dependencies := newDependencySet()
+	for _, relationship := range sbom.Relationships {
+		if relationship.Type != artifact.DependencyOfRelationship {
+			continue
+		}
+		if _, ok := relationship.From.(pkg.Package); !ok {
+			continue
+		}
+		if _, ok := relationship.To.(pkg.Package); !ok {
+			continue
+		}
+		dependencies.add(relationship.From, relationship.To)
+	}
+
+	for _, relationship := range LinkedPackageRelationships(sbom) {
+		dependencies.add(relationship.From, relationship.To)
+	}
+
+	return dependencies.relationships
+}
+
+// LinkedPackageRelationships returns the package-to-package dependencies implied by binaries dynamically linking
+// libraries (e.g. the DLL imports of a PE binary): a package that owns a binary depends on the packages that own the
+// libraries it links. A package owns the files it contains as well as the files it was found at (e.g. a DLL cataloged
+// as a package by itself).
+func LinkedPackageRelationships(sbom SBOM) []artifact.Relationship {
+	owners := fileOwners(sbom)
+	dependencies := newDependencySet()
+	for _, relationship := range sbom.Relationships {
+		if relationship.Type != artifact.DynamicLinkRelationship {
+			continue
+		}
+		binary, ok := relationship.From.(source.Coordinates)
+		if !ok {
+			continue
+		}
+		library, ok := relationship.To.(source.Coordinates)
+		if !ok {
+			continue
+		}
+		for _, dependent := range owners[binary] {
+			for _, dependency := range owners[library] {
+				dependencies.add(dependency, dependent)
+			}
+		}
+	}
+	return dependencies.relationships
+}
+
+// fileOwners returns the packages that own each file: the packages that contain the file and the packages that were
+// found at the file (in a stable order).
+func fileOwners(sbom SBOM) map[source.Coordinates][]pkg.Package {
+	owners := make(map[source.Coordinates][]pkg.Package)
+	seen := make(map[source.Coordinates]map[artifact.ID]struct{})
+	add := func(coordinates source.Coordinates, p pkg.Package) {
+		if seen[coordinates] == nil {
+			seen[coordinates] = make(map[artifact.ID]struct{})
+		}
+		if _, exists := seen[coordinates][p.ID()]; exists {
+			return
+		}
+		seen[coordinates][p.ID()] = struct{}{}
+		owners[coordinates] = append(owners[coordinates], p)
+	}
+
+	for _, relationship := range sbom.Relationships {
+		if relationship.Type != artifact.ContainsRelationship {
+			continue
+		}
+		p, ok := relationship.From.(pkg.Package)
+		if !ok {
+			continue
+		}
+		if coordinates, ok := relationship.To.(source.Coordinates); ok {
+			add(coordinates, p)
+		}
+	}
+
+	if sbom.Artifacts.PackageCatalog != nil {
+		for _, p := range sbom.Artifacts.PackageCatalog.Sorted() {
+			for _, location := range p.Locations {
+				add(location.Coordinates, p)
+			}
+		}
+	}
+
+	return owners
+}
+
+// dependencySet collects unique dependency relationships between different packages (in the order they were added).
+type dependencySet struct {
+	seen          map[[2]artifact.ID]struct{}
+	relationships []artifact.Relationship
+}
+
+func newDependencySet() *dependencySet {
+	return &dependencySet{seen: make(map[[2]artifact.ID]struct{})}
+}
+
+func (s *dependencySet) add(dependency, dependent artifact.Identifiable) {
+	key := [2]artifact.ID{dependency.ID(), dependent.ID()}
+	if _, exists := s.seen[key]; exists || key[0] == key[1] {
+		return
+	}
+	s.seen[key] = struct{}{}
+	s.relationships = append(s.relationships, artifact.Relationship{
+		From: dependency,
+		To:   dependent,
+		Type: artifact.DependencyOfRelationship,
+	})
+}
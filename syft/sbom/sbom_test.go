@@ -0,0 +1,136 @@
+package sbom
+
+import (
+	"testing"
+	"time"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/source"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDependencyRelationships(t *testing.T) {
+	app := pkg.Package{Name: "app", Version: "1.0.0"}
+	app.SetID()
+	libssl := pkg.Package{Name: "libssl", Version: "1.1.1"}
+	libssl.SetID()
+	zlib := pkg.Package{Name: "zlib", Version: "1.2.11"}
+	zlib.SetID()
+
+	binary := source.Coordinates{RealPath: "/usr/bin/app"}
+	library := source.Coordinates{RealPath: "/usr/lib/libssl.so.1.1"}
+	unowned := source.Coordinates{RealPath: "/opt/libunowned.so"}
+
+	tests := []struct {
+		name          string
+		relationships []artifact.Relationship
+		expected      []artifact.Relationship
+	}{
+		{
+			name: "explicit package dependencies",
+			relationships: []artifact.Relationship{
+				{From: zlib, To: app, Type: artifact.DependencyOfRelationship},
+			},
+			expected: []artifact.Relationship{
+				{From: zlib, To: app, Type: artifact.DependencyOfRelationship},
+			},
+		},
+		{
+			name: "dynamic links between files owned by packages",
+			relationships: []artifact.Relationship{
+				{From: app, To: binary, Type: artifact.ContainsRelationship},
+				{From: libssl, To: library, Type: artifact.ContainsRelationship},
+				{From: binary, To: library, Type: artifact.DynamicLinkRelationship},
+				{From: binary, To: unowned, Type: artifact.DynamicLinkRelationship},
+			},
+			expected: []artifact.Relationship{
+				{From: libssl, To: app, Type: artifact.DependencyOfRelationship},
+			},
+		},
+		{
+			name: "deduplicate dependencies",
+			relationships: []artifact.Relationship{
+				{From: libssl, To: app, Type: artifact.DependencyOfRelationship},
+				{From: app, To: binary, Type: artifact.ContainsRelationship},
+				{From: libssl, To: library, Type: artifact.ContainsRelationship},
+				{From: binary, To: library, Type: artifact.DynamicLinkRelationship},
+			},
+			expected: []artifact.Relationship{
+				{From: libssl, To: app, Type: artifact.DependencyOfRelationship},
+			},
+		},
+		{
+			name: "ignore links within the same package",
+			relationships: []artifact.Relationship{
+				{From: libssl, To: binary, Type: artifact.ContainsRelationship},
+				{From: libssl, To: library, Type: artifact.ContainsRelationship},
+				{From: binary, To: library, Type: artifact.DynamicLinkRelationship},
+			},
+		},
+		{
+			name: "ignore non-dependency relationships",
+			relationships: []artifact.Relationship{
+				{From: libssl, To: app, Type: artifact.OwnershipByFileOverlapRelationship},
+				{From: library, To: binary, Type: artifact.DebugSymbolsOfRelationship},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := DependencyRelationships(SBOM{Relationships: test.relationships})
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestLinkedPackageRelationships(t *testing.T) {
+	binary := source.Coordinates{RealPath: "/app/app.exe"}
+	library := source.Coordinates{RealPath: "/app/helper.dll"}
+	system := source.Coordinates{RealPath: "/Windows/System32/kernel32.dll"}
+
+	app := pkg.Package{Name: "app", Version: "1.0.0"}
+	app.SetID()
+	// packages cataloged from a binary itself (e.g. by its version resource) own the file they were found at
+	helper := pkg.Package{Name: "helper", Version: "2.0.0", Locations: []source.Location{{Coordinates: library}}}
+	helper.SetID()
+
+	s := SBOM{
+		Artifacts: Artifacts{
+			PackageCatalog: pkg.NewCatalog(helper),
+		},
+		Relationships: []artifact.Relationship{
+			{From: app, To: binary, Type: artifact.ContainsRelationship},
+			{From: binary, To: library, Type: artifact.DynamicLinkRelationship},
+			{From: binary, To: system, Type: artifact.DynamicLinkRelationship},
+		},
+	}
+
+	expected := []artifact.Relationship{
+		{From: helper, To: app, Type: artifact.DependencyOfRelationship},
+	}
+	assert.Equal(t, expected, LinkedPackageRelationships(s))
+	assert.Equal(t, expected, DependencyRelationships(s))
+}
+
+func TestNewDocumentOrigin(t *testing.T) {
+	previous := DocumentOrigin{Tool: "syft", Version: "0.39.0", Format: "json", Digest: "sha256:3c4d"}
+	s := SBOM{
+		Descriptor: Descriptor{
+			Name:      "syft",
+			Version:   "0.40.0",
+			Timestamp: time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC),
+			Origins:   []DocumentOrigin{previous},
+		},
+	}
+
+	assert.Equal(t, DocumentOrigin{
+		Tool:      "syft",
+		Version:   "0.40.0",
+		Format:    "spdx-json",
+		Timestamp: time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC),
+		// sha256 of "contents"
+		Digest:  "sha256:d1b2a59fbea7e20077af9f91b27e95e865061b270be03ff539ab3b73587882e8",
+		Origins: []DocumentOrigin{previous},
+	}, NewDocumentOrigin(s, "spdx-json", []byte("contents")))
+}
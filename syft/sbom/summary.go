@@ -0,0 +1,46 @@
+package sbom
+
+import (
+	"sort"
+	"time"
+
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// Summary describes the composition of an SBOM (how many packages of each type and files it describes, and how long
+// cataloging took), which is embedded within the metadata of each document so that it can be reported on without
+// processing the whole document.
+type Summary struct {
+	Packages       int
+	PackagesByType map[pkg.Type]int
+	Files          int
+	Duration       time.Duration // how long cataloging took (zero when unknown)
+}
+
+// NewSummary describes the composition of the given SBOM (nested SBOMs are not included).
+func NewSummary(s SBOM) Summary {
+	summary := Summary{
+		PackagesByType: make(map[pkg.Type]int),
+		Files:          len(AllCoordinates(s)),
+		Duration:       s.Descriptor.Duration,
+	}
+	if s.Artifacts.PackageCatalog != nil {
+		for p := range s.Artifacts.PackageCatalog.Enumerate() {
+			summary.Packages++
+			summary.PackagesByType[p.Type]++
+		}
+	}
+	return summary
+}
+
+// Types returns the package types within the SBOM, sorted by name.
+func (s Summary) Types() []pkg.Type {
+	types := make([]pkg.Type, 0, len(s.PackagesByType))
+	for t := range s.PackagesByType {
+		types = append(types, t)
+	}
+	sort.Slice(types, func(i, j int) bool {
+		return types[i] < types[j]
+	})
+	return types
+}
@@ -0,0 +1,50 @@
+package sbom
+
+import (
+	"testing"
+	"time"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/source"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSummary(t *testing.T) {
+	musl := pkg.Package{Name: "musl", Version: "1.2.2", Type: pkg.ApkPkg}
+	busybox := pkg.Package{Name: "busybox", Version: "1.33.1", Type: pkg.ApkPkg}
+	requests := pkg.Package{Name: "requests", Version: "2.26.0", Type: pkg.PythonPkg}
+	for _, p := range []*pkg.Package{&musl, &busybox, &requests} {
+		p.SetID()
+	}
+
+	s := SBOM{
+		Artifacts: Artifacts{
+			PackageCatalog: pkg.NewCatalog(musl, busybox, requests),
+			FileMetadata: map[source.Coordinates]source.FileMetadata{
+				{RealPath: "/lib/ld-musl-x86_64.so.1"}: {},
+				{RealPath: "/bin/busybox"}:             {},
+			},
+			FileDigests: map[source.Coordinates][]file.Digest{
+				{RealPath: "/bin/busybox"}: nil,
+			},
+		},
+		Relationships: []artifact.Relationship{
+			{From: busybox, To: source.Coordinates{RealPath: "/bin/sh"}, Type: artifact.ContainsRelationship},
+		},
+		Descriptor: Descriptor{Duration: 3 * time.Second},
+	}
+
+	summary := NewSummary(s)
+	assert.Equal(t, Summary{
+		Packages:       3,
+		PackagesByType: map[pkg.Type]int{pkg.ApkPkg: 2, pkg.PythonPkg: 1},
+		Files:          3,
+		Duration:       3 * time.Second,
+	}, summary)
+	assert.Equal(t, []pkg.Type{pkg.ApkPkg, pkg.PythonPkg}, summary.Types())
+
+	// an SBOM without packages is still summarized
+	assert.Equal(t, Summary{PackagesByType: map[pkg.Type]int{}}, NewSummary(SBOM{}))
+}
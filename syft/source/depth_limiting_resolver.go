@@ -0,0 +1,12 @@
+package source
+
+// NewDepthLimitingResolver creates a new resolver which wraps the provided delegate and excludes any entries that are
+// nested more than the given number of path elements deep (relative to the root of the source).
+func NewDepthLimitingResolver(delegate FileResolver, maxDepth int) FileResolver {
+	if maxDepth <= 0 {
+		return delegate
+	}
+	return NewExcludingResolver(delegate, func(path string) bool {
+		return pathDepth(path) > maxDepth
+	})
+}
@@ -0,0 +1,80 @@
+package source
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DirectoryConfig captures options that control how a directory source is traversed while indexing. The zero value
+// indexes the entire tree (except for special files) and follows all symlinks.
+type DirectoryConfig struct {
+	MaxDepth             int    // the maximum number of path elements below the scan root to index (0 = unlimited)
+	SkipExternalSymlinks bool   // do not index symlink targets that resolve outside of the scan root
+	IncludeSpecialFiles  bool   // index devices, sockets, and FIFOs (metadata only, their contents are never read)
+	CaseInsensitive      bool   // match glob patterns regardless of case (e.g. for filesystems from Windows or macOS)
+	NormalizeUnicode     bool   // match glob patterns regardless of the unicode normalization form (NFC or NFD) of paths
+	BasePath             string // treat this directory (containing the scan root) as the filesystem root, reporting absolute paths and resolving links within it (e.g. for a mounted root filesystem)
+	SkipIgnoreFile       bool   // do not exclude the paths listed in the ignore file (.syftignore) at the scan root
+}
+
+func (cfg DirectoryConfig) globMatchOptions() globMatchOptions {
+	return globMatchOptions{
+		caseInsensitive:  cfg.CaseInsensitive,
+		normalizeUnicode: cfg.NormalizeUnicode,
+	}
+}
+
+// getDirectoryTraversalFunctions returns the path filters needed to enforce the given traversal options relative to the given root.
+func getDirectoryTraversalFunctions(root string, cfg DirectoryConfig) ([]pathFilterFn, error) {
+	// this is what directoryResolver.indexTree is doing to get the absolute path:
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+
+	var filters []pathFilterFn
+
+	if cfg.MaxDepth > 0 {
+		filters = append(filters, func(path string, _ os.FileInfo) bool {
+			relPath, ok := relativeToRoot(root, path)
+			if !ok {
+				// paths outside of the scan root (e.g. symlink targets) are not subject to the depth limit
+				return false
+			}
+			return pathDepth(relPath) > cfg.MaxDepth
+		})
+	}
+
+	if cfg.SkipExternalSymlinks {
+		filters = append(filters, func(path string, _ os.FileInfo) bool {
+			// the only way to reach a path outside of the scan root is by indexing the target of a symlink
+			_, ok := relativeToRoot(root, path)
+			return !ok
+		})
+	}
+
+	return filters, nil
+}
+
+// relativeToRoot returns the given absolute path relative to the given absolute root, and whether the path is
+// within the root at all.
+func relativeToRoot(root, path string) (string, bool) {
+	if path == root {
+		return "", true
+	}
+	prefix := strings.TrimSuffix(root, string(filepath.Separator)) + string(filepath.Separator)
+	if !strings.HasPrefix(path, prefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(path, prefix), true
+}
+
+// pathDepth returns the number of path elements in the given path (e.g. "a/b/c" and "/a/b/c" both have a depth of 3).
+func pathDepth(path string) int {
+	path = strings.Trim(filepath.ToSlash(path), "/")
+	if path == "" {
+		return 0
+	}
+	return strings.Count(path, "/") + 1
+}
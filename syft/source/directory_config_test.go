@@ -0,0 +1,121 @@
+package source
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/anchore/stereoscope/pkg/file"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDirectoryConfig_MaxDepth(t *testing.T) {
+	tests := []struct {
+		name     string
+		maxDepth int
+		expected []string
+	}{
+		{
+			name:     "unlimited",
+			maxDepth: 0,
+			expected: []string{"Dockerfile", "file-1.txt", "file-2.txt", "target/really/nested/file-3.txt"},
+		},
+		{
+			name:     "root only",
+			maxDepth: 1,
+			expected: []string{"Dockerfile", "file-1.txt", "file-2.txt"},
+		},
+		{
+			name:     "exactly reaches nested file",
+			maxDepth: 4,
+			expected: []string{"Dockerfile", "file-1.txt", "file-2.txt", "target/really/nested/file-3.txt"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := "test-fixtures/image-simple"
+			filters, err := getDirectoryTraversalFunctions(root, DirectoryConfig{MaxDepth: test.maxDepth})
+			require.NoError(t, err)
+
+			resolver, err := newDirectoryResolver(root, filters...)
+			require.NoError(t, err)
+
+			locations, err := resolver.FilesByGlob("**")
+			require.NoError(t, err)
+
+			var actual []string
+			for _, l := range locations {
+				actual = append(actual, l.RealPath)
+			}
+			assert.ElementsMatch(t, test.expected, actual)
+		})
+	}
+}
+
+func TestDirectoryConfig_SkipExternalSymlinks(t *testing.T) {
+	root := "test-fixtures/symlinks-roots/root"
+	outsidePath, err := filepath.Abs("test-fixtures/symlinks-roots/outside/link_to_readme")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name            string
+		skip            bool
+		expectedOutside bool
+	}{
+		{
+			name:            "follow external symlinks",
+			skip:            false,
+			expectedOutside: true,
+		},
+		{
+			name:            "skip external symlinks",
+			skip:            true,
+			expectedOutside: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			filters, err := getDirectoryTraversalFunctions(root, DirectoryConfig{SkipExternalSymlinks: test.skip})
+			require.NoError(t, err)
+
+			resolver, err := newDirectoryResolver(root, filters...)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expectedOutside, resolver.fileTree.HasPath(file.Path(outsidePath)))
+			// the link itself (within the scan root) is always indexed
+			assert.True(t, resolver.HasPath("link_to_link_to_readme"))
+		})
+	}
+}
+
+func TestPathDepth(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected int
+	}{
+		{path: "", expected: 0},
+		{path: "/", expected: 0},
+		{path: "go.mod", expected: 1},
+		{path: "/go.mod", expected: 1},
+		{path: "a/b/go.mod", expected: 3},
+		{path: "/a/b/go.mod", expected: 3},
+	}
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			assert.Equal(t, test.expected, pathDepth(test.path))
+		})
+	}
+}
+
+func TestDepthLimitingResolver(t *testing.T) {
+	resolver := NewDepthLimitingResolver(NewMockResolverForPaths("/go.mod", "/a/go.mod", "/a/b/go.mod", "/a/b/c/go.mod"), 2)
+
+	locations, err := resolver.FilesByGlob("**/go.mod")
+	require.NoError(t, err)
+
+	var actual []string
+	for _, l := range locations {
+		actual = append(actual, l.RealPath)
+	}
+	assert.ElementsMatch(t, []string{"/go.mod", "/a/go.mod"}, actual)
+}
@@ -40,14 +40,22 @@ type directoryResolver struct {
 	currentWdRelativeToRoot string
 	currentWd               string
 	fileTree                *filetree.FileTree
+	globIndex               *globIndex
 	metadata                map[file.ID]FileMetadata
-	// TODO: wire up to report these paths in the json report
-	pathFilterFns  []pathFilterFn
-	refsByMIMEType map[string][]file.Reference
-	errPaths       map[string]error
+	pathFilterFns           []pathFilterFn
+	refsByMIMEType          map[string][]file.Reference
+	errPaths                map[string]error
+	inodes                  map[fileInode]string // the first path indexed for each file with multiple hardlinks
+	base                    string               // the absolute path treated as the filesystem root when re-rooting paths (empty when not re-rooting)
 }
 
 func newDirectoryResolver(root string, pathFilters ...pathFilterFn) (*directoryResolver, error) {
+	return newDirectoryResolverWithConfig(root, DirectoryConfig{}, pathFilters...)
+}
+
+// newDirectoryResolverWithConfig creates a directory resolver with the indexing and path matching options of the given
+// config (note: the traversal options are enforced by the given path filters, see getDirectoryTraversalFunctions).
+func newDirectoryResolverWithConfig(root string, cfg DirectoryConfig, pathFilters ...pathFilterFn) (*directoryResolver, error) {
 	currentWd, err := os.Getwd()
 	if err != nil {
 		return nil, fmt.Errorf("could not create directory resolver: %w", err)
@@ -72,9 +80,24 @@ func newDirectoryResolver(root string, pathFilters ...pathFilterFn) (*directoryR
 		pathFilterFns:           append([]pathFilterFn{isUnallowableFileType, isUnixSystemRuntimePath}, pathFilters...),
 		refsByMIMEType:          make(map[string][]file.Reference),
 		errPaths:                make(map[string]error),
+		inodes:                  make(map[fileInode]string),
+	}
+	if cfg.BasePath != "" {
+		if resolver.base, err = basePath(root, cfg.BasePath); err != nil {
+			return nil, fmt.Errorf("could not create directory resolver: %w", err)
+		}
+	}
+	if cfg.IncludeSpecialFiles {
+		// special files are indexed for their metadata only, their contents are never read
+		resolver.pathFilterFns[0] = isIrregularFileType
 	}
 
-	return &resolver, indexAllRoots(root, resolver.indexTree)
+	err = indexAllRoots(root, resolver.indexTree)
+
+	// all catalogers query the same (complete) tree, so glob searches are served from a single shared index
+	resolver.globIndex = newGlobIndex(resolver.fileTree, cfg.globMatchOptions())
+
+	return &resolver, err
 }
 
 func (r *directoryResolver) indexTree(root string, stager *progress.Stage) ([]string, error) {
@@ -177,6 +200,8 @@ func (r directoryResolver) addPathToIndex(p string, info os.FileInfo) (string, e
 		return "", r.addDirectoryToIndex(p, info)
 	case RegularFile:
 		return "", r.addFileToIndex(p, info)
+	case CharacterDevice, BlockDevice, FIFONode, Socket:
+		return "", r.addSpecialFileToIndex(p, info)
 	default:
 		return "", fmt.Errorf("unsupported file type: %s", t)
 	}
@@ -203,11 +228,32 @@ func (r directoryResolver) addFileToIndex(p string, info os.FileInfo) error {
 
 	location := NewLocationFromDirectory(p, *ref)
 	metadata := fileMetadataFromPath(p, info, r.isInIndex(location))
+	if inode, ok := getInode(info); ok {
+		// all paths of a hardlinked file share the same content, which is described by the first path indexed
+		if first, exists := r.inodes[inode]; exists {
+			metadata.Type = HardLink
+			metadata.LinkDestination = r.responsePath(first)
+		} else {
+			r.inodes[inode] = p
+		}
+	}
 	r.addFileMetadataToIndex(ref, metadata)
 
 	return nil
 }
 
+func (r directoryResolver) addSpecialFileToIndex(p string, info os.FileInfo) error {
+	ref, err := r.fileTree.AddFile(file.Path(p))
+	if err != nil {
+		return err
+	}
+
+	// note: the MIME type is not detected, since opening a FIFO (for example) may block
+	r.addFileMetadataToIndex(ref, fileMetadataFromPath(p, info, false))
+
+	return nil
+}
+
 func (r directoryResolver) addSymlinkToIndex(p string, info os.FileInfo) (string, error) {
 	var usedInfo = info
 
@@ -218,7 +264,10 @@ func (r directoryResolver) addSymlinkToIndex(p string, info os.FileInfo) (string
 
 	// note: if the link is not absolute (e.g, /dev/stderr -> fd/2 ) we need to resolve it relative to the directory
 	// in question (e.g. resolve to /dev/fd/2)
-	if !filepath.IsAbs(linkTarget) {
+	switch {
+	case r.base != "":
+		linkTarget = r.rerootLinkTarget(p, linkTarget)
+	case !filepath.IsAbs(linkTarget):
 		linkTarget = filepath.Join(filepath.Dir(p), linkTarget)
 	}
 
@@ -249,10 +298,14 @@ func (r directoryResolver) addFileMetadataToIndex(ref *file.Reference, metadata
 }
 
 func (r directoryResolver) requestPath(userPath string) (string, error) {
-	if filepath.IsAbs(userPath) {
+	switch {
+	case filepath.IsAbs(userPath) && r.base != "":
+		// absolute paths are paths of the system whose root filesystem is at the base path
+		userPath = path.Join(r.base, userPath)
+	case filepath.IsAbs(userPath):
 		// don't allow input to potentially hop above root path
 		userPath = path.Join(r.path, userPath)
-	} else {
+	default:
 		// ensure we take into account any relative difference between the root path and the CWD for relative requests
 		userPath = path.Join(r.currentWdRelativeToRoot, userPath)
 	}
@@ -271,6 +324,13 @@ func (r directoryResolver) responsePath(path string) string {
 		path = posixToWindows(path)
 	}
 
+	if r.base != "" {
+		if rel, err := filepath.Rel(r.base, path); err == nil && !isOutsideRoot(rel) {
+			// report paths as absolute paths of the system whose root filesystem is at the base path
+			return filepath.ToSlash(filepath.Join("/", rel))
+		}
+	}
+
 	// always return references relative to the request path (not absolute path)
 	if filepath.IsAbs(path) {
 		// we need to account for the cwd relative to the running process and the given root for the directory resolver
@@ -280,6 +340,63 @@ func (r directoryResolver) responsePath(path string) string {
 	return path
 }
 
+// rerootLinkTarget resolves the target of the symlink at the given path as if the base path were the filesystem root
+// (like a chroot): absolute targets are relative to the base path, and relative targets cannot climb above it.
+func (r directoryResolver) rerootLinkTarget(linkPath, linkTarget string) string {
+	linkDir, err := filepath.Rel(r.base, filepath.Dir(linkPath))
+	if err != nil || isOutsideRoot(linkDir) {
+		linkDir = ""
+	}
+
+	target := filepath.ToSlash(linkTarget)
+	if !path.IsAbs(target) {
+		target = path.Join("/", filepath.ToSlash(linkDir), target)
+	}
+	// note: cleaning an absolute path removes any ".." elements at the root
+	return filepath.Join(r.base, filepath.FromSlash(path.Clean(target)))
+}
+
+// rerootedFileByPath returns the location of the file at the given path, resolving links within the base path.
+func (r directoryResolver) rerootedFileByPath(requestPath string) (Location, bool) {
+	exists, resolved, err := r.fileTree.File(file.Path(requestPath), filetree.FollowBasenameLinks)
+	if err != nil || !exists || resolved == nil {
+		return Location{}, false
+	}
+	// don't consider directories
+	if metadata, ok := r.metadata[resolved.ID()]; ok && metadata.Type == Directory {
+		return Location{}, false
+	}
+
+	// like any other path, the location refers to the requested path (which may be a link)
+	exists, ref, err := r.fileTree.File(file.Path(requestPath))
+	if err != nil || !exists || ref == nil {
+		return Location{}, false
+	}
+	return NewLocationFromDirectory(r.responsePath(requestPath), *ref), true
+}
+
+// basePath returns the absolute base path, which must contain the given scan root.
+func basePath(root, base string) (string, error) {
+	absRoot, err := filepath.Abs(root)
+	if err != nil {
+		return "", err
+	}
+	absBase, err := filepath.Abs(base)
+	if err != nil {
+		return "", err
+	}
+	rel, err := filepath.Rel(absBase, absRoot)
+	if err != nil || isOutsideRoot(rel) {
+		return "", fmt.Errorf("the scan root %q is not within the base path %q", root, base)
+	}
+	return absBase, nil
+}
+
+// isOutsideRoot indicates if the given relative path refers to a path above the directory it is relative to.
+func isOutsideRoot(rel string) bool {
+	return rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
 // HasPath indicates if the given path exists in the underlying source.
 func (r *directoryResolver) HasPath(userPath string) bool {
 	requestPath, err := r.requestPath(userPath)
@@ -305,6 +422,14 @@ func (r directoryResolver) FilesByPath(userPaths ...string) ([]Location, error)
 			continue
 		}
 
+		if r.base != "" {
+			// links are resolved within the base path rather than by the host (where absolute link targets would escape it)
+			if location, ok := r.rerootedFileByPath(userStrPath); ok {
+				references = append(references, location)
+			}
+			continue
+		}
+
 		// TODO: why not use stored metadata?
 		fileMeta, err := os.Stat(userStrPath)
 		if errors.Is(err, os.ErrNotExist) {
@@ -345,7 +470,7 @@ func (r directoryResolver) FilesByGlob(patterns ...string) ([]Location, error) {
 	result := make([]Location, 0)
 
 	for _, pattern := range patterns {
-		globResults, err := r.fileTree.FilesByGlob(pattern)
+		globResults, err := r.filesByGlob(pattern)
 		if err != nil {
 			return nil, err
 		}
@@ -357,6 +482,13 @@ func (r directoryResolver) FilesByGlob(patterns ...string) ([]Location, error) {
 	return result, nil
 }
 
+func (r directoryResolver) filesByGlob(pattern string) ([]filetree.GlobResult, error) {
+	if r.globIndex == nil {
+		return r.fileTree.FilesByGlob(pattern)
+	}
+	return r.globIndex.FilesByGlob(pattern)
+}
+
 // RelativeFileByPath fetches a single file at the given path relative to the layer squash of the given reference.
 // This is helpful when attempting to find a file that is in the same layer or lower as another file. For the
 // directoryResolver, this is a simple path lookup.
@@ -384,9 +516,19 @@ func (r directoryResolver) FileContentsByLocation(location Location) (io.ReadClo
 		// by preference or these files are not readable by the current user).
 		return nil, fmt.Errorf("file content is inaccessible path=%q", location.ref.RealPath)
 	}
+	if metadata, ok := r.metadata[location.ref.ID()]; ok && metadata.Type.IsSpecial() {
+		return nil, fmt.Errorf("file content is not readable for special file (type=%s) path=%q", metadata.Type, location.ref.RealPath)
+	}
 	// RealPath is posix so for windows directory resolver we need to translate
 	// to its true on disk path.
 	filePath := string(location.ref.RealPath)
+	if r.base != "" {
+		// read the file a link resolves to within the base path, since the host would resolve absolute link targets
+		// outside of it
+		if exists, ref, err := r.fileTree.File(location.ref.RealPath, filetree.FollowBasenameLinks); err == nil && exists && ref != nil {
+			filePath = string(ref.RealPath)
+		}
+	}
 	if runtime.GOOS == WindowsOS {
 		filePath = posixToWindows(filePath)
 	}
@@ -478,6 +620,14 @@ func isUnallowableFileType(_ string, info os.FileInfo) bool {
 	return false
 }
 
+// isIrregularFileType filters the files that are neither regular, directories, symlinks nor special files.
+func isIrregularFileType(_ string, info os.FileInfo) bool {
+	if info == nil {
+		return false
+	}
+	return newFileTypeFromMode(info.Mode()) == IrregularFile
+}
+
 func indexAllRoots(root string, indexer func(string, *progress.Stage) ([]string, error)) error {
 	// why account for multiple roots? To cover cases when there is a symlink that references above the root path,
 	// in which case we need to additionally index where the link resolves to. it's for this reason why the filetree
@@ -770,3 +770,38 @@ func testWithTimeout(t *testing.T, timeout time.Duration, test func(*testing.T))
 	case <-done:
 	}
 }
+
+func Test_directoryResolver_BasePath(t *testing.T) {
+	base := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(base, "etc"), 0755))
+	require.NoError(t, os.MkdirAll(filepath.Join(base, "usr", "lib"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(base, "usr", "lib", "os-release"), []byte("ID=mounted\n"), 0644))
+	// absolute (and climbing) link targets refer to the mounted root, not to the machine running the scan
+	require.NoError(t, os.Symlink("/usr/lib/os-release", filepath.Join(base, "etc", "os-release")))
+	require.NoError(t, os.Symlink("../../../../usr/lib/os-release", filepath.Join(base, "etc", "os-release-relative")))
+
+	resolver, err := newDirectoryResolverWithConfig(base, DirectoryConfig{BasePath: base})
+	require.NoError(t, err)
+
+	for _, p := range []string{"/etc/os-release", "/etc/os-release-relative"} {
+		locations, err := resolver.FilesByPath(p)
+		require.NoError(t, err)
+		require.Len(t, locations, 1)
+		assert.Equal(t, p, locations[0].RealPath)
+
+		reader, err := resolver.FileContentsByLocation(locations[0])
+		require.NoError(t, err)
+		contents, err := ioutil.ReadAll(reader)
+		require.NoError(t, err)
+		assert.Equal(t, "ID=mounted\n", string(contents))
+	}
+
+	locations, err := resolver.FilesByGlob("**/lib/os-release")
+	require.NoError(t, err)
+	require.Len(t, locations, 1)
+	assert.Equal(t, "/usr/lib/os-release", locations[0].RealPath)
+
+	// the scan root must be within the base path
+	_, err = newDirectoryResolverWithConfig(base, DirectoryConfig{BasePath: filepath.Join(base, "etc")})
+	assert.Error(t, err)
+}
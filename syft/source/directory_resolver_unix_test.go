@@ -0,0 +1,66 @@
+//go:build linux || darwin
+// +build linux darwin
+
+package source
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDirectoryResolver_Hardlinks(t *testing.T) {
+	root := t.TempDir()
+	original := filepath.Join(root, "a-file.txt")
+	require.NoError(t, ioutil.WriteFile(original, []byte("contents"), 0644))
+	require.NoError(t, os.Link(original, filepath.Join(root, "b-link.txt")))
+
+	resolver, err := newDirectoryResolver(root)
+	require.NoError(t, err)
+
+	originalLocation, originalMetadata := locationByPath(t, resolver, "/a-file.txt")
+	assert.Equal(t, RegularFile, originalMetadata.Type)
+
+	// later paths to the same content are hardlinks to the first path indexed
+	_, linkMetadata := locationByPath(t, resolver, "/b-link.txt")
+	assert.Equal(t, HardLink, linkMetadata.Type)
+	assert.Equal(t, originalLocation.RealPath, linkMetadata.LinkDestination)
+}
+
+func TestDirectoryResolver_SpecialFiles(t *testing.T) {
+	root := t.TempDir()
+	require.NoError(t, syscall.Mkfifo(filepath.Join(root, "a-fifo"), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(root, "a-file.txt"), []byte("contents"), 0644))
+
+	// special files are not indexed by default
+	resolver, err := newDirectoryResolver(root)
+	require.NoError(t, err)
+	locations, err := resolver.FilesByPath("/a-fifo")
+	require.NoError(t, err)
+	assert.Empty(t, locations)
+
+	resolver, err = newDirectoryResolverWithConfig(root, DirectoryConfig{IncludeSpecialFiles: true})
+	require.NoError(t, err)
+	location, metadata := locationByPath(t, resolver, "/a-fifo")
+	assert.Equal(t, FIFONode, metadata.Type)
+
+	// the contents of special files are never read (opening a FIFO would block)
+	_, err = resolver.FileContentsByLocation(location)
+	assert.Error(t, err)
+}
+
+func locationByPath(t *testing.T, resolver *directoryResolver, p string) (Location, FileMetadata) {
+	t.Helper()
+	locations, err := resolver.FilesByPath(p)
+	require.NoError(t, err)
+	require.Len(t, locations, 1)
+
+	metadata, err := resolver.FileMetadataByLocation(locations[0])
+	require.NoError(t, err)
+	return locations[0], metadata
+}
@@ -19,3 +19,12 @@ func GetXid(info os.FileInfo) (uid, gid int) {
 
 	return uid, gid
 }
+
+// getInode returns the device and inode of a file that has more than one hardlink.
+func getInode(info os.FileInfo) (fileInode, bool) {
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	if !ok || stat.Nlink < 2 {
+		return fileInode{}, false
+	}
+	return fileInode{device: uint64(stat.Dev), inode: stat.Ino}, true // nolint:unconvert // the device type differs by platform
+}
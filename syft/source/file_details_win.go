@@ -11,3 +11,8 @@ import (
 func GetXid(info os.FileInfo) (uid, gid int) {
 	return -1, -1
 }
+
+// getInode is a placeholder for windows file information (hardlinks are not detected)
+func getInode(info os.FileInfo) (fileInode, bool) {
+	return fileInode{}, false
+}
@@ -22,6 +22,12 @@ const (
 
 type FileType string
 
+// fileInode identifies the content of a file on disk, which is shared by all hardlinks to the file.
+type fileInode struct {
+	device uint64
+	inode  uint64
+}
+
 func newFileTypeFromTarHeaderTypeFlag(flag byte) FileType {
 	switch flag {
 	case tar.TypeReg, tar.TypeRegA:
@@ -65,6 +71,15 @@ func newFileTypeFromMode(mode os.FileMode) FileType {
 	}
 }
 
+// IsSpecial indicates whether the file type is a device, socket, or FIFO, which have no content of their own.
+func (t FileType) IsSpecial() bool {
+	switch t {
+	case CharacterDevice, BlockDevice, FIFONode, Socket:
+		return true
+	}
+	return false
+}
+
 func isSet(mode, field os.FileMode) bool {
 	return mode&field != 0
 }
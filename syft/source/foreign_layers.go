@@ -0,0 +1,98 @@
+package source
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/anchore/stereoscope/pkg/image"
+	"github.com/anchore/syft/internal/log"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+)
+
+// emptyLayerTar is a tarball without any entries (two zero blocks).
+var emptyLayerTar = make([]byte, 1024)
+
+// distributableImage decorates an image such that the content of any non-distributable layers (the "foreign" layers
+// of Windows base images, which registries usually do not host) is never fetched. Such layers are read as empty
+// layers instead, keeping the layer order and diff IDs of the image intact.
+type distributableImage struct {
+	v1.Image
+}
+
+// withoutForeignLayers returns the given image with the content of any non-distributable layers skipped.
+func withoutForeignLayers(img v1.Image) v1.Image {
+	return distributableImage{Image: img}
+}
+
+func (i distributableImage) Layers() ([]v1.Layer, error) {
+	layers, err := i.Image.Layers()
+	if err != nil {
+		return nil, err
+	}
+	results := make([]v1.Layer, len(layers))
+	for idx, layer := range layers {
+		results[idx] = skipForeignLayer(layer)
+	}
+	return results, nil
+}
+
+func (i distributableImage) LayerByDigest(digest v1.Hash) (v1.Layer, error) {
+	layer, err := i.Image.LayerByDigest(digest)
+	if err != nil {
+		return nil, err
+	}
+	return skipForeignLayer(layer), nil
+}
+
+func (i distributableImage) LayerByDiffID(diffID v1.Hash) (v1.Layer, error) {
+	layer, err := i.Image.LayerByDiffID(diffID)
+	if err != nil {
+		return nil, err
+	}
+	return skipForeignLayer(layer), nil
+}
+
+// skipForeignLayer returns an empty stand-in for the given layer when it is non-distributable, or the layer itself.
+func skipForeignLayer(layer v1.Layer) v1.Layer {
+	mediaType, err := layer.MediaType()
+	if err != nil || mediaType.IsDistributable() {
+		return layer
+	}
+	if digest, err := layer.Digest(); err == nil {
+		log.Warnf("skipping the content of non-distributable layer=%q (%s)", digest, mediaType)
+	}
+	return foreignLayer{Layer: layer}
+}
+
+// foreignLayer is a non-distributable layer that is read as an empty layer.
+type foreignLayer struct {
+	v1.Layer
+}
+
+func (l foreignLayer) Compressed() (io.ReadCloser, error) {
+	return ioutil.NopCloser(bytes.NewReader(emptyLayerTar)), nil
+}
+
+func (l foreignLayer) Uncompressed() (io.ReadCloser, error) {
+	return ioutil.NopCloser(bytes.NewReader(emptyLayerTar)), nil
+}
+
+// foreignLayerWarnings describes the layers of the given image whose content was skipped for being non-distributable.
+func foreignLayerWarnings(img *image.Image) []Warning {
+	if img == nil {
+		return nil
+	}
+	var warnings []Warning
+	for _, layer := range img.Layers {
+		if layer == nil || layer.Metadata.MediaType.IsDistributable() {
+			continue
+		}
+		warnings = append(warnings, Warning{
+			Path:    layer.Metadata.Digest,
+			Message: fmt.Sprintf("skipped the content of a non-distributable layer (%s), its files were not cataloged", layer.Metadata.MediaType),
+		})
+	}
+	return warnings
+}
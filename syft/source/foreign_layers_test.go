@@ -0,0 +1,61 @@
+package source
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/anchore/stereoscope/pkg/image"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// unhostedLayer is a foreign layer whose content cannot be fetched (as with Windows base layers that the registry
+// does not host).
+type unhostedLayer struct {
+	v1.Layer
+}
+
+func (unhostedLayer) Compressed() (io.ReadCloser, error) {
+	return nil, errors.New("layer is not hosted by the registry")
+}
+
+func (unhostedLayer) Uncompressed() (io.ReadCloser, error) {
+	return nil, errors.New("layer is not hosted by the registry")
+}
+
+func (unhostedLayer) MediaType() (types.MediaType, error) {
+	return types.DockerForeignLayer, nil
+}
+
+func TestWithoutForeignLayers(t *testing.T) {
+	base, err := random.Layer(256, types.DockerLayer)
+	require.NoError(t, err)
+	app, err := random.Layer(256, types.DockerLayer)
+	require.NoError(t, err)
+
+	img, err := mutate.AppendLayers(empty.Image, unhostedLayer{Layer: base}, app)
+	require.NoError(t, err)
+
+	// reading the foreign layer fails the whole image...
+	assert.Error(t, image.NewImage(img, t.TempDir()).Read())
+
+	// ...unless its content is skipped
+	result := image.NewImage(withoutForeignLayers(img), t.TempDir())
+	require.NoError(t, result.Read())
+	require.Len(t, result.Layers, 2)
+
+	baseDiffID, err := base.DiffID()
+	require.NoError(t, err)
+	assert.Equal(t, []Warning{
+		{
+			Path:    baseDiffID.String(),
+			Message: "skipped the content of a non-distributable layer (application/vnd.docker.image.rootfs.foreign.diff.tar.gzip), its files were not cataloged",
+		},
+	}, foreignLayerWarnings(result))
+}
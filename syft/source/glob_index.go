@@ -0,0 +1,250 @@
+package source
+
+import (
+	"path"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/anchore/stereoscope/pkg/file"
+	"github.com/anchore/stereoscope/pkg/filetree"
+	"github.com/bmatcuk/doublestar/v4"
+	"golang.org/x/text/unicode/norm"
+)
+
+// recursivePrefix is the prefix of glob patterns that can be answered from a globIndex (e.g. "**/go.mod" or "**/*.jar").
+const recursivePrefix = "/**/"
+
+// globIndex is a lazily-built index of every non-directory path within a file tree, keyed by basename. Nearly all
+// catalogers search for files with patterns like "**/<basename-pattern>", which would otherwise require walking the
+// entire tree once per pattern; with the index the tree is walked once and each pattern is answered from memory.
+type globIndex struct {
+	tree       *filetree.FileTree
+	matching   globMatchOptions
+	once       sync.Once
+	err        error
+	results    []filetree.GlobResult
+	byBasename map[string][]int
+}
+
+// globMatchOptions describe how patterns are matched against paths, for sources from filesystems that do not
+// distinguish paths by case or by unicode normalization form (e.g. from Windows or macOS).
+type globMatchOptions struct {
+	caseInsensitive  bool // match patterns regardless of case
+	normalizeUnicode bool // match patterns regardless of the unicode normalization form (NFC or NFD) of either side
+}
+
+func (o globMatchOptions) enabled() bool {
+	return o.caseInsensitive || o.normalizeUnicode
+}
+
+// normalize returns the form of the given pattern or path that is compared when matching.
+func (o globMatchOptions) normalize(s string) string {
+	if o.normalizeUnicode {
+		s = norm.NFC.String(s)
+	}
+	if o.caseInsensitive {
+		s = strings.ToLower(s)
+	}
+	return s
+}
+
+// variantsPattern returns a pattern that matches any path that the given (normalized) pattern matches once the path is
+// normalized: ASCII letters match either case, and any run of other characters matches any run of characters (since
+// these may differ in case or normalization form). The pattern may match more, so the results must still be compared
+// with the normalized pattern.
+func (o globMatchOptions) variantsPattern(pattern string) string {
+	var variants strings.Builder
+	runes := []rune(pattern)
+	for idx := 0; idx < len(runes); idx++ {
+		r := runes[idx]
+		switch {
+		case r == '\\' && idx+1 < len(runes):
+			// escaped characters are kept as-is
+			variants.WriteRune(r)
+			idx++
+			variants.WriteRune(runes[idx])
+		case r == '[':
+			// character classes match either case of their members
+			end := idx + 1
+			for end < len(runes) && runes[end] != ']' {
+				end++
+			}
+			if end == len(runes) {
+				variants.WriteString(string(runes[idx:]))
+				return variants.String()
+			}
+			members := string(runes[idx+1 : end])
+			variants.WriteString("[" + members)
+			if o.caseInsensitive {
+				variants.WriteString(strings.ToUpper(strings.TrimLeft(members, "!^")))
+			}
+			variants.WriteString("]")
+			idx = end
+		case r > unicode.MaxASCII:
+			for idx+1 < len(runes) && runes[idx+1] > unicode.MaxASCII {
+				idx++
+			}
+			variants.WriteString("*")
+		case o.caseInsensitive && unicode.IsLower(r):
+			variants.WriteString("[" + string(r) + string(unicode.ToUpper(r)) + "]")
+		default:
+			variants.WriteRune(r)
+		}
+	}
+	return variants.String()
+}
+
+func newGlobIndex(tree *filetree.FileTree, matching globMatchOptions) *globIndex {
+	return &globIndex{
+		tree:     tree,
+		matching: matching,
+	}
+}
+
+func (i *globIndex) build() error {
+	i.once.Do(func() {
+		// note: this uses the same traversal as any "**/..." pattern would, so the set of paths visited is identical
+		i.results, i.err = i.tree.FilesByGlob("**")
+		i.byBasename = make(map[string][]int)
+		for idx, result := range i.results {
+			basename := i.matching.normalize(path.Base(string(result.MatchPath)))
+			i.byBasename[basename] = append(i.byBasename[basename], idx)
+		}
+	})
+	return i.err
+}
+
+// FilesByGlob returns the same results as filetree.FileTree.FilesByGlob for the given pattern, answering from the
+// index when possible and falling back to walking the tree otherwise.
+func (i *globIndex) FilesByGlob(pattern string) ([]filetree.GlobResult, error) {
+	if i.matching.enabled() {
+		return i.normalizedFilesByGlob(pattern)
+	}
+
+	basenamePattern, ok := indexableBasenamePattern(pattern)
+	if !ok {
+		// patterns with intermediate path elements may traverse symlinked directories, which requires the tree walk
+		return i.tree.FilesByGlob(pattern)
+	}
+
+	if err := i.build(); err != nil {
+		return nil, err
+	}
+
+	results := make([]filetree.GlobResult, 0)
+
+	if !hasGlobMeta(basenamePattern) {
+		for _, idx := range i.byBasename[basenamePattern] {
+			results = append(results, i.results[idx])
+		}
+		return results, nil
+	}
+
+	if !doublestar.ValidatePattern(basenamePattern) {
+		return nil, doublestar.ErrBadPattern
+	}
+
+	for _, result := range i.results {
+		if matches, _ := doublestar.Match(basenamePattern, path.Base(string(result.MatchPath))); matches {
+			results = append(results, result)
+		}
+	}
+	return results, nil
+}
+
+// normalizedFilesByGlob returns the results that match the given pattern when both are normalized. Patterns of the
+// form "**/<basename-pattern>" are answered from the index, any other pattern with a normalized tree walk.
+func (i *globIndex) normalizedFilesByGlob(pattern string) ([]filetree.GlobResult, error) {
+	basenamePattern, ok := indexableBasenamePattern(pattern)
+	if !ok {
+		// patterns with intermediate path elements may traverse symlinked directories, which requires the tree walk
+		return i.normalizedTreeGlob(pattern)
+	}
+
+	if err := i.build(); err != nil {
+		return nil, err
+	}
+
+	results := make([]filetree.GlobResult, 0)
+	basenamePattern = i.matching.normalize(basenamePattern)
+
+	if !hasGlobMeta(basenamePattern) {
+		for _, idx := range i.byBasename[basenamePattern] {
+			results = append(results, i.results[idx])
+		}
+		return results, nil
+	}
+
+	if !doublestar.ValidatePattern(basenamePattern) {
+		return nil, doublestar.ErrBadPattern
+	}
+
+	for _, result := range i.results {
+		if matches, _ := doublestar.Match(basenamePattern, i.matching.normalize(path.Base(string(result.MatchPath)))); matches {
+			results = append(results, result)
+		}
+	}
+	return results, nil
+}
+
+// normalizedTreeGlob walks the tree with a pattern that matches every variant of the given pattern (in case and
+// unicode normalization form), keeping the results that match the given pattern when both are normalized. This keeps
+// the traversal of the tree walk (e.g. through symlinked directories).
+func (i *globIndex) normalizedTreeGlob(pattern string) ([]filetree.GlobResult, error) {
+	if !strings.HasPrefix(pattern, file.DirSeparator) {
+		pattern = file.DirSeparator + pattern
+	}
+	normalizedPattern := i.matching.normalize(pattern)
+	if !doublestar.ValidatePattern(normalizedPattern) {
+		return nil, doublestar.ErrBadPattern
+	}
+
+	candidates, err := i.tree.FilesByGlob(i.matching.variantsPattern(normalizedPattern))
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasGlobMeta(pattern) {
+		// the tree resolves a literal path directly, rather than listing each directory along the way (which stops
+		// when a path doubles back on itself through a link)
+		literal, err := i.tree.FilesByGlob(pattern)
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(literal, candidates...)
+	}
+
+	results := make([]filetree.GlobResult, 0)
+	seen := file.NewPathSet()
+	for _, candidate := range candidates {
+		if seen.Contains(candidate.MatchPath) {
+			continue
+		}
+		if matches, _ := doublestar.Match(normalizedPattern, i.matching.normalize(string(candidate.MatchPath))); matches {
+			seen.Add(candidate.MatchPath)
+			results = append(results, candidate)
+		}
+	}
+	return results, nil
+}
+
+// indexableBasenamePattern returns the basename portion of a pattern of the form "**/<basename-pattern>" (relative to
+// root), and whether the pattern is of that form.
+func indexableBasenamePattern(pattern string) (string, bool) {
+	if !strings.HasPrefix(pattern, file.DirSeparator) {
+		pattern = file.DirSeparator + pattern
+	}
+	if !strings.HasPrefix(pattern, recursivePrefix) {
+		return "", false
+	}
+	basenamePattern := strings.TrimPrefix(pattern, recursivePrefix)
+	if basenamePattern == "" || strings.Contains(basenamePattern, file.DirSeparator) || strings.Contains(basenamePattern, "**") {
+		return "", false
+	}
+	return basenamePattern, true
+}
+
+func hasGlobMeta(pattern string) bool {
+	return strings.ContainsAny(pattern, `*?[]{}\`)
+}
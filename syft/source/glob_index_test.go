@@ -0,0 +1,172 @@
+package source
+
+import (
+	"testing"
+
+	"github.com/anchore/stereoscope/pkg/file"
+	"github.com/anchore/stereoscope/pkg/filetree"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGlobIndex_MatchesFileTree(t *testing.T) {
+	fixtures := []string{
+		"test-fixtures/image-simple",
+		"test-fixtures/symlinks-simple",
+		"test-fixtures/symlinks-roots",
+		"test-fixtures/system_paths",
+	}
+	patterns := []string{
+		"**",
+		"**/*",
+		"**/file-1.txt",
+		"**/*.txt",
+		"**/file-?.txt",
+		"**/{readme,file-2.txt}",
+		"**/does-not-exist",
+		"**/target/**/*.txt",
+		"/**/*.txt",
+	}
+	for _, fixture := range fixtures {
+		resolver, err := newDirectoryResolver(fixture)
+		require.NoError(t, err)
+
+		for _, pattern := range patterns {
+			t.Run(fixture+":"+pattern, func(t *testing.T) {
+				expected, err := resolver.fileTree.FilesByGlob(pattern)
+				require.NoError(t, err)
+
+				actual, err := resolver.globIndex.FilesByGlob(pattern)
+				require.NoError(t, err)
+
+				assert.ElementsMatch(t, matchPaths(expected), matchPaths(actual))
+			})
+		}
+	}
+}
+
+func TestIndexableBasenamePattern(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		expected string
+		ok       bool
+	}{
+		{pattern: "**/go.mod", expected: "go.mod", ok: true},
+		{pattern: "/**/*.jar", expected: "*.jar", ok: true},
+		{pattern: "**/{Gemfile.lock,*.gemspec}", expected: "{Gemfile.lock,*.gemspec}", ok: true},
+		{pattern: "**", ok: false},
+		{pattern: "**/", ok: false},
+		{pattern: "**/lib/*.so", ok: false},
+		{pattern: "**/**/*.so", ok: false},
+		{pattern: "/usr/lib/**/*.so", ok: false},
+		{pattern: "*.txt", ok: false},
+	}
+	for _, test := range tests {
+		t.Run(test.pattern, func(t *testing.T) {
+			actual, ok := indexableBasenamePattern(test.pattern)
+			assert.Equal(t, test.ok, ok)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func matchPaths(results []filetree.GlobResult) (paths []string) {
+	for _, r := range results {
+		paths = append(paths, string(r.MatchPath))
+	}
+	return paths
+}
+
+func TestGlobIndex_NormalizedMatching(t *testing.T) {
+	tree := filetree.NewFileTree()
+	for _, p := range []string{
+		"/app/GO.MOD",
+		"/app/vendor/go.mod",
+		// "café.txt" with a decomposed (NFD) "é", as stored by macOS filesystems
+		"/docs/cafe\u0301.txt",
+	} {
+		_, err := tree.AddFile(file.Path(p))
+		require.NoError(t, err)
+	}
+
+	tests := []struct {
+		name     string
+		matching globMatchOptions
+		pattern  string
+		expected []string
+	}{
+		{
+			name:     "case sensitive by default",
+			pattern:  "**/go.mod",
+			expected: []string{"/app/vendor/go.mod"},
+		},
+		{
+			name:     "case insensitive basename",
+			matching: globMatchOptions{caseInsensitive: true},
+			pattern:  "**/go.mod",
+			expected: []string{"/app/GO.MOD", "/app/vendor/go.mod"},
+		},
+		{
+			name:     "case insensitive path",
+			matching: globMatchOptions{caseInsensitive: true},
+			pattern:  "/APP/*.mod",
+			expected: []string{"/app/GO.MOD"},
+		},
+		{
+			name:     "composed pattern does not match decomposed path by default",
+			pattern:  "**/caf\u00e9.txt",
+			expected: nil,
+		},
+		{
+			name:     "composed pattern matches decomposed path",
+			matching: globMatchOptions{normalizeUnicode: true},
+			pattern:  "**/caf\u00e9.txt",
+			expected: []string{"/docs/cafe\u0301.txt"},
+		},
+		{
+			name:     "normalized glob",
+			matching: globMatchOptions{caseInsensitive: true, normalizeUnicode: true},
+			pattern:  "/DOCS/CAF\u00c9.*",
+			expected: []string{"/docs/cafe\u0301.txt"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := newGlobIndex(tree, test.matching).FilesByGlob(test.pattern)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, test.expected, matchPaths(actual))
+		})
+	}
+}
+
+func TestGlobIndex_NormalizedMatchingFollowsSymlinks(t *testing.T) {
+	tree := filetree.NewFileTree()
+	_, err := tree.AddFile("/releases/v1/lib/Module.jar")
+	require.NoError(t, err)
+	_, err = tree.AddSymLink("/current", "/releases/v1")
+	require.NoError(t, err)
+	_, err = tree.AddSymLink("/releases/v1/self", "/releases/v1")
+	require.NoError(t, err)
+
+	tests := []struct {
+		pattern  string
+		expected []string
+	}{
+		{pattern: "/current/lib/*.jar", expected: []string{"/current/lib/Module.jar"}},
+		{pattern: "/CURRENT/LIB/module.jar", expected: []string{"/current/lib/Module.jar"}},
+		{pattern: "/current/**/*.JAR", expected: []string{"/current/lib/Module.jar", "/current/self/lib/Module.jar"}},
+		// resolved through the link as the tree does, rather than stopping where the path doubles back on itself
+		{pattern: "/current/self/self/self/lib/Module.jar", expected: []string{"/current/self/self/self/lib/Module.jar"}},
+	}
+	index := newGlobIndex(tree, globMatchOptions{caseInsensitive: true})
+	for _, test := range tests {
+		t.Run(test.pattern, func(t *testing.T) {
+			actual, err := index.FilesByGlob(test.pattern)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, test.expected, matchPaths(actual))
+			for _, result := range actual {
+				assert.Equal(t, file.Path("/releases/v1/lib/Module.jar"), result.RealPath)
+			}
+		})
+	}
+}
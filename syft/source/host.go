@@ -0,0 +1,135 @@
+package source
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/spf13/afero"
+)
+
+const hostSchemePrefix = "host:"
+
+// hostExclusions are paths (relative to the scan root) that hold kernel/runtime pseudo-filesystems or the storage of
+// container runtimes. Cataloging these would report the contents of containers as if they were installed on the host.
+var hostExclusions = []string{
+	"./proc",
+	"./sys",
+	"./dev",
+	"./run/containerd",
+	"./run/docker",
+	"./var/lib/containerd",
+	"./var/lib/containers/storage",
+	"./var/lib/docker",
+	"./var/lib/kubelet/pods",
+	"./var/lib/lxc",
+}
+
+// osReleasePaths are the locations of the os-release file (relative to the scan root), in order of precedence.
+var osReleasePaths = []string{
+	"etc/os-release",
+	"usr/lib/os-release",
+}
+
+// HostMetadata describes the machine whose root filesystem was cataloged (host scans only).
+type HostMetadata struct {
+	Hostname        string `json:"hostname,omitempty"`
+	OperatingSystem string `json:"operatingSystem,omitempty"` // the operating system installed at the root (from os-release)
+	Architecture    string `json:"architecture,omitempty"`
+	KernelVersion   string `json:"kernelVersion,omitempty"`
+}
+
+// isHostInput indicates if the user input requests a scan of the local host, either "host:" (for the running root
+// filesystem) or "host:/path/to/root" (for a host root filesystem mounted elsewhere).
+func isHostInput(userInput string) bool {
+	return userInput == hostSchemePrefix || strings.HasPrefix(userInput, hostSchemePrefix+"/")
+}
+
+// hostRoot returns the root filesystem path requested by host user input.
+func hostRoot(userInput string) string {
+	root := strings.TrimPrefix(userInput, hostSchemePrefix)
+	if root == "" {
+		return "/"
+	}
+	return root
+}
+
+func generateHostSource(fs afero.Fs, location string) (*Source, func(), error) {
+	src, cleanup, err := generateDirectorySource(fs, location)
+	if err != nil {
+		return src, cleanup, err
+	}
+
+	src.Metadata.Host = newHostMetadata(location)
+	src.Exclusions = append(src.Exclusions, hostExclusions...)
+
+	if !canReadAllFiles() {
+		// the scan continues, with every file that cannot be read reported as a warning
+		log.Warnf("not running as root or with the CAP_DAC_READ_SEARCH capability: files not readable by the current user will be skipped")
+	}
+
+	return src, cleanup, nil
+}
+
+// newHostMetadata captures identifying information about the host at the given root. Details about the running
+// machine (kernel, architecture) are only included when the root is the running root filesystem.
+func newHostMetadata(root string) *HostMetadata {
+	metadata := HostMetadata{
+		OperatingSystem: readOSRelease(root),
+	}
+
+	if filepath.Clean(root) == "/" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			log.Warnf("unable to determine hostname: %+v", err)
+		}
+		metadata.Hostname = hostname
+		metadata.Architecture = runtime.GOARCH
+		metadata.KernelVersion = readFirstLine("/proc/sys/kernel/osrelease")
+	} else {
+		metadata.Hostname = readFirstLine(filepath.Join(root, "etc", "hostname"))
+	}
+
+	return &metadata
+}
+
+// readOSRelease describes the operating system installed at the given root from its os-release file, preferring the
+// PRETTY_NAME (e.g. "Ubuntu 20.04.3 LTS") over the NAME and VERSION_ID.
+func readOSRelease(root string) string {
+	for _, p := range osReleasePaths {
+		contents, err := ioutil.ReadFile(filepath.Join(root, p))
+		if err != nil {
+			continue
+		}
+
+		fields := make(map[string]string)
+		for _, line := range strings.Split(string(contents), "\n") {
+			parts := strings.SplitN(strings.TrimSpace(line), "=", 2)
+			if len(parts) != 2 {
+				continue
+			}
+			fields[parts[0]] = strings.Trim(parts[1], `"'`)
+		}
+
+		switch {
+		case fields["PRETTY_NAME"] != "":
+			return fields["PRETTY_NAME"]
+		case fields["NAME"] != "":
+			return strings.TrimSpace(fields["NAME"] + " " + fields["VERSION_ID"])
+		default:
+			return strings.TrimSpace(fields["ID"] + " " + fields["VERSION_ID"])
+		}
+	}
+	return ""
+}
+
+func readFirstLine(path string) string {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(strings.SplitN(string(contents), "\n", 2)[0])
+}
@@ -0,0 +1,109 @@
+package source
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsHostInput(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bool
+		root     string
+	}{
+		{input: "host:", expected: true, root: "/"},
+		{input: "host:/mnt/host-root", expected: true, root: "/mnt/host-root"},
+		{input: "host:5000/some/image:latest", expected: false},
+		{input: "host", expected: false},
+		{input: "dir:/", expected: false},
+	}
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			assert.Equal(t, test.expected, isHostInput(test.input))
+			if test.expected {
+				assert.Equal(t, test.root, hostRoot(test.input))
+			}
+		})
+	}
+}
+
+func TestNewHostSource(t *testing.T) {
+	root, err := filepath.Abs("test-fixtures/host-root")
+	require.NoError(t, err)
+
+	src, cleanup, err := New("host:"+root, nil, []string{"./home/**"})
+	require.NoError(t, err)
+	t.Cleanup(cleanup)
+
+	assert.Equal(t, DirectoryScheme, src.Metadata.Scheme)
+	assert.Equal(t, root, src.Metadata.Path)
+	require.NotNil(t, src.Metadata.Host)
+	assert.Equal(t, "build-server-01", src.Metadata.Host.Hostname)
+	// the operating system is that of the mounted root, not of the machine running the scan
+	assert.Equal(t, "Ubuntu 20.04.3 LTS", src.Metadata.Host.OperatingSystem)
+	assert.Empty(t, src.Metadata.Host.Architecture)
+
+	resolver, err := src.FileResolver(SquashedScope)
+	require.NoError(t, err)
+
+	var paths []string
+	for location := range resolver.AllLocations() {
+		paths = append(paths, strings.TrimPrefix(location.RealPath, "/"))
+	}
+
+	// pseudo-filesystems and container storage are excluded, along with the user-provided exclusions
+	assert.ElementsMatch(t, []string{
+		"etc/hostname",
+		"etc/os-release",
+		"var/lib/dpkg/status",
+	}, paths)
+}
+
+func TestNewHostMetadata_RunningRoot(t *testing.T) {
+	metadata := newHostMetadata("/")
+
+	assert.NotEmpty(t, metadata.Hostname)
+	assert.Equal(t, readOSRelease("/"), metadata.OperatingSystem)
+	assert.Equal(t, runtime.GOARCH, metadata.Architecture)
+}
+
+func TestReadOSRelease(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents string
+		expected string
+	}{
+		{
+			name:     "pretty name",
+			contents: "NAME=\"Alpine Linux\"\nID=alpine\nVERSION_ID=3.15.0\nPRETTY_NAME=\"Alpine Linux v3.15\"\n",
+			expected: "Alpine Linux v3.15",
+		},
+		{
+			name:     "name and version",
+			contents: "NAME=\"CentOS Linux\"\nVERSION_ID=\"8\"\n",
+			expected: "CentOS Linux 8",
+		},
+		{
+			name:     "id only",
+			contents: "ID=busybox\n",
+			expected: "busybox",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := t.TempDir()
+			require.NoError(t, os.MkdirAll(filepath.Join(root, "usr", "lib"), 0755))
+			require.NoError(t, ioutil.WriteFile(filepath.Join(root, "usr", "lib", "os-release"), []byte(test.contents), 0644))
+			assert.Equal(t, test.expected, readOSRelease(root))
+		})
+	}
+
+	assert.Empty(t, readOSRelease(t.TempDir()))
+}
@@ -0,0 +1,145 @@
+package source
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/bmatcuk/doublestar/v4"
+)
+
+// IgnoreFileName is the name of the file at the root of a directory source that lists (in gitignore syntax) the paths
+// that should not be cataloged.
+const IgnoreFileName = ".syftignore"
+
+// ignoreRule is a single pattern from an ignore file.
+type ignoreRule struct {
+	line    string // the pattern as written in the ignore file
+	pattern string // doublestar glob relative to the scan root
+	negate  bool   // the pattern re-includes paths excluded by an earlier pattern
+	dirOnly bool   // the pattern only matches directories
+}
+
+// matches indicates if the given path (relative to the scan root, using forward slashes) is selected by the rule.
+func (r ignoreRule) matches(relPath string, isDir bool) bool {
+	if r.dirOnly && !isDir {
+		return false
+	}
+	matches, err := doublestar.Match(r.pattern, relPath)
+	return err == nil && matches
+}
+
+// getIgnoreFileFunctions returns the path filters described by the ignore file at the given root (if one exists), along
+// with a warning for each applied pattern, so that paths excluded by the scanned directory itself are always visible in
+// the results.
+func getIgnoreFileFunctions(root string) ([]pathFilterFn, []Warning, error) {
+	// this is what directoryResolver.indexTree is doing to get the absolute path:
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ignorePath := filepath.Join(root, IgnoreFileName)
+	f, err := os.Open(ignorePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil, nil
+		}
+		return nil, nil, fmt.Errorf("unable to open ignore file: %w", err)
+	}
+	defer internal.CloseAndLogError(f, ignorePath)
+
+	rules, err := parseIgnoreRules(f)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to read ignore file=%q: %w", ignorePath, err)
+	}
+	if len(rules) == 0 {
+		return nil, nil, nil
+	}
+	log.Debugf("excluding paths from %d rules in %q", len(rules), ignorePath)
+
+	var warnings []Warning
+	for _, rule := range rules {
+		message := fmt.Sprintf("excluded paths matching %q", rule.line)
+		if rule.negate {
+			message = fmt.Sprintf("re-included paths matching %q", rule.line)
+		}
+		warnings = append(warnings, Warning{
+			Path:    IgnoreFileName,
+			Message: message,
+		})
+	}
+
+	return []pathFilterFn{
+		func(path string, info os.FileInfo) bool {
+			relPath, ok := relativeToRoot(root, path)
+			if !ok || relPath == "" {
+				return false
+			}
+			return isIgnored(rules, filepath.ToSlash(relPath), info != nil && info.IsDir())
+		},
+	}, warnings, nil
+}
+
+// isIgnored evaluates all rules against the given path, where the last matching rule decides if the path is ignored.
+func isIgnored(rules []ignoreRule, relPath string, isDir bool) bool {
+	ignored := false
+	for _, rule := range rules {
+		if rule.matches(relPath, isDir) {
+			ignored = !rule.negate
+		}
+	}
+	return ignored
+}
+
+// parseIgnoreRules reads gitignore-style patterns (see https://git-scm.com/docs/gitignore#_pattern_format).
+func parseIgnoreRules(reader io.Reader) ([]ignoreRule, error) {
+	var rules []ignoreRule
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		// trailing spaces are ignored unless they are escaped
+		if !strings.HasSuffix(line, `\ `) {
+			line = strings.TrimRight(line, " \t\r")
+		}
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		rule := ignoreRule{line: line}
+		switch {
+		case strings.HasPrefix(line, "!"):
+			rule.negate = true
+			line = line[1:]
+		case strings.HasPrefix(line, `\!`), strings.HasPrefix(line, `\#`):
+			line = line[1:]
+		}
+
+		if strings.HasSuffix(line, "/") {
+			rule.dirOnly = true
+			line = strings.TrimRight(line, "/")
+		}
+		if line == "" {
+			continue
+		}
+
+		// a pattern with a separator at the start or in the middle is relative to the scan root, otherwise it may
+		// match at any depth
+		if strings.Contains(line, "/") {
+			line = strings.TrimPrefix(line, "/")
+		} else {
+			line = "**/" + line
+		}
+
+		rule.pattern = line
+		rules = append(rules, rule)
+	}
+	return rules, scanner.Err()
+}
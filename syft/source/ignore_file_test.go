@@ -0,0 +1,128 @@
+package source
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseIgnoreRules(t *testing.T) {
+	contents := strings.Join([]string{
+		"# a comment",
+		"",
+		"*.log   ",
+		"!keep.log",
+		"/build/",
+		"src/vendor",
+		`\#not-a-comment`,
+		`\!not-negated`,
+		"**/testdata/**",
+	}, "\n")
+
+	actual, err := parseIgnoreRules(strings.NewReader(contents))
+	require.NoError(t, err)
+
+	assert.Equal(t, []ignoreRule{
+		{line: "*.log", pattern: "**/*.log"},
+		{line: "!keep.log", pattern: "**/keep.log", negate: true},
+		{line: "/build/", pattern: "build", dirOnly: true},
+		{line: "src/vendor", pattern: "src/vendor"},
+		{line: `\#not-a-comment`, pattern: "**/#not-a-comment"},
+		{line: `\!not-negated`, pattern: "**/!not-negated"},
+		{line: "**/testdata/**", pattern: "**/testdata/**"},
+	}, actual)
+}
+
+func TestIsIgnored(t *testing.T) {
+	rules, err := parseIgnoreRules(strings.NewReader("*.log\n!keep.log\n/build/\ndocs/*.md\n"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		path     string
+		isDir    bool
+		expected bool
+	}{
+		{path: "app.log", expected: true},
+		{path: "logs/debug.log", expected: true},
+		{path: "logs/keep.log", expected: false},
+		{path: "build", isDir: true, expected: true},
+		// only directories match a pattern with a trailing slash
+		{path: "build", expected: false},
+		// anchored patterns only match relative to the scan root
+		{path: "src/build", isDir: true, expected: false},
+		{path: "docs/readme.md", expected: true},
+		{path: "docs/nested/readme.md", expected: false},
+		{path: "package.json", expected: false},
+	}
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			assert.Equal(t, test.expected, isIgnored(rules, test.path, test.isDir))
+		})
+	}
+}
+
+func TestDirectorySource_IgnoreFile(t *testing.T) {
+	src, err := NewFromDirectory("test-fixtures/ignore-file")
+	require.NoError(t, err)
+
+	resolver, err := src.FileResolver(SquashedScope)
+	require.NoError(t, err)
+
+	locations, err := resolver.FilesByGlob("**")
+	require.NoError(t, err)
+
+	var actual []string
+	for _, l := range locations {
+		actual = append(actual, l.RealPath)
+	}
+
+	assert.ElementsMatch(t, []string{
+		".syftignore",
+		"docs/docs.txt",
+		"logs/keep.log",
+		"package.json",
+		"src/main.py",
+	}, actual)
+
+	// the applied patterns are reported, so exclusions made by the scanned directory are visible in the results
+	assert.Equal(t, []Warning{
+		{Path: IgnoreFileName, Message: `excluded paths matching "*.log"`},
+		{Path: IgnoreFileName, Message: `excluded paths matching "/build/"`},
+		{Path: IgnoreFileName, Message: `excluded paths matching "docs/*.md"`},
+		{Path: IgnoreFileName, Message: `excluded paths matching "src/vendor"`},
+		{Path: IgnoreFileName, Message: `re-included paths matching "!keep.log"`},
+	}, src.Warnings())
+}
+
+func TestGetIgnoreFileFunctions_NoIgnoreFile(t *testing.T) {
+	filters, warnings, err := getIgnoreFileFunctions("test-fixtures/image-simple")
+	require.NoError(t, err)
+	assert.Empty(t, filters)
+	assert.Empty(t, warnings)
+}
+
+func TestDirectorySource_IgnoreFileDisabled(t *testing.T) {
+	src, err := NewFromDirectory("test-fixtures/ignore-file")
+	require.NoError(t, err)
+	src.Directory.SkipIgnoreFile = true
+
+	resolver, err := src.FileResolver(SquashedScope)
+	require.NoError(t, err)
+
+	locations, err := resolver.FilesByGlob("**/*.log")
+	require.NoError(t, err)
+
+	var actual []string
+	for _, l := range locations {
+		actual = append(actual, l.RealPath)
+	}
+
+	assert.ElementsMatch(t, []string{
+		"app.log",
+		"logs/debug.log",
+		"logs/keep.log",
+	}, actual)
+	assert.Empty(t, src.Warnings())
+}
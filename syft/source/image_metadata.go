@@ -1,20 +1,37 @@
 package source
 
-import "github.com/anchore/stereoscope/pkg/image"
+import (
+	"encoding/json"
+
+	"github.com/anchore/stereoscope/pkg/image"
+)
 
 // ImageMetadata represents all static metadata that defines what a container image is. This is useful to later describe
 // "what" was cataloged without needing the more complicated stereoscope Image objects or FileResolver objects.
 type ImageMetadata struct {
-	UserInput      string          `json:"userInput"`
-	ID             string          `json:"imageID"`
-	ManifestDigest string          `json:"manifestDigest"`
-	MediaType      string          `json:"mediaType"`
-	Tags           []string        `json:"tags"`
-	Size           int64           `json:"imageSize"`
-	Layers         []LayerMetadata `json:"layers"`
-	RawManifest    []byte          `json:"manifest"`
-	RawConfig      []byte          `json:"config"`
-	RepoDigests    []string        `json:"repoDigests"`
+	UserInput      string             `json:"userInput"`
+	ID             string             `json:"imageID"`
+	ManifestDigest string             `json:"manifestDigest"`
+	MediaType      string             `json:"mediaType"`
+	Tags           []string           `json:"tags"`
+	Size           int64              `json:"imageSize"`
+	Layers         []LayerMetadata    `json:"layers"`
+	RawManifest    []byte             `json:"manifest"`
+	RawConfig      []byte             `json:"config"`
+	RepoDigests    []string           `json:"repoDigests"`
+	Verification   *ImageVerification `json:"verification,omitempty"`
+	Labels         map[string]string  `json:"labels,omitempty"`      // the labels of the image config (e.g. org.opencontainers.image.source)
+	Annotations    map[string]string  `json:"annotations,omitempty"` // the annotations of the image manifest (OCI images only)
+}
+
+// ImageVerification describes the signature verification performed against the image (in the registry) before it
+// was cataloged.
+type ImageVerification struct {
+	Verified         bool     `json:"verified"`
+	Digest           string   `json:"digest"`                     // the manifest digest that the signatures are bound to
+	Signatures       int      `json:"signatures"`                 // the number of valid cosign signatures found
+	AttestationTypes []string `json:"attestationTypes,omitempty"` // the predicate types of all valid attestations found
+	Error            string   `json:"error,omitempty"`            // why the image could not be verified (only when not enforced)
 }
 
 // LayerMetadata represents all static metadata that defines what a container image layer is.
@@ -42,6 +59,8 @@ func NewImageMetadata(img *image.Image, userInput string) ImageMetadata {
 		RawConfig:      img.Metadata.RawConfig,
 		RawManifest:    img.Metadata.RawManifest,
 		RepoDigests:    img.Metadata.RepoDigests,
+		Labels:         img.Metadata.Config.Config.Labels,
+		Annotations:    manifestAnnotations(img.Metadata.RawManifest),
 	}
 
 	// populate image metadata
@@ -54,3 +73,17 @@ func NewImageMetadata(img *image.Image, userInput string) ImageMetadata {
 	}
 	return theImg
 }
+
+// manifestAnnotations returns the annotations of the given raw image manifest, if any.
+func manifestAnnotations(rawManifest []byte) map[string]string {
+	if len(rawManifest) == 0 {
+		return nil
+	}
+	var manifest struct {
+		Annotations map[string]string `json:"annotations"`
+	}
+	if err := json.Unmarshal(rawManifest, &manifest); err != nil {
+		return nil
+	}
+	return manifest.Annotations
+}
@@ -0,0 +1,37 @@
+package source
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestManifestAnnotations(t *testing.T) {
+	tests := []struct {
+		name     string
+		manifest string
+		expected map[string]string
+	}{
+		{
+			name:     "oci manifest with annotations",
+			manifest: `{"schemaVersion": 2, "annotations": {"org.opencontainers.image.created": "2022-03-01T12:00:00Z"}}`,
+			expected: map[string]string{"org.opencontainers.image.created": "2022-03-01T12:00:00Z"},
+		},
+		{
+			name:     "docker manifest",
+			manifest: `{"schemaVersion": 2, "mediaType": "application/vnd.docker.distribution.manifest.v2+json"}`,
+		},
+		{
+			name: "no manifest",
+		},
+		{
+			name:     "invalid manifest",
+			manifest: `{`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, manifestAnnotations([]byte(test.manifest)))
+		})
+	}
+}
@@ -13,7 +13,8 @@ var _ FileResolver = (*imageSquashResolver)(nil)
 
 // imageSquashResolver implements path and content access for the Squashed source option for container image data sources.
 type imageSquashResolver struct {
-	img *image.Image
+	img       *image.Image
+	globIndex *globIndex
 }
 
 // newImageSquashResolver returns a new resolver from the perspective of the squashed representation for the given image.
@@ -23,7 +24,8 @@ func newImageSquashResolver(img *image.Image) (*imageSquashResolver, error) {
 	}
 
 	return &imageSquashResolver{
-		img: img,
+		img:       img,
+		globIndex: newGlobIndex(img.SquashedTree(), globMatchOptions{}),
 	}, nil
 }
 
@@ -82,7 +84,7 @@ func (r *imageSquashResolver) FilesByGlob(patterns ...string) ([]Location, error
 	uniqueLocations := make([]Location, 0)
 
 	for _, pattern := range patterns {
-		results, err := r.img.SquashedTree().FilesByGlob(pattern)
+		results, err := r.globIndex.FilesByGlob(pattern)
 		if err != nil {
 			return nil, fmt.Errorf("failed to resolve files by glob (%s): %w", pattern, err)
 		}
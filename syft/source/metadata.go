@@ -5,4 +5,5 @@ type Metadata struct {
 	Scheme        Scheme        // the source data scheme type (directory or image)
 	ImageMetadata ImageMetadata // all image info (image only)
 	Path          string        // the root path to be cataloged (directory only)
+	Host          *HostMetadata // details about the machine being cataloged (host scans only)
 }
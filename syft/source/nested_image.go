@@ -0,0 +1,213 @@
+package source
+
+import (
+	"archive/tar"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/anchore/stereoscope"
+	"github.com/anchore/stereoscope/pkg/image"
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+)
+
+// NestedImage is a container image that is stored within the files of another source (e.g. an OCI layout within a
+// registry mirror volume, or a "docker save" tarball within a directory).
+type NestedImage struct {
+	Location Location     // the tarball, or the index.json of an OCI layout
+	Source   image.Source // the stereoscope source type that can read the image
+}
+
+// FindNestedImages returns all OCI layouts and docker-archive tarballs found within the given resolver.
+func FindNestedImages(resolver FileResolver) ([]NestedImage, error) {
+	var results []NestedImage
+
+	layoutLocations, err := resolver.FilesByGlob("**/oci-layout")
+	if err != nil {
+		return nil, fmt.Errorf("unable to search for OCI layouts: %w", err)
+	}
+
+	for _, layoutLocation := range layoutLocations {
+		indexPath := path.Join(path.Dir(layoutLocation.RealPath), "index.json")
+		indexLocations, err := resolver.FilesByPath(indexPath)
+		if err != nil || len(indexLocations) == 0 {
+			log.Debugf("skipping OCI layout without an index (path=%q)", indexPath)
+			continue
+		}
+		results = append(results, NestedImage{
+			Location: indexLocations[0],
+			Source:   image.OciDirectorySource,
+		})
+	}
+
+	tarLocations, err := resolver.FilesByGlob("**/*.tar")
+	if err != nil {
+		return nil, fmt.Errorf("unable to search for docker archives: %w", err)
+	}
+
+	for _, tarLocation := range tarLocations {
+		isArchive, err := isDockerArchive(resolver, tarLocation)
+		if err != nil {
+			log.Debugf("unable to inspect tar for a nested image (path=%q): %+v", tarLocation.RealPath, err)
+			continue
+		}
+		if isArchive {
+			results = append(results, NestedImage{
+				Location: tarLocation,
+				Source:   image.DockerTarballSource,
+			})
+		}
+	}
+
+	return results, nil
+}
+
+// dockerArchiveEntryPattern matches the top-level entries of the tarballs written by "docker save" and similar tools:
+// layer directories and image configs named after their digest (with the layers and config of tools such as skopeo or
+// go-containerregistry as separate files), the blobs and index of the OCI layout written by newer docker versions, and
+// the manifests.
+var dockerArchiveEntryPattern = regexp.MustCompile(`^((sha256:)?[0-9a-f]{64}(\.json|\.tar|\.tar\.gz|/.*)?|blobs(/.*)?|manifest\.json|repositories|index\.json|oci-layout)$`)
+
+// isDockerArchive indicates if the given tar contains the top-level manifest.json written by "docker save".
+func isDockerArchive(resolver FileResolver, location Location) (bool, error) {
+	reader, err := resolver.FileContentsByLocation(location)
+	if err != nil {
+		return false, err
+	}
+	defer internal.CloseAndLogError(reader, location.VirtualPath)
+
+	return hasDockerArchiveManifest(reader)
+}
+
+// hasDockerArchiveManifest indicates if the given tar contains the top-level manifest.json of a docker archive. Since
+// the manifest is usually written last, reading stops at the first entry that cannot be part of a docker archive
+// instead (so that other tarballs are not read in full).
+func hasDockerArchiveManifest(reader io.Reader) (bool, error) {
+	tarReader := tar.NewReader(reader)
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		name := path.Clean(header.Name)
+		if name == "manifest.json" {
+			return true, nil
+		}
+		if !dockerArchiveEntryPattern.MatchString(name) {
+			return false, nil
+		}
+	}
+}
+
+// Open reads the nested image, copying the image content out of the parent resolver as needed. The returned cleanup
+// function removes the copied content and must be called once the image is no longer needed.
+func (n NestedImage) Open(resolver FileResolver) (*Source, func(), error) {
+	tempDir, err := ioutil.TempDir("", "syft-nested-image-")
+	if err != nil {
+		return nil, func() {}, fmt.Errorf("unable to create tempdir for nested image: %w", err)
+	}
+
+	cleanupFn := func() {
+		if err := os.RemoveAll(tempDir); err != nil {
+			log.Warnf("unable to cleanup nested image tempdir: %+v", err)
+		}
+	}
+
+	var location string
+	switch n.Source {
+	case image.OciDirectorySource:
+		location, err = n.copyOCILayout(resolver, tempDir)
+	case image.DockerTarballSource:
+		location = filepath.Join(tempDir, "image.tar")
+		err = copyLocation(resolver, n.Location, location)
+	default:
+		err = fmt.Errorf("unsupported nested image source: %+v", n.Source)
+	}
+	if err != nil {
+		return nil, cleanupFn, err
+	}
+
+	var img *image.Image
+	if n.Source == image.OciDirectorySource {
+		img, err = readOCILayoutImage(location, "", filepath.Join(tempDir, "content"))
+	} else {
+		img, err = stereoscope.GetImageFromSource(location, n.Source, nil)
+	}
+	if err != nil {
+		return nil, cleanupFn, fmt.Errorf("could not read nested image %q: %w", n.Location.RealPath, err)
+	}
+
+	src, err := NewFromImage(img, n.Location.RealPath)
+	if err != nil {
+		return nil, cleanupFn, err
+	}
+
+	return &src, cleanupFn, nil
+}
+
+// copyOCILayout copies the layout marker, index, and all blobs of the OCI layout into the given directory.
+func (n NestedImage) copyOCILayout(resolver FileResolver, dest string) (string, error) {
+	layoutDir := path.Dir(n.Location.RealPath)
+
+	// the prefix of all paths within the layout (which may be at the root of the source, e.g. "." or "/")
+	layoutPrefix := ""
+	if layoutDir != "." {
+		layoutPrefix = strings.TrimSuffix(layoutDir, "/") + "/"
+	}
+
+	locations, err := resolver.FilesByPath(path.Join(layoutDir, "oci-layout"), path.Join(layoutDir, "index.json"))
+	if err != nil {
+		return "", err
+	}
+
+	blobs, err := resolver.FilesByGlob("**/blobs/*/*")
+	if err != nil {
+		return "", err
+	}
+	for _, blob := range blobs {
+		if strings.HasPrefix(blob.RealPath, layoutPrefix+"blobs/") {
+			locations = append(locations, blob)
+		}
+	}
+
+	for _, location := range locations {
+		target := filepath.Join(dest, filepath.FromSlash(strings.TrimPrefix(location.RealPath, layoutPrefix)))
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return "", err
+		}
+		if err := copyLocation(resolver, location, target); err != nil {
+			return "", err
+		}
+	}
+
+	return dest, nil
+}
+
+func copyLocation(resolver FileResolver, location Location, target string) error {
+	reader, err := resolver.FileContentsByLocation(location)
+	if err != nil {
+		return err
+	}
+	defer internal.CloseAndLogError(reader, location.VirtualPath)
+
+	f, err := os.Create(target)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(f, reader); err != nil {
+		return fmt.Errorf("unable to copy %q: %w", location.RealPath, err)
+	}
+	return nil
+}
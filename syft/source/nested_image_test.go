@@ -0,0 +1,193 @@
+package source
+
+import (
+	"archive/tar"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/anchore/stereoscope/pkg/image"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/layout"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeNestedImageFixtures creates a directory with an OCI layout, a docker-archive tarball, and a plain tar (which
+// should not be considered an image).
+func writeNestedImageFixtures(t *testing.T) string {
+	t.Helper()
+
+	root, err := ioutil.TempDir("", "syft-nested-image-fixture-")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(root) })
+
+	img, err := random.Image(256, 1)
+	require.NoError(t, err)
+
+	ociPath, err := layout.Write(filepath.Join(root, "mirror", "library", "busybox"), empty.Index)
+	require.NoError(t, err)
+	require.NoError(t, ociPath.AppendImage(img))
+
+	tag, err := name.NewTag("example.com/some/image:latest")
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "cache"), 0755))
+	require.NoError(t, tarball.WriteToFile(filepath.Join(root, "cache", "image.tar"), tag, img))
+
+	layerReader, err := img.Layers()
+	require.NoError(t, err)
+	uncompressed, err := layerReader[0].Uncompressed()
+	require.NoError(t, err)
+	contents, err := ioutil.ReadAll(uncompressed)
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(root, "cache", "not-an-image.tar"), contents, 0644))
+
+	return root
+}
+
+func TestFindNestedImages(t *testing.T) {
+	root := writeNestedImageFixtures(t)
+
+	src, err := NewFromDirectory(root)
+	require.NoError(t, err)
+
+	resolver, err := src.FileResolver(SquashedScope)
+	require.NoError(t, err)
+
+	images, err := FindNestedImages(resolver)
+	require.NoError(t, err)
+
+	actual := make(map[string]image.Source)
+	for _, img := range images {
+		actual[img.Location.RealPath] = img.Source
+	}
+
+	assert.Equal(t, map[string]image.Source{
+		"mirror/library/busybox/index.json": image.OciDirectorySource,
+		"cache/image.tar":                   image.DockerTarballSource,
+	}, actual)
+}
+
+func TestHasDockerArchiveManifest(t *testing.T) {
+	const largeEntrySize = 1024 * 1024
+
+	tests := []struct {
+		name     string
+		entries  []string
+		expected bool
+		// whether reading stops before the contents of the first entry
+		stopsEarly bool
+	}{
+		{
+			name:     "docker save (legacy layout)",
+			entries:  []string{"3c5d9e1f0a2b4c6d8e0f1a3b5c7d9e1f0a2b4c6d8e0f1a3b5c7d9e1f0a2b4c6d/layer.tar", "repositories", "manifest.json"},
+			expected: true,
+		},
+		{
+			name:     "docker save (OCI layout)",
+			entries:  []string{"blobs/sha256/3c5d9e1f0a2b4c6d8e0f1a3b5c7d9e1f0a2b4c6d8e0f1a3b5c7d9e1f0a2b4c6d", "index.json", "manifest.json", "oci-layout"},
+			expected: true,
+		},
+		{
+			name:     "go-containerregistry tarball",
+			entries:  []string{"sha256:3c5d9e1f0a2b4c6d8e0f1a3b5c7d9e1f0a2b4c6d8e0f1a3b5c7d9e1f0a2b4c6d", "3c5d9e1f0a2b4c6d8e0f1a3b5c7d9e1f0a2b4c6d8e0f1a3b5c7d9e1f0a2b4c6d.tar.gz", "manifest.json"},
+			expected: true,
+		},
+		{
+			name:       "other tarball",
+			entries:    []string{"usr/bin/app", "manifest.json"},
+			expected:   false,
+			stopsEarly: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var archive bytes.Buffer
+			tw := tar.NewWriter(&archive)
+			for _, name := range test.entries {
+				require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: largeEntrySize}))
+				_, err := tw.Write(make([]byte, largeEntrySize))
+				require.NoError(t, err)
+			}
+			require.NoError(t, tw.Close())
+
+			size := archive.Len()
+			actual, err := hasDockerArchiveManifest(&archive)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+			if test.stopsEarly {
+				assert.Less(t, size-archive.Len(), largeEntrySize)
+			}
+		})
+	}
+}
+
+func TestNestedImage_Open(t *testing.T) {
+	root := writeNestedImageFixtures(t)
+
+	src, err := NewFromDirectory(root)
+	require.NoError(t, err)
+
+	resolver, err := src.FileResolver(SquashedScope)
+	require.NoError(t, err)
+
+	images, err := FindNestedImages(resolver)
+	require.NoError(t, err)
+	require.Len(t, images, 2)
+
+	for _, img := range images {
+		t.Run(img.Location.RealPath, func(t *testing.T) {
+			nested, cleanup, err := img.Open(resolver)
+			t.Cleanup(cleanup)
+			require.NoError(t, err)
+
+			assert.Equal(t, ImageScheme, nested.Metadata.Scheme)
+			assert.Equal(t, img.Location.RealPath, nested.Metadata.ImageMetadata.UserInput)
+			assert.Len(t, nested.Metadata.ImageMetadata.Layers, 1)
+		})
+	}
+}
+
+func TestNestedImage_Open_RootLayout(t *testing.T) {
+	root := t.TempDir()
+
+	img, err := random.Image(256, 1)
+	require.NoError(t, err)
+
+	ociPath, err := layout.Write(root, empty.Index)
+	require.NoError(t, err)
+	require.NoError(t, ociPath.AppendImage(img))
+
+	src, err := NewFromDirectory(root)
+	require.NoError(t, err)
+
+	resolver, err := src.FileResolver(SquashedScope)
+	require.NoError(t, err)
+
+	images, err := FindNestedImages(resolver)
+	require.NoError(t, err)
+	require.Len(t, images, 1)
+
+	nested, cleanup, err := images[0].Open(resolver)
+	t.Cleanup(cleanup)
+	require.NoError(t, err)
+	assert.Len(t, nested.Metadata.ImageMetadata.Layers, 1)
+}
+
+func TestNestedImage_Open_RemoteSource(t *testing.T) {
+	// nested images are only ever read from the files of the parent source, never pulled
+	for _, imgSource := range []image.Source{image.OciRegistrySource, image.DockerDaemonSource} {
+		t.Run(imgSource.String(), func(t *testing.T) {
+			img := NestedImage{Location: NewLocation("/image"), Source: imgSource}
+			_, cleanup, err := img.Open(NewMockResolverForPaths())
+			t.Cleanup(cleanup)
+			assert.Error(t, err)
+		})
+	}
+}
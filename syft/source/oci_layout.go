@@ -0,0 +1,212 @@
+package source
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/anchore/stereoscope/pkg/file"
+	"github.com/anchore/stereoscope/pkg/image"
+	"github.com/anchore/syft/internal/log"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/layout"
+	"github.com/spf13/afero"
+)
+
+// ociRefNameAnnotation names a manifest within an OCI layout (e.g. the tag given to skopeo or buildkit).
+const ociRefNameAnnotation = "org.opencontainers.image.ref.name"
+
+// ociLayoutManifest is an image manifest within an OCI layout, either listed by the layout index itself or within a
+// nested index (e.g. the per-platform manifests of a multi-platform build).
+type ociLayoutManifest struct {
+	index      v1.ImageIndex // the index the manifest is listed by
+	descriptor v1.Descriptor
+	refName    string // the reference name of the manifest (or of the nested index it is listed by)
+}
+
+// String returns a description of the manifest for listing the manifests that could have been selected.
+func (m ociLayoutManifest) String() string {
+	description := m.descriptor.Digest.String()
+	if m.refName != "" {
+		description = m.refName + "@" + description
+	}
+	if p := m.descriptor.Platform; p != nil {
+		description += fmt.Sprintf(" (%s/%s)", p.OS, p.Architecture)
+	}
+	return description
+}
+
+// getOCILayoutImage reads an image from an OCI layout directory or OCI archive, where the location may name the
+// manifest to read (e.g. "./image:latest", as with the "oci:" transport of skopeo). When no manifest is named the
+// layout may still list several manifests (e.g. for a multi-platform build), in which case the manifest for the
+// platform syft is running on is selected.
+func getOCILayoutImage(location string, imageSource image.Source) (*image.Image, func(), error) {
+	tempDir, err := ioutil.TempDir("", "syft-oci-layout-")
+	if err != nil {
+		return nil, func() {}, fmt.Errorf("unable to create tempdir for OCI layout: %w", err)
+	}
+	cleanupFn := func() {
+		if err := os.RemoveAll(tempDir); err != nil {
+			log.Warnf("unable to cleanup OCI layout tempdir: %+v", err)
+		}
+	}
+
+	layoutPath, reference := splitOCILayoutReference(afero.NewOsFs(), location)
+	if imageSource == image.OciTarballSource {
+		archive, err := os.Open(layoutPath)
+		if err != nil {
+			return nil, cleanupFn, fmt.Errorf("unable to open OCI archive: %w", err)
+		}
+		defer archive.Close()
+
+		layoutPath = filepath.Join(tempDir, "layout")
+		if err := file.UntarToDirectory(archive, layoutPath); err != nil {
+			return nil, cleanupFn, fmt.Errorf("unable to unpack OCI archive: %w", err)
+		}
+	}
+
+	img, err := readOCILayoutImage(layoutPath, reference, filepath.Join(tempDir, "content"))
+	return img, cleanupFn, err
+}
+
+// splitOCILayoutReference splits the (optional) reference name of a manifest from the path of an OCI layout.
+func splitOCILayoutReference(fs afero.Fs, location string) (string, string) {
+	if _, err := fs.Stat(location); err == nil {
+		return location, ""
+	}
+	idx := strings.LastIndex(location, ":")
+	if idx <= 0 {
+		return location, ""
+	}
+	if _, err := fs.Stat(location[:idx]); err != nil {
+		return location, ""
+	}
+	return location[:idx], location[idx+1:]
+}
+
+// readOCILayoutImage reads the selected image from the OCI layout directory at the given path, keeping the image
+// content within the given directory.
+func readOCILayoutImage(layoutPath, reference, contentDir string) (*image.Image, error) {
+	index, err := layout.ImageIndexFromPath(layoutPath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read OCI layout index: %w", err)
+	}
+
+	manifests, err := ociLayoutManifests(index, "")
+	if err != nil {
+		return nil, err
+	}
+
+	platform := v1.Platform{OS: "linux", Architecture: runtime.GOARCH}
+	selected, err := selectOCILayoutManifest(manifests, reference, platform)
+	if err != nil {
+		return nil, err
+	}
+	log.Debugf("selected OCI layout manifest: %s", selected)
+
+	img, err := selected.index.Image(selected.descriptor.Digest)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read OCI layout manifest %s: %w", selected, err)
+	}
+
+	metadata := []image.AdditionalMetadata{
+		image.WithManifestDigest(selected.descriptor.Digest.String()),
+	}
+	if rawManifest, err := img.RawManifest(); err == nil {
+		metadata = append(metadata, image.WithManifest(rawManifest))
+	}
+
+	if err := os.MkdirAll(contentDir, 0700); err != nil {
+		return nil, fmt.Errorf("unable to create OCI layout content dir: %w", err)
+	}
+
+	result := image.NewImage(withoutForeignLayers(img), contentDir, metadata...)
+	if err := result.Read(); err != nil {
+		return nil, fmt.Errorf("could not read image: %w", err)
+	}
+	return result, nil
+}
+
+// ociLayoutManifests returns all image manifests listed by the given index, including those within nested indexes.
+func ociLayoutManifests(index v1.ImageIndex, refName string) ([]ociLayoutManifest, error) {
+	indexManifest, err := index.IndexManifest()
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse OCI layout index: %w", err)
+	}
+
+	var results []ociLayoutManifest
+	for _, descriptor := range indexManifest.Manifests {
+		name := refName
+		if annotation := descriptor.Annotations[ociRefNameAnnotation]; annotation != "" {
+			name = annotation
+		}
+
+		switch {
+		case descriptor.MediaType.IsIndex():
+			nested, err := index.ImageIndex(descriptor.Digest)
+			if err != nil {
+				return nil, fmt.Errorf("unable to read nested OCI index %s: %w", descriptor.Digest, err)
+			}
+			nestedManifests, err := ociLayoutManifests(nested, name)
+			if err != nil {
+				return nil, err
+			}
+			results = append(results, nestedManifests...)
+		case descriptor.MediaType.IsImage():
+			results = append(results, ociLayoutManifest{
+				index:      index,
+				descriptor: descriptor,
+				refName:    name,
+			})
+		}
+	}
+	return results, nil
+}
+
+// selectOCILayoutManifest selects the manifest with the given reference name (if any), picking the manifest for the
+// given platform when several manifests remain.
+func selectOCILayoutManifest(manifests []ociLayoutManifest, reference string, platform v1.Platform) (*ociLayoutManifest, error) {
+	var candidates []ociLayoutManifest
+	for _, m := range manifests {
+		if p := m.descriptor.Platform; p != nil && p.OS == "unknown" {
+			// not an image, but an attestation attached to one (e.g. the provenance written by buildkit)
+			continue
+		}
+		if reference != "" && m.refName != reference {
+			continue
+		}
+		candidates = append(candidates, m)
+	}
+
+	switch {
+	case len(candidates) == 1:
+		return &candidates[0], nil
+	case len(candidates) == 0 && reference != "":
+		return nil, fmt.Errorf("no OCI layout manifest with reference %q (found: %s)", reference, describeOCILayoutManifests(manifests))
+	case len(candidates) == 0:
+		return nil, fmt.Errorf("no image manifests found within the OCI layout")
+	}
+
+	// manifests without a platform may be for any platform (e.g. when copied into the layout by skopeo)
+	var matching []ociLayoutManifest
+	for _, m := range candidates {
+		if p := m.descriptor.Platform; p == nil || p.OS == platform.OS && p.Architecture == platform.Architecture {
+			matching = append(matching, m)
+		}
+	}
+	if len(matching) == 1 {
+		return &matching[0], nil
+	}
+	return nil, fmt.Errorf(`unable to select one of several OCI layout manifests for platform %s/%s, name the manifest to use by appending ":<reference>" to the path (found: %s)`, platform.OS, platform.Architecture, describeOCILayoutManifests(candidates))
+}
+
+func describeOCILayoutManifests(manifests []ociLayoutManifest) string {
+	var descriptions []string
+	for _, m := range manifests {
+		descriptions = append(descriptions, m.String())
+	}
+	return strings.Join(descriptions, ", ")
+}
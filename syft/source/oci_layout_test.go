@@ -0,0 +1,291 @@
+package source
+
+import (
+	"archive/tar"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/anchore/stereoscope/pkg/image"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/layout"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeOCILayoutFixture creates an OCI layout with a tagged single-platform image ("v1") and a tagged multi-platform
+// index ("v2") for the platform syft is running on and for one other platform, returning the path of the layout and
+// the images by a short name.
+func writeOCILayoutFixture(t *testing.T) (string, map[string]v1.Image) {
+	t.Helper()
+
+	images := make(map[string]v1.Image)
+	for _, name := range []string{"v1", "v2-native", "v2-other"} {
+		img, err := random.Image(256, 1)
+		require.NoError(t, err)
+		images[name] = img
+	}
+
+	otherArch := "s390x"
+	if runtime.GOARCH == otherArch {
+		otherArch = "ppc64le"
+	}
+
+	root := filepath.Join(t.TempDir(), "layout")
+	ociPath, err := layout.Write(root, empty.Index)
+	require.NoError(t, err)
+
+	require.NoError(t, ociPath.AppendImage(images["v1"], layout.WithAnnotations(map[string]string{ociRefNameAnnotation: "v1"})))
+
+	index := mutate.AppendManifests(empty.Index,
+		mutate.IndexAddendum{
+			Add:        images["v2-native"],
+			Descriptor: v1.Descriptor{Platform: &v1.Platform{OS: "linux", Architecture: runtime.GOARCH}},
+		},
+		mutate.IndexAddendum{
+			Add:        images["v2-other"],
+			Descriptor: v1.Descriptor{Platform: &v1.Platform{OS: "linux", Architecture: otherArch}},
+		},
+	)
+	require.NoError(t, ociPath.AppendIndex(index, layout.WithAnnotations(map[string]string{ociRefNameAnnotation: "v2"})))
+
+	return root, images
+}
+
+func TestGetOCILayoutImage(t *testing.T) {
+	root, images := writeOCILayoutFixture(t)
+
+	tests := []struct {
+		name     string
+		location string
+		expected string
+		wantErr  require.ErrorAssertionFunc
+	}{
+		{
+			name:     "single-platform manifest by reference",
+			location: root + ":v1",
+			expected: "v1",
+		},
+		{
+			name:     "multi-platform index by reference",
+			location: root + ":v2",
+			expected: "v2-native",
+		},
+		{
+			name:     "several manifests without a reference",
+			location: root,
+			wantErr:  require.Error,
+		},
+		{
+			name:     "unknown reference",
+			location: root + ":v3",
+			wantErr:  require.Error,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.wantErr == nil {
+				test.wantErr = require.NoError
+			}
+
+			img, cleanup, err := getOCILayoutImage(test.location, image.OciDirectorySource)
+			t.Cleanup(cleanup)
+			test.wantErr(t, err)
+			if err != nil {
+				return
+			}
+
+			expected, err := images[test.expected].Digest()
+			require.NoError(t, err)
+			assert.Equal(t, expected.String(), img.Metadata.ManifestDigest)
+		})
+	}
+}
+
+func TestGetOCILayoutImage_Archive(t *testing.T) {
+	root, images := writeOCILayoutFixture(t)
+	archivePath := filepath.Join(t.TempDir(), "image.tar")
+	writeTarFromDirectory(t, root, archivePath)
+
+	tests := []struct {
+		name     string
+		location string
+		expected string
+		wantErr  require.ErrorAssertionFunc
+	}{
+		{
+			name:     "single-platform manifest by reference",
+			location: archivePath + ":v1",
+			expected: "v1",
+		},
+		{
+			name:     "multi-platform index by reference",
+			location: archivePath + ":v2",
+			expected: "v2-native",
+		},
+		{
+			name:     "several manifests without a reference",
+			location: archivePath,
+			wantErr:  require.Error,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.wantErr == nil {
+				test.wantErr = require.NoError
+			}
+
+			img, cleanup, err := getOCILayoutImage(test.location, image.OciTarballSource)
+			t.Cleanup(cleanup)
+			test.wantErr(t, err)
+			if err != nil {
+				return
+			}
+
+			expected, err := images[test.expected].Digest()
+			require.NoError(t, err)
+			assert.Equal(t, expected.String(), img.Metadata.ManifestDigest)
+		})
+	}
+}
+
+// writeTarFromDirectory archives the contents of the given directory (as skopeo does for the "oci-archive:" transport).
+func writeTarFromDirectory(t *testing.T, dir, archivePath string) {
+	t.Helper()
+
+	archive, err := os.Create(archivePath)
+	require.NoError(t, err)
+	defer archive.Close()
+
+	tw := tar.NewWriter(archive)
+	require.NoError(t, filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		name, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(name)
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(tw, f)
+		return err
+	}))
+	require.NoError(t, tw.Close())
+}
+
+func TestSplitOCILayoutReference(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	require.NoError(t, fs.MkdirAll("/images/app", 0755))
+	require.NoError(t, fs.MkdirAll("/images/with:colon", 0755))
+
+	tests := []struct {
+		location          string
+		expectedPath      string
+		expectedReference string
+	}{
+		{location: "/images/app", expectedPath: "/images/app"},
+		{location: "/images/app:latest", expectedPath: "/images/app", expectedReference: "latest"},
+		{location: "/images/with:colon", expectedPath: "/images/with:colon"},
+		{location: "/images/missing:latest", expectedPath: "/images/missing:latest"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.location, func(t *testing.T) {
+			path, reference := splitOCILayoutReference(fs, test.location)
+			assert.Equal(t, test.expectedPath, path)
+			assert.Equal(t, test.expectedReference, reference)
+		})
+	}
+}
+
+func TestSelectOCILayoutManifest(t *testing.T) {
+	platform := v1.Platform{OS: "linux", Architecture: "amd64"}
+	amd64 := ociLayoutManifest{refName: "app", descriptor: v1.Descriptor{Platform: &v1.Platform{OS: "linux", Architecture: "amd64"}}}
+	arm64 := ociLayoutManifest{refName: "app", descriptor: v1.Descriptor{Platform: &v1.Platform{OS: "linux", Architecture: "arm64"}}}
+	s390x := ociLayoutManifest{refName: "app", descriptor: v1.Descriptor{Platform: &v1.Platform{OS: "linux", Architecture: "s390x"}}}
+	attestation := ociLayoutManifest{refName: "app", descriptor: v1.Descriptor{Platform: &v1.Platform{OS: "unknown", Architecture: "unknown"}}}
+	untagged := ociLayoutManifest{}
+
+	tests := []struct {
+		name      string
+		manifests []ociLayoutManifest
+		reference string
+		expected  *ociLayoutManifest
+		wantErr   require.ErrorAssertionFunc
+	}{
+		{
+			name:      "single manifest",
+			manifests: []ociLayoutManifest{untagged},
+			expected:  &untagged,
+		},
+		{
+			name:      "attestations are not images",
+			manifests: []ociLayoutManifest{arm64, attestation},
+			expected:  &arm64,
+		},
+		{
+			name:      "platform",
+			manifests: []ociLayoutManifest{arm64, amd64, attestation},
+			expected:  &amd64,
+		},
+		{
+			name:      "reference",
+			manifests: []ociLayoutManifest{untagged, arm64},
+			reference: "app",
+			expected:  &arm64,
+		},
+		{
+			name:      "manifest without a platform",
+			manifests: []ociLayoutManifest{arm64, untagged},
+			expected:  &untagged,
+		},
+		{
+			name:      "manifests with and without a platform",
+			manifests: []ociLayoutManifest{amd64, untagged},
+			wantErr:   require.Error,
+		},
+		{
+			name:      "no manifest for the platform",
+			manifests: []ociLayoutManifest{arm64, s390x},
+			wantErr:   require.Error,
+		},
+		{
+			name:    "no manifests",
+			wantErr: require.Error,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.wantErr == nil {
+				test.wantErr = require.NoError
+			}
+			actual, err := selectOCILayoutManifest(test.manifests, test.reference, platform)
+			test.wantErr(t, err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
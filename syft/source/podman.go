@@ -0,0 +1,195 @@
+package source
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/anchore/stereoscope/pkg/file"
+	"github.com/anchore/stereoscope/pkg/image"
+	"github.com/anchore/stereoscope/pkg/image/docker"
+	"github.com/anchore/syft/internal/log"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/client"
+	"github.com/spf13/afero"
+)
+
+const (
+	podmanScheme = "podman:"
+	// the sockets of a rootful podman service and of the docker daemon (as used by the docker client by default)
+	rootfulPodmanSocket = "/run/podman/podman.sock"
+	dockerSocket        = "/var/run/docker.sock"
+)
+
+// PodmanHost returns the address of the podman service that the image of the given input is read from: always for
+// "podman:" inputs, and for image references given without a scheme when no docker daemon is available but a podman
+// service is. An empty address is returned when the input is not read from the podman service.
+func PodmanHost(userInput string, imageSource image.Source) (string, error) {
+	return podmanInputHost(afero.NewOsFs(), userInput, imageSource)
+}
+
+func podmanInputHost(fs afero.Fs, userInput string, imageSource image.Source) (string, error) {
+	if strings.HasPrefix(userInput, podmanScheme) {
+		return podmanHost(fs, os.Getenv, os.Getuid())
+	}
+
+	// only image references without an explicit scheme fall back to the podman service (when the docker daemon is
+	// unavailable these are detected as registry references)
+	if imageSource != image.OciRegistrySource || strings.HasPrefix(userInput, "registry:") || !dockerUnavailable(fs) {
+		return "", nil
+	}
+	host, err := podmanHost(fs, os.Getenv, os.Getuid())
+	if err != nil {
+		log.Debugf("no docker daemon or podman service found: %+v", err)
+		return "", nil
+	}
+	log.Debugf("no docker daemon found, using the podman service at %q", host)
+	return host, nil
+}
+
+// getPodmanImage reads the given image from the podman service at the given address (pulling it first when the service
+// does not have it yet). The podman service provides a docker compatible API, so the image is saved with a docker client
+// for that address only (leaving the docker client configuration of the process untouched) and read as an archive.
+func getPodmanImage(host, location string) (*image.Image, func(), error) {
+	tempDirGen := file.NewTempDirGenerator()
+	cleanupFn := func() {
+		if err := tempDirGen.Cleanup(); err != nil {
+			log.Warnf("unable to cleanup podman image tempdir: %+v", err)
+		}
+	}
+
+	tempDir, err := tempDirGen.NewTempDir()
+	if err != nil {
+		return nil, cleanupFn, fmt.Errorf("unable to create tempdir for podman image: %w", err)
+	}
+
+	cli, err := client.NewClientWithOpts(client.WithHost(host), client.WithAPIVersionNegotiation())
+	if err != nil {
+		return nil, cleanupFn, fmt.Errorf("unable to create a client for the podman service at %q: %w", host, err)
+	}
+	defer cli.Close()
+
+	ctx := context.Background()
+	inspectResult, _, err := cli.ImageInspectWithRaw(ctx, location)
+	if client.IsErrNotFound(err) {
+		log.Infof("pulling image %q with the podman service", location)
+		if err = pullPodmanImage(ctx, cli, location); err != nil {
+			return nil, cleanupFn, err
+		}
+		inspectResult, _, err = cli.ImageInspectWithRaw(ctx, location)
+	}
+	if err != nil {
+		return nil, cleanupFn, fmt.Errorf("unable to inspect image with the podman service: %w", err)
+	}
+
+	archivePath := filepath.Join(tempDir, "image.tar")
+	if err := savePodmanImage(ctx, cli, location, archivePath); err != nil {
+		return nil, cleanupFn, err
+	}
+
+	img, err := docker.NewProviderFromTarball(archivePath, &tempDirGen, inspectResult.RepoTags, inspectResult.RepoDigests).Provide()
+	if err != nil {
+		return nil, cleanupFn, fmt.Errorf("unable to use podman image: %w", err)
+	}
+	if err := img.Read(); err != nil {
+		return nil, cleanupFn, fmt.Errorf("could not read image: %w", err)
+	}
+	return img, cleanupFn, nil
+}
+
+// pullPodmanImage pulls the given image with the podman service, reporting any error from the pull progress stream.
+func pullPodmanImage(ctx context.Context, cli *client.Client, location string) error {
+	reader, err := cli.ImagePull(ctx, location, types.ImagePullOptions{})
+	if err != nil {
+		return fmt.Errorf("unable to pull image with the podman service: %w", err)
+	}
+	defer reader.Close()
+
+	decoder := json.NewDecoder(reader)
+	for {
+		var message struct {
+			Error string `json:"error"`
+		}
+		err := decoder.Decode(&message)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("unable to read pull progress from the podman service: %w", err)
+		}
+		if message.Error != "" {
+			return fmt.Errorf("unable to pull image with the podman service: %s", message.Error)
+		}
+	}
+}
+
+// savePodmanImage saves the given image from the podman service as a docker archive at the given path.
+func savePodmanImage(ctx context.Context, cli *client.Client, location, archivePath string) error {
+	reader, err := cli.ImageSave(ctx, []string{location})
+	if err != nil {
+		return fmt.Errorf("unable to save image with the podman service: %w", err)
+	}
+	defer reader.Close()
+
+	archive, err := os.Create(archivePath)
+	if err != nil {
+		return fmt.Errorf("unable to create temp file for podman image: %w", err)
+	}
+	defer archive.Close()
+
+	nBytes, err := io.Copy(archive, reader)
+	if err != nil {
+		return fmt.Errorf("unable to save podman image to tar: %w", err)
+	}
+	if nBytes == 0 {
+		return fmt.Errorf("cannot read an empty image from the podman service")
+	}
+	return archive.Close()
+}
+
+// dockerUnavailable indicates that no docker daemon is configured and none is listening on the default socket.
+func dockerUnavailable(fs afero.Fs) bool {
+	if runtime.GOOS == "windows" || os.Getenv("DOCKER_HOST") != "" {
+		return false
+	}
+	_, err := fs.Stat(dockerSocket)
+	return err != nil
+}
+
+// podmanHost returns the address of the podman API service: CONTAINER_HOST (as used by the podman remote client) when
+// given, otherwise the socket of the rootless service of the current user, or the socket of the rootful service.
+func podmanHost(fs afero.Fs, getenv func(string) string, uid int) (string, error) {
+	if host := getenv("CONTAINER_HOST"); host != "" {
+		if strings.HasPrefix(host, "ssh://") {
+			return "", fmt.Errorf("podman connections over ssh are not supported (CONTAINER_HOST=%q), forward the remote socket and use a unix:// address instead", host)
+		}
+		return host, nil
+	}
+
+	runtimeDir := getenv("XDG_RUNTIME_DIR")
+	if runtimeDir == "" {
+		runtimeDir = fmt.Sprintf("/run/user/%d", uid)
+	}
+
+	candidates := []string{path.Join(runtimeDir, "podman", "podman.sock")}
+	if uid == 0 {
+		// the rootful service takes precedence for root (where the runtime dir is /run)
+		candidates = append([]string{rootfulPodmanSocket}, candidates...)
+	} else {
+		candidates = append(candidates, rootfulPodmanSocket)
+	}
+
+	for _, socket := range candidates {
+		if _, err := fs.Stat(socket); err == nil {
+			return "unix://" + socket, nil
+		}
+	}
+	return "", fmt.Errorf("no podman socket found (tried %s), start the service with \"systemctl --user start podman.socket\" or set CONTAINER_HOST", strings.Join(candidates, ", "))
+}
@@ -0,0 +1,134 @@
+package source
+
+import (
+	"testing"
+
+	"github.com/anchore/stereoscope/pkg/image"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPodmanHost(t *testing.T) {
+	tests := []struct {
+		name     string
+		env      map[string]string
+		uid      int
+		sockets  []string
+		expected string
+		wantErr  require.ErrorAssertionFunc
+	}{
+		{
+			name:     "container host",
+			env:      map[string]string{"CONTAINER_HOST": "unix:///tmp/podman.sock", "XDG_RUNTIME_DIR": "/run/user/1000"},
+			uid:      1000,
+			sockets:  []string{"/run/user/1000/podman/podman.sock"},
+			expected: "unix:///tmp/podman.sock",
+		},
+		{
+			name:    "container host over ssh",
+			env:     map[string]string{"CONTAINER_HOST": "ssh://core@localhost:2222/run/user/1000/podman/podman.sock"},
+			uid:     1000,
+			wantErr: require.Error,
+		},
+		{
+			name:     "rootless socket within the runtime dir",
+			env:      map[string]string{"XDG_RUNTIME_DIR": "/tmp/runtime"},
+			uid:      1000,
+			sockets:  []string{"/tmp/runtime/podman/podman.sock", rootfulPodmanSocket},
+			expected: "unix:///tmp/runtime/podman/podman.sock",
+		},
+		{
+			name:     "rootless socket within the default runtime dir",
+			uid:      1000,
+			sockets:  []string{"/run/user/1000/podman/podman.sock"},
+			expected: "unix:///run/user/1000/podman/podman.sock",
+		},
+		{
+			name:     "rootful socket without a rootless service",
+			env:      map[string]string{"XDG_RUNTIME_DIR": "/run/user/1000"},
+			uid:      1000,
+			sockets:  []string{rootfulPodmanSocket},
+			expected: "unix://" + rootfulPodmanSocket,
+		},
+		{
+			name:     "rootful socket for root",
+			uid:      0,
+			sockets:  []string{"/run/user/0/podman/podman.sock", rootfulPodmanSocket},
+			expected: "unix://" + rootfulPodmanSocket,
+		},
+		{
+			name:    "no socket",
+			env:     map[string]string{"XDG_RUNTIME_DIR": "/run/user/1000"},
+			uid:     1000,
+			wantErr: require.Error,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.wantErr == nil {
+				test.wantErr = require.NoError
+			}
+
+			fs := afero.NewMemMapFs()
+			for _, socket := range test.sockets {
+				_, err := fs.Create(socket)
+				require.NoError(t, err)
+			}
+
+			getenv := func(key string) string {
+				return test.env[key]
+			}
+
+			actual, err := podmanHost(fs, getenv, test.uid)
+			test.wantErr(t, err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestPodmanInputHost_DockerInputs(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		imageSource image.Source
+		sockets     []string
+	}{
+		{
+			name:        "explicit registry scheme",
+			input:       "registry:alpine:latest",
+			imageSource: image.OciRegistrySource,
+		},
+		{
+			name:        "docker daemon image",
+			input:       "docker:alpine:latest",
+			imageSource: image.DockerDaemonSource,
+		},
+		{
+			name:        "docker socket available",
+			input:       "alpine:latest",
+			imageSource: image.OciRegistrySource,
+			sockets:     []string{dockerSocket},
+		},
+		{
+			name:        "image archive",
+			input:       "docker-archive:image.tar",
+			imageSource: image.DockerTarballSource,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			for _, socket := range test.sockets {
+				_, err := fs.Create(socket)
+				require.NoError(t, err)
+			}
+
+			actual, err := podmanInputHost(fs, test.input, test.imageSource)
+			require.NoError(t, err)
+			assert.Empty(t, actual)
+		})
+	}
+}
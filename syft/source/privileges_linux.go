@@ -0,0 +1,53 @@
+//go:build linux
+// +build linux
+
+package source
+
+import (
+	"bufio"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	capDACOverride   = 1 // bypass all file permission checks
+	capDACReadSearch = 2 // bypass file read and directory read/search permission checks
+)
+
+// canReadAllFiles indicates if the process may read any file regardless of its permissions, either by running as root
+// or by having been granted the CAP_DAC_READ_SEARCH (or CAP_DAC_OVERRIDE) capability, e.g. with
+// "setcap cap_dac_read_search+ep /usr/local/bin/syft".
+func canReadAllFiles() bool {
+	if os.Geteuid() == 0 {
+		return true
+	}
+	capabilities, ok := effectiveCapabilities("/proc/self/status")
+	if !ok {
+		return false
+	}
+	return capabilities&(1<<capDACReadSearch) != 0 || capabilities&(1<<capDACOverride) != 0
+}
+
+// effectiveCapabilities reads the effective capability set from the given process status file.
+func effectiveCapabilities(statusPath string) (uint64, bool) {
+	f, err := os.Open(statusPath)
+	if err != nil {
+		return 0, false
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 || fields[0] != "CapEff:" {
+			continue
+		}
+		capabilities, err := strconv.ParseUint(fields[1], 16, 64)
+		if err != nil {
+			return 0, false
+		}
+		return capabilities, true
+	}
+	return 0, false
+}
@@ -0,0 +1,27 @@
+//go:build linux
+// +build linux
+
+package source
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEffectiveCapabilities(t *testing.T) {
+	statusPath := filepath.Join(t.TempDir(), "status")
+	status := "Name:\tsyft\nCapInh:\t0000000000000000\nCapPrm:\t0000000000000004\nCapEff:\t0000000000000004\n"
+	require.NoError(t, ioutil.WriteFile(statusPath, []byte(status), 0600))
+
+	capabilities, ok := effectiveCapabilities(statusPath)
+	assert.True(t, ok)
+	assert.NotZero(t, capabilities&(1<<capDACReadSearch))
+	assert.Zero(t, capabilities&(1<<capDACOverride))
+
+	_, ok = effectiveCapabilities(filepath.Join(t.TempDir(), "missing"))
+	assert.False(t, ok)
+}
@@ -0,0 +1,19 @@
+//go:build !linux
+// +build !linux
+
+package source
+
+import (
+	"os"
+	"runtime"
+)
+
+// canReadAllFiles indicates if the process may read any file regardless of its permissions (only root is known to,
+// since capabilities are specific to linux).
+func canReadAllFiles() bool {
+	if runtime.GOOS == WindowsOS {
+		// permissions are not modeled after unix users, so there is nothing to check up front
+		return true
+	}
+	return os.Geteuid() == 0
+}
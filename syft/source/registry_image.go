@@ -0,0 +1,57 @@
+package source
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/anchore/stereoscope/pkg/image"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/internal/registry"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+)
+
+// getRegistryImage pulls the given image directly from its registry (as stereoscope does), without fetching the
+// content of any non-distributable layers (see withoutForeignLayers).
+func getRegistryImage(location string, registryOptions *image.RegistryOptions) (*image.Image, func(), error) {
+	tempDir, err := ioutil.TempDir("", "syft-registry-image-")
+	if err != nil {
+		return nil, func() {}, fmt.Errorf("unable to create tempdir for registry image: %w", err)
+	}
+	cleanupFn := func() {
+		if err := os.RemoveAll(tempDir); err != nil {
+			log.Warnf("unable to cleanup registry image tempdir: %+v", err)
+		}
+	}
+
+	ref, err := name.ParseReference(location, registry.ReferenceOptions(registryOptions)...)
+	if err != nil {
+		return nil, cleanupFn, fmt.Errorf("unable to parse registry reference=%q: %w", location, err)
+	}
+
+	descriptor, err := remote.Get(ref, registry.RemoteOptions(ref.Context().Registry, registryOptions)...)
+	if err != nil {
+		return nil, cleanupFn, fmt.Errorf("failed to get image descriptor from registry: %w", err)
+	}
+
+	img, err := descriptor.Image()
+	if err != nil {
+		return nil, cleanupFn, fmt.Errorf("failed to get image from registry: %w", err)
+	}
+
+	// the descriptor is fetched from the registry, so its digest is the repo digest
+	repoDigest := fmt.Sprintf("%s/%s@%s", ref.Context().RegistryStr(), ref.Context().RepositoryStr(), descriptor.Digest.String())
+	metadata := []image.AdditionalMetadata{
+		image.WithRepoDigests([]string{repoDigest}),
+	}
+	if rawManifest, err := img.RawManifest(); err == nil {
+		metadata = append(metadata, image.WithManifest(rawManifest))
+	}
+
+	result := image.NewImage(withoutForeignLayers(img), tempDir, metadata...)
+	if err := result.Read(); err != nil {
+		return nil, cleanupFn, fmt.Errorf("could not read image: %w", err)
+	}
+	return result, cleanupFn, nil
+}
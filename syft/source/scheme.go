@@ -29,6 +29,12 @@ var AllSchemes = []Scheme{
 	FileScheme,
 }
 
+// DetectScheme determines the scheme, image source (for images), and location of the given user input, in the same
+// way as when creating a new Source.
+func DetectScheme(userInput string) (Scheme, image.Source, string, error) {
+	return detectScheme(afero.NewOsFs(), image.DetectSource, userInput)
+}
+
 func detectScheme(fs afero.Fs, imageDetector sourceDetector, userInput string) (Scheme, image.Source, string, error) {
 	switch {
 	case strings.HasPrefix(userInput, "dir:"):
@@ -38,6 +44,18 @@ func detectScheme(fs afero.Fs, imageDetector sourceDetector, userInput string) (
 		}
 		return DirectoryScheme, image.UnknownSource, dirLocation, nil
 
+	case isHostInput(userInput):
+		// the root filesystem of the local host
+		return DirectoryScheme, image.UnknownSource, hostRoot(userInput), nil
+
+	case isSSHInput(userInput):
+		// a directory on a remote host (the location is parsed further when creating the source)
+		return DirectoryScheme, image.UnknownSource, userInput, nil
+
+	case strings.HasPrefix(userInput, podmanScheme):
+		// an image from the podman service, which is read through its docker-compatible API
+		return ImageScheme, image.DockerDaemonSource, strings.TrimPrefix(userInput, podmanScheme), nil
+
 	case strings.HasPrefix(userInput, "file:"):
 		fileLocation, err := homedir.Expand(strings.TrimPrefix(userInput, "file:"))
 		if err != nil {
@@ -175,6 +175,26 @@ func TestDetectScheme(t *testing.T) {
 			expectedScheme:   FileScheme,
 			expectedLocation: "some/path-to-file",
 		},
+		{
+			name:      "ssh-remote-dir",
+			userInput: "ssh://user@example.com/var/lib",
+			detection: detectorResult{
+				src: image.UnknownSource,
+				ref: "",
+			},
+			expectedScheme:   DirectoryScheme,
+			expectedLocation: "ssh://user@example.com/var/lib",
+		},
+		{
+			name:      "podman-image-explicit-scheme",
+			userInput: "podman:wagoodman/dive:latest",
+			detection: detectorResult{
+				src: image.DockerDaemonSource,
+				ref: "wagoodman/dive:latest",
+			},
+			expectedScheme:   ImageScheme,
+			expectedLocation: "wagoodman/dive:latest",
+		},
 		{
 			name:      "explicit-current-dir",
 			userInput: "dir:.",
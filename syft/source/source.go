@@ -27,9 +27,16 @@ type Source struct {
 	Image             *image.Image // the image object to be cataloged (image only)
 	Metadata          Metadata
 	directoryResolver *directoryResolver
+	sshTarget         *sshTarget   // the remote host and path to catalog (ssh directory only)
+	sshResolver       *sshResolver // the resolver for the remote directory (ssh directory only)
+	sshCloser         func()
+	ignoreWarnings    []Warning              // the patterns applied from the ignore file (directory only)
+	imageResolvers    map[Scope]FileResolver // resolvers are kept per scope so all catalogers share the same file index
 	path              string
 	mutex             *sync.Mutex
 	Exclusions        []string
+	Directory         DirectoryConfig // traversal options used when indexing a directory source
+	SSH               SSHOptions      // connection options for a remote directory source (ssh:// user input)
 }
 
 type sourceDetector func(string) (image.Source, string, error)
@@ -49,15 +56,33 @@ func New(userInput string, registryOptions *image.RegistryOptions, exclusions []
 	case FileScheme:
 		source, cleanupFn, err = generateFileSource(fs, location)
 	case DirectoryScheme:
+		if isSSHInput(location) {
+			source, cleanupFn, err = generateSSHSource(location)
+			break
+		}
+		if isHostInput(userInput) {
+			source, cleanupFn, err = generateHostSource(fs, location)
+			break
+		}
 		source, cleanupFn, err = generateDirectorySource(fs, location)
 	case ImageScheme:
+		var podman string
+		podman, err = podmanInputHost(fs, userInput, imageSource)
+		if err != nil {
+			err = fmt.Errorf("unable to find the podman service for input=%q: %w", userInput, err)
+			break
+		}
+		if podman != "" {
+			source, cleanupFn, err = generatePodmanImageSource(podman, location)
+			break
+		}
 		source, cleanupFn, err = generateImageSource(userInput, location, imageSource, registryOptions)
 	default:
 		err = fmt.Errorf("unable to process input for scanning: '%s'", userInput)
 	}
 
 	if err == nil {
-		source.Exclusions = exclusions
+		source.Exclusions = append(source.Exclusions, exclusions...)
 	}
 
 	return source, cleanupFn, err
@@ -77,6 +102,20 @@ func generateImageSource(userInput, location string, imageSource image.Source, r
 	return &s, cleanup, nil
 }
 
+func generatePodmanImageSource(host, location string) (*Source, func(), error) {
+	img, cleanup, err := getPodmanImage(host, location)
+	if err != nil || img == nil {
+		return &Source{}, cleanup, fmt.Errorf("could not fetch image '%s': %w", location, err)
+	}
+
+	s, err := NewFromImage(img, location)
+	if err != nil {
+		return &Source{}, cleanup, fmt.Errorf("could not populate source with image: %w", err)
+	}
+
+	return &s, cleanup, nil
+}
+
 func parseScheme(userInput string) string {
 	parts := strings.SplitN(userInput, ":", 2)
 	if len(parts) < 2 {
@@ -87,11 +126,18 @@ func parseScheme(userInput string) string {
 }
 
 func getImageWithRetryStrategy(userInput, location string, imageSource image.Source, registryOptions *image.RegistryOptions) (*image.Image, func(), error) {
-	img, err := stereoscope.GetImageFromSource(location, imageSource, registryOptions)
+	switch imageSource {
+	case image.OciDirectorySource, image.OciTarballSource:
+		// OCI layouts may list several manifests, which stereoscope does not select between
+		return getOCILayoutImage(location, imageSource)
+	}
+
+	img, cleanup, err := getImage(location, imageSource, registryOptions)
 	if err == nil {
 		// Success on the first try!
-		return img, stereoscope.Cleanup, nil
+		return img, cleanup, nil
 	}
+	cleanup()
 
 	scheme := parseScheme(userInput)
 	if !(scheme == "docker" || scheme == "registry") {
@@ -117,11 +163,26 @@ func getImageWithRetryStrategy(userInput, location string, imageSource image.Sou
 	// We need to determine the image source again, such that this determination
 	// doesn't take scheme parsing into account.
 	imageSource = image.DetermineImagePullSource(userInput)
-	img, err = stereoscope.GetImageFromSource(userInput, imageSource, registryOptions)
+	img, cleanup, err = getImage(userInput, imageSource, registryOptions)
 	if err != nil {
+		cleanup()
 		return nil, nil, err
 	}
 
+	return img, cleanup, nil
+}
+
+// getImage reads the image from the given source. Images pulled from a registry skip the content of non-distributable
+// layers (e.g. the base layers of Windows images), which stereoscope would otherwise fail to fetch.
+func getImage(location string, imageSource image.Source, registryOptions *image.RegistryOptions) (*image.Image, func(), error) {
+	if imageSource == image.OciRegistrySource {
+		return getRegistryImage(location, registryOptions)
+	}
+	img, err := stereoscope.GetImageFromSource(location, imageSource, registryOptions)
+	if err != nil {
+		// the stereoscope cleanup removes the content of all images read so far, not only this one
+		return nil, func() {}, err
+	}
 	return img, stereoscope.Cleanup, nil
 }
 
@@ -153,6 +214,10 @@ func generateFileSource(fs afero.Fs, location string) (*Source, func(), error) {
 		return &Source{}, func() {}, fmt.Errorf("given path is not a directory (path=%q): %w", location, err)
 	}
 
+	if isVirtualDiskFile(location) {
+		return &Source{}, func() {}, fmt.Errorf("virtual hard disk files are not supported (path=%q): mount the disk and catalog the mounted directory instead", location)
+	}
+
 	s, cleanupFn := NewFromFile(location)
 
 	return &s, cleanupFn, nil
@@ -193,6 +258,17 @@ func fileAnalysisPath(path string) (string, func()) {
 	// if the given file is an archive (as indicated by the file extension and not MIME type) then unarchive it and
 	// use the contents as the source. Note: this does NOT recursively unarchive contents, only the given path is
 	// unarchived.
+	if isWindowsImageFile(path) {
+		unwimPath, tmpCleanup, err := unWimToTmp(path)
+		if err != nil {
+			log.Warnf("wim file could not be extracted: %+v", err)
+		} else {
+			log.Debugf("source path is a wim file")
+			analysisPath = unwimPath
+		}
+		return analysisPath, tmpCleanup
+	}
+
 	envelopedUnarchiver, err := archiver.ByExtension(path)
 	if unarchiver, ok := envelopedUnarchiver.(archiver.Unarchiver); err == nil && ok {
 		unarchivedPath, tmpCleanup, err := unarchiveToTmp(path, unarchiver)
@@ -219,6 +295,7 @@ func NewFromImage(img *image.Image, userImageStr string) (Source, error) {
 
 	return Source{
 		Image: img,
+		mutex: &sync.Mutex{},
 		Metadata: Metadata{
 			Scheme:        ImageScheme,
 			ImageMetadata: NewImageMetadata(img, userImageStr),
@@ -231,12 +308,28 @@ func (s *Source) FileResolver(scope Scope) (FileResolver, error) {
 	case DirectoryScheme, FileScheme:
 		s.mutex.Lock()
 		defer s.mutex.Unlock()
+		if s.sshTarget != nil {
+			return s.remoteFileResolver()
+		}
 		if s.directoryResolver == nil {
 			exclusionFunctions, err := getDirectoryExclusionFunctions(s.path, s.Exclusions)
 			if err != nil {
 				return nil, err
 			}
-			resolver, err := newDirectoryResolver(s.path, exclusionFunctions...)
+			traversalFunctions, err := getDirectoryTraversalFunctions(s.path, s.Directory)
+			if err != nil {
+				return nil, err
+			}
+			if s.Metadata.Scheme == DirectoryScheme && !s.Directory.SkipIgnoreFile {
+				// repository owners may describe what should not be cataloged with an ignore file at the scan root
+				ignoreFunctions, ignoreWarnings, err := getIgnoreFileFunctions(s.path)
+				if err != nil {
+					return nil, err
+				}
+				exclusionFunctions = append(exclusionFunctions, ignoreFunctions...)
+				s.ignoreWarnings = ignoreWarnings
+			}
+			resolver, err := newDirectoryResolverWithConfig(s.path, s.Directory, append(exclusionFunctions, traversalFunctions...)...)
 			if err != nil {
 				return nil, err
 			}
@@ -244,18 +337,30 @@ func (s *Source) FileResolver(scope Scope) (FileResolver, error) {
 		}
 		return s.directoryResolver, nil
 	case ImageScheme:
-		var resolver FileResolver
-		var err error
-		switch scope {
-		case SquashedScope:
-			resolver, err = newImageSquashResolver(s.Image)
-		case AllLayersScope:
-			resolver, err = newAllLayersResolver(s.Image)
-		default:
-			return nil, fmt.Errorf("bad image scope provided: %+v", scope)
-		}
-		if err != nil {
-			return nil, err
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+		resolver, ok := s.imageResolvers[scope]
+		if !ok {
+			var err error
+			switch scope {
+			case SquashedScope:
+				resolver, err = newImageSquashResolver(s.Image)
+			case AllLayersScope:
+				resolver, err = newAllLayersResolver(s.Image)
+			default:
+				return nil, fmt.Errorf("bad image scope provided: %+v", scope)
+			}
+			if err != nil {
+				return nil, err
+			}
+			if isWindowsImage(s.Image) {
+				// windows layers nest the container filesystem within a "Files" directory alongside registry hives
+				resolver = newWindowsImageResolver(resolver)
+			}
+			if s.imageResolvers == nil {
+				s.imageResolvers = make(map[Scope]FileResolver)
+			}
+			s.imageResolvers[scope] = resolver
 		}
 		// image tree contains all paths, so we filter out the excluded entries afterwards
 		if len(s.Exclusions) > 0 {
@@ -0,0 +1,262 @@
+package source
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/mitchellh/go-homedir"
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+const sshInputPrefix = "ssh://"
+
+// default private keys to try (relative to the user home dir) when no explicit key file has been given
+var defaultSSHKeyFiles = []string{
+	".ssh/id_ed25519",
+	".ssh/id_ecdsa",
+	".ssh/id_rsa",
+}
+
+// SSHOptions captures the options for connecting to a remote host for an ssh:// source.
+type SSHOptions struct {
+	KeyFile               string // the private key to authenticate with (defaults to the ssh agent and ~/.ssh/id_* keys)
+	KnownHostsFile        string // the known hosts file to verify the host key against (default: ~/.ssh/known_hosts)
+	InsecureIgnoreHostKey bool   // do not verify the host key of the remote host
+}
+
+// sshTarget is a parsed ssh://[user[:password]@]host[:port]/path user input.
+type sshTarget struct {
+	user     string
+	password string
+	address  string
+	path     string
+}
+
+func isSSHInput(userInput string) bool {
+	return strings.HasPrefix(userInput, sshInputPrefix)
+}
+
+func parseSSHTarget(userInput string) (*sshTarget, error) {
+	u, err := url.Parse(userInput)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse ssh source: %w", err)
+	}
+	if u.Hostname() == "" {
+		return nil, fmt.Errorf("no host given for ssh source: %q", userInput)
+	}
+
+	target := sshTarget{
+		address: u.Host,
+		path:    path.Clean("/" + u.Path),
+	}
+
+	if u.Port() == "" {
+		target.address = net.JoinHostPort(u.Hostname(), "22")
+	}
+
+	if u.User != nil {
+		target.user = u.User.Username()
+		target.password, _ = u.User.Password()
+	}
+	if target.user == "" {
+		target.user = os.Getenv("USER")
+	}
+
+	return &target, nil
+}
+
+// String returns the target without any credentials.
+func (t sshTarget) String() string {
+	return fmt.Sprintf("%s%s@%s%s", sshInputPrefix, t.user, t.address, t.path)
+}
+
+// generateSSHSource creates a source for a directory on a remote host. The connection is deferred until the
+// file resolver is first requested.
+func generateSSHSource(userInput string) (*Source, func(), error) {
+	target, err := parseSSHTarget(userInput)
+	if err != nil {
+		return &Source{}, func() {}, err
+	}
+
+	s, err := NewFromDirectory(target.String())
+	if err != nil {
+		return &Source{}, func() {}, err
+	}
+	s.path = target.path
+	s.sshTarget = target
+
+	src := &s
+	return src, src.closeSSH, nil
+}
+
+// dialSFTP connects to the given target and opens an SFTP session on top of the connection.
+func dialSFTP(target sshTarget, opts SSHOptions) (*sftp.Client, func(), error) {
+	hostKeyCallback, err := sshHostKeyCallback(opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	authMethods, agentConn := sshAuthMethods(target, opts)
+	closeAgent := func() {
+		if agentConn == nil {
+			return
+		}
+		if err := agentConn.Close(); err != nil {
+			log.Debugf("unable to close ssh agent connection: %+v", err)
+		}
+	}
+
+	config := &ssh.ClientConfig{
+		User:            target.user,
+		Auth:            authMethods,
+		HostKeyCallback: hostKeyCallback,
+	}
+
+	log.Debugf("connecting to %s", target)
+	conn, err := ssh.Dial("tcp", target.address, config)
+	if err != nil {
+		closeAgent()
+		return nil, nil, fmt.Errorf("unable to connect to %s: %w", target, err)
+	}
+
+	client, err := sftp.NewClient(conn)
+	if err != nil {
+		conn.Close()
+		closeAgent()
+		return nil, nil, fmt.Errorf("unable to start sftp session with %s: %w", target, err)
+	}
+
+	closer := func() {
+		if err := client.Close(); err != nil {
+			log.Debugf("unable to close sftp session: %+v", err)
+		}
+		if err := conn.Close(); err != nil {
+			log.Debugf("unable to close ssh connection: %+v", err)
+		}
+		closeAgent()
+	}
+
+	return client, closer, nil
+}
+
+func sshHostKeyCallback(opts SSHOptions) (ssh.HostKeyCallback, error) {
+	if opts.InsecureIgnoreHostKey {
+		log.Warn("host key verification is disabled for the ssh source")
+		return ssh.InsecureIgnoreHostKey(), nil // nolint:gosec
+	}
+
+	knownHostsFile := opts.KnownHostsFile
+	if knownHostsFile == "" {
+		home, err := homedir.Dir()
+		if err != nil {
+			return nil, fmt.Errorf("unable to determine known hosts file: %w", err)
+		}
+		knownHostsFile = filepath.Join(home, ".ssh", "known_hosts")
+	}
+
+	callback, err := knownhosts.New(knownHostsFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read known hosts file=%q: %w", knownHostsFile, err)
+	}
+	return callback, nil
+}
+
+// sshAuthMethods returns the methods to authenticate with, along with the connection to the SSH agent (if any), which
+// must be closed by the caller once the SSH connection is no longer needed.
+func sshAuthMethods(target sshTarget, opts SSHOptions) ([]ssh.AuthMethod, net.Conn) {
+	var methods []ssh.AuthMethod
+
+	var signers []ssh.Signer
+	if opts.KeyFile != "" {
+		if signer, err := readSSHKey(opts.KeyFile); err != nil {
+			log.Warnf("unable to use ssh key file=%q: %+v", opts.KeyFile, err)
+		} else {
+			signers = append(signers, signer)
+		}
+	} else if home, err := homedir.Dir(); err == nil {
+		for _, keyFile := range defaultSSHKeyFiles {
+			if signer, err := readSSHKey(filepath.Join(home, keyFile)); err == nil {
+				signers = append(signers, signer)
+			}
+		}
+	}
+	if len(signers) > 0 {
+		methods = append(methods, ssh.PublicKeys(signers...))
+	}
+
+	var agentConn net.Conn
+	if socket := os.Getenv("SSH_AUTH_SOCK"); socket != "" {
+		conn, err := net.Dial("unix", socket)
+		if err == nil {
+			agentConn = conn
+			methods = append(methods, ssh.PublicKeysCallback(agent.NewClient(conn).Signers))
+		} else {
+			log.Debugf("unable to connect to ssh agent: %+v", err)
+		}
+	}
+
+	if target.password != "" {
+		methods = append(methods, ssh.Password(target.password))
+	}
+
+	return methods, agentConn
+}
+
+func readSSHKey(keyFile string) (ssh.Signer, error) {
+	contents, err := ioutil.ReadFile(keyFile)
+	if err != nil {
+		return nil, err
+	}
+	return ssh.ParsePrivateKey(contents)
+}
+
+// remoteFileResolver connects to the remote host and indexes the remote directory (only on first use).
+// note: the caller must hold the source mutex.
+func (s *Source) remoteFileResolver() (FileResolver, error) {
+	if s.sshResolver != nil {
+		return s.sshResolver, nil
+	}
+
+	exclusionFunctions, err := getDirectoryExclusionFunctions(s.path, s.Exclusions)
+	if err != nil {
+		return nil, err
+	}
+	traversalFunctions, err := getDirectoryTraversalFunctions(s.path, s.Directory)
+	if err != nil {
+		return nil, err
+	}
+
+	client, closer, err := dialSFTP(*s.sshTarget, s.SSH)
+	if err != nil {
+		return nil, err
+	}
+	s.sshCloser = closer
+
+	resolver, err := newSSHResolver(client, s.path, append(exclusionFunctions, traversalFunctions...)...)
+	if err != nil {
+		return nil, err
+	}
+	// note: the glob index is built on first use, so the path matching options still apply
+	resolver.globIndex.matching = s.Directory.globMatchOptions()
+	s.sshResolver = resolver
+	return resolver, nil
+}
+
+func (s *Source) closeSSH() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.sshCloser != nil {
+		s.sshCloser()
+		s.sshCloser = nil
+	}
+}
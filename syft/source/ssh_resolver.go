@@ -0,0 +1,278 @@
+package source
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/anchore/stereoscope/pkg/file"
+	"github.com/anchore/stereoscope/pkg/filetree"
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/pkg/sftp"
+)
+
+var _ FileResolver = (*sshResolver)(nil)
+
+// sshResolver implements path and content access for a directory on a remote host over SFTP. The remote tree is
+// indexed once up front, where file contents are only fetched when requested.
+type sshResolver struct {
+	client         *sftp.Client
+	root           string
+	fileTree       *filetree.FileTree
+	globIndex      *globIndex
+	metadata       map[file.ID]FileMetadata
+	pathFilterFns  []pathFilterFn
+	errPaths       map[string]error
+	refsByMIMEType map[string][]file.Reference
+	classifyOnce   sync.Once
+}
+
+func newSSHResolver(client *sftp.Client, root string, pathFilters ...pathFilterFn) (*sshResolver, error) {
+	resolver := sshResolver{
+		client:         client,
+		root:           path.Clean(root),
+		fileTree:       filetree.NewFileTree(),
+		metadata:       make(map[file.ID]FileMetadata),
+		pathFilterFns:  append([]pathFilterFn{isUnallowableFileType, isUnixSystemRuntimePath}, pathFilters...),
+		errPaths:       make(map[string]error),
+		refsByMIMEType: make(map[string][]file.Reference),
+	}
+
+	if err := resolver.indexTree(); err != nil {
+		return nil, err
+	}
+	resolver.globIndex = newGlobIndex(resolver.fileTree, globMatchOptions{})
+
+	return &resolver, nil
+}
+
+func (r *sshResolver) indexTree() error {
+	log.Debugf("indexing remote path=%q", r.root)
+
+	stager, prog := indexingProgress(r.root)
+	defer prog.SetCompleted()
+
+	walker := r.client.Walk(r.root)
+	for walker.Step() {
+		p := walker.Path()
+		stager.Current = p
+
+		if err := walker.Err(); err != nil {
+			if p == r.root {
+				return fmt.Errorf("unable to index remote path=%q: %w", p, err)
+			}
+			log.Warnf("unable to access remote path=%q: %+v", p, err)
+			r.errPaths[p] = err
+			continue
+		}
+
+		info := walker.Stat()
+		if r.isFiltered(p, info) {
+			if info.IsDir() {
+				walker.SkipDir()
+			}
+			continue
+		}
+
+		if err := r.addPathToIndex(p, info); err != nil {
+			log.Warnf("unable to index remote path=%q: %+v", p, err)
+			r.errPaths[p] = err
+		}
+	}
+	return nil
+}
+
+func (r *sshResolver) isFiltered(p string, info os.FileInfo) bool {
+	for _, filterFn := range r.pathFilterFns {
+		if filterFn != nil && filterFn(p, info) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *sshResolver) addPathToIndex(p string, info os.FileInfo) error {
+	var ref *file.Reference
+	var err error
+	var linkDestination string
+
+	switch t := newFileTypeFromMode(info.Mode()); t {
+	case SymbolicLink:
+		linkDestination, err = r.client.ReadLink(p)
+		if err != nil {
+			return fmt.Errorf("unable to readlink: %w", err)
+		}
+		if !path.IsAbs(linkDestination) {
+			linkDestination = path.Join(path.Dir(p), linkDestination)
+		}
+		ref, err = r.fileTree.AddSymLink(file.Path(p), file.Path(linkDestination))
+	case Directory:
+		ref, err = r.fileTree.AddDir(file.Path(p))
+	case RegularFile:
+		ref, err = r.fileTree.AddFile(file.Path(p))
+	default:
+		return fmt.Errorf("unsupported file type: %s", t)
+	}
+	if err != nil {
+		return err
+	}
+
+	if ref != nil {
+		metadata := FileMetadata{
+			Mode:            info.Mode(),
+			Type:            newFileTypeFromMode(info.Mode()),
+			UserID:          -1,
+			GroupID:         -1,
+			LinkDestination: linkDestination,
+			Size:            info.Size(),
+		}
+		if stat, ok := info.Sys().(*sftp.FileStat); ok {
+			metadata.UserID = int(stat.UID)
+			metadata.GroupID = int(stat.GID)
+		}
+		r.metadata[ref.ID()] = metadata
+	}
+	return nil
+}
+
+func (r *sshResolver) requestPath(userPath string) string {
+	// don't allow input to potentially hop above root path
+	return path.Join(r.root, path.Clean("/"+userPath))
+}
+
+func (r *sshResolver) responsePath(p string) string {
+	// always return references relative to the root path (not absolute path), like the directory resolver
+	if r.root == "/" {
+		return strings.TrimPrefix(p, "/")
+	}
+	return strings.TrimPrefix(p, r.root+"/")
+}
+
+// HasPath indicates if the given path exists in the underlying source.
+func (r *sshResolver) HasPath(userPath string) bool {
+	return r.fileTree.HasPath(file.Path(r.requestPath(userPath)))
+}
+
+// Stringer to represent a remote directory path data source
+func (r *sshResolver) String() string {
+	return fmt.Sprintf("ssh:%s", r.root)
+}
+
+// FilesByPath returns all file.References that match the given paths from the remote directory.
+func (r *sshResolver) FilesByPath(userPaths ...string) ([]Location, error) {
+	var references = make([]Location, 0)
+
+	for _, userPath := range userPaths {
+		exists, ref, err := r.fileTree.File(file.Path(r.requestPath(userPath)), filetree.FollowBasenameLinks)
+		if err != nil || !exists || ref == nil {
+			continue
+		}
+		if metadata, ok := r.metadata[ref.ID()]; ok && metadata.Type == Directory {
+			continue
+		}
+		references = append(references, NewLocationFromDirectory(r.responsePath(string(ref.RealPath)), *ref))
+	}
+
+	return references, nil
+}
+
+// FilesByGlob returns all file.References that match the given path glob pattern from the remote directory.
+func (r *sshResolver) FilesByGlob(patterns ...string) ([]Location, error) {
+	result := make([]Location, 0)
+
+	for _, pattern := range patterns {
+		if !strings.HasPrefix(pattern, "**") {
+			// patterns are relative to the root path (recursive patterns match anywhere within the indexed tree already)
+			pattern = path.Join(r.root, pattern)
+		}
+		globResults, err := r.globIndex.FilesByGlob(pattern)
+		if err != nil {
+			return nil, err
+		}
+		for _, globResult := range globResults {
+			result = append(result, NewLocationFromDirectory(r.responsePath(string(globResult.MatchPath)), globResult.Reference))
+		}
+	}
+
+	return result, nil
+}
+
+// RelativeFileByPath fetches a single file at the given path. For the sshResolver, this is a simple path lookup.
+func (r *sshResolver) RelativeFileByPath(_ Location, p string) *Location {
+	paths, err := r.FilesByPath(p)
+	if err != nil || len(paths) == 0 {
+		return nil
+	}
+	return &paths[0]
+}
+
+// FileContentsByLocation fetches file contents for a single file reference from the remote host.
+func (r *sshResolver) FileContentsByLocation(location Location) (io.ReadCloser, error) {
+	if location.ref.RealPath == "" {
+		return nil, errors.New("empty path given")
+	}
+	if !r.fileTree.HasPath(location.ref.RealPath, filetree.FollowBasenameLinks) {
+		return nil, fmt.Errorf("file content is inaccessible path=%q", location.ref.RealPath)
+	}
+	return r.client.Open(string(location.ref.RealPath))
+}
+
+func (r *sshResolver) AllLocations() <-chan Location {
+	results := make(chan Location)
+	go func() {
+		defer close(results)
+		for _, ref := range r.fileTree.AllFiles() {
+			results <- NewLocationFromDirectory(r.responsePath(string(ref.RealPath)), ref)
+		}
+	}()
+	return results
+}
+
+func (r *sshResolver) FileMetadataByLocation(location Location) (FileMetadata, error) {
+	metadata, exists := r.metadata[location.ref.ID()]
+	if !exists {
+		return FileMetadata{}, fmt.Errorf("location: %+v : %w", location, os.ErrNotExist)
+	}
+	return metadata, nil
+}
+
+// FilesByMIMEType returns all files that have been classified as one of the given MIME types. Since classifying a file
+// requires reading it over the network, only executable files are classified (which is what catalogers search for).
+func (r *sshResolver) FilesByMIMEType(types ...string) ([]Location, error) {
+	r.classifyOnce.Do(r.classifyExecutables)
+
+	var locations []Location
+	for _, ty := range types {
+		for _, ref := range r.refsByMIMEType[ty] {
+			locations = append(locations, NewLocationFromDirectory(r.responsePath(string(ref.RealPath)), ref))
+		}
+	}
+	return locations, nil
+}
+
+func (r *sshResolver) classifyExecutables() {
+	for _, ref := range r.fileTree.AllFiles() {
+		metadata, ok := r.metadata[ref.ID()]
+		if !ok || metadata.Type != RegularFile || metadata.Mode&0111 == 0 {
+			continue
+		}
+
+		f, err := r.client.Open(string(ref.RealPath))
+		if err != nil {
+			log.Debugf("unable to classify remote file=%q: %+v", ref.RealPath, err)
+			continue
+		}
+		metadata.MIMEType = file.MIMEType(f)
+		internal.CloseAndLogError(f, string(ref.RealPath))
+
+		r.metadata[ref.ID()] = metadata
+		if metadata.MIMEType != "" {
+			r.refsByMIMEType[metadata.MIMEType] = append(r.refsByMIMEType[metadata.MIMEType], ref)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package source
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSSHAuthMethods_AgentConnection(t *testing.T) {
+	socket := filepath.Join(t.TempDir(), "agent.sock")
+	listener, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	original, set := os.LookupEnv("SSH_AUTH_SOCK")
+	require.NoError(t, os.Setenv("SSH_AUTH_SOCK", socket))
+	t.Cleanup(func() {
+		if set {
+			os.Setenv("SSH_AUTH_SOCK", original)
+		} else {
+			os.Unsetenv("SSH_AUTH_SOCK")
+		}
+	})
+
+	methods, agentConn := sshAuthMethods(sshTarget{password: "secret"}, SSHOptions{KeyFile: "missing"})
+	require.NotNil(t, agentConn)
+	assert.Len(t, methods, 2)
+
+	// the agent connection is handed to the caller, so it can be closed along with the ssh connection
+	server, err := listener.Accept()
+	require.NoError(t, err)
+	defer server.Close()
+
+	require.NoError(t, agentConn.Close())
+	_, err = server.Read(make([]byte, 1))
+	assert.Error(t, err)
+}
@@ -0,0 +1,54 @@
+package source
+
+import (
+	"sort"
+)
+
+// Warning describes a non-fatal problem found while cataloging a source, such as a file that could not be read or
+// was skipped, that may have caused the results to be incomplete.
+type Warning struct {
+	Path    string // the path the warning applies to (optional)
+	Message string
+}
+
+// Warnings returns the problems found while indexing the source (e.g. paths that could not be accessed, paths excluded
+// by an ignore file, or image layers that were skipped), sorted by path.
+func (s *Source) Warnings() []Warning {
+	if s.mutex != nil {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+	}
+
+	var warnings []Warning
+	warnings = append(warnings, s.ignoreWarnings...)
+	if s.directoryResolver != nil {
+		warnings = append(warnings, errPathWarnings(s.directoryResolver.errPaths)...)
+	}
+	if s.sshResolver != nil {
+		warnings = append(warnings, errPathWarnings(s.sshResolver.errPaths)...)
+	}
+	warnings = append(warnings, foreignLayerWarnings(s.Image)...)
+	SortWarnings(warnings)
+	return warnings
+}
+
+// SortWarnings orders the given warnings by path and then by message.
+func SortWarnings(warnings []Warning) {
+	sort.SliceStable(warnings, func(i, j int) bool {
+		if warnings[i].Path != warnings[j].Path {
+			return warnings[i].Path < warnings[j].Path
+		}
+		return warnings[i].Message < warnings[j].Message
+	})
+}
+
+func errPathWarnings(errPaths map[string]error) []Warning {
+	var warnings []Warning
+	for p, err := range errPaths {
+		warnings = append(warnings, Warning{
+			Path:    p,
+			Message: "unable to access path: " + err.Error(),
+		})
+	}
+	return warnings
+}
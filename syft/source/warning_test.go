@@ -0,0 +1,28 @@
+package source
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSource_Warnings(t *testing.T) {
+	src := Source{
+		mutex: &sync.Mutex{},
+		directoryResolver: &directoryResolver{
+			errPaths: map[string]error{
+				"/root/b": errors.New("permission denied"),
+				"/root/a": errors.New("no such file or directory"),
+			},
+		},
+	}
+
+	assert.Equal(t, []Warning{
+		{Path: "/root/a", Message: "unable to access path: no such file or directory"},
+		{Path: "/root/b", Message: "unable to access path: permission denied"},
+	}, src.Warnings())
+
+	assert.Empty(t, (&Source{mutex: &sync.Mutex{}}).Warnings())
+}
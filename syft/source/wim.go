@@ -0,0 +1,135 @@
+package source
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/internal/wim"
+)
+
+// windowsImageExtensions are Windows imaging format files (e.g. the install.wim found on installer media) that are
+// extracted and cataloged like any other archive. Only uncompressed and LZX compressed images can be read, so ESD files
+// (which are LZMS compressed) are not included.
+var windowsImageExtensions = []string{".wim"}
+
+// virtualDiskExtensions are Windows virtual hard disk files, which contain partitioned NTFS volumes.
+var virtualDiskExtensions = []string{".vhd", ".vhdx"}
+
+func hasAnyExtension(path string, extensions ...string) bool {
+	ext := strings.ToLower(filepath.Ext(path))
+	for _, e := range extensions {
+		if ext == e {
+			return true
+		}
+	}
+	return false
+}
+
+func isWindowsImageFile(path string) bool {
+	return hasAnyExtension(path, windowsImageExtensions...)
+}
+
+func isVirtualDiskFile(path string) bool {
+	return hasAnyExtension(path, virtualDiskExtensions...)
+}
+
+// unWimToTmp extracts the first image within the given WIM file to a temp dir. WIM files may hold several images
+// (e.g. one per Windows edition), however, these largely share the same content and are costly to extract.
+func unWimToTmp(path string) (string, func(), error) {
+	tempDir, err := ioutil.TempDir("", "syft-wim-contents-")
+	if err != nil {
+		return "", func() {}, fmt.Errorf("unable to create tempdir for wim processing: %w", err)
+	}
+
+	cleanupFn := func() {
+		if err := os.RemoveAll(tempDir); err != nil {
+			log.Warnf("unable to cleanup wim tempdir: %+v", err)
+		}
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return tempDir, cleanupFn, fmt.Errorf("unable to open wim file: %w", err)
+	}
+	defer f.Close()
+
+	reader, err := wim.NewReader(f)
+	if err != nil {
+		return tempDir, cleanupFn, fmt.Errorf("unable to read wim file: %w", err)
+	}
+	defer reader.Close()
+
+	if len(reader.Image) == 0 {
+		return tempDir, cleanupFn, fmt.Errorf("no images found within wim file")
+	}
+
+	img := reader.Image[0]
+	if len(reader.Image) > 1 {
+		log.Debugf("wim file contains %d images, only cataloging the first (index=%d name=%q)", len(reader.Image), img.Index, img.Name)
+	}
+
+	root, err := img.Open()
+	if err != nil {
+		return tempDir, cleanupFn, fmt.Errorf("unable to open wim image: %w", err)
+	}
+
+	return tempDir, cleanupFn, extractWimDir(root, tempDir)
+}
+
+func extractWimDir(dir *wim.File, dest string) error {
+	entries, err := dir.Readdir()
+	if err != nil {
+		return fmt.Errorf("unable to read wim directory %q: %w", dest, err)
+	}
+
+	for _, entry := range entries {
+		// guard against entries that would escape the destination directory
+		if entry.Name == "" || entry.Name == "." || entry.Name == ".." || strings.ContainsAny(entry.Name, `/\`) {
+			log.Warnf("skipping wim entry with unexpected name: %q", entry.Name)
+			continue
+		}
+		target := filepath.Join(dest, entry.Name)
+
+		switch {
+		case entry.IsDir():
+			if err := os.Mkdir(target, 0755); err != nil {
+				return err
+			}
+			if err := extractWimDir(entry, target); err != nil {
+				return err
+			}
+		case entry.ReparseTag != 0:
+			// reparse points (symlinks, junctions, dedup stubs) do not have content to catalog
+			log.Debugf("skipping wim reparse point: %q", target)
+		default:
+			if err := extractWimFile(entry, target); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func extractWimFile(entry *wim.File, target string) error {
+	reader, err := entry.Open()
+	if err != nil {
+		return fmt.Errorf("unable to open wim file %q: %w", target, err)
+	}
+	defer reader.Close()
+
+	f, err := os.Create(target)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(f, reader); err != nil {
+		return fmt.Errorf("unable to extract wim file %q: %w", target, err)
+	}
+	return nil
+}
@@ -0,0 +1,183 @@
+package source
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/binary"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWindowsImageFileExtensions(t *testing.T) {
+	tests := []struct {
+		path        string
+		isImage     bool
+		isDiskImage bool
+	}{
+		{path: "sources/install.wim", isImage: true},
+		{path: "sources/INSTALL.WIM", isImage: true},
+		{path: "sources/install.esd"},
+		{path: "golden.vhd", isDiskImage: true},
+		{path: "golden.VHDX", isDiskImage: true},
+		{path: "ubuntu.iso"},
+		{path: "wim"},
+	}
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			assert.Equal(t, test.isImage, isWindowsImageFile(test.path))
+			assert.Equal(t, test.isDiskImage, isVirtualDiskFile(test.path))
+		})
+	}
+}
+
+func TestNewFromVirtualDiskFails(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "golden.vhdx")
+	require.NoError(t, ioutil.WriteFile(path, []byte("vhdxfile"), 0644))
+
+	_, cleanup, err := New("file:"+path, nil, nil)
+	if cleanup != nil {
+		t.Cleanup(cleanup)
+	}
+	assert.Error(t, err)
+}
+
+func TestUnWimToTmp(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "install.wim")
+	writeWIMFixture(t, path, "Windows", "win.ini", []byte("; for 16-bit app support\n"))
+
+	dir, cleanup, err := unWimToTmp(path)
+	t.Cleanup(cleanup)
+	require.NoError(t, err)
+
+	contents, err := ioutil.ReadFile(filepath.Join(dir, "Windows", "win.ini"))
+	require.NoError(t, err)
+	assert.Equal(t, "; for 16-bit app support\n", string(contents))
+}
+
+func TestNewFromWindowsImageFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "install.wim")
+	writeWIMFixture(t, path, "Windows", "win.ini", []byte("[fonts]\n"))
+
+	src, cleanup, err := New("file:"+path, nil, nil)
+	if cleanup != nil {
+		t.Cleanup(cleanup)
+	}
+	require.NoError(t, err)
+
+	resolver, err := src.FileResolver(SquashedScope)
+	require.NoError(t, err)
+	locations, err := resolver.FilesByPath("/Windows/win.ini")
+	require.NoError(t, err)
+	assert.Len(t, locations, 1)
+}
+
+// writeWIMFixture writes an uncompressed WIM file holding a single image, with a single file within a single directory.
+func writeWIMFixture(t *testing.T, path, dirName, fileName string, contents []byte) {
+	t.Helper()
+
+	const (
+		headerSize        = 208
+		directoryAttr     = 0x10
+		normalAttr        = 0x80
+		noSecurityID      = 0xffffffff
+		metadataResFlag   = 2 << 56
+		streamEntrySize   = 50
+		securityBlockSize = 8
+	)
+
+	// a directory entry: its length, the fixed size fields, the (UTF-16) name with a null terminator, padded to 8 bytes
+	dirEntry := func(attributes uint32, subdirOffset int64, hash [20]byte, name string) []byte {
+		encodedName := utf16.Encode([]rune(name))
+		length := int64(110 + 2*len(encodedName) + 2)
+		length = (length + 7) &^ 7
+
+		buf := &bytes.Buffer{}
+		write := func(v interface{}) { require.NoError(t, binary.Write(buf, binary.LittleEndian, v)) }
+		write(length)
+		write(attributes)
+		write(uint32(noSecurityID))
+		write(subdirOffset)
+		write(make([]byte, 16+24)) // unused fields and timestamps
+		write(hash)
+		write(make([]byte, 4+8)) // padding and reparse/hard link
+		write(uint16(0))         // stream count
+		write(uint16(0))         // short name length
+		write(uint16(2 * len(encodedName)))
+		write(encodedName)
+		buf.Write(make([]byte, length-int64(buf.Len())))
+		return buf.Bytes()
+	}
+
+	fileHash := sha1.Sum(contents)
+
+	// metadata: an empty security block, the root directory, then the contents of the root and of the subdirectory
+	// (each terminated by an empty entry)
+	rootEntry := dirEntry(directoryAttr, 0, [20]byte{}, "")
+	rootChildrenOffset := int64(securityBlockSize+len(rootEntry)) + 8
+	subdirEntry := dirEntry(directoryAttr, 0, [20]byte{}, dirName)
+	subdirChildrenOffset := rootChildrenOffset + int64(len(subdirEntry)) + 8
+
+	metadata := &bytes.Buffer{}
+	require.NoError(t, binary.Write(metadata, binary.LittleEndian, [2]uint32{securityBlockSize, 0}))
+	metadata.Write(dirEntry(directoryAttr, rootChildrenOffset, [20]byte{}, ""))
+	metadata.Write(make([]byte, 8))
+	metadata.Write(dirEntry(directoryAttr, subdirChildrenOffset, [20]byte{}, dirName))
+	metadata.Write(make([]byte, 8))
+	metadata.Write(dirEntry(normalAttr, 0, fileHash, fileName))
+	metadata.Write(make([]byte, 8))
+
+	xmlData := &bytes.Buffer{}
+	require.NoError(t, binary.Write(xmlData, binary.LittleEndian, utf16.Encode([]rune("\ufeff<WIM><IMAGE INDEX=\"1\"><NAME>fixture</NAME></IMAGE></WIM>"))))
+
+	// layout: header, file contents, metadata, offset table, xml data
+	contentsOffset := int64(headerSize)
+	metadataOffset := contentsOffset + int64(len(contents))
+	offsetTableOffset := metadataOffset + int64(metadata.Len())
+	xmlOffset := offsetTableOffset + 2*streamEntrySize
+
+	offsetTable := &bytes.Buffer{}
+	writeStream := func(flagsAndSize uint64, offset int64, size int64, hash [20]byte) {
+		require.NoError(t, binary.Write(offsetTable, binary.LittleEndian, flagsAndSize))
+		require.NoError(t, binary.Write(offsetTable, binary.LittleEndian, offset))
+		require.NoError(t, binary.Write(offsetTable, binary.LittleEndian, size))
+		require.NoError(t, binary.Write(offsetTable, binary.LittleEndian, uint16(1))) // part number
+		require.NoError(t, binary.Write(offsetTable, binary.LittleEndian, uint32(1))) // reference count
+		require.NoError(t, binary.Write(offsetTable, binary.LittleEndian, hash))
+	}
+	writeStream(uint64(len(contents)), contentsOffset, int64(len(contents)), fileHash)
+	writeStream(metadataResFlag|uint64(metadata.Len()), metadataOffset, int64(metadata.Len()), sha1.Sum(metadata.Bytes()))
+
+	resource := func(offset int64, size int) []uint64 {
+		return []uint64{uint64(size), uint64(offset), uint64(size)}
+	}
+
+	header := &bytes.Buffer{}
+	writeHeader := func(v interface{}) { require.NoError(t, binary.Write(header, binary.LittleEndian, v)) }
+	writeHeader([]byte("MSWIM\x00\x00\x00"))
+	writeHeader(uint32(headerSize))
+	writeHeader(uint32(0x10d00)) // version
+	writeHeader(uint32(0))       // flags (uncompressed)
+	writeHeader(uint32(0x8000))  // compression chunk size
+	writeHeader(make([]byte, 16))
+	writeHeader(uint16(1)) // part number
+	writeHeader(uint16(1)) // total parts
+	writeHeader(uint32(1)) // image count
+	writeHeader(resource(offsetTableOffset, offsetTable.Len()))
+	writeHeader(resource(xmlOffset, xmlData.Len()))
+	writeHeader(make([]byte, 24)) // boot metadata
+	writeHeader(uint32(0))        // boot index
+	writeHeader(make([]byte, 24)) // integrity table
+	writeHeader(make([]byte, 60))
+	require.Equal(t, headerSize, header.Len())
+
+	var wim []byte
+	for _, part := range [][]byte{header.Bytes(), contents, metadata.Bytes(), offsetTable.Bytes(), xmlData.Bytes()} {
+		wim = append(wim, part...)
+	}
+	require.NoError(t, ioutil.WriteFile(path, wim, 0644))
+}
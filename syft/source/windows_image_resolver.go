@@ -0,0 +1,86 @@
+package source
+
+import (
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/anchore/stereoscope/pkg/image"
+)
+
+// windowsFilesPrefix is the directory within each Windows container image layer that holds the container filesystem.
+// Sibling directories hold registry hive deltas ("Hives") and the Hyper-V utility VM filesystem ("UtilityVM"), neither
+// of which are part of the filesystem seen by the container.
+const windowsFilesPrefix = "/Files"
+
+var windowsDriveRegexp = regexp.MustCompile(`^[a-zA-Z]:`)
+
+var _ FileResolver = (*windowsImageResolver)(nil)
+
+// windowsImageResolver decorates an image resolver for Windows container images, such that paths are requested
+// relative to the container filesystem (e.g. "C:\Windows\System32" or "/Windows/System32") instead of relative to
+// the layer layout (e.g. "/Files/Windows/System32"). Any paths outside of the container filesystem are hidden.
+type windowsImageResolver struct {
+	FileResolver
+}
+
+func newWindowsImageResolver(delegate FileResolver) FileResolver {
+	return &windowsImageResolver{
+		FileResolver: NewExcludingResolver(delegate, func(p string) bool {
+			// note: locations may not have a virtual path, which should not be considered for exclusion
+			return p != "" && !isWithinWindowsFiles(p)
+		}),
+	}
+}
+
+// isWindowsImage indicates if the given image was built for the Windows OS.
+func isWindowsImage(img *image.Image) bool {
+	return img != nil && strings.EqualFold(img.Metadata.Config.OS, "windows")
+}
+
+func (r *windowsImageResolver) HasPath(p string) bool {
+	return r.FileResolver.HasPath(windowsLayerPath(p))
+}
+
+func (r *windowsImageResolver) FilesByPath(paths ...string) ([]Location, error) {
+	layerPaths := make([]string, len(paths))
+	for idx, p := range paths {
+		layerPaths[idx] = windowsLayerPath(p)
+	}
+	return r.FileResolver.FilesByPath(layerPaths...)
+}
+
+func (r *windowsImageResolver) FilesByGlob(patterns ...string) ([]Location, error) {
+	layerPatterns := make([]string, len(patterns))
+	for idx, p := range patterns {
+		if strings.HasPrefix(p, "**") {
+			// recursive patterns already match anywhere within the layer layout (excluded paths are filtered)
+			layerPatterns[idx] = p
+			continue
+		}
+		layerPatterns[idx] = windowsLayerPath(p)
+	}
+	return r.FileResolver.FilesByGlob(layerPatterns...)
+}
+
+func (r *windowsImageResolver) RelativeFileByPath(location Location, p string) *Location {
+	return r.FileResolver.RelativeFileByPath(location, windowsLayerPath(p))
+}
+
+// windowsLayerPath converts a path within the container filesystem to a path within the layer layout, normalizing
+// drive letters and backslash separators along the way (e.g. "C:\Windows" becomes "/Files/Windows").
+func windowsLayerPath(p string) string {
+	p = windowsDriveRegexp.ReplaceAllString(p, "")
+	p = strings.ReplaceAll(p, `\`, "/")
+	if !strings.HasPrefix(p, "/") {
+		p = "/" + p
+	}
+	if isWithinWindowsFiles(p) {
+		return p
+	}
+	return path.Join(windowsFilesPrefix, p)
+}
+
+func isWithinWindowsFiles(p string) bool {
+	return p == windowsFilesPrefix || strings.HasPrefix(p, windowsFilesPrefix+"/")
+}
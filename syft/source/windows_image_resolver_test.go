@@ -0,0 +1,66 @@
+package source
+
+import (
+	"testing"
+
+	"github.com/anchore/stereoscope/pkg/image"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_windowsLayerPath(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: "/Windows/System32/kernel32.dll", expected: "/Files/Windows/System32/kernel32.dll"},
+		{input: `C:\Windows\System32\kernel32.dll`, expected: "/Files/Windows/System32/kernel32.dll"},
+		{input: `c:/Program Files/app/app.exe`, expected: "/Files/Program Files/app/app.exe"},
+		{input: "Windows/win.ini", expected: "/Files/Windows/win.ini"},
+		{input: "/Files/Windows/win.ini", expected: "/Files/Windows/win.ini"},
+		{input: "/Filesystem/thing", expected: "/Files/Filesystem/thing"},
+	}
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			assert.Equal(t, test.expected, windowsLayerPath(test.input))
+		})
+	}
+}
+
+func TestWindowsImageResolver(t *testing.T) {
+	resolver := newWindowsImageResolver(NewMockResolverForPaths(
+		"/Files/Windows/win.ini",
+		"/Files/Program Files/app/packages.config",
+		"/Hives/Software_Delta",
+		"/UtilityVM/Files/Windows/win.ini",
+	))
+
+	assert.True(t, resolver.HasPath(`C:\Windows\win.ini`))
+	assert.False(t, resolver.HasPath("/Hives/Software_Delta"))
+
+	locations, err := resolver.FilesByPath("/Windows/win.ini")
+	require.NoError(t, err)
+	require.Len(t, locations, 1)
+	assert.Equal(t, "/Files/Windows/win.ini", locations[0].RealPath)
+
+	locations, err = resolver.FilesByGlob("**/win.ini", "/Program Files/**/*.config")
+	require.NoError(t, err)
+	var actual []string
+	for _, l := range locations {
+		actual = append(actual, l.RealPath)
+	}
+	assert.ElementsMatch(t, []string{"/Files/Windows/win.ini", "/Files/Program Files/app/packages.config"}, actual)
+
+	actual = nil
+	for l := range resolver.AllLocations() {
+		actual = append(actual, l.RealPath)
+	}
+	assert.ElementsMatch(t, []string{"/Files/Windows/win.ini", "/Files/Program Files/app/packages.config"}, actual)
+}
+
+func Test_isWindowsImage(t *testing.T) {
+	assert.False(t, isWindowsImage(nil))
+	assert.False(t, isWindowsImage(&image.Image{Metadata: image.Metadata{Config: v1.ConfigFile{OS: "linux"}}}))
+	assert.True(t, isWindowsImage(&image.Image{Metadata: image.Metadata{Config: v1.ConfigFile{OS: "windows"}}}))
+}
@@ -21,8 +21,23 @@ func TestAllFormatsExpressible(t *testing.T) {
 
 	for _, o := range format.AllOptions {
 		t.Run(fmt.Sprintf("format:%s", o), func(t *testing.T) {
-			cmd, stdout, stderr := runSyft(t, nil, "dir:./test-fixtures/image-pkg-coverage", "-o", string(o))
-			for _, traitFn := range commonAssertions {
+			args := []string{"dir:./test-fixtures/image-pkg-coverage", "-o", string(o)}
+			var env map[string]string
+			assertions := commonAssertions
+			switch o {
+			case format.TemplateOption:
+				args = append(args, "-t", "./test-fixtures/csv.template")
+			case format.SARIFOption:
+				// only file findings are reported, which are not cataloged by default
+				args = []string{"dir:./test-fixtures/image-secrets", "-o", string(o)}
+				env = map[string]string{"SYFT_SECRETS_CATALOGER_ENABLED": "true"}
+				assertions = []traitAssertion{
+					assertInOutput(`"ruleId": "secret/generic-api-key"`),
+					assertSuccessfulReturnCode,
+				}
+			}
+			cmd, stdout, stderr := runSyft(t, env, args...)
+			for _, traitFn := range assertions {
 				traitFn(t, stdout, stderr, cmd.ProcessState.ExitCode())
 			}
 			if t.Failed() {
@@ -2,7 +2,9 @@ package cli
 
 import (
 	"fmt"
+	"io/ioutil"
 	"path"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -71,6 +73,31 @@ func TestJSONSchema(t *testing.T) {
 	}
 }
 
+func TestValidateCmd(t *testing.T) {
+	_, stdout, stderr := runSyft(t, nil, "packages", "dir:test-fixtures/image-pkg-coverage", "-q", "-o", "json")
+	if len(strings.Trim(stdout, "\n ")) < 100 {
+		t.Fatalf("bad syft run:\noutput: %q\n:error: %q", stdout, stderr)
+	}
+
+	dir := t.TempDir()
+	valid := filepath.Join(dir, "valid.json")
+	if err := ioutil.WriteFile(valid, []byte(stdout), 0600); err != nil {
+		t.Fatal(err)
+	}
+	invalid := filepath.Join(dir, "invalid.json")
+	if err := ioutil.WriteFile(invalid, []byte(strings.Replace(stdout, `"artifacts": [`, `"artifacts": "bogus", "other": [`, 1)), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd, stdout, stderr := runSyft(t, nil, "validate", valid)
+	assertSuccessfulReturnCode(t, stdout, stderr, cmd.ProcessState.ExitCode())
+	assertInOutput(fmt.Sprintf("valid (syft JSON schema version %s)", internal.JSONSchemaVersion))(t, stdout, stderr, cmd.ProcessState.ExitCode())
+
+	cmd, stdout, stderr = runSyft(t, nil, "validate", valid, invalid)
+	assertFailingReturnCode(t, stdout, stderr, cmd.ProcessState.ExitCode())
+	assertInOutput("1 of 2 document(s) failed validation")(t, stdout, stderr, cmd.ProcessState.ExitCode())
+}
+
 func validateJsonAgainstSchema(t testing.TB, json string) {
 	fullSchemaPath := path.Join(repoRoot(t), jsonSchemaPath, fmt.Sprintf("schema-%s.json", internal.JSONSchemaVersion))
 	schemaLoader := gojsonschema.NewReferenceLoader(fmt.Sprintf("file://%s", fullSchemaPath))
@@ -174,6 +174,7 @@ func TestPackagesCmdFlags(t *testing.T) {
 			env: map[string]string{
 				"SYFT_PACKAGE_SEARCH_UNINDEXED_ARCHIVES": "true",
 				"SYFT_PACKAGE_SEARCH_INDEXED_ARCHIVES":   "false",
+				"SYFT_PACKAGE_SEARCH_SOURCE_MAPS":        "true",
 			},
 			assertions: []traitAssertion{
 				// the application config in the log matches that of what we expect to have been configured. Note:
@@ -181,6 +182,7 @@ func TestPackagesCmdFlags(t *testing.T) {
 				// package-cataloger-level options.
 				assertInOutput("search-unindexed-archives: true"),
 				assertInOutput("search-indexed-archives: false"),
+				assertInOutput("search-source-maps: true"),
 			},
 		},
 	}
@@ -63,6 +63,13 @@ var imageOnlyTestCases = []testCase{
 }
 
 var dirOnlyTestCases = []testCase{
+	{
+		name:    "find buildroot packages",
+		pkgType: pkg.BuildrootPkg,
+		pkgInfo: map[string]string{
+			"zlib": "1.2.13",
+		},
+	},
 	{
 		name:        "find gemfile packages",
 		pkgType:     pkg.GemPkg,
@@ -189,6 +196,43 @@ var dirOnlyTestCases = []testCase{
 }
 
 var commonTestCases = []testCase{
+	{
+		name:        "find PECL/PEAR packages and PHP extensions",
+		pkgType:     pkg.PhpPeclPkg,
+		pkgLanguage: pkg.PHP,
+		pkgInfo: map[string]string{
+			"redis": "5.3.7",
+		},
+	},
+	{
+		name:    "find static libraries",
+		pkgType: pkg.StaticLibraryPkg,
+		pkgInfo: map[string]string{
+			"widget": "2.0.1",
+		},
+	},
+	{
+		name:    "find language runtimes",
+		pkgType: pkg.RuntimePkg,
+		pkgInfo: map[string]string{
+			"openjdk": "17.0.1",
+			"node":    "16.13.1",
+		},
+	},
+	{
+		name:    "find opkg packages",
+		pkgType: pkg.OpkgPkg,
+		pkgInfo: map[string]string{
+			"busybox": "1.35.0-r0",
+		},
+	},
+	{
+		name:    "find yocto license manifest packages",
+		pkgType: pkg.YoctoPkg,
+		pkgInfo: map[string]string{
+			"base-files": "3.0.14",
+		},
+	},
 	{
 		name:    "find rpmdb packages",
 		pkgType: pkg.RpmPkg,
@@ -37,7 +37,7 @@ func BenchmarkImagePackageCatalogers(b *testing.B) {
 
 		b.Run(c.Name(), func(b *testing.B) {
 			for i := 0; i < b.N; i++ {
-				pc, _, err = cataloger.Catalog(resolver, theDistro, c)
+				pc, _, _, err = cataloger.Catalog(resolver, theDistro, c)
 				if err != nil {
 					b.Fatalf("failure during benchmark: %+v", err)
 				}
@@ -71,6 +71,9 @@ func TestPkgCoverageImage(t *testing.T) {
 	definedPkgs.Remove(string(pkg.KbPkg))
 	definedPkgs.Remove(string(pkg.GoModulePkg))
 	definedPkgs.Remove(string(pkg.RustPkg))
+	definedPkgs.Remove(string(pkg.BuildrootPkg))
+	// web server modules are compiled shared objects, which are not part of the fixture
+	definedPkgs.Remove(string(pkg.WebServerModulePkg))
 
 	var cases []testCase
 	cases = append(cases, commonTestCases...)
@@ -198,6 +201,8 @@ func TestPkgCoverageDirectory(t *testing.T) {
 
 	// for directory scans we should not expect to see any of the following package types
 	definedPkgs.Remove(string(pkg.KbPkg))
+	// web server modules are compiled shared objects, which are not part of the fixture
+	definedPkgs.Remove(string(pkg.WebServerModulePkg))
 
 	// ensure that integration test commonTestCases stay in sync with the available catalogers
 	if len(observedLanguages) < len(definedLanguages) {
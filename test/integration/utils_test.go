@@ -25,7 +25,7 @@ func catalogFixtureImage(t *testing.T, fixtureImageName string) (sbom.SBOM, *sou
 	// TODO: this would be better with functional options (after/during API refactor)
 	c := cataloger.DefaultConfig()
 	c.Search.Scope = source.SquashedScope
-	pkgCatalog, relationships, actualDistro, err := syft.CatalogPackages(theSource, c)
+	pkgCatalog, relationships, actualDistro, warnings, err := syft.CatalogPackages(theSource, c)
 	if err != nil {
 		t.Fatalf("failed to catalog image: %+v", err)
 	}
@@ -34,6 +34,7 @@ func catalogFixtureImage(t *testing.T, fixtureImageName string) (sbom.SBOM, *sou
 		Artifacts: sbom.Artifacts{
 			PackageCatalog: pkgCatalog,
 			Distro:         actualDistro,
+			Warnings:       warnings,
 		},
 		Relationships: relationships,
 		Source:        theSource.Metadata,
@@ -59,7 +60,7 @@ func catalogDirectory(t *testing.T, dir string) (sbom.SBOM, *source.Source) {
 	// TODO: this would be better with functional options (after/during API refactor)
 	c := cataloger.DefaultConfig()
 	c.Search.Scope = source.AllLayersScope
-	pkgCatalog, relationships, actualDistro, err := syft.CatalogPackages(theSource, c)
+	pkgCatalog, relationships, actualDistro, warnings, err := syft.CatalogPackages(theSource, c)
 	if err != nil {
 		t.Fatalf("failed to catalog image: %+v", err)
 	}
@@ -68,6 +69,7 @@ func catalogDirectory(t *testing.T, dir string) (sbom.SBOM, *source.Source) {
 		Artifacts: sbom.Artifacts{
 			PackageCatalog: pkgCatalog,
 			Distro:         actualDistro,
+			Warnings:       warnings,
 		},
 		Relationships: relationships,
 		Source:        theSource.Metadata,